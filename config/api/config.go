@@ -7,6 +7,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/invopop/validation"
@@ -19,6 +20,10 @@ type Config struct {
 	Postgres      PostgresConfig
 	Observability ObservabilityConfig
 	Redis         RedisConfig
+	Bank          BankConfig
+	Worker        WorkerConfig
+	Attendance    AttendanceConfig
+	Payroll       PayrollConfig
 }
 
 func (c Config) Validate() error {
@@ -28,6 +33,10 @@ func (c Config) Validate() error {
 		validation.Field(&c.Postgres),
 		validation.Field(&c.Observability),
 		validation.Field(&c.Redis),
+		validation.Field(&c.Bank),
+		validation.Field(&c.Worker),
+		validation.Field(&c.Attendance),
+		validation.Field(&c.Payroll),
 	)
 }
 
@@ -112,6 +121,81 @@ func (rc RedisConfig) Validate() error {
 	)
 }
 
+// BankConfig is the company account salaries are paid from.
+type BankConfig struct {
+	DebtorName     string `mapstructure:"debtor_name"`
+	DebtorAccount  string `mapstructure:"debtor_account"`
+	DebtorBankCode string `mapstructure:"debtor_bank_code"`
+	DebtorBIC      string `mapstructure:"debtor_bic"`
+	Currency       string `mapstructure:"currency"`
+}
+
+// Validate skips an empty section, deployments that never export bank files
+// leave it out and the export is refused instead.
+func (bc BankConfig) Validate() error {
+	if bc == (BankConfig{}) {
+		return nil
+	}
+
+	return validation.ValidateStruct(&bc,
+		validation.Field(&bc.DebtorName, validation.Required, validation.Length(1, 70)),
+		validation.Field(&bc.DebtorAccount, validation.Required, validation.Length(4, 34)),
+		validation.Field(&bc.DebtorBankCode, validation.Required, validation.Length(1, 11)),
+		validation.Field(&bc.DebtorBIC, validation.Length(8, 11)),
+		validation.Field(&bc.Currency, validation.Required, validation.Length(3, 3)),
+	)
+}
+
+// WorkerConfig sizes the background workers of an instance. An instance with
+// no payroll workers only queues payroll jobs for the other instances to run.
+type WorkerConfig struct {
+	PayrollConcurrency int64         `mapstructure:"payroll_concurrency"`
+	PollInterval       time.Duration `mapstructure:"poll_interval"`
+}
+
+func (wc WorkerConfig) Validate() error {
+	return validation.ValidateStruct(&wc,
+		validation.Field(&wc.PayrollConcurrency, validation.Min(0), validation.Max(16)),
+		validation.Field(&wc.PollInterval, validation.Min(0)),
+	)
+}
+
+// AttendanceConfig is the work schedule clock events are measured against,
+// WorkStart and WorkEnd are HH:MM times of day.
+type AttendanceConfig struct {
+	WorkStart   string        `mapstructure:"work_start"`
+	WorkEnd     string        `mapstructure:"work_end"`
+	GracePeriod time.Duration `mapstructure:"grace_period"`
+}
+
+func (ac AttendanceConfig) Validate() error {
+	return validation.ValidateStruct(&ac,
+		validation.Field(&ac.WorkStart, validation.Required, validation.Date("15:04")),
+		validation.Field(&ac.WorkEnd, validation.Required, validation.Date("15:04")),
+		validation.Field(&ac.GracePeriod, validation.Min(time.Duration(0))),
+	)
+}
+
+type PayrollConfig struct {
+	// FiscalYearStartMonth is the first month of the fiscal year, 1 when it
+	// follows the calendar year.
+	FiscalYearStartMonth int64 `mapstructure:"fiscal_year_start_month"`
+	// AttendanceBasis pays the salary for the attended working days, or for
+	// the clocked hours when it is hours.
+	AttendanceBasis string `mapstructure:"attendance_basis"`
+	// VerificationKey signs the verification IDs printed on payslips. It is
+	// kept apart from the app key so rotating one does not affect the other.
+	VerificationKey string `mapstructure:"verification_key"`
+}
+
+func (pc PayrollConfig) Validate() error {
+	return validation.ValidateStruct(&pc,
+		validation.Field(&pc.FiscalYearStartMonth, validation.Required, validation.Min(int64(1)), validation.Max(int64(12))),
+		validation.Field(&pc.AttendanceBasis, validation.Required, validation.In("days", "hours")),
+		validation.Field(&pc.VerificationKey, validation.Required, validation.Length(32, 64)),
+	)
+}
+
 var (
 	global *Config
 )
@@ -138,6 +222,15 @@ func LoadConfig(env string) (Config, error) {
 
 		bindEnvs(v, Config{})
 
+		// Config files written before the workers existed still run payroll jobs
+		v.SetDefault("worker.payroll_concurrency", 1)
+		v.SetDefault("worker.poll_interval", "2s")
+		v.SetDefault("payroll.fiscal_year_start_month", 1)
+		v.SetDefault("payroll.attendance_basis", "days")
+		v.SetDefault("attendance.work_start", "09:00")
+		v.SetDefault("attendance.work_end", "17:00")
+		v.SetDefault("attendance.grace_period", "0s")
+
 		if err := v.ReadInConfig(); err != nil {
 			if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 				log.Println("Config file not found, failing back to environment variables")
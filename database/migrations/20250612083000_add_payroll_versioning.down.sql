@@ -0,0 +1,13 @@
+ALTER TABLE payroll_summaries DROP COLUMN IF EXISTS voided_at;
+ALTER TABLE payslips DROP COLUMN IF EXISTS voided_at;
+
+DROP INDEX IF EXISTS payrolls_period_id_version_key;
+DROP INDEX IF EXISTS payrolls_period_id_active_key;
+
+ALTER TABLE payrolls
+    DROP COLUMN IF EXISTS void_reason,
+    DROP COLUMN IF EXISTS voided_by,
+    DROP COLUMN IF EXISTS voided_at,
+    DROP COLUMN IF EXISTS version;
+
+ALTER TABLE payrolls ADD CONSTRAINT payrolls_period_id_key UNIQUE (period_id);
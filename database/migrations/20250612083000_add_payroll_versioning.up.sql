@@ -0,0 +1,14 @@
+ALTER TABLE payrolls DROP CONSTRAINT IF EXISTS payrolls_period_id_key;
+
+ALTER TABLE payrolls
+    ADD COLUMN version INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN voided_at TIMESTAMPTZ,
+    ADD COLUMN voided_by UUID REFERENCES users(id),
+    ADD COLUMN void_reason TEXT;
+
+-- Only one payroll per period may be active, voided runs are kept for audit.
+CREATE UNIQUE INDEX payrolls_period_id_active_key ON payrolls(period_id) WHERE voided_at IS NULL;
+CREATE UNIQUE INDEX payrolls_period_id_version_key ON payrolls(period_id, version);
+
+ALTER TABLE payslips ADD COLUMN voided_at TIMESTAMPTZ;
+ALTER TABLE payroll_summaries ADD COLUMN voided_at TIMESTAMPTZ;
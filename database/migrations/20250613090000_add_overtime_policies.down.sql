@@ -0,0 +1,7 @@
+ALTER TABLE payslips
+    DROP COLUMN IF EXISTS overtime_rate_per_hour,
+    DROP COLUMN IF EXISTS overtime_policy_version,
+    DROP COLUMN IF EXISTS overtime_policy_id;
+
+DROP TRIGGER IF EXISTS trg_audit_overtime_policies ON overtime_policies;
+DROP TABLE IF EXISTS overtime_policies;
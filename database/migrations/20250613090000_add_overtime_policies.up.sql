@@ -0,0 +1,27 @@
+CREATE TABLE overtime_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    version INTEGER NOT NULL UNIQUE,
+    weekday_multiplier NUMERIC(5, 2) NOT NULL,
+    weekend_multiplier NUMERIC(5, 2) NOT NULL,
+    standard_hours_per_day INTEGER NOT NULL,
+    -- Ordered list of {"after_hours": N, "multiplier": M}
+    tiers JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE TRIGGER trg_audit_overtime_policies
+AFTER INSERT OR UPDATE OR DELETE ON overtime_policies
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- Default policy keeps the previous flat multiplier with an 8 hour working day.
+INSERT INTO overtime_policies (version, weekday_multiplier, weekend_multiplier, standard_hours_per_day)
+VALUES (1, 1.00, 1.00, 8);
+
+ALTER TABLE payslips
+    ADD COLUMN overtime_policy_id UUID REFERENCES overtime_policies(id),
+    ADD COLUMN overtime_policy_version INTEGER,
+    ADD COLUMN overtime_rate_per_hour NUMERIC(12, 2);
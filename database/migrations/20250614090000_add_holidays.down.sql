@@ -0,0 +1,5 @@
+ALTER TABLE payslips
+    DROP COLUMN IF EXISTS working_days;
+
+DROP TRIGGER IF EXISTS trg_audit_holidays ON holidays;
+DROP TABLE IF EXISTS holidays;
@@ -0,0 +1,18 @@
+CREATE TABLE holidays (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    holiday_date DATE NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE TRIGGER trg_audit_holidays
+AFTER INSERT OR UPDATE OR DELETE ON holidays
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- Payslips stored before this column existed counted every day of the period.
+ALTER TABLE payslips
+    ADD COLUMN working_days INTEGER;
@@ -0,0 +1,8 @@
+ALTER TABLE payslips
+    DROP COLUMN IF EXISTS total_deductions;
+
+DROP TRIGGER IF EXISTS trg_audit_payslip_items ON payslip_items;
+DROP TABLE IF EXISTS payslip_items;
+
+DROP TRIGGER IF EXISTS trg_audit_deduction_rules ON deduction_rules;
+DROP TABLE IF EXISTS deduction_rules;
@@ -0,0 +1,48 @@
+CREATE TABLE deduction_rules (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('progressive_tax', 'percentage', 'fixed')),
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    -- percentage: share of the gross pay, capped at cap_amount when positive
+    rate NUMERIC(7, 4) NOT NULL DEFAULT 0,
+    cap_amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    -- fixed: amount deducted from every payslip
+    amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    -- progressive_tax: ordered list of {"up_to": N, "rate": R}, the last up_to is 0
+    brackets JSONB NOT NULL DEFAULT '[]',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE TRIGGER trg_audit_deduction_rules
+AFTER INSERT OR UPDATE OR DELETE ON deduction_rules
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+CREATE TABLE payslip_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payslip_id UUID NOT NULL REFERENCES payslips(id) ON DELETE CASCADE,
+    item_type TEXT NOT NULL,
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_payslip_items_payslip_id ON payslip_items(payslip_id);
+
+CREATE TRIGGER trg_audit_payslip_items
+AFTER INSERT OR UPDATE OR DELETE ON payslip_items
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+ALTER TABLE payslips
+    ADD COLUMN total_deductions NUMERIC(12, 2) NOT NULL DEFAULT 0;
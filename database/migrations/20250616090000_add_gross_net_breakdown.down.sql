@@ -0,0 +1,7 @@
+ALTER TABLE payroll_summaries
+    DROP COLUMN IF EXISTS total_deductions,
+    DROP COLUMN IF EXISTS total_reimbursement,
+    DROP COLUMN IF EXISTS total_gross_pay;
+
+ALTER TABLE payslips
+    DROP COLUMN IF EXISTS gross_pay;
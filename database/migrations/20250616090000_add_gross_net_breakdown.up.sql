@@ -0,0 +1,25 @@
+ALTER TABLE payslips
+    ADD COLUMN gross_pay NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+-- Existing payslips never paid out reimbursements, their take-home was the
+-- gross pay less deductions.
+UPDATE payslips SET gross_pay = total_take_home + total_deductions;
+
+ALTER TABLE payroll_summaries
+    ADD COLUMN total_gross_pay NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN total_reimbursement NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN total_deductions NUMERIC(12, 2) NOT NULL DEFAULT 0;
+
+UPDATE payroll_summaries ps
+SET
+    total_gross_pay = totals.total_gross_pay,
+    total_deductions = totals.total_deductions
+FROM (
+    SELECT
+        payroll_id,
+        SUM(gross_pay) AS total_gross_pay,
+        SUM(total_deductions) AS total_deductions
+    FROM payslips
+    GROUP BY payroll_id
+) totals
+WHERE ps.payroll_id = totals.payroll_id;
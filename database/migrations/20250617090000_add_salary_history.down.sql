@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS trg_audit_payslip_salary_segments ON payslip_salary_segments;
+DROP TABLE IF EXISTS payslip_salary_segments;
+
+DROP TRIGGER IF EXISTS trg_audit_salary_history ON salary_history;
+DROP TABLE IF EXISTS salary_history;
@@ -0,0 +1,51 @@
+CREATE TABLE salary_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    salary NUMERIC(12, 2) NOT NULL,
+    effective_from DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT,
+    UNIQUE (user_id, effective_from)
+);
+
+CREATE INDEX idx_salary_history_effective_from ON salary_history(effective_from);
+
+CREATE TRIGGER trg_audit_salary_history
+AFTER INSERT OR UPDATE OR DELETE ON salary_history
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- The current salary becomes the first entry. users.salary is kept as the
+-- salary of dates before the first entry.
+INSERT INTO salary_history (user_id, salary, effective_from, reason, created_at, updated_at)
+SELECT id, salary, created_at::DATE, 'Initial salary', now(), now()
+FROM users;
+
+-- Split of the attendance pay of a payslip when the salary changed within the
+-- period, a period without changes has a single segment.
+CREATE TABLE payslip_salary_segments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payslip_id UUID NOT NULL REFERENCES payslips(id) ON DELETE CASCADE,
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    salary NUMERIC(12, 2) NOT NULL,
+    working_days INTEGER NOT NULL,
+    attendance_days INTEGER NOT NULL,
+    attendance_pay NUMERIC(12, 2) NOT NULL,
+    overtime_rate_per_hour NUMERIC(12, 2) NOT NULL,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_payslip_salary_segments_payslip_id ON payslip_salary_segments(payslip_id);
+
+CREATE TRIGGER trg_audit_payslip_salary_segments
+AFTER INSERT OR UPDATE OR DELETE ON payslip_salary_segments
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
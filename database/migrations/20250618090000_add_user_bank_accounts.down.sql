@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_audit_user_bank_accounts ON user_bank_accounts;
+DROP TABLE IF EXISTS user_bank_accounts;
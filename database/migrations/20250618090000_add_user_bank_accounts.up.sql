@@ -0,0 +1,19 @@
+-- Account every take-home pay is transferred to, one per user.
+CREATE TABLE user_bank_accounts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id),
+    account_holder_name TEXT NOT NULL,
+    account_number TEXT NOT NULL,
+    bank_name TEXT NOT NULL,
+    bank_code TEXT NOT NULL,
+    bic TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE TRIGGER trg_audit_user_bank_accounts
+AFTER INSERT OR UPDATE OR DELETE ON user_bank_accounts
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
@@ -0,0 +1,29 @@
+-- Background runs of the payroll generation. The progress of a running job is
+-- written every few seconds, so the table has no audit trigger, the job row is
+-- the record of the run and the generated payroll is audited as usual.
+CREATE TABLE payroll_jobs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    period_id UUID NOT NULL REFERENCES attendance_periods(id),
+    status TEXT NOT NULL DEFAULT 'queued' CHECK (status IN ('queued', 'running', 'succeeded', 'failed')),
+    stage TEXT,
+    progress_done INTEGER NOT NULL DEFAULT 0,
+    progress_total INTEGER NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    failure_code TEXT,
+    failure_reason TEXT,
+    payroll_id UUID REFERENCES payrolls(id),
+    result JSONB,
+    request_id TEXT,
+    heartbeat_at TIMESTAMPTZ,
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+-- A period can only be generated by one job at a time
+CREATE UNIQUE INDEX payroll_jobs_period_id_active_key ON payroll_jobs(period_id) WHERE status IN ('queued', 'running');
+CREATE INDEX idx_payroll_jobs_status_created_at ON payroll_jobs(status, created_at);
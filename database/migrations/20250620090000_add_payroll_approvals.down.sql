@@ -0,0 +1,4 @@
+DROP TRIGGER IF EXISTS trg_audit_payroll_approvals ON payroll_approvals;
+DROP TABLE IF EXISTS payroll_approvals;
+
+ALTER TABLE payrolls DROP COLUMN IF EXISTS status;
@@ -0,0 +1,26 @@
+-- Payrolls generated before the approval workflow were already final, they
+-- are kept visible to employees as approved. New payrolls start as drafts.
+ALTER TABLE payrolls
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'approved' CHECK (status IN ('draft', 'submitted', 'approved', 'paid'));
+ALTER TABLE payrolls ALTER COLUMN status SET DEFAULT 'draft';
+
+-- Every status change of a payroll with the comment it was made with.
+CREATE TABLE payroll_approvals (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    payroll_id UUID NOT NULL REFERENCES payrolls(id),
+    action TEXT NOT NULL CHECK (action IN ('submit', 'approve', 'reject', 'pay')),
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    comment TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID REFERENCES users(id),
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_payroll_approvals_payroll_id ON payroll_approvals(payroll_id, created_at);
+
+CREATE TRIGGER trg_audit_payroll_approvals
+AFTER INSERT OR UPDATE OR DELETE ON payroll_approvals
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
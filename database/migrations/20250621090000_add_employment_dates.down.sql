@@ -0,0 +1,6 @@
+ALTER TABLE payslips DROP COLUMN IF EXISTS eligible_working_days;
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_employment_dates_check;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS employment_end_date,
+    DROP COLUMN IF EXISTS employment_start_date;
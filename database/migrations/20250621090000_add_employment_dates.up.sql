@@ -0,0 +1,16 @@
+-- Existing users are assumed to be employed since they were created.
+ALTER TABLE users
+    ADD COLUMN employment_start_date DATE,
+    ADD COLUMN employment_end_date DATE;
+
+UPDATE users SET employment_start_date = created_at::DATE;
+
+ALTER TABLE users ALTER COLUMN employment_start_date SET NOT NULL;
+ALTER TABLE users ALTER COLUMN employment_start_date SET DEFAULT CURRENT_DATE;
+ALTER TABLE users
+    ADD CONSTRAINT users_employment_dates_check
+    CHECK (employment_end_date IS NULL OR employment_end_date >= employment_start_date);
+
+-- Working days the employee was employed for within the period, NULL for
+-- payslips generated before proration.
+ALTER TABLE payslips ADD COLUMN eligible_working_days INTEGER;
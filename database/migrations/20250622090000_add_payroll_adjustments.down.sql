@@ -0,0 +1,7 @@
+ALTER TABLE payslip_items DROP COLUMN IF EXISTS taxable;
+
+DROP TRIGGER IF EXISTS trg_audit_payroll_adjustments ON payroll_adjustments;
+DROP TABLE IF EXISTS payroll_adjustments;
+
+DROP TRIGGER IF EXISTS trg_audit_allowances ON allowances;
+DROP TABLE IF EXISTS allowances;
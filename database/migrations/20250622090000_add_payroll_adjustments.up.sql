@@ -0,0 +1,53 @@
+-- Recurring earnings of a user, paid in full in every period they are active
+-- in. An empty end_date keeps the allowance running.
+CREATE TABLE allowances (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL CHECK (amount > 0),
+    taxable BOOLEAN NOT NULL,
+    start_date DATE NOT NULL,
+    end_date DATE CHECK (end_date IS NULL OR end_date >= start_date),
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_allowances_user_id ON allowances(user_id);
+CREATE INDEX idx_allowances_dates ON allowances(start_date, end_date);
+
+CREATE TRIGGER trg_audit_allowances
+AFTER INSERT OR UPDATE OR DELETE ON allowances
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- One-off bonuses and manual corrections paid with the payroll of a period,
+-- a negative amount takes money back.
+CREATE TABLE payroll_adjustments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    period_id UUID NOT NULL REFERENCES attendance_periods(id),
+    code TEXT NOT NULL,
+    name TEXT NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL CHECK (amount <> 0),
+    taxable BOOLEAN NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_payroll_adjustments_user_id ON payroll_adjustments(user_id);
+CREATE INDEX idx_payroll_adjustments_period_id ON payroll_adjustments(period_id);
+
+CREATE TRIGGER trg_audit_payroll_adjustments
+AFTER INSERT OR UPDATE OR DELETE ON payroll_adjustments
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- Allowances and adjustments are written as payslip items next to the
+-- deductions, taxable ones are part of the gross pay.
+ALTER TABLE payslip_items ADD COLUMN taxable BOOLEAN NOT NULL DEFAULT FALSE;
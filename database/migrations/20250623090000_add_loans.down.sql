@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_payslip_items_loan_id;
+ALTER TABLE payslip_items DROP COLUMN IF EXISTS loan_id;
+
+DROP TRIGGER IF EXISTS trg_audit_loans ON loans;
+DROP TABLE IF EXISTS loans;
@@ -0,0 +1,29 @@
+-- Salary advances repaid through payroll. Every payroll deducts the
+-- installment from the period the loan starts in until the principal is
+-- repaid, the outstanding balance is derived from the repayments.
+CREATE TABLE loans (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    principal NUMERIC(12, 2) NOT NULL CHECK (principal > 0),
+    installment_amount NUMERIC(12, 2) NOT NULL CHECK (installment_amount > 0 AND installment_amount <= principal),
+    start_date DATE NOT NULL,
+    reason TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_loans_user_id ON loans(user_id);
+CREATE INDEX idx_loans_start_date ON loans(start_date);
+
+CREATE TRIGGER trg_audit_loans
+AFTER INSERT OR UPDATE OR DELETE ON loans
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- A repayment is the payslip item that deducted it, so a voided payroll
+-- gives its repayments back to the balance.
+ALTER TABLE payslip_items ADD COLUMN loan_id UUID REFERENCES loans(id);
+
+CREATE INDEX idx_payslip_items_loan_id ON payslip_items(loan_id) WHERE loan_id IS NOT NULL;
@@ -0,0 +1,7 @@
+ALTER TABLE payslips
+    DROP COLUMN IF EXISTS cost_centre,
+    DROP COLUMN IF EXISTS department;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS cost_centre,
+    DROP COLUMN IF EXISTS department;
@@ -0,0 +1,9 @@
+ALTER TABLE users
+    ADD COLUMN department TEXT,
+    ADD COLUMN cost_centre TEXT;
+
+-- The department and cost centre of the employee when the payslip was
+-- generated, so cost reports keep their numbers when an employee moves.
+ALTER TABLE payslips
+    ADD COLUMN department TEXT,
+    ADD COLUMN cost_centre TEXT;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_audit_attendance_period_reopenings ON attendance_period_reopenings;
+DROP TABLE IF EXISTS attendance_period_reopenings;
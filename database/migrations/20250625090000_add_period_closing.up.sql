@@ -0,0 +1,21 @@
+-- A period is closed once it has an active payroll, attendance, overtime and
+-- reimbursements dated inside it can no longer change. Reopening is recorded
+-- against the payroll it unlocks, the next payroll generated for the period
+-- closes it again.
+CREATE TABLE attendance_period_reopenings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    period_id UUID NOT NULL REFERENCES attendance_periods(id),
+    payroll_id UUID NOT NULL REFERENCES payrolls(id) UNIQUE,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID REFERENCES users(id),
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_attendance_period_reopenings_period_id ON attendance_period_reopenings(period_id, created_at);
+
+CREATE TRIGGER trg_audit_attendance_period_reopenings
+AFTER INSERT OR UPDATE OR DELETE ON attendance_period_reopenings
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
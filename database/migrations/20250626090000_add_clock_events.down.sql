@@ -0,0 +1,9 @@
+ALTER TABLE payslips DROP COLUMN IF EXISTS worked_duration;
+
+DROP INDEX IF EXISTS idx_attendances_open_clock_in;
+
+ALTER TABLE attendances
+    DROP COLUMN IF EXISTS early_leave_by,
+    DROP COLUMN IF EXISTS late_by,
+    DROP COLUMN IF EXISTS clock_out_at,
+    DROP COLUMN IF EXISTS clock_in_at;
@@ -0,0 +1,13 @@
+-- Attendance keeps the presence flag of the day, clocking in and out records
+-- when the employee arrived and left. Lateness and early leave are measured
+-- against the work schedule in effect when the event was recorded.
+ALTER TABLE attendances
+    ADD COLUMN clock_in_at TIMESTAMPTZ,
+    ADD COLUMN clock_out_at TIMESTAMPTZ,
+    ADD COLUMN late_by INTERVAL,
+    ADD COLUMN early_leave_by INTERVAL;
+
+CREATE INDEX idx_attendances_open_clock_in ON attendances(user_id, clock_in_at) WHERE clock_out_at IS NULL;
+
+-- Payslips generated before clock events existed have no worked duration.
+ALTER TABLE payslips ADD COLUMN worked_duration INTERVAL;
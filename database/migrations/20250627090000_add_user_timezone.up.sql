@@ -0,0 +1,4 @@
+-- The IANA timezone a user works in, attendance and overtime are dated and
+-- checked against working hours in it. Existing users keep the UTC dates they
+-- were recorded with.
+ALTER TABLE users ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
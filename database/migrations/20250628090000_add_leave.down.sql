@@ -0,0 +1,11 @@
+DROP TRIGGER IF EXISTS trg_audit_leave_requests ON leave_requests;
+DROP TABLE IF EXISTS leave_requests;
+
+DROP TRIGGER IF EXISTS trg_audit_leave_balances ON leave_balances;
+DROP TABLE IF EXISTS leave_balances;
+
+DROP TRIGGER IF EXISTS trg_audit_leave_types ON leave_types;
+DROP TABLE IF EXISTS leave_types;
+
+DROP INDEX IF EXISTS idx_users_manager_id;
+ALTER TABLE users DROP COLUMN IF EXISTS manager_id;
@@ -0,0 +1,77 @@
+-- The manager approving the leave of an employee, admins can approve the
+-- leave of anyone.
+ALTER TABLE users ADD COLUMN manager_id UUID REFERENCES users(id);
+
+CREATE INDEX idx_users_manager_id ON users(manager_id) WHERE manager_id IS NOT NULL;
+
+-- Kinds of leave. Every year employees accrue the allowance, at most
+-- max_carry_over unused days move to the next year. Approved paid leave is
+-- counted as attended days by payroll.
+CREATE TABLE leave_types (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code TEXT NOT NULL UNIQUE,
+    name TEXT NOT NULL,
+    paid BOOLEAN NOT NULL DEFAULT TRUE,
+    annual_allowance INTEGER NOT NULL CHECK (annual_allowance >= 0),
+    max_carry_over INTEGER NOT NULL DEFAULT 0 CHECK (max_carry_over >= 0),
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE TRIGGER trg_audit_leave_types
+AFTER INSERT OR UPDATE OR DELETE ON leave_types
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- The days a user may take of a leave type in a year. The days used are not
+-- stored, they total the approved requests of the year.
+CREATE TABLE leave_balances (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    leave_type_id UUID NOT NULL REFERENCES leave_types(id),
+    year INTEGER NOT NULL,
+    accrued_days INTEGER NOT NULL CHECK (accrued_days >= 0),
+    carried_over_days INTEGER NOT NULL DEFAULT 0 CHECK (carried_over_days >= 0),
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT,
+    UNIQUE (user_id, leave_type_id, year)
+);
+
+CREATE TRIGGER trg_audit_leave_balances
+AFTER INSERT OR UPDATE OR DELETE ON leave_balances
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
+
+-- A request covers the working days between its dates, both included, within
+-- a single year so it is taken from one balance.
+CREATE TABLE leave_requests (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    leave_type_id UUID NOT NULL REFERENCES leave_types(id),
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    days INTEGER NOT NULL CHECK (days > 0),
+    reason TEXT,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected', 'cancelled')),
+    decided_by UUID REFERENCES users(id),
+    decided_at TIMESTAMPTZ,
+    decision_comment TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT,
+    CHECK (end_date >= start_date),
+    CHECK (EXTRACT(YEAR FROM start_date) = EXTRACT(YEAR FROM end_date))
+);
+
+CREATE INDEX idx_leave_requests_user_id ON leave_requests(user_id, start_date);
+CREATE INDEX idx_leave_requests_dates ON leave_requests(start_date, end_date) WHERE status = 'approved';
+
+CREATE TRIGGER trg_audit_leave_requests
+AFTER INSERT OR UPDATE OR DELETE ON leave_requests
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS trg_audit_attendance_corrections ON attendance_corrections;
+DROP TABLE IF EXISTS attendance_corrections;
@@ -0,0 +1,25 @@
+-- A request to record attendance for a past day the employee forgot to
+-- submit. Approving it inserts the attendance row, attendance_id links the two.
+CREATE TABLE attendance_corrections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id),
+    attendance_date DATE NOT NULL,
+    reason TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    attendance_id UUID REFERENCES attendances(id),
+    decided_by UUID REFERENCES users(id),
+    decided_at TIMESTAMPTZ,
+    decision_comment TEXT,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    created_by UUID,
+    updated_by UUID,
+    ip_address TEXT
+);
+
+CREATE INDEX idx_attendance_corrections_user_id ON attendance_corrections(user_id, attendance_date);
+CREATE UNIQUE INDEX idx_attendance_corrections_pending ON attendance_corrections(user_id, attendance_date) WHERE status = 'pending';
+
+CREATE TRIGGER trg_audit_attendance_corrections
+AFTER INSERT OR UPDATE OR DELETE ON attendance_corrections
+FOR EACH ROW EXECUTE FUNCTION fn_log_audit_changes();
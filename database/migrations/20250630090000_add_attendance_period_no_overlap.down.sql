@@ -0,0 +1 @@
+ALTER TABLE attendance_periods DROP CONSTRAINT IF EXISTS attendance_periods_no_overlap;
@@ -0,0 +1,24 @@
+-- Periods may not overlap. The check in the use case alone lets two
+-- concurrent creates or updates both pass it, the constraint rejects the
+-- second one.
+
+-- The schema used to allow overlapping periods, they have to be resolved by
+-- hand before the constraint can be added.
+DO $$
+DECLARE
+    conflicts TEXT;
+BEGIN
+    SELECT string_agg(a.id || ' overlaps ' || b.id, ', ')
+    INTO conflicts
+    FROM attendance_periods a
+    JOIN attendance_periods b ON a.id < b.id
+    AND daterange(a.start_date, a.end_date, '[]') && daterange(b.start_date, b.end_date, '[]');
+
+    IF conflicts IS NOT NULL THEN
+        RAISE EXCEPTION 'attendance periods overlap, resolve them before migrating: %', conflicts;
+    END IF;
+END $$;
+
+ALTER TABLE attendance_periods
+    ADD CONSTRAINT attendance_periods_no_overlap
+    EXCLUDE USING gist (daterange(start_date, end_date, '[]') WITH &&);
@@ -6,6 +6,7 @@ BEGIN
       password,
       is_admin,
       salary,
+      employment_start_date,
       created_at,
       updated_at
     ) VALUES (
@@ -13,6 +14,7 @@ BEGIN
       crypt('password123', gen_salt('bf')),
       FALSE,
       trunc(random() * 5000000 + 3000000)::NUMERIC(12, 2),
+      DATE '2024-01-01',
       now(),
       now()
     );
@@ -25,6 +27,7 @@ INSERT INTO users (
   password,
   is_admin,
   salary,
+  employment_start_date,
   created_at,
   updated_at
 ) VALUES (
@@ -32,6 +35,7 @@ INSERT INTO users (
   crypt('adminpass123', gen_salt('bf')),
   TRUE,
   10000000.00,
+  DATE '2024-01-01',
   now(),
   now()
 );
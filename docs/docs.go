@@ -16,6 +16,74 @@ const docTemplate = `{
     "basePath": "{{.BasePath}}",
     "paths": {
         "/v1/attendance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the attendance of every user newest first, filtered by user, date range and period. Pass the next_cursor of the metadata as cursor to get the next page",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List Attendance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Attendance Period ID",
+                        "name": "period_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attendance List Response",
+                        "schema": {
+                            "$ref": "#/definitions/docshelper.Response-string-dtos_AttendanceResponse-entity_ListAttendanceMetadata"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
@@ -49,38 +117,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/auth/login": {
-            "post": {
+        "/v1/attendance/calendar": {
+            "get": {
                 "security": [
                     {
-                        "NoAuth": []
+                        "BearerAuth": []
                     }
                 ],
-                "description": "User login to get access token",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Show a month as a user by day matrix, of every employee for admins and of direct reports for team leads",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "Attendance"
                 ],
-                "summary": "Login",
+                "summary": "Show Attendance Calendar",
                 "parameters": [
                     {
-                        "description": "Login Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dtos.LoginRequest"
-                        }
+                        "type": "string",
+                        "description": "Month (YYYY-MM)",
+                        "name": "month",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Access Token Response",
+                        "description": "Attendance Calendar Response",
                         "schema": {
                             "allOf": [
                                 {
@@ -90,10 +153,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "object",
-                                            "additionalProperties": {
-                                                "type": "string"
-                                            }
+                                            "$ref": "#/definitions/dtos.AttendanceCalendarResponse"
                                         }
                                     }
                                 }
@@ -109,40 +169,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/overtime": {
+        "/v1/attendance/clock-in": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Submit an overtime request",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Clock in for the current day, arriving after the scheduled start and its grace period is recorded as late",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Overtime"
-                ],
-                "summary": "Submit Overtime",
-                "parameters": [
-                    {
-                        "description": "Overtime Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dtos.OvertimeRequest"
-                        }
-                    }
+                    "Attendance"
                 ],
+                "summary": "Clock In",
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "Attendance Response",
                         "schema": {
-                            "$ref": "#/definitions/dtos.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendanceResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -154,38 +212,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/payroll": {
+        "/v1/attendance/clock-out": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Generate payroll for a specific period",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Clock out of the latest clock in, leaving before the scheduled end is recorded as early leave",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payroll"
-                ],
-                "summary": "Generate Payroll",
-                "parameters": [
-                    {
-                        "description": "Generate Payroll Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dtos.GeneratePayrollRequest"
-                        }
-                    }
+                    "Attendance"
                 ],
+                "summary": "Clock Out",
                 "responses": {
-                    "201": {
-                        "description": "Generated Payroll Response",
+                    "200": {
+                        "description": "Attendance Response",
                         "schema": {
                             "allOf": [
                                 {
@@ -195,7 +239,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dtos.GeneratedPayrollResponse"
+                                            "$ref": "#/definitions/dtos.AttendanceResponse"
                                         }
                                     }
                                 }
@@ -211,36 +255,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/payroll/{payrollId}/payslip": {
+        "/v1/attendance/corrections/pending": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Show payslip for a specific payroll",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "List the attendance corrections waiting for a decision, of direct reports or of everyone as an admin",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Payroll"
-                ],
-                "summary": "Show Payslip",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Payroll ID",
-                        "name": "payrollId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Attendance"
                 ],
+                "summary": "List Pending Attendance Corrections",
                 "responses": {
                     "200": {
-                        "description": "Payslip Response",
+                        "description": "Attendance Correction List Response",
                         "schema": {
                             "allOf": [
                                 {
@@ -250,7 +282,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/dtos.PayslipDataResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AttendanceCorrectionResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -266,9 +301,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/payroll/{payrollId}/payslips": {
-            "get": {
-                "description": "List payslips for a specific payroll",
+        "/v1/attendance/corrections/{correctionId}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a pending attendance correction of a direct report, or of anyone as an admin, recording the attendance of the day. Days in a closed or paid period cannot be corrected",
                 "consumes": [
                     "application/json"
                 ],
@@ -276,50 +316,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Payroll"
+                    "Attendance"
                 ],
-                "summary": "List Payslips",
+                "summary": "Approve Attendance Correction",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Payroll ID",
-                        "name": "payrollId",
+                        "description": "Attendance Correction ID",
+                        "name": "correctionId",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "default": "offset",
-                        "description": "Mode",
-                        "name": "mode",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Cursor",
-                        "name": "cursor",
-                        "in": "query"
+                        "description": "Attendance Correction Decision Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AttendanceCorrectionDecisionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of Payslips",
+                        "description": "Attendance Correction Response",
                         "schema": {
-                            "$ref": "#/definitions/docshelper.Response-string-dtos_PayslipDataResponse-entity_ListPayslipMetadata"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendanceCorrectionResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -331,14 +364,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/reimbursement": {
+        "/v1/attendance/corrections/{correctionId}/reject": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Submit a reimbursement request",
+                "description": "Reject a pending attendance correction of a direct report, or of anyone as an admin",
                 "consumes": [
                     "application/json"
                 ],
@@ -346,25 +379,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Reimbursement"
+                    "Attendance"
                 ],
-                "summary": "Submit Reimbursement",
+                "summary": "Reject Attendance Correction",
                 "parameters": [
                     {
-                        "description": "Reimbursement Request",
+                        "type": "string",
+                        "description": "Attendance Correction ID",
+                        "name": "correctionId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attendance Correction Decision Request",
                         "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dtos.ReimbursementRequest"
+                            "$ref": "#/definitions/dtos.AttendanceCorrectionDecisionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "Attendance Correction Response",
                         "schema": {
-                            "$ref": "#/definitions/dtos.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendanceCorrectionResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -375,124 +426,5837 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "apperror.Error": {
-            "type": "object",
-            "properties": {
-                "formatted": {
-                    "type": "object",
-                    "additionalProperties": {}
-                },
-                "issues": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/apperror.ErrorIssue"
-                    }
-                }
-            }
+        },
+        "/v1/attendance/period": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the attendance periods, latest first, with their status and active payroll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List Attendance Periods",
+                "responses": {
+                    "200": {
+                        "description": "Attendance Periods Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AttendancePeriodResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create an attendance period, it may not overlap an existing period",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Create Attendance Period",
+                "parameters": [
+                    {
+                        "description": "Attendance Period Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AttendancePeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/attendance/period/{periodId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show an attendance period with its status (open, closed or paid) and active payroll",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Show Attendance Period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attendance Period ID",
+                        "name": "periodId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attendance Period Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendancePeriodResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the dates of an attendance period, only before a payroll is generated for it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Update Attendance Period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attendance Period ID",
+                        "name": "periodId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Attendance Period Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AttendancePeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attendance Period Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendancePeriodResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an attendance period, only before a payroll is generated for it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Delete Attendance Period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attendance Period ID",
+                        "name": "periodId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/attendance/period/{periodId}/reopen": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reopen a period closed by its payroll so attendance, overtime and reimbursements dated inside it can be submitted again, the period is closed again by the next payroll generated for it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Reopen Attendance Period",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Attendance Period ID",
+                        "name": "periodId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reopen Attendance Period Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ReopenAttendancePeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attendance Period Reopening Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendancePeriodReopeningResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/login": {
+            "post": {
+                "security": [
+                    {
+                        "NoAuth": []
+                    }
+                ],
+                "description": "User login to get access token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login",
+                "parameters": [
+                    {
+                        "description": "Login Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Access Token Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holidays": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the holidays between two dates, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holiday"
+                ],
+                "summary": "List Holidays",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Holidays Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.HolidayResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a holiday to the working-day calendar",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holiday"
+                ],
+                "summary": "Create Holiday",
+                "parameters": [
+                    {
+                        "description": "Holiday Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.HolidayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Holiday Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.HolidayResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holidays/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Import holidays from a CSV (date,name) or ICS file, existing dates are renamed",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holiday"
+                ],
+                "summary": "Import Holidays",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Holiday file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "File format (csv or ics), defaults to the file extension",
+                        "name": "format",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Import Holidays Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.ImportHolidaysResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/holidays/{holidayId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the date or name of a holiday",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holiday"
+                ],
+                "summary": "Update Holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday ID",
+                        "name": "holidayId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Holiday Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.HolidayRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Holiday Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.HolidayResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a holiday from the working-day calendar",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Holiday"
+                ],
+                "summary": "Delete Holiday",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday ID",
+                        "name": "holidayId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/accruals": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grant every user employed in the year the allowance of each leave type, prorated to the days they are employed, and carry over the unused days of the previous year up to the limit of the type. Accruing a year again recomputes its balances",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Accrue Leave Balances",
+                "parameters": [
+                    {
+                        "description": "Accrue Leave Balances Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LeaveAccrualRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Accrual Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveAccrualResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/calendar": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show the approved leave on every date between two dates, both included and within one year. Admins see everyone, other users their own leave and the leave of their direct reports",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Show Leave Calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "start_date",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "end_date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Calendar Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveCalendarDayResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/requests/pending": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the leave requests waiting for a decision of the caller, admins see every request and managers the requests of their direct reports",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List Pending Leave Requests",
+                "responses": {
+                    "200": {
+                        "description": "Leave Requests Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/requests/{requestId}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a pending leave request of a direct report, or of anyone as an admin. The days are taken from the balance of the year",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Approve Leave Request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave Request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leave Decision Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LeaveDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Request Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/requests/{requestId}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Reject a pending leave request of a direct report, or of anyone as an admin, giving its days back to the balance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Reject Leave Request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave Request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Leave Decision Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LeaveDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Request Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/leave/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the kinds of leave employees can request",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List Leave Types",
+                "responses": {
+                    "200": {
+                        "description": "Leave Types Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveTypeResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a kind of leave with the days accrued every year and the unused days that carry over to the next one, approved leave of a paid type counts as attended days in payroll",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Create Leave Type",
+                "parameters": [
+                    {
+                        "description": "Create Leave Type Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LeaveTypeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Leave Type Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveTypeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/attendance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the attendance of the logged in employee between two dates, both included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List My Attendance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Attendance List Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AttendanceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/attendance/corrections": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the attendance corrections requested by the logged in employee, latest date first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "List My Attendance Corrections",
+                "responses": {
+                    "200": {
+                        "description": "Attendance Correction List Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AttendanceCorrectionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ask to record attendance for a past working day that was not submitted, an admin or the manager of the employee decides on it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Attendance"
+                ],
+                "summary": "Request Attendance Correction",
+                "parameters": [
+                    {
+                        "description": "Attendance Correction Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AttendanceCorrectionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Attendance Correction Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AttendanceCorrectionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/leave/balances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the leave balances of the caller for a year",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List My Leave Balances",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year, the current year when empty",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Balances Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveBalanceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/leave/requests": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the leave requests of the caller, latest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List My Leave Requests",
+                "responses": {
+                    "200": {
+                        "description": "Leave Requests Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request leave for the working days between two dates, both included and within one year. The days are held from the balance until the request is decided or cancelled",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Submit My Leave Request",
+                "parameters": [
+                    {
+                        "description": "Submit Leave Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LeaveRequestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Leave Request Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/leave/requests/{requestId}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Withdraw a pending leave request or give back the days of an approved one, as long as payroll has not been generated for them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "Cancel My Leave Request",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Leave Request ID",
+                        "name": "requestId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Request Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/loans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the loans of the logged in employee with their outstanding balance",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan"
+                ],
+                "summary": "List My Loans",
+                "responses": {
+                    "200": {
+                        "description": "Loans Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LoanResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/loans/{loanId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show a loan of the logged in employee with its installment schedule and repayments",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan"
+                ],
+                "summary": "Show My Loan Statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Loan Statement Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LoanStatementResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/payslips": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the payslips of the logged in employee across payrolls, latest period first. Only approved and paid payrolls are listed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List My Payslips",
+                "responses": {
+                    "200": {
+                        "description": "My Payslips Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.MyPayslipResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me/payslips/ytd": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Total the payslips of the logged in employee whose period ends within a calendar or fiscal year. A fiscal year is named after the year it starts in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Show My Year-to-Date Pay",
+                "parameters": [
+                    {
+                        "enum": [
+                            "calendar",
+                            "fiscal"
+                        ],
+                        "type": "string",
+                        "default": "calendar",
+                        "description": "Year basis",
+                        "name": "basis",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Year, the current one when omitted",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Year-to-Date Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayslipYearToDateResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/overtime": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Submit an overtime request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Overtime"
+                ],
+                "summary": "Submit Overtime",
+                "parameters": [
+                    {
+                        "description": "Overtime Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.OvertimeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/overtime/policy": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the active overtime policy",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Overtime"
+                ],
+                "summary": "Get Overtime Policy",
+                "responses": {
+                    "200": {
+                        "description": "Overtime Policy Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.OvertimePolicyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Store a new version of the overtime policy, used by payrolls generated afterwards",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Overtime"
+                ],
+                "summary": "Update Overtime Policy",
+                "parameters": [
+                    {
+                        "description": "Overtime Policy Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.OvertimePolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Overtime Policy Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.OvertimePolicyResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue the payroll generation of a specific period, its progress is tracked with the returned job",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Generate Payroll",
+                "parameters": [
+                    {
+                        "description": "Generate Payroll Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.GeneratePayrollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Payroll Job Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/jobs/{jobId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show the status, progress and outcome of a payroll generation job",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Show Payroll Job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll Job ID",
+                        "name": "jobId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Job Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollJobResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/payslips/{payslipId}/verify": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Check the verification ID printed on a payslip document against the payslip as stored. A superseded payslip was issued for a payroll that has since been regenerated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Verify Payslip",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payslip ID",
+                        "name": "payslipId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Verification ID printed on the payslip",
+                        "name": "verification_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payslip Verification Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayslipVerificationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/preview": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Calculate payroll for a specific period without storing anything",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Preview Payroll",
+                "parameters": [
+                    {
+                        "description": "Preview Payroll Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.GeneratePayrollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Preview Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollPreviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/approvals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the approval trail of a payroll with the comment of every action, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List Payroll Approvals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Approvals Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.PayrollApprovalResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Approve a submitted payroll, its payslips become visible to the employees. The admin who submitted it cannot approve it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Approve Payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payroll Transition Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.PayrollTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Approval Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollApprovalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/bank-transfers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the take-home transfers of a payroll as a bank disbursement file. Employees without a take-home pay are left out, every other employee needs a bank account.",
+                "produces": [
+                    "text/csv",
+                    "text/plain",
+                    "application/xml"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Download Bank Transfers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "fixed_width",
+                            "pain001"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Requested execution date (YYYY-MM-DD), defaults to today",
+                        "name": "execution_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bank disbursement file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/diff/{otherPayrollId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the payslips of two payroll runs per employee, like two periods or a regenerated payroll and the version it voided. Changes reaching a threshold are flagged as anomalies, a zero threshold turns its flag off",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Diff Payrolls",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID to compare from",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payroll ID to compare to",
+                        "name": "otherPayrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Flag a take-home pay change of at least this percent",
+                        "name": "take_home_change_percent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Flag a base salary change of at least this percent",
+                        "name": "base_salary_change_percent",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "Flag an attendance change of at least this many days",
+                        "name": "attendance_days_change",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Flag an overtime change of at least this many hours",
+                        "name": "overtime_hours_change",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Diff Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollDiffResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/pay": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark an approved payroll as paid once the bank transfers are done",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Pay Payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payroll Transition Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.PayrollTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Approval Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollApprovalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/payslip": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show payslip for a specific payroll",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Show Payslip",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payslip Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayslipDataResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/payslip.pdf": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the payslip of the current user for a specific payroll as a PDF",
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Download Payslip",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payslip PDF",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/payslips": {
+            "get": {
+                "description": "List payslips for a specific payroll",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "List Payslips",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "offset",
+                        "description": "Mode",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of Payslips",
+                        "schema": {
+                            "$ref": "#/definitions/docshelper.Response-string-dtos_PayslipDataResponse-entity_ListPayslipMetadata"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/payslips.zip": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the payslips of every employee for a specific payroll as a ZIP of PDFs",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Download Payslips",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payslips ZIP",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/regenerate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Void the active payroll of a period and generate a new version of it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Regenerate Payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Regenerate Payroll Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.RegeneratePayrollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Generated Payroll Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.GeneratedPayrollResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Send a submitted payroll back to draft with a comment on what to fix. The admin who submitted it cannot reject it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Reject Payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reject Payroll Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.RejectPayrollRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Approval Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollApprovalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/payroll/{payrollId}/submit": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Submit a draft payroll for review by another admin",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Payroll"
+                ],
+                "summary": "Submit Payroll",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll ID",
+                        "name": "payrollId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payroll Transition Request",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.PayrollTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Approval Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollApprovalResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reimbursement": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Submit a reimbursement request",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Reimbursement"
+                ],
+                "summary": "Submit Reimbursement",
+                "parameters": [
+                    {
+                        "description": "Reimbursement Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.ReimbursementRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports/payroll-cost": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Break the cost of the payrolls of the periods starting within a range down by component, per period and optionally per department or cost centre, with the change of the total cost from the previous period. Voided payrolls are left out.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Show Payroll Cost Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First period start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last period start date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "none",
+                            "department",
+                            "cost_centre"
+                        ],
+                        "type": "string",
+                        "default": "none",
+                        "description": "Group the payslips by",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll Cost Report Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.PayrollCostReportResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/reports/payroll-cost.csv": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the payroll cost report as CSV, one row per period or per group within a period",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "Report"
+                ],
+                "summary": "Download Payroll Cost Report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First period start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last period start date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "none",
+                            "department",
+                            "cost_centre"
+                        ],
+                        "type": "string",
+                        "default": "none",
+                        "description": "Group the payslips by",
+                        "name": "group_by",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Payroll cost report",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/adjustments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the one-off adjustments of a user across periods",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "List Adjustments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Adjustments Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AdjustmentResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a one-off bonus or correction to the payroll of a period, a negative amount takes money back",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "Create Adjustment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Adjustment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AdjustmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Adjustment Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AdjustmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/adjustments/{adjustmentId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a one-off adjustment, payslips already generated keep the amount they were paid",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "Delete Adjustment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Adjustment ID",
+                        "name": "adjustmentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/allowances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the recurring allowances of a user, including ended ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "List Allowances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Allowances Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.AllowanceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a recurring allowance paid in full with every payroll whose period it overlaps, taxable allowances are part of the gross pay",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "Create Allowance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Allowance Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.AllowanceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Allowance Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.AllowanceResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/allowances/{allowanceId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Stop an allowance, payslips already generated keep the amount they were paid",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Adjustment"
+                ],
+                "summary": "Delete Allowance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Allowance ID",
+                        "name": "allowanceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/bank-account": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show the bank account the take-home pay of a user is transferred to",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bank Account"
+                ],
+                "summary": "Show Bank Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bank Account Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.BankAccountResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the bank account of a user, replacing the previous one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bank Account"
+                ],
+                "summary": "Upsert Bank Account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bank Account Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.BankAccountRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Bank Account Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.BankAccountResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/department": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the department and cost centre payroll costs of a user are reported under, payslips keep the ones they were generated with",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User Department",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User Department Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserDepartmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User Department Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.UserDepartmentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/employment": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the dates a user is employed between, payroll excludes users outside a period and prorates the ones joining or leaving within it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User Employment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User Employment Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserEmploymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User Employment Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.UserEmploymentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/leave/balances": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the leave balances of a user for a year",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Leave"
+                ],
+                "summary": "List Leave Balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Year, the current year when empty",
+                        "name": "year",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Leave Balances Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LeaveBalanceResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/loans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the loans of a user with their outstanding balance, including repaid ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan"
+                ],
+                "summary": "List Loans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Loans Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.LoanResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Grant a salary advance repaid through payroll, every payroll from the period containing the start date deducts the installment as long as the net pay allows it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan"
+                ],
+                "summary": "Create Loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create Loan Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.LoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Loan Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LoanResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/loans/{loanId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Show a loan of a user with its installment schedule and the repayments deducted by payrolls that were not voided",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Loan"
+                ],
+                "summary": "Show Loan Statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "loanId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Loan Statement Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.LoanStatementResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/manager": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the manager approving the leave of a user, without a manager only admins approve it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User Manager",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User Manager Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserManagerRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User Manager Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.UserManagerResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/salaries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the salary changes of a user, including scheduled ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Salary"
+                ],
+                "summary": "List Salary History",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Salary History Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/dtos.SalaryHistoryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a new salary for a user from the effective date on, payroll prorates periods the change falls in",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Salary"
+                ],
+                "summary": "Schedule Salary Change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Salary Change Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.SalaryChangeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Salary History Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.SalaryHistoryResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/salaries/{salaryId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a salary change that is not effective yet",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Salary"
+                ],
+                "summary": "Cancel Salary Change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Salary Change ID",
+                        "name": "salaryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success",
+                        "schema": {
+                            "$ref": "#/definitions/dtos.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/{userId}/timezone": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Set the IANA timezone attendance and overtime of a user are dated and checked in, records already submitted keep their dates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Users"
+                ],
+                "summary": "Update User Timezone",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User Timezone Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dtos.UserTimezoneRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User Timezone Response",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/dtos.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/dtos.UserTimezoneResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperror.Error"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apperror.Error": {
+            "type": "object",
+            "properties": {
+                "formatted": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apperror.ErrorIssue"
+                    }
+                }
+            }
         },
         "apperror.ErrorIssue": {
             "type": "object",
             "properties": {
-                "expected": {},
-                "issue_code": {
-                    "type": "string"
+                "expected": {},
+                "issue_code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "received": {}
+            }
+        },
+        "docshelper.Response-string-dtos_AttendanceResponse-entity_ListAttendanceMetadata": {
+            "type": "object",
+            "properties": {
+                "request_id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "metadata": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListAttendanceMetadata"
+                        }
+                    ],
+                    "x-order": "1"
+                },
+                "data": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/resourceful.Data-string-dtos_AttendanceResponse"
+                        }
+                    ],
+                    "x-order": "2"
+                }
+            }
+        },
+        "docshelper.Response-string-dtos_PayslipDataResponse-entity_ListPayslipMetadata": {
+            "type": "object",
+            "properties": {
+                "request_id": {
+                    "type": "string",
+                    "x-order": "0"
+                },
+                "metadata": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ListPayslipMetadata"
+                        }
+                    ],
+                    "x-order": "1"
+                },
+                "data": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/resourceful.Data-string-dtos_PayslipDataResponse"
+                        }
+                    ],
+                    "x-order": "2"
+                }
+            }
+        },
+        "dtos.AdjustmentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "code",
+                "name",
+                "period_id",
+                "taxable"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "taxable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.AdjustmentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "taxable": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AllowanceRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "code",
+                "name",
+                "start_date",
+                "taxable"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "taxable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.AllowanceResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "taxable": {
+                    "type": "boolean"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendanceCalendarResponse": {
+            "type": "object",
+            "properties": {
+                "dates": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.AttendanceCalendarRowResponse"
+                    }
+                }
+            }
+        },
+        "dtos.AttendanceCalendarRowResponse": {
+            "type": "object",
+            "properties": {
+                "absent_days": {
+                    "type": "integer"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leave_days": {
+                    "type": "integer"
+                },
+                "present_days": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendanceCorrectionDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/optional.String"
+                }
+            }
+        },
+        "dtos.AttendanceCorrectionRequest": {
+            "type": "object",
+            "required": [
+                "attendance_date",
+                "reason"
+            ],
+            "properties": {
+                "attendance_date": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendanceCorrectionResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_date": {
+                    "type": "string"
+                },
+                "attendance_id": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "decided_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "decided_by": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "decision_comment": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendancePeriodDataResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendancePeriodReopeningResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendancePeriodRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendancePeriodResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payroll_id": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.AttendanceResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_date": {
+                    "type": "string"
+                },
+                "clock_in_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "clock_out_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "early_leave_by": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "late_by": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "worked_duration": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.BankAccountRequest": {
+            "type": "object",
+            "required": [
+                "account_holder_name",
+                "account_number",
+                "bank_code",
+                "bank_name"
+            ],
+            "properties": {
+                "account_holder_name": {
+                    "type": "string"
+                },
+                "account_number": {
+                    "type": "string"
+                },
+                "bank_code": {
+                    "type": "string"
+                },
+                "bank_name": {
+                    "type": "string"
+                },
+                "bic": {
+                    "$ref": "#/definitions/optional.String"
+                }
+            }
+        },
+        "dtos.BankAccountResponse": {
+            "type": "object",
+            "properties": {
+                "account_holder_name": {
+                    "type": "string"
+                },
+                "account_number": {
+                    "type": "string"
+                },
+                "bank_code": {
+                    "type": "string"
+                },
+                "bank_name": {
+                    "type": "string"
+                },
+                "bic": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "updated_by": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.CountDiffResponse": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "integer"
+                },
+                "from": {
+                    "type": "integer"
+                },
+                "to": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.DurationDiffResponse": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.GeneratePayrollRequest": {
+            "type": "object",
+            "required": [
+                "period_id"
+            ],
+            "properties": {
+                "period_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.GeneratedPayrollResponse": {
+            "type": "object",
+            "properties": {
+                "generated_at": {
+                    "type": "string"
+                },
+                "generated_by": {
+                    "type": "string"
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entity.PayrollStatus"
+                },
+                "total_deductions": {
+                    "type": "number"
+                },
+                "total_employee": {
+                    "type": "integer"
+                },
+                "total_gross_pay": {
+                    "type": "number"
+                },
+                "total_payslip": {
+                    "type": "integer"
+                },
+                "total_reimbursement": {
+                    "type": "number"
+                },
+                "total_take_home_pay": {
+                    "type": "number"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.HolidayRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "name"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.HolidayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.ImportHolidaysResponse": {
+            "type": "object",
+            "properties": {
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.ImportedHolidayResponse"
+                    }
+                },
+                "total_imported": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.ImportedHolidayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LeaveAccrualRequest": {
+            "type": "object",
+            "required": [
+                "year"
+            ],
+            "properties": {
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.LeaveAccrualResponse": {
+            "type": "object",
+            "properties": {
+                "balances": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.LeaveBalanceResponse"
+                    }
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.LeaveBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "accrued_days": {
+                    "type": "integer"
+                },
+                "available_days": {
+                    "type": "integer"
+                },
+                "carried_over_days": {
+                    "type": "integer"
+                },
+                "entitlement": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "leave_type_code": {
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "leave_type_name": {
+                    "type": "string"
+                },
+                "pending_days": {
+                    "type": "integer"
+                },
+                "used_days": {
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.LeaveCalendarDayResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "leaves": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.LeaveRequestResponse"
+                    }
+                }
+            }
+        },
+        "dtos.LeaveDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "$ref": "#/definitions/optional.String"
+                }
+            }
+        },
+        "dtos.LeaveRequestRequest": {
+            "type": "object",
+            "required": [
+                "end_date",
+                "leave_type_id",
+                "start_date"
+            ],
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LeaveRequestResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "integer"
+                },
+                "decided_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "decided_by": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "decision_comment": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "leave_type_code": {
+                    "type": "string"
+                },
+                "leave_type_id": {
+                    "type": "string"
+                },
+                "leave_type_name": {
+                    "type": "string"
+                },
+                "paid": {
+                    "type": "boolean"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LeaveTypeRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "annual_allowance": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "max_carry_over": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paid": {
+                    "$ref": "#/definitions/optional.Bool"
+                }
+            }
+        },
+        "dtos.LeaveTypeResponse": {
+            "type": "object",
+            "properties": {
+                "annual_allowance": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "max_carry_over": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.LoanInstallmentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "balance_after": {
+                    "type": "number"
+                },
+                "number": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.LoanRepaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "payroll_status": {
+                    "type": "string"
+                },
+                "payslip_id": {
+                    "type": "string"
+                },
+                "period_end_date": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "period_start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LoanRequest": {
+            "type": "object",
+            "required": [
+                "installment_amount",
+                "principal",
+                "start_date"
+            ],
+            "properties": {
+                "installment_amount": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LoanResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installment_amount": {
+                    "type": "number"
+                },
+                "outstanding_balance": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "repaid_amount": {
+                    "type": "number"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.LoanStatementResponse": {
+            "type": "object",
+            "properties": {
+                "loan": {
+                    "$ref": "#/definitions/dtos.LoanResponse"
+                },
+                "repayments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.LoanRepaymentResponse"
+                    }
+                },
+                "schedule": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.LoanInstallmentResponse"
+                    }
+                }
+            }
+        },
+        "dtos.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.MoneyDiffResponse": {
+            "type": "object",
+            "properties": {
+                "delta": {
+                    "type": "number"
+                },
+                "from": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.MyPayslipResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_days": {
+                    "type": "integer"
+                },
+                "attendance_period": {
+                    "$ref": "#/definitions/dtos.AttendancePeriodDataResponse"
+                },
+                "base_salary": {
+                    "type": "number"
+                },
+                "deductions": {
+                    "type": "number"
+                },
+                "gross_pay": {
+                    "type": "number"
+                },
+                "overtime_hours": {
+                    "type": "string"
+                },
+                "overtime_pay": {
+                    "type": "number"
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "payroll_status": {
+                    "type": "string"
+                },
+                "payroll_version": {
+                    "type": "integer"
+                },
+                "payslip_id": {
+                    "type": "string"
+                },
+                "reimbursement": {
+                    "type": "number"
+                },
+                "take_home_pay": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.OvertimeDataResponse": {
+            "type": "object",
+            "properties": {
+                "multiplier": {
+                    "type": "number"
+                },
+                "overtime_hours": {
+                    "type": "string"
+                },
+                "overtime_pay": {
+                    "type": "number"
+                },
+                "policy_version": {
+                    "$ref": "#/definitions/optional.Int64"
+                },
+                "rate_per_hour": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.OvertimePolicyRequest": {
+            "type": "object",
+            "required": [
+                "standard_hours_per_day",
+                "weekday_multiplier",
+                "weekend_multiplier"
+            ],
+            "properties": {
+                "standard_hours_per_day": {
+                    "type": "integer"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.OvertimeTierRequest"
+                    }
+                },
+                "weekday_multiplier": {
+                    "type": "number"
+                },
+                "weekend_multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.OvertimePolicyResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "standard_hours_per_day": {
+                    "type": "integer"
+                },
+                "tiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.OvertimeTierResponse"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "weekday_multiplier": {
+                    "type": "number"
+                },
+                "weekend_multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.OvertimeRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "overtime"
+            ],
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "overtime": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.OvertimeTierRequest": {
+            "type": "object",
+            "required": [
+                "after_hours",
+                "multiplier"
+            ],
+            "properties": {
+                "after_hours": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.OvertimeTierResponse": {
+            "type": "object",
+            "properties": {
+                "after_hours": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.PayrollApprovalResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "from_status": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "to_status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayrollCostGroupResponse": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "$ref": "#/definitions/dtos.PayrollCostResponse"
+                },
+                "key": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "total_cost_change": {
+                    "$ref": "#/definitions/optional.Money"
+                }
+            }
+        },
+        "dtos.PayrollCostPeriodResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayrollCostGroupResponse"
+                    }
+                },
+                "payroll_id": {
+                    "type": "string"
+                },
+                "payroll_status": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/dtos.PayrollCostGroupResponse"
+                }
+            }
+        },
+        "dtos.PayrollCostReportResponse": {
+            "type": "object",
+            "properties": {
+                "end_date": {
+                    "type": "string"
+                },
+                "group_by": {
+                    "type": "string"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayrollCostPeriodResponse"
+                    }
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/dtos.PayrollCostResponse"
+                }
+            }
+        },
+        "dtos.PayrollCostResponse": {
+            "type": "object",
+            "properties": {
+                "base_pay": {
+                    "type": "number"
+                },
+                "deductions": {
+                    "type": "number"
+                },
+                "earnings": {
+                    "type": "number"
+                },
+                "gross_pay": {
+                    "type": "number"
+                },
+                "headcount": {
+                    "type": "integer"
+                },
+                "overtime_pay": {
+                    "type": "number"
+                },
+                "reimbursement": {
+                    "type": "number"
+                },
+                "take_home_pay": {
+                    "type": "number"
+                },
+                "total_cost": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.PayrollDiffResponse": {
+            "type": "object",
+            "properties": {
+                "employees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayslipDiffResponse"
+                    }
+                },
+                "from": {
+                    "$ref": "#/definitions/dtos.PayrollRunResponse"
+                },
+                "summary": {
+                    "$ref": "#/definitions/dtos.PayrollDiffSummaryResponse"
+                },
+                "thresholds": {
+                    "$ref": "#/definitions/dtos.PayrollDiffThresholdsResponse"
+                },
+                "to": {
+                    "$ref": "#/definitions/dtos.PayrollRunResponse"
+                }
+            }
+        },
+        "dtos.PayrollDiffSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "anomaly_employee": {
+                    "type": "integer"
+                },
+                "changed_employee": {
+                    "type": "integer"
+                },
+                "missing_employee": {
+                    "type": "integer"
+                },
+                "new_employee": {
+                    "type": "integer"
+                },
+                "total_base_salary": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "total_employee": {
+                    "$ref": "#/definitions/dtos.CountDiffResponse"
+                },
+                "total_overtime_pay": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "total_reimbursement": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "total_take_home_pay": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                }
+            }
+        },
+        "dtos.PayrollDiffThresholdsResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_days_change": {
+                    "type": "integer"
+                },
+                "base_salary_change_percent": {
+                    "type": "integer"
+                },
+                "overtime_hours_change": {
+                    "type": "string"
+                },
+                "take_home_change_percent": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.PayrollJobFailureResponse": {
+            "type": "object",
+            "properties": {
+                "issue_code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayrollJobProgressResponse": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "integer"
+                },
+                "percent": {
+                    "type": "integer"
+                },
+                "stage": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.PayrollJobResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "string"
+                },
+                "failure": {
+                    "$ref": "#/definitions/dtos.PayrollJobFailureResponse"
+                },
+                "finished_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "progress": {
+                    "$ref": "#/definitions/dtos.PayrollJobProgressResponse"
+                },
+                "result": {
+                    "$ref": "#/definitions/dtos.GeneratedPayrollResponse"
+                },
+                "started_at": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayrollPreviewResponse": {
+            "type": "object",
+            "properties": {
+                "payslips": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayslipDataResponse"
+                    }
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "total_deductions": {
+                    "type": "number"
+                },
+                "total_employee": {
+                    "type": "integer"
+                },
+                "total_gross_pay": {
+                    "type": "number"
+                },
+                "total_payslip": {
+                    "type": "integer"
+                },
+                "total_reimbursement": {
+                    "type": "number"
+                },
+                "total_take_home_pay": {
+                    "type": "number"
+                }
+            }
+        },
+        "dtos.PayrollRunResponse": {
+            "type": "object",
+            "properties": {
+                "payroll_id": {
+                    "type": "string"
+                },
+                "period_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "voided": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.PayrollTransitionRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayslipDataResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_days": {
+                    "type": "integer"
+                },
+                "attendance_pay": {
+                    "type": "number"
+                },
+                "attendance_period": {
+                    "$ref": "#/definitions/dtos.AttendancePeriodDataResponse"
+                },
+                "base_salary": {
+                    "type": "number"
+                },
+                "deductions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayslipItemResponse"
+                    }
+                },
+                "earnings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.PayslipEarningResponse"
+                    }
+                },
+                "eligible_working_days": {
+                    "type": "integer"
+                },
+                "gross_pay": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "overtime": {
+                    "$ref": "#/definitions/dtos.OvertimeDataResponse"
+                },
+                "proration_factor": {
+                    "type": "number"
+                },
+                "reimbursement_total": {
+                    "type": "number"
+                },
+                "reimbursements": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.ReimbursementDataResponse"
+                    }
+                },
+                "salary_segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.SalarySegmentResponse"
+                    }
+                },
+                "total_deductions": {
+                    "type": "number"
+                },
+                "total_take_home_pay": {
+                    "type": "number"
+                },
+                "user": {
+                    "$ref": "#/definitions/dtos.UserDataResponse"
+                },
+                "verification_id": {
+                    "type": "string"
+                },
+                "worked_duration": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "working_days": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.PayslipDiffResponse": {
+            "type": "object",
+            "properties": {
+                "anomalies": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "attendance_days": {
+                    "$ref": "#/definitions/dtos.CountDiffResponse"
+                },
+                "base_salary": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "overtime_hours": {
+                    "$ref": "#/definitions/dtos.DurationDiffResponse"
+                },
+                "overtime_pay": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "reimbursement": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "take_home_pay": {
+                    "$ref": "#/definitions/dtos.MoneyDiffResponse"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayslipEarningResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "taxable": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.PayslipItemResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.PayslipVerificationResponse": {
+            "type": "object",
+            "properties": {
+                "payroll_id": {
+                    "type": "string"
+                },
+                "payslip_id": {
+                    "type": "string"
+                },
+                "superseded": {
+                    "type": "boolean"
+                },
+                "total_take_home_pay": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "dtos.PayslipYearToDateResponse": {
+            "type": "object",
+            "properties": {
+                "basis": {
+                    "type": "string"
+                },
+                "deductions": {
+                    "type": "number"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "gross_pay": {
+                    "type": "number"
+                },
+                "overtime_pay": {
+                    "type": "number"
+                },
+                "reimbursement": {
+                    "type": "number"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "take_home_pay": {
+                    "type": "number"
+                },
+                "total_payslip": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dtos.RegeneratePayrollRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.ReimbursementDataResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "reimbursement_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.ReimbursementRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "date"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
                 },
-                "message": {
+                "date": {
                     "type": "string"
                 },
-                "path": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "received": {}
+                "description": {
+                    "$ref": "#/definitions/optional.String"
+                }
             }
         },
-        "docshelper.Response-string-dtos_PayslipDataResponse-entity_ListPayslipMetadata": {
+        "dtos.RejectPayrollRequest": {
             "type": "object",
+            "required": [
+                "comment"
+            ],
             "properties": {
-                "request_id": {
-                    "type": "string",
-                    "x-order": "0"
-                },
-                "metadata": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/entity.ListPayslipMetadata"
-                        }
-                    ],
-                    "x-order": "1"
-                },
-                "data": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/resourceful.Data-string-dtos_PayslipDataResponse"
-                        }
-                    ],
-                    "x-order": "2"
+                "comment": {
+                    "type": "string"
                 }
             }
         },
-        "dtos.AttendancePeriodDataResponse": {
+        "dtos.ReopenAttendancePeriodRequest": {
             "type": "object",
+            "required": [
+                "reason"
+            ],
             "properties": {
-                "end_date": {
+                "reason": {
                     "type": "string"
+                }
+            }
+        },
+        "dtos.Response": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
                 },
-                "start_date": {
+                "request_id": {
                     "type": "string"
                 }
             }
         },
-        "dtos.GeneratePayrollRequest": {
+        "dtos.SalaryChangeRequest": {
             "type": "object",
             "required": [
-                "period_id"
+                "effective_from",
+                "salary"
             ],
             "properties": {
-                "period_id": {
+                "effective_from": {
                     "type": "string"
+                },
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
+                },
+                "salary": {
+                    "type": "number"
                 }
             }
         },
-        "dtos.GeneratedPayrollResponse": {
+        "dtos.SalaryHistoryResponse": {
             "type": "object",
             "properties": {
-                "generated_at": {
+                "created_at": {
                     "type": "string"
                 },
-                "generated_by": {
+                "created_by": {
                     "type": "string"
                 },
-                "payroll_id": {
+                "effective_from": {
                     "type": "string"
                 },
-                "period_id": {
+                "id": {
                     "type": "string"
                 },
-                "total_employee": {
-                    "type": "integer"
+                "reason": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "total_payslip": {
+                "salary": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dtos.SalarySegmentResponse": {
+            "type": "object",
+            "properties": {
+                "attendance_days": {
                     "type": "integer"
                 },
-                "total_take_home_pay": {
+                "attendance_pay": {
+                    "type": "number"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "overtime_rate_per_hour": {
+                    "type": "number"
+                },
+                "salary": {
+                    "type": "number"
+                },
+                "start_date": {
+                    "type": "string"
+                },
+                "working_days": {
                     "type": "integer"
                 }
             }
         },
-        "dtos.LoginRequest": {
+        "dtos.UserDataResponse": {
             "type": "object",
-            "required": [
-                "password",
-                "username"
-            ],
             "properties": {
-                "password": {
+                "id": {
                     "type": "string"
                 },
                 "username": {
@@ -500,140 +6264,126 @@ const docTemplate = `{
                 }
             }
         },
-        "dtos.OvertimeDataResponse": {
+        "dtos.UserDepartmentRequest": {
             "type": "object",
             "properties": {
-                "multiplier": {
-                    "type": "number"
+                "cost_centre": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "overtime_hours": {
-                    "type": "string"
+                "department": {
+                    "$ref": "#/definitions/optional.String"
+                }
+            }
+        },
+        "dtos.UserDepartmentResponse": {
+            "type": "object",
+            "properties": {
+                "cost_centre": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "overtime_pay": {
-                    "type": "integer"
+                "department": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "rate_per_hour": {
-                    "type": "integer"
+                "id": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "dtos.OvertimeRequest": {
+        "dtos.UserEmploymentRequest": {
             "type": "object",
             "required": [
-                "date",
-                "overtime"
+                "employment_start_date"
             ],
             "properties": {
-                "date": {
-                    "type": "string"
+                "employment_end_date": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "overtime": {
+                "employment_start_date": {
                     "type": "string"
                 }
             }
         },
-        "dtos.PayslipDataResponse": {
+        "dtos.UserEmploymentResponse": {
             "type": "object",
             "properties": {
-                "attendance_days": {
-                    "type": "integer"
-                },
-                "attendance_pay": {
-                    "type": "integer"
-                },
-                "attendance_period": {
-                    "$ref": "#/definitions/dtos.AttendancePeriodDataResponse"
+                "employment_end_date": {
+                    "$ref": "#/definitions/optional.String"
                 },
-                "base_salary": {
-                    "type": "integer"
+                "employment_start_date": {
+                    "type": "string"
                 },
                 "id": {
                     "type": "string"
                 },
-                "overtime": {
-                    "$ref": "#/definitions/dtos.OvertimeDataResponse"
-                },
-                "reimbursement_total": {
-                    "type": "integer"
-                },
-                "reimbursements": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dtos.ReimbursementDataResponse"
-                    }
-                },
-                "total_take_home_pay": {
-                    "type": "integer"
-                },
-                "user": {
-                    "$ref": "#/definitions/dtos.UserDataResponse"
-                },
-                "working_days": {
-                    "type": "integer"
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "dtos.ReimbursementDataResponse": {
+        "dtos.UserManagerRequest": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "description": {
+                "manager_id": {
                     "$ref": "#/definitions/optional.String"
-                },
-                "reimbursement_date": {
-                    "type": "string"
                 }
             }
         },
-        "dtos.ReimbursementRequest": {
+        "dtos.UserManagerResponse": {
             "type": "object",
-            "required": [
-                "amount",
-                "date"
-            ],
             "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "date": {
+                "id": {
                     "type": "string"
                 },
-                "description": {
+                "manager_id": {
                     "$ref": "#/definitions/optional.String"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "dtos.Response": {
+        "dtos.UserTimezoneRequest": {
             "type": "object",
+            "required": [
+                "timezone"
+            ],
             "properties": {
-                "data": {},
-                "errors": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
-                    }
-                },
-                "request_id": {
+                "timezone": {
                     "type": "string"
                 }
             }
         },
-        "dtos.UserDataResponse": {
+        "dtos.UserTimezoneResponse": {
             "type": "object",
             "properties": {
                 "id": {
                     "type": "string"
                 },
+                "timezone": {
+                    "type": "string"
+                },
                 "username": {
                     "type": "string"
                 }
             }
         },
+        "entity.ListAttendanceMetadata": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "$ref": "#/definitions/optional.String"
+                }
+            }
+        },
         "entity.ListPayslipMetadata": {
             "type": "object",
             "properties": {
@@ -650,13 +6400,54 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "total_take_home_pay": {
-                    "type": "integer"
+                    "type": "number"
                 }
             }
         },
+        "entity.PayrollStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "submitted",
+                "approved",
+                "paid"
+            ],
+            "x-enum-varnames": [
+                "PayrollStatusDraft",
+                "PayrollStatusSubmitted",
+                "PayrollStatusApproved",
+                "PayrollStatusPaid"
+            ]
+        },
+        "optional.Bool": {
+            "type": "object"
+        },
+        "optional.Int64": {
+            "type": "object"
+        },
+        "optional.Money": {
+            "type": "object"
+        },
         "optional.String": {
             "type": "object"
         },
+        "resourceful.Data-string-dtos_AttendanceResponse": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "paginated_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dtos.AttendanceResponse"
+                    }
+                }
+            }
+        },
         "resourceful.Data-string-dtos_PayslipDataResponse": {
             "type": "object",
             "properties": {
@@ -0,0 +1,280 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/adjustment"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type AdjustmentHandler struct {
+	uc adjustment.UseCase
+}
+
+func NewAdjustmentHandler(uc adjustment.UseCase) *AdjustmentHandler {
+	return &AdjustmentHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      List Allowances
+// @Description  List the recurring allowances of a user, including ended ones
+// @Tags         Adjustment
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} dtos.Response{data=[]dtos.AllowanceResponse} "Allowances Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/allowances [GET]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) ListAllowances(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.ListAllowances()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().ListAllowances().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ListAllowances(ctx, authCredential, param.UserID.String())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().ListAllowances().uc.ListAllowances()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAllowanceResponse(data),
+		},
+	)
+}
+
+// @Summary      Create Allowance
+// @Description  Add a recurring allowance paid in full with every payroll whose period it overlaps, taxable allowances are part of the gross pay
+// @Tags         Adjustment
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.AllowanceRequest true "Create Allowance Request"
+// @Success      201 {object} dtos.Response{data=dtos.AllowanceResponse} "Allowance Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/allowances [POST]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) CreateAllowance(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.CreateAllowance()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAllowance().c.ParamsParser()")
+	}
+
+	var req dtos.AllowanceRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAllowance().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAllowance().req.Validate()")
+	}
+
+	data, err := h.uc.CreateAllowance(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAllowance().uc.CreateAllowance()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAllowanceResponse(data),
+		},
+	)
+}
+
+// @Summary      Delete Allowance
+// @Description  Stop an allowance, payslips already generated keep the amount they were paid
+// @Tags         Adjustment
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        allowanceId path string true "Allowance ID"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/allowances/{allowanceId} [DELETE]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) DeleteAllowance(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.DeleteAllowance()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID      uuid.UUID `params:"userId"`
+		AllowanceID uuid.UUID `params:"allowanceId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().DeleteAllowance().c.ParamsParser()")
+	}
+
+	err = h.uc.DeleteAllowance(ctx, authCredential, param.UserID.String(), param.AllowanceID.String())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().DeleteAllowance().uc.DeleteAllowance()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+		},
+	)
+}
+
+// @Summary      List Adjustments
+// @Description  List the one-off adjustments of a user across periods
+// @Tags         Adjustment
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} dtos.Response{data=[]dtos.AdjustmentResponse} "Adjustments Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/adjustments [GET]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) ListAdjustments(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.ListAdjustments()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().ListAdjustments().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ListAdjustments(ctx, authCredential, param.UserID.String())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().ListAdjustments().uc.ListAdjustments()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAdjustmentResponse(data),
+		},
+	)
+}
+
+// @Summary      Create Adjustment
+// @Description  Attach a one-off bonus or correction to the payroll of a period, a negative amount takes money back
+// @Tags         Adjustment
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.AdjustmentRequest true "Create Adjustment Request"
+// @Success      201 {object} dtos.Response{data=dtos.AdjustmentResponse} "Adjustment Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/adjustments [POST]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) CreateAdjustment(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.CreateAdjustment()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAdjustment().c.ParamsParser()")
+	}
+
+	var req dtos.AdjustmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAdjustment().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAdjustment().req.Validate()")
+	}
+
+	data, err := h.uc.CreateAdjustment(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().CreateAdjustment().uc.CreateAdjustment()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAdjustmentResponse(data),
+		},
+	)
+}
+
+// @Summary      Delete Adjustment
+// @Description  Remove a one-off adjustment, payslips already generated keep the amount they were paid
+// @Tags         Adjustment
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        adjustmentId path string true "Adjustment ID"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/adjustments/{adjustmentId} [DELETE]
+// @Security     BearerAuth
+func (h *AdjustmentHandler) DeleteAdjustment(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AdjustmentHandler.DeleteAdjustment()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID       uuid.UUID `params:"userId"`
+		AdjustmentID uuid.UUID `params:"adjustmentId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().DeleteAdjustment().c.ParamsParser()")
+	}
+
+	err = h.uc.DeleteAdjustment(ctx, authCredential, param.UserID.String(), param.AdjustmentID.String())
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentHandler().DeleteAdjustment().uc.DeleteAdjustment()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+		},
+	)
+}
@@ -0,0 +1,17 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapAdjustment(routes fiber.Router, h *AdjustmentHandler) {
+	allowances := routes.Group("/users/:userId/allowances")
+
+	allowances.Get("/", h.ListAllowances)
+	allowances.Post("/", h.CreateAllowance)
+	allowances.Delete("/:allowanceId", h.DeleteAllowance)
+
+	adjustments := routes.Group("/users/:userId/adjustments")
+
+	adjustments.Get("/", h.ListAdjustments)
+	adjustments.Post("/", h.CreateAdjustment)
+	adjustments.Delete("/:adjustmentId", h.DeleteAdjustment)
+}
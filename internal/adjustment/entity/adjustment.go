@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// Allowance is a recurring earning of a user, paid in full with every payroll
+// whose period overlaps StartDate and EndDate. An empty EndDate keeps it
+// running.
+type Allowance struct {
+	ID        string        `db:"id"`
+	UserID    string        `db:"user_id"`
+	Code      string        `db:"code"`
+	Name      string        `db:"name"`
+	Amount    money.Money   `db:"amount"`
+	Taxable   bool          `db:"taxable"`
+	StartDate time.Time     `db:"start_date"`
+	EndDate   optional.Time `db:"end_date"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+	CreatedBy string        `db:"created_by"`
+	UpdatedBy string        `db:"updated_by"`
+	IPAddress string        `db:"ip_address"`
+}
+
+type CreateAllowance struct {
+	Code      string
+	Name      string
+	Amount    money.Money
+	Taxable   bool
+	StartDate time.Time
+	EndDate   optional.Time
+}
+
+// Adjustment is a one-off bonus or correction paid with the payroll of a
+// period. A negative Amount takes money back.
+type Adjustment struct {
+	ID        string          `db:"id"`
+	UserID    string          `db:"user_id"`
+	PeriodID  string          `db:"period_id"`
+	Code      string          `db:"code"`
+	Name      string          `db:"name"`
+	Amount    money.Money     `db:"amount"`
+	Taxable   bool            `db:"taxable"`
+	Reason    optional.String `db:"reason"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+	CreatedBy string          `db:"created_by"`
+	UpdatedBy string          `db:"updated_by"`
+	IPAddress string          `db:"ip_address"`
+}
+
+type CreateAdjustment struct {
+	PeriodID string
+	Code     string
+	Name     string
+	Amount   money.Money
+	Taxable  bool
+	Reason   optional.String
+}
+
+type MappedBy string
+
+const (
+	MappedByUserID MappedBy = "user_id"
+)
+
+type MappedOptions struct {
+	MappedBy MappedBy
+}
+
+type FindAllowanceOptions struct {
+	PessimisticLock bool
+	*MappedOptions
+}
+
+type FindAllowanceResult struct {
+	List     []Allowance
+	Mapped   map[any][]Allowance
+	IsMapped bool
+	MappedBy MappedBy
+}
+
+type FindAdjustmentOptions struct {
+	PessimisticLock bool
+	*MappedOptions
+}
+
+type FindAdjustmentResult struct {
+	List     []Adjustment
+	Mapped   map[any][]Adjustment
+	IsMapped bool
+	MappedBy MappedBy
+}
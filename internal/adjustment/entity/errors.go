@@ -0,0 +1,32 @@
+package entity
+
+const (
+	AdjustmentNotAuthorized  = "ADJUSTMENT_NOT_AUTHORIZED"
+	AdjustmentUserNotFound   = "ADJUSTMENT_USER_NOT_FOUND"
+	AdjustmentPeriodNotFound = "ADJUSTMENT_PERIOD_NOT_FOUND"
+	AdjustmentNotFound       = "ADJUSTMENT_NOT_FOUND"
+	AllowanceNotFound        = "ALLOWANCE_NOT_FOUND"
+	AllowanceInvalidDates    = "ALLOWANCE_INVALID_DATES"
+	AdjustmentPeriodClosed   = "ADJUSTMENT_PERIOD_CLOSED"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case AdjustmentNotAuthorized:
+		return "You are not authorized to manage allowances and adjustments"
+	case AdjustmentUserNotFound:
+		return "User not found"
+	case AdjustmentPeriodNotFound:
+		return "Attendance period not found"
+	case AdjustmentNotFound:
+		return "Adjustment not found"
+	case AllowanceNotFound:
+		return "Allowance not found"
+	case AllowanceInvalidDates:
+		return "The allowance end date cannot be before its start date"
+	case AdjustmentPeriodClosed:
+		return "Adjustments cannot be changed, the payroll of the period has already been generated or paid"
+	default:
+		return "An unknown error occurred"
+	}
+}
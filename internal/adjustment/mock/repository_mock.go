@@ -0,0 +1,479 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adjustment/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/adjustment/repository.go -destination internal/adjustment/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	adjustment "github.com/vnnyx/employee-management/internal/adjustment"
+	entity "github.com/vnnyx/employee-management/internal/adjustment/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteAdjustment mocks base method.
+func (m *MockRepository) DeleteAdjustment(ctx context.Context, adjustmentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdjustment", ctx, adjustmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdjustment indicates an expected call of DeleteAdjustment.
+func (mr *MockRepositoryMockRecorder) DeleteAdjustment(ctx, adjustmentID any) *MockRepositoryDeleteAdjustmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdjustment", reflect.TypeOf((*MockRepository)(nil).DeleteAdjustment), ctx, adjustmentID)
+	return &MockRepositoryDeleteAdjustmentCall{Call: call}
+}
+
+// MockRepositoryDeleteAdjustmentCall wrap *gomock.Call
+type MockRepositoryDeleteAdjustmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryDeleteAdjustmentCall) Return(arg0 error) *MockRepositoryDeleteAdjustmentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryDeleteAdjustmentCall) Do(f func(context.Context, string) error) *MockRepositoryDeleteAdjustmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryDeleteAdjustmentCall) DoAndReturn(f func(context.Context, string) error) *MockRepositoryDeleteAdjustmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAllowance mocks base method.
+func (m *MockRepository) DeleteAllowance(ctx context.Context, allowanceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllowance", ctx, allowanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllowance indicates an expected call of DeleteAllowance.
+func (mr *MockRepositoryMockRecorder) DeleteAllowance(ctx, allowanceID any) *MockRepositoryDeleteAllowanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllowance", reflect.TypeOf((*MockRepository)(nil).DeleteAllowance), ctx, allowanceID)
+	return &MockRepositoryDeleteAllowanceCall{Call: call}
+}
+
+// MockRepositoryDeleteAllowanceCall wrap *gomock.Call
+type MockRepositoryDeleteAllowanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryDeleteAllowanceCall) Return(arg0 error) *MockRepositoryDeleteAllowanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryDeleteAllowanceCall) Do(f func(context.Context, string) error) *MockRepositoryDeleteAllowanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryDeleteAllowanceCall) DoAndReturn(f func(context.Context, string) error) *MockRepositoryDeleteAllowanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAdjustmentByID mocks base method.
+func (m *MockRepository) FindAdjustmentByID(ctx context.Context, userID, adjustmentID string) (*entity.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdjustmentByID", ctx, userID, adjustmentID)
+	ret0, _ := ret[0].(*entity.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdjustmentByID indicates an expected call of FindAdjustmentByID.
+func (mr *MockRepositoryMockRecorder) FindAdjustmentByID(ctx, userID, adjustmentID any) *MockRepositoryFindAdjustmentByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdjustmentByID", reflect.TypeOf((*MockRepository)(nil).FindAdjustmentByID), ctx, userID, adjustmentID)
+	return &MockRepositoryFindAdjustmentByIDCall{Call: call}
+}
+
+// MockRepositoryFindAdjustmentByIDCall wrap *gomock.Call
+type MockRepositoryFindAdjustmentByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAdjustmentByIDCall) Return(arg0 *entity.Adjustment, arg1 error) *MockRepositoryFindAdjustmentByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAdjustmentByIDCall) Do(f func(context.Context, string, string) (*entity.Adjustment, error)) *MockRepositoryFindAdjustmentByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAdjustmentByIDCall) DoAndReturn(f func(context.Context, string, string) (*entity.Adjustment, error)) *MockRepositoryFindAdjustmentByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAdjustmentsByPeriodID mocks base method.
+func (m *MockRepository) FindAdjustmentsByPeriodID(ctx context.Context, periodID string, opts ...entity.FindAdjustmentOptions) (entity.FindAdjustmentResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, periodID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAdjustmentsByPeriodID", varargs...)
+	ret0, _ := ret[0].(entity.FindAdjustmentResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdjustmentsByPeriodID indicates an expected call of FindAdjustmentsByPeriodID.
+func (mr *MockRepositoryMockRecorder) FindAdjustmentsByPeriodID(ctx, periodID any, opts ...any) *MockRepositoryFindAdjustmentsByPeriodIDCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, periodID}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdjustmentsByPeriodID", reflect.TypeOf((*MockRepository)(nil).FindAdjustmentsByPeriodID), varargs...)
+	return &MockRepositoryFindAdjustmentsByPeriodIDCall{Call: call}
+}
+
+// MockRepositoryFindAdjustmentsByPeriodIDCall wrap *gomock.Call
+type MockRepositoryFindAdjustmentsByPeriodIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAdjustmentsByPeriodIDCall) Return(arg0 entity.FindAdjustmentResult, arg1 error) *MockRepositoryFindAdjustmentsByPeriodIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAdjustmentsByPeriodIDCall) Do(f func(context.Context, string, ...entity.FindAdjustmentOptions) (entity.FindAdjustmentResult, error)) *MockRepositoryFindAdjustmentsByPeriodIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAdjustmentsByPeriodIDCall) DoAndReturn(f func(context.Context, string, ...entity.FindAdjustmentOptions) (entity.FindAdjustmentResult, error)) *MockRepositoryFindAdjustmentsByPeriodIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAdjustmentsByUserID mocks base method.
+func (m *MockRepository) FindAdjustmentsByUserID(ctx context.Context, userID string) ([]entity.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAdjustmentsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAdjustmentsByUserID indicates an expected call of FindAdjustmentsByUserID.
+func (mr *MockRepositoryMockRecorder) FindAdjustmentsByUserID(ctx, userID any) *MockRepositoryFindAdjustmentsByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAdjustmentsByUserID", reflect.TypeOf((*MockRepository)(nil).FindAdjustmentsByUserID), ctx, userID)
+	return &MockRepositoryFindAdjustmentsByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindAdjustmentsByUserIDCall wrap *gomock.Call
+type MockRepositoryFindAdjustmentsByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAdjustmentsByUserIDCall) Return(arg0 []entity.Adjustment, arg1 error) *MockRepositoryFindAdjustmentsByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAdjustmentsByUserIDCall) Do(f func(context.Context, string) ([]entity.Adjustment, error)) *MockRepositoryFindAdjustmentsByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAdjustmentsByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.Adjustment, error)) *MockRepositoryFindAdjustmentsByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAllowanceByID mocks base method.
+func (m *MockRepository) FindAllowanceByID(ctx context.Context, userID, allowanceID string) (*entity.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllowanceByID", ctx, userID, allowanceID)
+	ret0, _ := ret[0].(*entity.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllowanceByID indicates an expected call of FindAllowanceByID.
+func (mr *MockRepositoryMockRecorder) FindAllowanceByID(ctx, userID, allowanceID any) *MockRepositoryFindAllowanceByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllowanceByID", reflect.TypeOf((*MockRepository)(nil).FindAllowanceByID), ctx, userID, allowanceID)
+	return &MockRepositoryFindAllowanceByIDCall{Call: call}
+}
+
+// MockRepositoryFindAllowanceByIDCall wrap *gomock.Call
+type MockRepositoryFindAllowanceByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAllowanceByIDCall) Return(arg0 *entity.Allowance, arg1 error) *MockRepositoryFindAllowanceByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAllowanceByIDCall) Do(f func(context.Context, string, string) (*entity.Allowance, error)) *MockRepositoryFindAllowanceByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAllowanceByIDCall) DoAndReturn(f func(context.Context, string, string) (*entity.Allowance, error)) *MockRepositoryFindAllowanceByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAllowancesByRange mocks base method.
+func (m *MockRepository) FindAllowancesByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindAllowanceOptions) (entity.FindAllowanceResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, startDate, endDate}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAllowancesByRange", varargs...)
+	ret0, _ := ret[0].(entity.FindAllowanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllowancesByRange indicates an expected call of FindAllowancesByRange.
+func (mr *MockRepositoryMockRecorder) FindAllowancesByRange(ctx, startDate, endDate any, opts ...any) *MockRepositoryFindAllowancesByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, startDate, endDate}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllowancesByRange", reflect.TypeOf((*MockRepository)(nil).FindAllowancesByRange), varargs...)
+	return &MockRepositoryFindAllowancesByRangeCall{Call: call}
+}
+
+// MockRepositoryFindAllowancesByRangeCall wrap *gomock.Call
+type MockRepositoryFindAllowancesByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAllowancesByRangeCall) Return(arg0 entity.FindAllowanceResult, arg1 error) *MockRepositoryFindAllowancesByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAllowancesByRangeCall) Do(f func(context.Context, time.Time, time.Time, ...entity.FindAllowanceOptions) (entity.FindAllowanceResult, error)) *MockRepositoryFindAllowancesByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAllowancesByRangeCall) DoAndReturn(f func(context.Context, time.Time, time.Time, ...entity.FindAllowanceOptions) (entity.FindAllowanceResult, error)) *MockRepositoryFindAllowancesByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAllowancesByUserID mocks base method.
+func (m *MockRepository) FindAllowancesByUserID(ctx context.Context, userID string) ([]entity.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllowancesByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllowancesByUserID indicates an expected call of FindAllowancesByUserID.
+func (mr *MockRepositoryMockRecorder) FindAllowancesByUserID(ctx, userID any) *MockRepositoryFindAllowancesByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllowancesByUserID", reflect.TypeOf((*MockRepository)(nil).FindAllowancesByUserID), ctx, userID)
+	return &MockRepositoryFindAllowancesByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindAllowancesByUserIDCall wrap *gomock.Call
+type MockRepositoryFindAllowancesByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAllowancesByUserIDCall) Return(arg0 []entity.Allowance, arg1 error) *MockRepositoryFindAllowancesByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAllowancesByUserIDCall) Do(f func(context.Context, string) ([]entity.Allowance, error)) *MockRepositoryFindAllowancesByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAllowancesByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.Allowance, error)) *MockRepositoryFindAllowancesByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewAdjustment mocks base method.
+func (m *MockRepository) StoreNewAdjustment(ctx context.Context, arg1 entity.Adjustment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewAdjustment", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewAdjustment indicates an expected call of StoreNewAdjustment.
+func (mr *MockRepositoryMockRecorder) StoreNewAdjustment(ctx, arg1 any) *MockRepositoryStoreNewAdjustmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewAdjustment", reflect.TypeOf((*MockRepository)(nil).StoreNewAdjustment), ctx, arg1)
+	return &MockRepositoryStoreNewAdjustmentCall{Call: call}
+}
+
+// MockRepositoryStoreNewAdjustmentCall wrap *gomock.Call
+type MockRepositoryStoreNewAdjustmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewAdjustmentCall) Return(arg0 error) *MockRepositoryStoreNewAdjustmentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewAdjustmentCall) Do(f func(context.Context, entity.Adjustment) error) *MockRepositoryStoreNewAdjustmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewAdjustmentCall) DoAndReturn(f func(context.Context, entity.Adjustment) error) *MockRepositoryStoreNewAdjustmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewAllowance mocks base method.
+func (m *MockRepository) StoreNewAllowance(ctx context.Context, allowance entity.Allowance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewAllowance", ctx, allowance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewAllowance indicates an expected call of StoreNewAllowance.
+func (mr *MockRepositoryMockRecorder) StoreNewAllowance(ctx, allowance any) *MockRepositoryStoreNewAllowanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewAllowance", reflect.TypeOf((*MockRepository)(nil).StoreNewAllowance), ctx, allowance)
+	return &MockRepositoryStoreNewAllowanceCall{Call: call}
+}
+
+// MockRepositoryStoreNewAllowanceCall wrap *gomock.Call
+type MockRepositoryStoreNewAllowanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewAllowanceCall) Return(arg0 error) *MockRepositoryStoreNewAllowanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewAllowanceCall) Do(f func(context.Context, entity.Allowance) error) *MockRepositoryStoreNewAllowanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewAllowanceCall) DoAndReturn(f func(context.Context, entity.Allowance) error) *MockRepositoryStoreNewAllowanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) adjustment.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(adjustment.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 adjustment.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) adjustment.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) adjustment.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/adjustment/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/adjustment/usecase.go -destination internal/adjustment/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/adjustment/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/auth/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateAdjustment mocks base method.
+func (m *MockUseCase) CreateAdjustment(ctx context.Context, authCredential entity0.Credential, userID string, payload entity.CreateAdjustment) (entity.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdjustment", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAdjustment indicates an expected call of CreateAdjustment.
+func (mr *MockUseCaseMockRecorder) CreateAdjustment(ctx, authCredential, userID, payload any) *MockUseCaseCreateAdjustmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdjustment", reflect.TypeOf((*MockUseCase)(nil).CreateAdjustment), ctx, authCredential, userID, payload)
+	return &MockUseCaseCreateAdjustmentCall{Call: call}
+}
+
+// MockUseCaseCreateAdjustmentCall wrap *gomock.Call
+type MockUseCaseCreateAdjustmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCreateAdjustmentCall) Return(arg0 entity.Adjustment, arg1 error) *MockUseCaseCreateAdjustmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCreateAdjustmentCall) Do(f func(context.Context, entity0.Credential, string, entity.CreateAdjustment) (entity.Adjustment, error)) *MockUseCaseCreateAdjustmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCreateAdjustmentCall) DoAndReturn(f func(context.Context, entity0.Credential, string, entity.CreateAdjustment) (entity.Adjustment, error)) *MockUseCaseCreateAdjustmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAllowance mocks base method.
+func (m *MockUseCase) CreateAllowance(ctx context.Context, authCredential entity0.Credential, userID string, payload entity.CreateAllowance) (entity.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAllowance", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAllowance indicates an expected call of CreateAllowance.
+func (mr *MockUseCaseMockRecorder) CreateAllowance(ctx, authCredential, userID, payload any) *MockUseCaseCreateAllowanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAllowance", reflect.TypeOf((*MockUseCase)(nil).CreateAllowance), ctx, authCredential, userID, payload)
+	return &MockUseCaseCreateAllowanceCall{Call: call}
+}
+
+// MockUseCaseCreateAllowanceCall wrap *gomock.Call
+type MockUseCaseCreateAllowanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCreateAllowanceCall) Return(arg0 entity.Allowance, arg1 error) *MockUseCaseCreateAllowanceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCreateAllowanceCall) Do(f func(context.Context, entity0.Credential, string, entity.CreateAllowance) (entity.Allowance, error)) *MockUseCaseCreateAllowanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCreateAllowanceCall) DoAndReturn(f func(context.Context, entity0.Credential, string, entity.CreateAllowance) (entity.Allowance, error)) *MockUseCaseCreateAllowanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAdjustment mocks base method.
+func (m *MockUseCase) DeleteAdjustment(ctx context.Context, authCredential entity0.Credential, userID, adjustmentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdjustment", ctx, authCredential, userID, adjustmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdjustment indicates an expected call of DeleteAdjustment.
+func (mr *MockUseCaseMockRecorder) DeleteAdjustment(ctx, authCredential, userID, adjustmentID any) *MockUseCaseDeleteAdjustmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdjustment", reflect.TypeOf((*MockUseCase)(nil).DeleteAdjustment), ctx, authCredential, userID, adjustmentID)
+	return &MockUseCaseDeleteAdjustmentCall{Call: call}
+}
+
+// MockUseCaseDeleteAdjustmentCall wrap *gomock.Call
+type MockUseCaseDeleteAdjustmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseDeleteAdjustmentCall) Return(arg0 error) *MockUseCaseDeleteAdjustmentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseDeleteAdjustmentCall) Do(f func(context.Context, entity0.Credential, string, string) error) *MockUseCaseDeleteAdjustmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseDeleteAdjustmentCall) DoAndReturn(f func(context.Context, entity0.Credential, string, string) error) *MockUseCaseDeleteAdjustmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAllowance mocks base method.
+func (m *MockUseCase) DeleteAllowance(ctx context.Context, authCredential entity0.Credential, userID, allowanceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllowance", ctx, authCredential, userID, allowanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllowance indicates an expected call of DeleteAllowance.
+func (mr *MockUseCaseMockRecorder) DeleteAllowance(ctx, authCredential, userID, allowanceID any) *MockUseCaseDeleteAllowanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllowance", reflect.TypeOf((*MockUseCase)(nil).DeleteAllowance), ctx, authCredential, userID, allowanceID)
+	return &MockUseCaseDeleteAllowanceCall{Call: call}
+}
+
+// MockUseCaseDeleteAllowanceCall wrap *gomock.Call
+type MockUseCaseDeleteAllowanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseDeleteAllowanceCall) Return(arg0 error) *MockUseCaseDeleteAllowanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseDeleteAllowanceCall) Do(f func(context.Context, entity0.Credential, string, string) error) *MockUseCaseDeleteAllowanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseDeleteAllowanceCall) DoAndReturn(f func(context.Context, entity0.Credential, string, string) error) *MockUseCaseDeleteAllowanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAdjustments mocks base method.
+func (m *MockUseCase) ListAdjustments(ctx context.Context, authCredential entity0.Credential, userID string) ([]entity.Adjustment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdjustments", ctx, authCredential, userID)
+	ret0, _ := ret[0].([]entity.Adjustment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdjustments indicates an expected call of ListAdjustments.
+func (mr *MockUseCaseMockRecorder) ListAdjustments(ctx, authCredential, userID any) *MockUseCaseListAdjustmentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdjustments", reflect.TypeOf((*MockUseCase)(nil).ListAdjustments), ctx, authCredential, userID)
+	return &MockUseCaseListAdjustmentsCall{Call: call}
+}
+
+// MockUseCaseListAdjustmentsCall wrap *gomock.Call
+type MockUseCaseListAdjustmentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListAdjustmentsCall) Return(arg0 []entity.Adjustment, arg1 error) *MockUseCaseListAdjustmentsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListAdjustmentsCall) Do(f func(context.Context, entity0.Credential, string) ([]entity.Adjustment, error)) *MockUseCaseListAdjustmentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListAdjustmentsCall) DoAndReturn(f func(context.Context, entity0.Credential, string) ([]entity.Adjustment, error)) *MockUseCaseListAdjustmentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAllowances mocks base method.
+func (m *MockUseCase) ListAllowances(ctx context.Context, authCredential entity0.Credential, userID string) ([]entity.Allowance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllowances", ctx, authCredential, userID)
+	ret0, _ := ret[0].([]entity.Allowance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllowances indicates an expected call of ListAllowances.
+func (mr *MockUseCaseMockRecorder) ListAllowances(ctx, authCredential, userID any) *MockUseCaseListAllowancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllowances", reflect.TypeOf((*MockUseCase)(nil).ListAllowances), ctx, authCredential, userID)
+	return &MockUseCaseListAllowancesCall{Call: call}
+}
+
+// MockUseCaseListAllowancesCall wrap *gomock.Call
+type MockUseCaseListAllowancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListAllowancesCall) Return(arg0 []entity.Allowance, arg1 error) *MockUseCaseListAllowancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListAllowancesCall) Do(f func(context.Context, entity0.Credential, string) ([]entity.Allowance, error)) *MockUseCaseListAllowancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListAllowancesCall) DoAndReturn(f func(context.Context, entity0.Credential, string) ([]entity.Allowance, error)) *MockUseCaseListAllowancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,25 @@
+package adjustment
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	StoreNewAllowance(ctx context.Context, allowance entity.Allowance) error
+	DeleteAllowance(ctx context.Context, allowanceID string) error
+	FindAllowanceByID(ctx context.Context, userID, allowanceID string) (*entity.Allowance, error)
+	FindAllowancesByUserID(ctx context.Context, userID string) ([]entity.Allowance, error)
+	FindAllowancesByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindAllowanceOptions) (entity.FindAllowanceResult, error)
+
+	StoreNewAdjustment(ctx context.Context, adjustment entity.Adjustment) error
+	DeleteAdjustment(ctx context.Context, adjustmentID string) error
+	FindAdjustmentByID(ctx context.Context, userID, adjustmentID string) (*entity.Adjustment, error)
+	FindAdjustmentsByUserID(ctx context.Context, userID string) ([]entity.Adjustment, error)
+	FindAdjustmentsByPeriodID(ctx context.Context, periodID string, opts ...entity.FindAdjustmentOptions) (entity.FindAdjustmentResult, error)
+}
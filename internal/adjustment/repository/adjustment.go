@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/adjustment"
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type adjustmentRepo struct {
+	db database.Queryer
+}
+
+func NewAdjustmentRepository(db database.Queryer) adjustment.Repository {
+	return &adjustmentRepo{
+		db: db,
+	}
+}
+
+func (r *adjustmentRepo) WithTx(tx database.DBTx) adjustment.Repository {
+	return &adjustmentRepo{
+		db: tx,
+	}
+}
+
+func (r *adjustmentRepo) StoreNewAllowance(ctx context.Context, allowance entity.Allowance) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.StoreNewAllowance()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertAllowanceQuery, allowance)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert allowance"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *adjustmentRepo) DeleteAllowance(ctx context.Context, allowanceID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.DeleteAllowance()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, deleteAllowanceQuery, allowanceID)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *adjustmentRepo) FindAllowanceByID(ctx context.Context, userID, allowanceID string) (*entity.Allowance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAllowanceByID()",
+	)
+	defer span.End()
+
+	var allowance entity.Allowance
+	err := pgxscan.Get(ctx, r.db, &allowance, findAllowanceByIDQuery, userID, allowanceID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &allowance, nil
+}
+
+func (r *adjustmentRepo) FindAllowancesByUserID(ctx context.Context, userID string) ([]entity.Allowance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAllowancesByUserID()",
+	)
+	defer span.End()
+
+	var allowances []entity.Allowance
+	err := pgxscan.Select(ctx, r.db, &allowances, findAllowancesByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return allowances, nil
+}
+
+func (r *adjustmentRepo) FindAllowancesByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindAllowanceOptions) (entity.FindAllowanceResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAllowancesByRange()",
+	)
+	defer span.End()
+
+	var result entity.FindAllowanceResult
+
+	query := findAllowancesByRangeQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var allowances []entity.Allowance
+	err := pgxscan.Select(ctx, r.db, &allowances, query, startDate, endDate)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = allowances
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedAllowances := make(map[any][]entity.Allowance)
+		var keyFunc func(allowance entity.Allowance) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(allowance entity.Allowance) any {
+				return allowance.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range allowances {
+			key := keyFunc(item)
+			mappedAllowances[key] = append(mappedAllowances[key], item)
+		}
+
+		result.Mapped = mappedAllowances
+	}
+
+	return result, nil
+}
+
+func (r *adjustmentRepo) StoreNewAdjustment(ctx context.Context, adjustment entity.Adjustment) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.StoreNewAdjustment()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertAdjustmentQuery, adjustment)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert adjustment"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *adjustmentRepo) DeleteAdjustment(ctx context.Context, adjustmentID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.DeleteAdjustment()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, deleteAdjustmentQuery, adjustmentID)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *adjustmentRepo) FindAdjustmentByID(ctx context.Context, userID, adjustmentID string) (*entity.Adjustment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAdjustmentByID()",
+	)
+	defer span.End()
+
+	var adjustment entity.Adjustment
+	err := pgxscan.Get(ctx, r.db, &adjustment, findAdjustmentByIDQuery, userID, adjustmentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &adjustment, nil
+}
+
+func (r *adjustmentRepo) FindAdjustmentsByUserID(ctx context.Context, userID string) ([]entity.Adjustment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAdjustmentsByUserID()",
+	)
+	defer span.End()
+
+	var adjustments []entity.Adjustment
+	err := pgxscan.Select(ctx, r.db, &adjustments, findAdjustmentsByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return adjustments, nil
+}
+
+func (r *adjustmentRepo) FindAdjustmentsByPeriodID(ctx context.Context, periodID string, opts ...entity.FindAdjustmentOptions) (entity.FindAdjustmentResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentRepository.FindAdjustmentsByPeriodID()",
+	)
+	defer span.End()
+
+	var result entity.FindAdjustmentResult
+
+	query := findAdjustmentsByPeriodIDQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var adjustments []entity.Adjustment
+	err := pgxscan.Select(ctx, r.db, &adjustments, query, periodID)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = adjustments
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedAdjustments := make(map[any][]entity.Adjustment)
+		var keyFunc func(adjustment entity.Adjustment) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(adjustment entity.Adjustment) any {
+				return adjustment.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range adjustments {
+			key := keyFunc(item)
+			mappedAdjustments[key] = append(mappedAdjustments[key], item)
+		}
+
+		result.Mapped = mappedAdjustments
+	}
+
+	return result, nil
+}
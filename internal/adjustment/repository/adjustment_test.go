@@ -0,0 +1,541 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	"github.com/vnnyx/employee-management/internal/adjustment/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var allowanceColumns = []string{
+	"id", "user_id", "code", "name", "amount", "taxable", "start_date", "end_date",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+var adjustmentColumns = []string{
+	"id", "user_id", "period_id", "code", "name", "amount", "taxable", "reason",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
+
+func TestStoreNewAllowance(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.Allowance
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO allowances").
+					WithArgs(anyArgs(len(allowanceColumns))...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("allowance-1"))
+			},
+			input: entity.Allowance{
+				ID:        "allowance-1",
+				UserID:    "user-1",
+				Code:      "transport",
+				Name:      "Transport Allowance",
+				Amount:    money.New(100),
+				Taxable:   true,
+				StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				CreatedAt: now,
+				UpdatedAt: now,
+				CreatedBy: "admin",
+				UpdatedBy: "admin",
+				IPAddress: "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO allowances").
+					WithArgs(anyArgs(len(allowanceColumns))...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.Allowance{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO allowances").
+					WithArgs(anyArgs(len(allowanceColumns))...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.Allowance{ID: "allowance-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewAllowance(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteAllowance(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		allowanceID string
+		expectErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM allowances").
+					WithArgs("allowance-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			allowanceID: "allowance-1",
+			expectErr:   false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM allowances").
+					WithArgs("allowance-2").
+					WillReturnError(errors.New("delete failed"))
+			},
+			allowanceID: "allowance-2",
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.DeleteAllowance(context.Background(), tt.allowanceID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAllowanceByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		allowanceID string
+		expectNil   bool
+		expectErr   bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE user_id (.+) AND id").
+					WithArgs("user-1", "allowance-1").
+					WillReturnRows(pgxmock.NewRows(allowanceColumns).
+						AddRow("allowance-1", "user-1", "transport", "Transport Allowance", "100.00", true, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.NewTime(), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			allowanceID: "allowance-1",
+			expectNil:   false,
+			expectErr:   false,
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE user_id (.+) AND id").
+					WithArgs("user-1", "allowance-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			allowanceID: "allowance-2",
+			expectNil:   true,
+			expectErr:   false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE user_id (.+) AND id").
+					WithArgs("user-1", "allowance-3").
+					WillReturnError(errors.New("db error"))
+			},
+			allowanceID: "allowance-3",
+			expectNil:   true,
+			expectErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAllowanceByID(context.Background(), "user-1", tt.allowanceID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, money.New(100), result.Amount)
+				assert.True(t, result.Taxable)
+			}
+		})
+	}
+}
+
+func TestFindAllowancesByRange(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindAllowanceOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE start_date").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(allowanceColumns).
+						AddRow("allowance-1", "user-1", "transport", "Transport Allowance", "100.00", true, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.NewTime(), now, now, "admin", "admin", "127.0.0.1").
+						AddRow("allowance-2", "user-1", "meal", "Meal Allowance", "50.00", false, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.NewTime(endDate), now, now, "admin", "admin", "127.0.0.1").
+						AddRow("allowance-3", "user-2", "transport", "Transport Allowance", "80.00", true, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), optional.NewTime(), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindAllowanceOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID}},
+			},
+			expectedLen:    3,
+			expectedMapped: map[any]int{"user-1": 2, "user-2": 1},
+			expectErr:      false,
+		},
+		{
+			name: "success - pessimistic lock",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE start_date (.+) FOR UPDATE").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(allowanceColumns).
+						AddRow("allowance-1", "user-1", "transport", "Transport Allowance", "100.00", true, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.NewTime(), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindAllowanceOptions{
+				{PessimisticLock: true},
+			},
+			expectedLen: 1,
+			expectErr:   false,
+		},
+		{
+			name: "error - unsupported mapped by",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE start_date").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(allowanceColumns))
+			},
+			opts: []entity.FindAllowanceOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: "unknown"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM allowances WHERE start_date").
+					WithArgs(startDate, endDate).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAllowancesByRange(context.Background(), startDate, endDate, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.List, tt.expectedLen)
+				for key, expectedLen := range tt.expectedMapped {
+					assert.Len(t, result.Mapped[key], expectedLen)
+				}
+			}
+		})
+	}
+}
+
+func TestStoreNewAdjustment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.Adjustment
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_adjustments").
+					WithArgs(anyArgs(len(adjustmentColumns))...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("adjustment-1"))
+			},
+			input: entity.Adjustment{
+				ID:        "adjustment-1",
+				UserID:    "user-1",
+				PeriodID:  "period-1",
+				Code:      "bonus",
+				Name:      "Project Bonus",
+				Amount:    money.New(500),
+				Taxable:   true,
+				Reason:    optional.NewString("Release shipped"),
+				CreatedAt: now,
+				UpdatedAt: now,
+				CreatedBy: "admin",
+				UpdatedBy: "admin",
+				IPAddress: "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_adjustments").
+					WithArgs(anyArgs(len(adjustmentColumns))...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.Adjustment{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_adjustments").
+					WithArgs(anyArgs(len(adjustmentColumns))...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.Adjustment{ID: "adjustment-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewAdjustment(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAdjustmentByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name         string
+		setupMock    func()
+		adjustmentID string
+		expectNil    bool
+		expectErr    bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE user_id (.+) AND id").
+					WithArgs("user-1", "adjustment-1").
+					WillReturnRows(pgxmock.NewRows(adjustmentColumns).
+						AddRow("adjustment-1", "user-1", "period-1", "correction", "Overpaid Overtime", "-25.00", true, optional.NewString("Overtime paid twice"), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			adjustmentID: "adjustment-1",
+			expectNil:    false,
+			expectErr:    false,
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE user_id (.+) AND id").
+					WithArgs("user-1", "adjustment-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			adjustmentID: "adjustment-2",
+			expectNil:    true,
+			expectErr:    false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE user_id (.+) AND id").
+					WithArgs("user-1", "adjustment-3").
+					WillReturnError(errors.New("db error"))
+			},
+			adjustmentID: "adjustment-3",
+			expectNil:    true,
+			expectErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAdjustmentByID(context.Background(), "user-1", tt.adjustmentID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, money.MustParse("-25.00"), result.Amount)
+			}
+		})
+	}
+}
+
+func TestFindAdjustmentsByPeriodID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAdjustmentRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindAdjustmentOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE period_id").
+					WithArgs("period-1").
+					WillReturnRows(pgxmock.NewRows(adjustmentColumns).
+						AddRow("adjustment-1", "user-1", "period-1", "bonus", "Project Bonus", "500.00", true, optional.String{}, now, now, "admin", "admin", "127.0.0.1").
+						AddRow("adjustment-2", "user-2", "period-1", "correction", "Overpaid Overtime", "-25.00", true, optional.String{}, now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindAdjustmentOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID}},
+			},
+			expectedLen:    2,
+			expectedMapped: map[any]int{"user-1": 1, "user-2": 1},
+			expectErr:      false,
+		},
+		{
+			name: "success - pessimistic lock",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE period_id (.+) FOR UPDATE").
+					WithArgs("period-1").
+					WillReturnRows(pgxmock.NewRows(adjustmentColumns).
+						AddRow("adjustment-1", "user-1", "period-1", "bonus", "Project Bonus", "500.00", true, optional.String{}, now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindAdjustmentOptions{
+				{PessimisticLock: true},
+			},
+			expectedLen: 1,
+			expectErr:   false,
+		},
+		{
+			name: "error - unsupported mapped by",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE period_id").
+					WithArgs("period-1").
+					WillReturnRows(pgxmock.NewRows(adjustmentColumns))
+			},
+			opts: []entity.FindAdjustmentOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: "unknown"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_adjustments WHERE period_id").
+					WithArgs("period-1").
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAdjustmentsByPeriodID(context.Background(), "period-1", tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.List, tt.expectedLen)
+				for key, expectedLen := range tt.expectedMapped {
+					assert.Len(t, result.Mapped[key], expectedLen)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package repository
+
+const insertAllowanceQuery = `
+INSERT INTO allowances (
+	id,
+	user_id,
+	code,
+	name,
+	amount,
+	taxable,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:code,
+	:name,
+	:amount,
+	:taxable,
+	:start_date,
+	:end_date,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const deleteAllowanceQuery = `
+DELETE FROM allowances
+WHERE id = $1
+`
+
+const findAllowanceByIDQuery = `
+SELECT
+	id,
+	user_id,
+	code,
+	name,
+	amount,
+	taxable,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM allowances
+WHERE user_id = $1 AND id = $2
+`
+
+const findAllowancesByUserIDQuery = `
+SELECT
+	id,
+	user_id,
+	code,
+	name,
+	amount,
+	taxable,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM allowances
+WHERE user_id = $1
+ORDER BY start_date ASC, code ASC
+`
+
+// An allowance is paid with every period it overlaps, both dates included.
+const findAllowancesByRangeQuery = `
+SELECT
+	id,
+	user_id,
+	code,
+	name,
+	amount,
+	taxable,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM allowances
+WHERE start_date <= $2::DATE AND (end_date IS NULL OR end_date >= $1::DATE)
+ORDER BY user_id, start_date ASC, code ASC
+`
+
+const insertAdjustmentQuery = `
+INSERT INTO payroll_adjustments (
+	id,
+	user_id,
+	period_id,
+	code,
+	name,
+	amount,
+	taxable,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:period_id,
+	:code,
+	:name,
+	:amount,
+	:taxable,
+	:reason,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const deleteAdjustmentQuery = `
+DELETE FROM payroll_adjustments
+WHERE id = $1
+`
+
+const findAdjustmentByIDQuery = `
+SELECT
+	id,
+	user_id,
+	period_id,
+	code,
+	name,
+	amount,
+	taxable,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payroll_adjustments
+WHERE user_id = $1 AND id = $2
+`
+
+const findAdjustmentsByUserIDQuery = `
+SELECT
+	id,
+	user_id,
+	period_id,
+	code,
+	name,
+	amount,
+	taxable,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payroll_adjustments
+WHERE user_id = $1
+ORDER BY created_at ASC, id ASC
+`
+
+const findAdjustmentsByPeriodIDQuery = `
+SELECT
+	id,
+	user_id,
+	period_id,
+	code,
+	name,
+	amount,
+	taxable,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payroll_adjustments
+WHERE period_id = $1
+ORDER BY user_id, created_at ASC, id ASC
+`
@@ -0,0 +1,18 @@
+package adjustment
+
+import (
+	"context"
+
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+)
+
+type UseCase interface {
+	ListAllowances(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Allowance, error)
+	CreateAllowance(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateAllowance) (entity.Allowance, error)
+	DeleteAllowance(ctx context.Context, authCredential authCredential.Credential, userID, allowanceID string) error
+
+	ListAdjustments(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Adjustment, error)
+	CreateAdjustment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateAdjustment) (entity.Adjustment, error)
+	DeleteAdjustment(ctx context.Context, authCredential authCredential.Credential, userID, adjustmentID string) error
+}
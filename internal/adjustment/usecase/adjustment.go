@@ -0,0 +1,397 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/adjustment"
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	"github.com/vnnyx/employee-management/internal/attendance"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+const adjustmentDateFormat = "2006-01-02"
+
+type adjustmentUseCase struct {
+	adjustmentRepo adjustment.Repository
+	userRepo       users.Repository
+	attendanceRepo attendance.Repository
+}
+
+func NewAdjustmentUseCase(adjustmentRepo adjustment.Repository, userRepo users.Repository, attendanceRepo attendance.Repository) adjustment.UseCase {
+	return &adjustmentUseCase{
+		adjustmentRepo: adjustmentRepo,
+		userRepo:       userRepo,
+		attendanceRepo: attendanceRepo,
+	}
+}
+
+func (u *adjustmentUseCase) ListAllowances(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Allowance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.ListAllowances()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdjustmentUseCase.ListAllowances().FindUserByID()")
+	}
+	if user == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	allowances, err := u.adjustmentRepo.FindAllowancesByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdjustmentUseCase.ListAllowances().FindAllowancesByUserID()")
+	}
+
+	return allowances, nil
+}
+
+// CreateAllowance starts a recurring allowance. Payrolls already generated
+// for the periods it covers only pick it up when they are regenerated.
+func (u *adjustmentUseCase) CreateAllowance(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateAllowance) (entity.Allowance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.CreateAllowance()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.Allowance{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	if endDate, ok := payload.EndDate.Get(); ok && endDate.Before(payload.StartDate) {
+		return entity.Allowance{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AllowanceInvalidDates,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AllowanceInvalidDates),
+				Received:  endDate.Format(adjustmentDateFormat),
+				Expected:  payload.StartDate.Format(adjustmentDateFormat),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newAllowance := entity.Allowance{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Code:      payload.Code,
+		Name:      payload.Name,
+		Amount:    payload.Amount,
+		Taxable:   payload.Taxable,
+		StartDate: payload.StartDate,
+		EndDate:   payload.EndDate,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+		CreatedBy: authCredential.UserID,
+		UpdatedBy: authCredential.UserID,
+		IPAddress: authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		user, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAllowance().FindUserByID()")
+		}
+		if user == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		err = adjustmentRepoTx.StoreNewAllowance(ctx, newAllowance)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAllowance().StoreNewAllowance()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Allowance{}, errors.Wrap(err, "AdjustmentUseCase.CreateAllowance().WithAuditContext()")
+	}
+
+	return newAllowance, nil
+}
+
+// DeleteAllowance stops an allowance for every period, payslips already
+// generated keep the amount they were paid.
+func (u *adjustmentUseCase) DeleteAllowance(ctx context.Context, authCredential authCredential.Credential, userID, allowanceID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.DeleteAllowance()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+
+		existingAllowance, err := adjustmentRepoTx.FindAllowanceByID(ctx, userID, allowanceID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.DeleteAllowance().FindAllowanceByID()")
+		}
+		if existingAllowance == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AllowanceNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AllowanceNotFound),
+					Received:  allowanceID,
+				},
+			)
+		}
+
+		err = adjustmentRepoTx.DeleteAllowance(ctx, allowanceID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.DeleteAllowance().DeleteAllowance()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentUseCase.DeleteAllowance().WithAuditContext()")
+	}
+
+	return nil
+}
+
+func (u *adjustmentUseCase) ListAdjustments(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Adjustment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.ListAdjustments()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdjustmentUseCase.ListAdjustments().FindUserByID()")
+	}
+	if user == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	adjustments, err := u.adjustmentRepo.FindAdjustmentsByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AdjustmentUseCase.ListAdjustments().FindAdjustmentsByUserID()")
+	}
+
+	return adjustments, nil
+}
+
+// CreateAdjustment attaches a one-off bonus or correction to the payroll of
+// a period. A payroll already generated for the period only picks it up
+// when it is regenerated.
+func (u *adjustmentUseCase) CreateAdjustment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateAdjustment) (entity.Adjustment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.CreateAdjustment()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.Adjustment{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newAdjustment := entity.Adjustment{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		PeriodID:  payload.PeriodID,
+		Code:      payload.Code,
+		Name:      payload.Name,
+		Amount:    payload.Amount,
+		Taxable:   payload.Taxable,
+		Reason:    payload.Reason,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+		CreatedBy: authCredential.UserID,
+		UpdatedBy: authCredential.UserID,
+		IPAddress: authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		user, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAdjustment().FindUserByID()")
+		}
+		if user == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		period, err := attendanceRepoTx.FindPeriodByID(ctx, payload.PeriodID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAdjustment().FindPeriodByID()")
+		}
+		if period == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodNotFound),
+					Received:  payload.PeriodID,
+				},
+			)
+		}
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByID(ctx, payload.PeriodID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAdjustment().FindClosedPeriodByID()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
+
+		err = adjustmentRepoTx.StoreNewAdjustment(ctx, newAdjustment)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.CreateAdjustment().StoreNewAdjustment()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Adjustment{}, errors.Wrap(err, "AdjustmentUseCase.CreateAdjustment().WithAuditContext()")
+	}
+
+	return newAdjustment, nil
+}
+
+func (u *adjustmentUseCase) DeleteAdjustment(ctx context.Context, authCredential authCredential.Credential, userID, adjustmentID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AdjustmentUseCase.DeleteAdjustment()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AdjustmentNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		existingAdjustment, err := adjustmentRepoTx.FindAdjustmentByID(ctx, userID, adjustmentID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.DeleteAdjustment().FindAdjustmentByID()")
+		}
+		if existingAdjustment == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotFound),
+					Received:  adjustmentID,
+				},
+			)
+		}
+
+		// The payslips of a closed or paid period already include it
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByID(ctx, existingAdjustment.PeriodID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.DeleteAdjustment().FindClosedPeriodByID()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
+
+		err = adjustmentRepoTx.DeleteAdjustment(ctx, adjustmentID)
+		if err != nil {
+			return errors.Wrap(err, "AdjustmentUseCase.DeleteAdjustment().DeleteAdjustment()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "AdjustmentUseCase.DeleteAdjustment().WithAuditContext()")
+	}
+
+	return nil
+}
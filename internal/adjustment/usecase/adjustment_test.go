@@ -0,0 +1,562 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	mockAdjustment "github.com/vnnyx/employee-management/internal/adjustment/mock"
+	"github.com/vnnyx/employee-management/internal/adjustment/usecase"
+	attEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
+	mockAtt "github.com/vnnyx/employee-management/internal/attendance/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	adjustmentRepo   *mockAdjustment.MockRepository
+	adjustmentRepoTx *mockAdjustment.MockRepository
+	userRepo         *mockUser.MockRepository
+	userRepoTx       *mockUser.MockRepository
+	attRepo          *mockAtt.MockRepository
+	attRepoTx        *mockAtt.MockRepository
+}
+
+func newMockParams(ctrl *gomock.Controller) mockParams {
+	return mockParams{
+		adjustmentRepo:   mockAdjustment.NewMockRepository(ctrl),
+		adjustmentRepoTx: mockAdjustment.NewMockRepository(ctrl),
+		userRepo:         mockUser.NewMockRepository(ctrl),
+		userRepoTx:       mockUser.NewMockRepository(ctrl),
+		attRepo:          mockAtt.NewMockRepository(ctrl),
+		attRepoTx:        mockAtt.NewMockRepository(ctrl),
+	}
+}
+
+func TestListAllowances(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedLen    int
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - allowances listed",
+			authCredential: adminCredential,
+			expectedLen:    2,
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.adjustmentRepo.EXPECT().FindAllowancesByUserID(gomock.Any(), "user-1").Return([]entity.Allowance{
+					{ID: "allowance-1", UserID: "user-1", Code: "transport", Amount: money.New(100), Taxable: true},
+					{ID: "allowance-2", UserID: "user-1", Code: "meal", Amount: money.New(50)},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewAdjustmentUseCase(m.adjustmentRepo, m.userRepo, m.attRepo)
+
+			allowances, err := useCase.ListAllowances(context.Background(), tt.authCredential, "user-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, allowances, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestCreateAllowance(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.CreateAllowance
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.CreateAllowance{
+		Code:      "transport",
+		Name:      "Transport Allowance",
+		Amount:    money.New(100),
+		Taxable:   true,
+		StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - allowance created",
+			authCredential: adminCredential,
+			payload:        payload,
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.adjustmentRepoTx.EXPECT().StoreNewAllowance(gomock.Any(), mock.MatchedBy(func(args entity.Allowance) bool {
+					return testutil.EqualVerbose(
+						entity.Allowance{
+							UserID:    "user-1",
+							Code:      "transport",
+							Name:      "Transport Allowance",
+							Amount:    money.New(100),
+							Taxable:   true,
+							StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+							CreatedBy: "admin-1",
+							UpdatedBy: "admin-1",
+							IPAddress: "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Allowance{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - end date before start date",
+			authCredential: adminCredential,
+			payload: entity.CreateAllowance{
+				Code:      "transport",
+				Name:      "Transport Allowance",
+				Amount:    money.New(100),
+				StartDate: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   optional.NewTime(time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AllowanceInvalidDates,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AllowanceInvalidDates),
+					Received:  "2025-08-31",
+					Expected:  "2025-09-01",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewAdjustmentUseCase(m.adjustmentRepo, m.userRepo, m.attRepo)
+
+			allowance, err := useCase.CreateAllowance(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, allowance.ID)
+				assert.True(t, tt.payload.Amount.Equal(allowance.Amount))
+			}
+		})
+	}
+}
+
+func TestDeleteAllowance(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - allowance deleted",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.adjustmentRepoTx.EXPECT().FindAllowanceByID(gomock.Any(), "user-1", "allowance-1").Return(&entity.Allowance{ID: "allowance-1", UserID: "user-1"}, nil)
+				m.adjustmentRepoTx.EXPECT().DeleteAllowance(gomock.Any(), "allowance-1").Return(nil)
+			},
+		},
+		{
+			name:           "error - allowance not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AllowanceNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AllowanceNotFound),
+					Received:  "allowance-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.adjustmentRepoTx.EXPECT().FindAllowanceByID(gomock.Any(), "user-1", "allowance-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewAdjustmentUseCase(m.adjustmentRepo, m.userRepo, m.attRepo)
+
+			err := useCase.DeleteAllowance(context.Background(), tt.authCredential, "user-1", "allowance-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCreateAdjustment(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.CreateAdjustment
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.CreateAdjustment{
+		PeriodID: "period-1",
+		Code:     "correction",
+		Name:     "Overpaid Overtime",
+		Amount:   money.New(-25),
+		Taxable:  true,
+		Reason:   optional.NewString("Overtime paid twice"),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - negative correction created",
+			authCredential: adminCredential,
+			payload:        payload,
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{ID: "period-1"}, nil)
+				m.attRepoTx.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(nil, nil)
+				m.adjustmentRepoTx.EXPECT().StoreNewAdjustment(gomock.Any(), mock.MatchedBy(func(args entity.Adjustment) bool {
+					return testutil.EqualVerbose(
+						entity.Adjustment{
+							UserID:    "user-1",
+							PeriodID:  "period-1",
+							Code:      "correction",
+							Name:      "Overpaid Overtime",
+							Amount:    money.New(-25),
+							Taxable:   true,
+							Reason:    optional.NewString("Overtime paid twice"),
+							CreatedBy: "admin-1",
+							UpdatedBy: "admin-1",
+							IPAddress: "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Adjustment{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodNotFound),
+					Received:  "period-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - period closed",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodClosed),
+					Received:  "period-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{ID: "period-1"}, nil)
+				m.attRepoTx.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(&attEntity.ClosedAttendancePeriod{
+					AttendancePeriod: attEntity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        "payroll-1",
+				}, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewAdjustmentUseCase(m.adjustmentRepo, m.userRepo, m.attRepo)
+
+			adjustment, err := useCase.CreateAdjustment(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, adjustment.ID)
+				assert.True(t, tt.payload.Amount.Equal(adjustment.Amount))
+			}
+		})
+	}
+}
+
+func TestDeleteAdjustment(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - adjustment deleted",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentByID(gomock.Any(), "user-1", "adjustment-1").Return(&entity.Adjustment{ID: "adjustment-1", UserID: "user-1", PeriodID: "period-1"}, nil)
+				m.attRepoTx.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(nil, nil)
+				m.adjustmentRepoTx.EXPECT().DeleteAdjustment(gomock.Any(), "adjustment-1").Return(nil)
+			},
+		},
+		{
+			name:           "error - period closed",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentPeriodClosed),
+					Received:  "period-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentByID(gomock.Any(), "user-1", "adjustment-1").Return(&entity.Adjustment{ID: "adjustment-1", UserID: "user-1", PeriodID: "period-1"}, nil)
+				m.attRepoTx.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(&attEntity.ClosedAttendancePeriod{
+					AttendancePeriod: attEntity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        "payroll-1",
+				}, nil)
+			},
+		},
+		{
+			name:           "error - adjustment not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotFound),
+					Received:  "adjustment-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentByID(gomock.Any(), "user-1", "adjustment-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AdjustmentNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AdjustmentNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewAdjustmentUseCase(m.adjustmentRepo, m.userRepo, m.attRepo)
+
+			err := useCase.DeleteAdjustment(context.Background(), tt.authCredential, "user-1", "adjustment-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
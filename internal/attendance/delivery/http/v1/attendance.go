@@ -2,12 +2,17 @@ package v1
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	_ "github.com/vnnyx/employee-management/docs/helper"
 	"github.com/vnnyx/employee-management/internal/attendance"
+	"github.com/vnnyx/employee-management/internal/attendance/entity"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
 	"github.com/vnnyx/employee-management/internal/constants"
 	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
 type AttendanceHandler struct {
@@ -49,6 +54,76 @@ func (h *AttendanceHandler) SubmitAttendance(c *fiber.Ctx) error {
 	)
 }
 
+// @Summary      Clock In
+// @Description  Clock in for the current day, arriving after the scheduled start and its grace period is recorded as late
+// @Tags         Attendance
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=dtos.AttendanceResponse} "Attendance Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/clock-in [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ClockIn(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ClockIn()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ClockIn(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ClockIn().uc.ClockIn()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceResponse(data),
+		},
+	)
+}
+
+// @Summary      Clock Out
+// @Description  Clock out of the latest clock in, leaving before the scheduled end is recorded as early leave
+// @Tags         Attendance
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=dtos.AttendanceResponse} "Attendance Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/clock-out [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ClockOut(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ClockOut()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ClockOut(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ClockOut().uc.ClockOut()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceResponse(data),
+		},
+	)
+}
+
+// @Summary      Create Attendance Period
+// @Description  Create an attendance period, it may not overlap an existing period
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.AttendancePeriodRequest true "Attendance Period Request"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period [POST]
+// @Security     BearerAuth
 func (h *AttendanceHandler) CreateAttendancePeriod(c *fiber.Ctx) error {
 	ctx, span := instrumentation.NewTraceSpan(
 		c.UserContext(),
@@ -63,6 +138,10 @@ func (h *AttendanceHandler) CreateAttendancePeriod(c *fiber.Ctx) error {
 		return errors.Wrap(err, "AttendanceHandler().CreateAttendancePeriod().c.BodyParser()")
 	}
 
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().CreateAttendancePeriod().req.Validate()")
+	}
+
 	id, err := h.uc.CreateAttendancePeriod(ctx, authCredential, req.ToRequestEntity())
 	if err != nil {
 		return errors.Wrap(err, "AttendanceHandler().CreateAttendancePeriod().uc.CreateAttendancePeriod()")
@@ -77,3 +156,546 @@ func (h *AttendanceHandler) CreateAttendancePeriod(c *fiber.Ctx) error {
 		},
 	)
 }
+
+// @Summary      List Attendance Periods
+// @Description  List the attendance periods, latest first, with their status and active payroll
+// @Tags         Attendance
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.AttendancePeriodResponse} "Attendance Periods Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ListAttendancePeriods(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ListAttendancePeriods()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListAttendancePeriods(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListAttendancePeriods().uc.ListAttendancePeriods()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAttendancePeriodResponse(data),
+		},
+	)
+}
+
+// @Summary      Show Attendance Period
+// @Description  Show an attendance period with its status (open, closed or paid) and active payroll
+// @Tags         Attendance
+// @Produce      json
+// @Param        periodId path string true "Attendance Period ID"
+// @Success      200 {object} dtos.Response{data=dtos.AttendancePeriodResponse} "Attendance Period Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period/{periodId} [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ShowAttendancePeriod(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ShowAttendancePeriod()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PeriodID uuid.UUID `params:"periodId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ShowAttendancePeriod().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowAttendancePeriod(ctx, authCredential, param.PeriodID.String())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ShowAttendancePeriod().uc.ShowAttendancePeriod()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendancePeriodResponse(data),
+		},
+	)
+}
+
+// @Summary      Update Attendance Period
+// @Description  Change the dates of an attendance period, only before a payroll is generated for it
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        periodId path string true "Attendance Period ID"
+// @Param        request body dtos.AttendancePeriodRequest true "Attendance Period Request"
+// @Success      200 {object} dtos.Response{data=dtos.AttendancePeriodResponse} "Attendance Period Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period/{periodId} [PUT]
+// @Security     BearerAuth
+func (h *AttendanceHandler) UpdateAttendancePeriod(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.UpdateAttendancePeriod()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PeriodID uuid.UUID `params:"periodId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().UpdateAttendancePeriod().c.ParamsParser()")
+	}
+
+	var req dtos.AttendancePeriodRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().UpdateAttendancePeriod().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().UpdateAttendancePeriod().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateAttendancePeriod(ctx, authCredential, param.PeriodID.String(), req.ToUpdateEntity())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().UpdateAttendancePeriod().uc.UpdateAttendancePeriod()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendancePeriodResponse(data),
+		},
+	)
+}
+
+// @Summary      Delete Attendance Period
+// @Description  Delete an attendance period, only before a payroll is generated for it
+// @Tags         Attendance
+// @Produce      json
+// @Param        periodId path string true "Attendance Period ID"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period/{periodId} [DELETE]
+// @Security     BearerAuth
+func (h *AttendanceHandler) DeleteAttendancePeriod(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.DeleteAttendancePeriod()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PeriodID uuid.UUID `params:"periodId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().DeleteAttendancePeriod().c.ParamsParser()")
+	}
+
+	err = h.uc.DeleteAttendancePeriod(ctx, authCredential, param.PeriodID.String())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().DeleteAttendancePeriod().uc.DeleteAttendancePeriod()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+		},
+	)
+}
+
+// @Summary      Reopen Attendance Period
+// @Description  Reopen a period closed by its payroll so attendance, overtime and reimbursements dated inside it can be submitted again, the period is closed again by the next payroll generated for it
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        periodId path string true "Attendance Period ID"
+// @Param        request body dtos.ReopenAttendancePeriodRequest true "Reopen Attendance Period Request"
+// @Success      201 {object} dtos.Response{data=dtos.AttendancePeriodReopeningResponse} "Attendance Period Reopening Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/period/{periodId}/reopen [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ReopenAttendancePeriod(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ReopenAttendancePeriod()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PeriodID uuid.UUID `params:"periodId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ReopenAttendancePeriod().c.ParamsParser()")
+	}
+
+	var req dtos.ReopenAttendancePeriodRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ReopenAttendancePeriod().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ReopenAttendancePeriod().req.Validate()")
+	}
+
+	data, err := h.uc.ReopenAttendancePeriod(ctx, authCredential, param.PeriodID.String(), req.Reason)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ReopenAttendancePeriod().uc.ReopenAttendancePeriod()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendancePeriodReopeningResponse(data),
+		},
+	)
+}
+
+// @Summary      Request Attendance Correction
+// @Description  Ask to record attendance for a past working day that was not submitted, an admin or the manager of the employee decides on it
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.AttendanceCorrectionRequest true "Attendance Correction Request"
+// @Success      201 {object} dtos.Response{data=dtos.AttendanceCorrectionResponse} "Attendance Correction Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/attendance/corrections [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) RequestAttendanceCorrection(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.RequestAttendanceCorrection()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.AttendanceCorrectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().RequestAttendanceCorrection().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().RequestAttendanceCorrection().req.Validate()")
+	}
+
+	data, err := h.uc.RequestAttendanceCorrection(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().RequestAttendanceCorrection().uc.RequestAttendanceCorrection()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceCorrectionResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Attendance Corrections
+// @Description  List the attendance corrections requested by the logged in employee, latest date first
+// @Tags         Attendance
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.AttendanceCorrectionResponse} "Attendance Correction List Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/attendance/corrections [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ListMyAttendanceCorrections(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ListMyAttendanceCorrections()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListMyAttendanceCorrections(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListMyAttendanceCorrections().uc.ListMyAttendanceCorrections()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAttendanceCorrectionResponse(data),
+		},
+	)
+}
+
+// @Summary      List Pending Attendance Corrections
+// @Description  List the attendance corrections waiting for a decision, of direct reports or of everyone as an admin
+// @Tags         Attendance
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.AttendanceCorrectionResponse} "Attendance Correction List Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/corrections/pending [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ListPendingAttendanceCorrections(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ListPendingAttendanceCorrections()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListPendingAttendanceCorrections(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListPendingAttendanceCorrections().uc.ListPendingAttendanceCorrections()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAttendanceCorrectionResponse(data),
+		},
+	)
+}
+
+// @Summary      Approve Attendance Correction
+// @Description  Approve a pending attendance correction of a direct report, or of anyone as an admin, recording the attendance of the day. Days in a closed or paid period cannot be corrected
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        correctionId path string true "Attendance Correction ID"
+// @Param        request body dtos.AttendanceCorrectionDecisionRequest false "Attendance Correction Decision Request"
+// @Success      200 {object} dtos.Response{data=dtos.AttendanceCorrectionResponse} "Attendance Correction Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/corrections/{correctionId}/approve [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ApproveAttendanceCorrection(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ApproveAttendanceCorrection()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		CorrectionID uuid.UUID `params:"correctionId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ApproveAttendanceCorrection().c.ParamsParser()")
+	}
+
+	var req dtos.AttendanceCorrectionDecisionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "AttendanceHandler().ApproveAttendanceCorrection().c.BodyParser()")
+		}
+	}
+
+	data, err := h.uc.ApproveAttendanceCorrection(ctx, authCredential, param.CorrectionID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ApproveAttendanceCorrection().uc.ApproveAttendanceCorrection()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceCorrectionResponse(data),
+		},
+	)
+}
+
+// @Summary      Reject Attendance Correction
+// @Description  Reject a pending attendance correction of a direct report, or of anyone as an admin
+// @Tags         Attendance
+// @Accept       json
+// @Produce      json
+// @Param        correctionId path string true "Attendance Correction ID"
+// @Param        request body dtos.AttendanceCorrectionDecisionRequest false "Attendance Correction Decision Request"
+// @Success      200 {object} dtos.Response{data=dtos.AttendanceCorrectionResponse} "Attendance Correction Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/corrections/{correctionId}/reject [POST]
+// @Security     BearerAuth
+func (h *AttendanceHandler) RejectAttendanceCorrection(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.RejectAttendanceCorrection()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		CorrectionID uuid.UUID `params:"correctionId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().RejectAttendanceCorrection().c.ParamsParser()")
+	}
+
+	var req dtos.AttendanceCorrectionDecisionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "AttendanceHandler().RejectAttendanceCorrection().c.BodyParser()")
+		}
+	}
+
+	data, err := h.uc.RejectAttendanceCorrection(ctx, authCredential, param.CorrectionID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().RejectAttendanceCorrection().uc.RejectAttendanceCorrection()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceCorrectionResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Attendance
+// @Description  List the attendance of the logged in employee between two dates, both included
+// @Tags         Attendance
+// @Produce      json
+// @Param        from query string true "From date (YYYY-MM-DD)"
+// @Param        to query string true "To date (YYYY-MM-DD)"
+// @Success      200 {object} dtos.Response{data=[]dtos.AttendanceResponse} "Attendance List Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/attendance [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ListMyAttendance(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ListMyAttendance()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.MyAttendanceRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListMyAttendance().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListMyAttendance().req.Validate()")
+	}
+
+	from, to := req.ToRequestEntity()
+	data, err := h.uc.ListMyAttendance(ctx, authCredential, from, to)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListMyAttendance().uc.ListMyAttendance()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListAttendanceResponse(data),
+		},
+	)
+}
+
+// @Summary      List Attendance
+// @Description  List the attendance of every user newest first, filtered by user, date range and period. Pass the next_cursor of the metadata as cursor to get the next page
+// @Tags         Attendance
+// @Produce      json
+// @Param        user_id query string false "User ID"
+// @Param        period_id query string false "Attendance Period ID"
+// @Param        from query string false "From date (YYYY-MM-DD)"
+// @Param        to query string false "To date (YYYY-MM-DD)"
+// @Param        limit query int false "Limit" default(20)
+// @Param        cursor query string false "Cursor"
+// @Success      200 {object} docshelper.Response[string, dtos.AttendanceResponse, entity.ListAttendanceMetadata] "Attendance List Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ListAttendances(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ListAttendances()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.ListAttendancesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListAttendances().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListAttendances().req.Validate()")
+	}
+
+	decodedCursor, err := resourceful.DecodeCursor(req.Cursor)
+	if err != nil {
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidCursor,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidCursor),
+				Received:  req.Cursor.GetOrDefault(),
+			},
+		)
+	}
+
+	resource := resourceful.NewResource[string, dtos.AttendanceResponse](&resourceful.Parameter{
+		Limit:  req.PageLimit(),
+		Mode:   resourceful.ModeCursor,
+		Cursor: decodedCursor,
+	})
+
+	data, err := h.uc.ListAttendances(ctx, authCredential, req.ToRequestEntity(), resource)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ListAttendances().uc.ListAttendances()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(data.Response(authCredential.RequestID))
+}
+
+// @Summary      Show Attendance Calendar
+// @Description  Show a month as a user by day matrix, of every employee for admins and of direct reports for team leads
+// @Tags         Attendance
+// @Produce      json
+// @Param        month query string true "Month (YYYY-MM)"
+// @Success      200 {object} dtos.Response{data=dtos.AttendanceCalendarResponse} "Attendance Calendar Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/attendance/calendar [GET]
+// @Security     BearerAuth
+func (h *AttendanceHandler) ShowAttendanceCalendar(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"AttendanceHandler.ShowAttendanceCalendar()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.AttendanceCalendarRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ShowAttendanceCalendar().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ShowAttendanceCalendar().req.Validate()")
+	}
+
+	data, err := h.uc.ShowAttendanceCalendar(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "AttendanceHandler().ShowAttendanceCalendar().uc.ShowAttendanceCalendar()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewAttendanceCalendarResponse(data),
+		},
+	)
+}
@@ -5,6 +5,24 @@ import "github.com/gofiber/fiber/v2"
 func MapAttendance(routes fiber.Router, h *AttendanceHandler) {
 	attendance := routes.Group("/attendance")
 
+	attendance.Get("/", h.ListAttendances)
 	attendance.Post("/", h.SubmitAttendance)
+	attendance.Get("/calendar", h.ShowAttendanceCalendar)
+	attendance.Post("/clock-in", h.ClockIn)
+	attendance.Post("/clock-out", h.ClockOut)
+	attendance.Get("/period", h.ListAttendancePeriods)
 	attendance.Post("/period", h.CreateAttendancePeriod)
+	attendance.Get("/period/:periodId", h.ShowAttendancePeriod)
+	attendance.Put("/period/:periodId", h.UpdateAttendancePeriod)
+	attendance.Delete("/period/:periodId", h.DeleteAttendancePeriod)
+	attendance.Post("/period/:periodId/reopen", h.ReopenAttendancePeriod)
+	attendance.Get("/corrections/pending", h.ListPendingAttendanceCorrections)
+	attendance.Post("/corrections/:correctionId/approve", h.ApproveAttendanceCorrection)
+	attendance.Post("/corrections/:correctionId/reject", h.RejectAttendanceCorrection)
+
+	me := routes.Group("/me")
+
+	me.Get("/attendance", h.ListMyAttendance)
+	me.Get("/attendance/corrections", h.ListMyAttendanceCorrections)
+	me.Post("/attendance/corrections", h.RequestAttendanceCorrection)
 }
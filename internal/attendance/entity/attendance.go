@@ -2,17 +2,43 @@ package entity
 
 import (
 	"time"
+
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
+// Attendance is the presence of a user on a day. Attendance submitted before
+// clock events existed, or through the presence flag, has no clock times.
 type Attendance struct {
-	ID             string    `db:"id"`
-	UserID         string    `db:"user_id"`
-	AttendanceDate time.Time `db:"attendance_date"`
-	CreatedAt      time.Time `db:"created_at"`
-	UpdatedAt      time.Time `db:"updated_at"`
-	CreatedBy      string    `db:"created_by"`
-	UpdatedBy      string    `db:"updated_by"`
-	IPAddress      string    `db:"ip_address"`
+	ID             string            `db:"id"`
+	UserID         string            `db:"user_id"`
+	AttendanceDate time.Time         `db:"attendance_date"`
+	ClockInAt      optional.Time     `db:"clock_in_at"`
+	ClockOutAt     optional.Time     `db:"clock_out_at"`
+	LateBy         optional.Duration `db:"late_by"`
+	EarlyLeaveBy   optional.Duration `db:"early_leave_by"`
+	CreatedAt      time.Time         `db:"created_at"`
+	UpdatedAt      time.Time         `db:"updated_at"`
+	CreatedBy      string            `db:"created_by"`
+	UpdatedBy      string            `db:"updated_by"`
+	IPAddress      string            `db:"ip_address"`
+}
+
+// HasClockEvents reports whether the day was recorded by clocking in rather
+// than by the presence flag.
+func (a Attendance) HasClockEvents() bool {
+	return a.ClockInAt.IsPresent()
+}
+
+// WorkedDuration is the time between clocking in and out, zero while the user
+// has not clocked out.
+func (a Attendance) WorkedDuration() time.Duration {
+	clockIn, okIn := a.ClockInAt.Get()
+	clockOut, okOut := a.ClockOutAt.Get()
+	if !okIn || !okOut || clockOut.Before(clockIn) {
+		return 0
+	}
+	return clockOut.Sub(clockIn)
 }
 
 type AttendancePeriod struct {
@@ -31,6 +57,112 @@ type CreateAttendancePeriod struct {
 	EndDate   time.Time
 }
 
+type UpdateAttendancePeriod struct {
+	StartDate time.Time
+	EndDate   time.Time
+}
+
+// AttendancePeriodStatus is derived from the active payroll of a period, it is
+// not stored.
+type AttendancePeriodStatus string
+
+const (
+	AttendancePeriodStatusOpen   AttendancePeriodStatus = "open"
+	AttendancePeriodStatusClosed AttendancePeriodStatus = "closed"
+	AttendancePeriodStatusPaid   AttendancePeriodStatus = "paid"
+)
+
+// payrollStatusPaid mirrors the paid status of the payroll package, which
+// depends on this one.
+const payrollStatusPaid = "paid"
+
+// AttendancePeriodDetail is a period with the payroll generated for it.
+// HasPayroll also counts voided payrolls, they keep referencing the period.
+type AttendancePeriodDetail struct {
+	AttendancePeriod
+	PayrollID     optional.String `db:"payroll_id"`
+	PayrollStatus optional.String `db:"payroll_status"`
+	Reopened      bool            `db:"reopened"`
+	HasPayroll    bool            `db:"has_payroll"`
+}
+
+func (p AttendancePeriodDetail) Status() AttendancePeriodStatus {
+	if !p.PayrollID.IsPresent() {
+		return AttendancePeriodStatusOpen
+	}
+	if p.PayrollStatus.GetOrDefault() == payrollStatusPaid {
+		return AttendancePeriodStatusPaid
+	}
+	if p.Reopened {
+		return AttendancePeriodStatusOpen
+	}
+	return AttendancePeriodStatusClosed
+}
+
+// ClosedAttendancePeriod is a period locked by its active payroll, data dated
+// inside it cannot change until the period is reopened.
+type ClosedAttendancePeriod struct {
+	AttendancePeriod
+	PayrollID string `db:"payroll_id"`
+}
+
+// AttendancePeriodReopening unlocks a closed period for the payroll it was
+// closed by, generating a new payroll for the period closes it again.
+type AttendancePeriodReopening struct {
+	ID        string    `db:"id"`
+	PeriodID  string    `db:"period_id"`
+	PayrollID string    `db:"payroll_id"`
+	Reason    string    `db:"reason"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+	CreatedBy string    `db:"created_by"`
+	UpdatedBy string    `db:"updated_by"`
+	IPAddress string    `db:"ip_address"`
+}
+
+// AttendanceCorrectionStatus is where a correction request is in its
+// approval.
+type AttendanceCorrectionStatus string
+
+const (
+	AttendanceCorrectionStatusPending  AttendanceCorrectionStatus = "pending"
+	AttendanceCorrectionStatusApproved AttendanceCorrectionStatus = "approved"
+	AttendanceCorrectionStatusRejected AttendanceCorrectionStatus = "rejected"
+)
+
+// AttendanceCorrection asks to record attendance for a past day the user did
+// not submit. AttendanceID is the attendance its approval inserted.
+type AttendanceCorrection struct {
+	ID              string                     `db:"id"`
+	UserID          string                     `db:"user_id"`
+	Username        string                     `db:"username"`
+	AttendanceDate  time.Time                  `db:"attendance_date"`
+	Reason          string                     `db:"reason"`
+	Status          AttendanceCorrectionStatus `db:"status"`
+	AttendanceID    optional.String            `db:"attendance_id"`
+	DecidedBy       optional.String            `db:"decided_by"`
+	DecidedAt       optional.Time              `db:"decided_at"`
+	DecisionComment optional.String            `db:"decision_comment"`
+	CreatedAt       time.Time                  `db:"created_at"`
+	UpdatedAt       time.Time                  `db:"updated_at"`
+	CreatedBy       string                     `db:"created_by"`
+	UpdatedBy       string                     `db:"updated_by"`
+	IPAddress       string                     `db:"ip_address"`
+}
+
+type RequestAttendanceCorrection struct {
+	AttendanceDate time.Time
+	Reason         string
+}
+
+type DecideAttendanceCorrection struct {
+	Comment optional.String
+}
+
+type FindAttendanceCorrectionOptions struct {
+	PessimisticLock bool
+}
+
 type MappedBy string
 
 const (
@@ -43,7 +175,8 @@ type MappedOptions struct {
 }
 
 type FindAttendanceOptions struct {
-	PessimisticLock bool
+	PessimisticLock      bool
+	ResourcefulParameter *resourceful.Parameter
 	*MappedOptions
 }
 
@@ -53,3 +186,61 @@ type FindAttendanceResult struct {
 	IsMapped bool
 	MappedBy MappedBy
 }
+
+// FindAttendanceFilter narrows the attendance listed, unset fields do not
+// filter.
+type FindAttendanceFilter struct {
+	UserID    optional.String
+	StartDate optional.Time
+	EndDate   optional.Time
+}
+
+// ListAttendanceFilter is the admin listing filter, a period is resolved to
+// its dates and intersected with StartDate and EndDate.
+type ListAttendanceFilter struct {
+	UserID    optional.String
+	PeriodID  optional.String
+	StartDate optional.Time
+	EndDate   optional.Time
+}
+
+// ListAttendanceMetadata describes a page of attendance, NextCursor is unset
+// on the last page.
+type ListAttendanceMetadata struct {
+	Count      int64               `json:"count"`
+	Limit      int64               `json:"limit"`
+	NextCursor optional.String     `json:"next_cursor"`
+	Cursor     *resourceful.Cursor `json:"-"`
+	IDs        []string            `json:"-"`
+}
+
+// AttendanceCalendarStatus is what a user's day shows in the calendar.
+type AttendanceCalendarStatus string
+
+const (
+	AttendanceCalendarStatusPresent     AttendanceCalendarStatus = "present"
+	AttendanceCalendarStatusAbsent      AttendanceCalendarStatus = "absent"
+	AttendanceCalendarStatusLeave       AttendanceCalendarStatus = "leave"
+	AttendanceCalendarStatusHoliday     AttendanceCalendarStatus = "holiday"
+	AttendanceCalendarStatusWeekend     AttendanceCalendarStatus = "weekend"
+	AttendanceCalendarStatusNotEmployed AttendanceCalendarStatus = "not_employed"
+	AttendanceCalendarStatusUpcoming    AttendanceCalendarStatus = "upcoming"
+)
+
+// AttendanceCalendar is the month of a team as a user by day matrix, the days
+// of every row line up with Dates.
+type AttendanceCalendar struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Dates     []time.Time
+	Rows      []AttendanceCalendarRow
+}
+
+type AttendanceCalendarRow struct {
+	UserID      string
+	Username    string
+	Days        []AttendanceCalendarStatus
+	PresentDays int64
+	AbsentDays  int64
+	LeaveDays   int64
+}
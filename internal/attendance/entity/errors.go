@@ -5,18 +5,75 @@ const (
 	AttendanceNotAuthorized       = "ATTENDANCE_NOT_AUTHORIZED"
 	AttendanceInvalidPeriod       = "ATTENDANCE_INVALID_PERIOD"
 	AttendancePeriodAlreadyExists = "ATTENDANCE_PERIOD_ALREADY_EXISTS"
+	AttendancePeriodClosed        = "ATTENDANCE_PERIOD_CLOSED"
+	AttendancePeriodNotFound      = "ATTENDANCE_PERIOD_NOT_FOUND"
+	AttendancePeriodNotClosed     = "ATTENDANCE_PERIOD_NOT_CLOSED"
+	AttendancePeriodOverlaps      = "ATTENDANCE_PERIOD_OVERLAPS"
+	AttendancePeriodHasPayroll    = "ATTENDANCE_PERIOD_HAS_PAYROLL"
+	AttendancePeriodInUse         = "ATTENDANCE_PERIOD_IN_USE"
+	AttendanceAlreadyClockedIn    = "ATTENDANCE_ALREADY_CLOCKED_IN"
+	AttendanceNotClockedIn        = "ATTENDANCE_NOT_CLOCKED_IN"
+	AttendanceUserNotFound        = "ATTENDANCE_USER_NOT_FOUND"
+	AttendancePeriodPaid          = "ATTENDANCE_PERIOD_PAID"
+	AttendanceAlreadyRecorded     = "ATTENDANCE_ALREADY_RECORDED"
+	AttendanceCorrectionNotPast   = "ATTENDANCE_CORRECTION_NOT_PAST"
+	AttendanceCorrectionExists    = "ATTENDANCE_CORRECTION_EXISTS"
+	AttendanceCorrectionNotFound  = "ATTENDANCE_CORRECTION_NOT_FOUND"
+	AttendanceCorrectionDecided   = "ATTENDANCE_CORRECTION_DECIDED"
+	AttendanceCorrectionSelf      = "ATTENDANCE_CORRECTION_SELF"
+	AttendanceInvalidRange        = "ATTENDANCE_INVALID_RANGE"
+	AttendanceInvalidCursor       = "ATTENDANCE_INVALID_CURSOR"
+	AttendancePeriodReopenPaid    = "ATTENDANCE_PERIOD_REOPEN_PAID"
 )
 
 func GetErrorMessageByIssueCode(issueCode string) string {
 	switch issueCode {
 	case AttendanceInvalidDay:
-		return "Attendance cannot be submitted on weekends"
+		return "Attendance cannot be submitted on weekends or holidays"
 	case AttendanceNotAuthorized:
 		return "You are not authorized to perform this action"
 	case AttendanceInvalidPeriod:
 		return "The attendance period is invalid, start date must be before end date"
 	case AttendancePeriodAlreadyExists:
 		return "An attendance period with the same start and end date already exists"
+	case AttendancePeriodClosed:
+		return "Attendance cannot be submitted, the payroll of the period has already been generated"
+	case AttendancePeriodNotFound:
+		return "Attendance period not found"
+	case AttendancePeriodNotClosed:
+		return "The attendance period is not closed, it has no payroll or was already reopened"
+	case AttendancePeriodOverlaps:
+		return "The attendance period overlaps an existing period"
+	case AttendancePeriodHasPayroll:
+		return "The attendance period cannot be changed or deleted once a payroll has been generated for it"
+	case AttendancePeriodInUse:
+		return "The attendance period is still referenced by payroll jobs or adjustments"
+	case AttendanceAlreadyClockedIn:
+		return "You have already clocked in today"
+	case AttendanceNotClockedIn:
+		return "You have not clocked in, or have already clocked out"
+	case AttendanceUserNotFound:
+		return "User not found"
+	case AttendancePeriodPaid:
+		return "Attendance cannot be corrected, the payroll of the period has already been paid"
+	case AttendanceAlreadyRecorded:
+		return "Attendance is already recorded for the date"
+	case AttendanceCorrectionNotPast:
+		return "Attendance corrections can only be requested for a past date"
+	case AttendanceCorrectionExists:
+		return "An attendance correction for the date is already waiting for a decision"
+	case AttendanceCorrectionNotFound:
+		return "Attendance correction not found"
+	case AttendanceCorrectionDecided:
+		return "The attendance correction has already been decided"
+	case AttendanceCorrectionSelf:
+		return "You cannot decide on your own attendance correction"
+	case AttendanceInvalidRange:
+		return "The date range is invalid, from must not be after to"
+	case AttendanceInvalidCursor:
+		return "The cursor is invalid"
+	case AttendancePeriodReopenPaid:
+		return "The attendance period cannot be reopened, its payroll has already been paid"
 	default:
 		return "An unknown error occurred"
 	}
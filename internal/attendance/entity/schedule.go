@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+const scheduleTimeFormat = "15:04"
+
+// WorkSchedule is the working day clock events are measured against. Start
+// and End are wall clock times of day in the timezone of the clock event,
+// 09:00 stays 09:00 on days clocks change. Arriving within GracePeriod of
+// Start is not counted as late.
+type WorkSchedule struct {
+	Start       time.Duration
+	End         time.Duration
+	GracePeriod time.Duration
+}
+
+// ParseWorkSchedule reads start and end as HH:MM times of day.
+func ParseWorkSchedule(start, end string, gracePeriod time.Duration) (WorkSchedule, error) {
+	startTime, err := time.Parse(scheduleTimeFormat, start)
+	if err != nil {
+		return WorkSchedule{}, fmt.Errorf("invalid work start %q: %w", start, err)
+	}
+	endTime, err := time.Parse(scheduleTimeFormat, end)
+	if err != nil {
+		return WorkSchedule{}, fmt.Errorf("invalid work end %q: %w", end, err)
+	}
+	if !endTime.After(startTime) {
+		return WorkSchedule{}, fmt.Errorf("work end %q must be after work start %q", end, start)
+	}
+
+	return WorkSchedule{
+		Start:       startTime.Sub(startTime.Truncate(24 * time.Hour)),
+		End:         endTime.Sub(endTime.Truncate(24 * time.Hour)),
+		GracePeriod: gracePeriod,
+	}, nil
+}
+
+// Length is the scheduled working time of a day.
+func (s WorkSchedule) Length() time.Duration {
+	return s.End - s.Start
+}
+
+// LateBy is how long after the scheduled start the user clocked in, zero when
+// they arrived on time or within the grace period.
+func (s WorkSchedule) LateBy(clockIn time.Time) time.Duration {
+	late := clockIn.Sub(s.startOf(clockIn))
+	if late <= s.GracePeriod {
+		return 0
+	}
+	return late
+}
+
+// EarlyLeaveBy is how long before the scheduled end of the day the user
+// clocked in on they clocked out, zero when they stayed until the end.
+func (s WorkSchedule) EarlyLeaveBy(clockIn, clockOut time.Time) time.Duration {
+	early := s.endOf(clockIn).Sub(clockOut)
+	if early <= 0 {
+		return 0
+	}
+	return early
+}
+
+// CappedWorkedDuration is the worked time of an attendance counted towards
+// pay, never more than the scheduled length of the day.
+func (s WorkSchedule) CappedWorkedDuration(attendance Attendance) time.Duration {
+	return min(attendance.WorkedDuration(), s.Length())
+}
+
+func (s WorkSchedule) startOf(t time.Time) time.Time {
+	return wallClock(t, s.Start)
+}
+
+func (s WorkSchedule) endOf(t time.Time) time.Time {
+	return wallClock(t, s.End)
+}
+
+// wallClock is the time of day on the date of t in its location. time.Date
+// normalizes the offset as a time of day, not as elapsed time since midnight.
+func wallClock(t time.Time, timeOfDay time.Duration) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, int(timeOfDay), t.Location())
+}
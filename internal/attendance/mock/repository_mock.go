@@ -44,85 +44,689 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteAttendancePeriod mocks base method.
+func (m *MockRepository) DeleteAttendancePeriod(ctx context.Context, periodID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendancePeriod", ctx, periodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendancePeriod indicates an expected call of DeleteAttendancePeriod.
+func (mr *MockRepositoryMockRecorder) DeleteAttendancePeriod(ctx, periodID any) *MockRepositoryDeleteAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendancePeriod", reflect.TypeOf((*MockRepository)(nil).DeleteAttendancePeriod), ctx, periodID)
+	return &MockRepositoryDeleteAttendancePeriodCall{Call: call}
+}
+
+// MockRepositoryDeleteAttendancePeriodCall wrap *gomock.Call
+type MockRepositoryDeleteAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryDeleteAttendancePeriodCall) Return(arg0 error) *MockRepositoryDeleteAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryDeleteAttendancePeriodCall) Do(f func(context.Context, string) error) *MockRepositoryDeleteAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryDeleteAttendancePeriodCall) DoAndReturn(f func(context.Context, string) error) *MockRepositoryDeleteAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindAttendanceByPeriod mocks base method.
 func (m *MockRepository) FindAttendanceByPeriod(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{ctx, startDate, endDate}
+	varargs := []any{ctx, startDate, endDate}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAttendanceByPeriod", varargs...)
+	ret0, _ := ret[0].(entity.FindAttendanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendanceByPeriod indicates an expected call of FindAttendanceByPeriod.
+func (mr *MockRepositoryMockRecorder) FindAttendanceByPeriod(ctx, startDate, endDate any, opts ...any) *MockRepositoryFindAttendanceByPeriodCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, startDate, endDate}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendanceByPeriod", reflect.TypeOf((*MockRepository)(nil).FindAttendanceByPeriod), varargs...)
+	return &MockRepositoryFindAttendanceByPeriodCall{Call: call}
+}
+
+// MockRepositoryFindAttendanceByPeriodCall wrap *gomock.Call
+type MockRepositoryFindAttendanceByPeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendanceByPeriodCall) Return(arg0 entity.FindAttendanceResult, arg1 error) *MockRepositoryFindAttendanceByPeriodCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendanceByPeriodCall) Do(f func(context.Context, time.Time, time.Time, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendanceByPeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendanceByPeriodCall) DoAndReturn(f func(context.Context, time.Time, time.Time, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendanceByPeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendanceByUserIDDate mocks base method.
+func (m *MockRepository) FindAttendanceByUserIDDate(ctx context.Context, userID string, date time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, date}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAttendanceByUserIDDate", varargs...)
+	ret0, _ := ret[0].(*entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendanceByUserIDDate indicates an expected call of FindAttendanceByUserIDDate.
+func (mr *MockRepositoryMockRecorder) FindAttendanceByUserIDDate(ctx, userID, date any, opts ...any) *MockRepositoryFindAttendanceByUserIDDateCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, date}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendanceByUserIDDate", reflect.TypeOf((*MockRepository)(nil).FindAttendanceByUserIDDate), varargs...)
+	return &MockRepositoryFindAttendanceByUserIDDateCall{Call: call}
+}
+
+// MockRepositoryFindAttendanceByUserIDDateCall wrap *gomock.Call
+type MockRepositoryFindAttendanceByUserIDDateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendanceByUserIDDateCall) Return(arg0 *entity.Attendance, arg1 error) *MockRepositoryFindAttendanceByUserIDDateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendanceByUserIDDateCall) Do(f func(context.Context, string, time.Time, ...entity.FindAttendanceOptions) (*entity.Attendance, error)) *MockRepositoryFindAttendanceByUserIDDateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendanceByUserIDDateCall) DoAndReturn(f func(context.Context, string, time.Time, ...entity.FindAttendanceOptions) (*entity.Attendance, error)) *MockRepositoryFindAttendanceByUserIDDateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendanceCorrectionByID mocks base method.
+func (m *MockRepository) FindAttendanceCorrectionByID(ctx context.Context, correctionID string, opts ...entity.FindAttendanceCorrectionOptions) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, correctionID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAttendanceCorrectionByID", varargs...)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendanceCorrectionByID indicates an expected call of FindAttendanceCorrectionByID.
+func (mr *MockRepositoryMockRecorder) FindAttendanceCorrectionByID(ctx, correctionID any, opts ...any) *MockRepositoryFindAttendanceCorrectionByIDCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, correctionID}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendanceCorrectionByID", reflect.TypeOf((*MockRepository)(nil).FindAttendanceCorrectionByID), varargs...)
+	return &MockRepositoryFindAttendanceCorrectionByIDCall{Call: call}
+}
+
+// MockRepositoryFindAttendanceCorrectionByIDCall wrap *gomock.Call
+type MockRepositoryFindAttendanceCorrectionByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendanceCorrectionByIDCall) Return(arg0 *entity.AttendanceCorrection, arg1 error) *MockRepositoryFindAttendanceCorrectionByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendanceCorrectionByIDCall) Do(f func(context.Context, string, ...entity.FindAttendanceCorrectionOptions) (*entity.AttendanceCorrection, error)) *MockRepositoryFindAttendanceCorrectionByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendanceCorrectionByIDCall) DoAndReturn(f func(context.Context, string, ...entity.FindAttendanceCorrectionOptions) (*entity.AttendanceCorrection, error)) *MockRepositoryFindAttendanceCorrectionByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendanceCorrectionsByUserID mocks base method.
+func (m *MockRepository) FindAttendanceCorrectionsByUserID(ctx context.Context, userID string) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttendanceCorrectionsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendanceCorrectionsByUserID indicates an expected call of FindAttendanceCorrectionsByUserID.
+func (mr *MockRepositoryMockRecorder) FindAttendanceCorrectionsByUserID(ctx, userID any) *MockRepositoryFindAttendanceCorrectionsByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendanceCorrectionsByUserID", reflect.TypeOf((*MockRepository)(nil).FindAttendanceCorrectionsByUserID), ctx, userID)
+	return &MockRepositoryFindAttendanceCorrectionsByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindAttendanceCorrectionsByUserIDCall wrap *gomock.Call
+type MockRepositoryFindAttendanceCorrectionsByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendanceCorrectionsByUserIDCall) Return(arg0 []entity.AttendanceCorrection, arg1 error) *MockRepositoryFindAttendanceCorrectionsByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendanceCorrectionsByUserIDCall) Do(f func(context.Context, string) ([]entity.AttendanceCorrection, error)) *MockRepositoryFindAttendanceCorrectionsByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendanceCorrectionsByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.AttendanceCorrection, error)) *MockRepositoryFindAttendanceCorrectionsByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendancePeriodByPayrollID mocks base method.
+func (m *MockRepository) FindAttendancePeriodByPayrollID(ctx context.Context, payrollID string) (*entity.AttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttendancePeriodByPayrollID", ctx, payrollID)
+	ret0, _ := ret[0].(*entity.AttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendancePeriodByPayrollID indicates an expected call of FindAttendancePeriodByPayrollID.
+func (mr *MockRepositoryMockRecorder) FindAttendancePeriodByPayrollID(ctx, payrollID any) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendancePeriodByPayrollID", reflect.TypeOf((*MockRepository)(nil).FindAttendancePeriodByPayrollID), ctx, payrollID)
+	return &MockRepositoryFindAttendancePeriodByPayrollIDCall{Call: call}
+}
+
+// MockRepositoryFindAttendancePeriodByPayrollIDCall wrap *gomock.Call
+type MockRepositoryFindAttendancePeriodByPayrollIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) Return(arg0 *entity.AttendancePeriod, arg1 error) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) Do(f func(context.Context, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) DoAndReturn(f func(context.Context, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendancePeriodDetailByDate mocks base method.
+func (m *MockRepository) FindAttendancePeriodDetailByDate(ctx context.Context, date time.Time) (*entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttendancePeriodDetailByDate", ctx, date)
+	ret0, _ := ret[0].(*entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendancePeriodDetailByDate indicates an expected call of FindAttendancePeriodDetailByDate.
+func (mr *MockRepositoryMockRecorder) FindAttendancePeriodDetailByDate(ctx, date any) *MockRepositoryFindAttendancePeriodDetailByDateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendancePeriodDetailByDate", reflect.TypeOf((*MockRepository)(nil).FindAttendancePeriodDetailByDate), ctx, date)
+	return &MockRepositoryFindAttendancePeriodDetailByDateCall{Call: call}
+}
+
+// MockRepositoryFindAttendancePeriodDetailByDateCall wrap *gomock.Call
+type MockRepositoryFindAttendancePeriodDetailByDateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendancePeriodDetailByDateCall) Return(arg0 *entity.AttendancePeriodDetail, arg1 error) *MockRepositoryFindAttendancePeriodDetailByDateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendancePeriodDetailByDateCall) Do(f func(context.Context, time.Time) (*entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodDetailByDateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendancePeriodDetailByDateCall) DoAndReturn(f func(context.Context, time.Time) (*entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodDetailByDateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendancePeriodDetailByID mocks base method.
+func (m *MockRepository) FindAttendancePeriodDetailByID(ctx context.Context, periodID string) (*entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttendancePeriodDetailByID", ctx, periodID)
+	ret0, _ := ret[0].(*entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendancePeriodDetailByID indicates an expected call of FindAttendancePeriodDetailByID.
+func (mr *MockRepositoryMockRecorder) FindAttendancePeriodDetailByID(ctx, periodID any) *MockRepositoryFindAttendancePeriodDetailByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendancePeriodDetailByID", reflect.TypeOf((*MockRepository)(nil).FindAttendancePeriodDetailByID), ctx, periodID)
+	return &MockRepositoryFindAttendancePeriodDetailByIDCall{Call: call}
+}
+
+// MockRepositoryFindAttendancePeriodDetailByIDCall wrap *gomock.Call
+type MockRepositoryFindAttendancePeriodDetailByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendancePeriodDetailByIDCall) Return(arg0 *entity.AttendancePeriodDetail, arg1 error) *MockRepositoryFindAttendancePeriodDetailByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendancePeriodDetailByIDCall) Do(f func(context.Context, string) (*entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodDetailByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendancePeriodDetailByIDCall) DoAndReturn(f func(context.Context, string) (*entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodDetailByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendancePeriods mocks base method.
+func (m *MockRepository) FindAttendancePeriods(ctx context.Context) ([]entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAttendancePeriods", ctx)
+	ret0, _ := ret[0].([]entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendancePeriods indicates an expected call of FindAttendancePeriods.
+func (mr *MockRepositoryMockRecorder) FindAttendancePeriods(ctx any) *MockRepositoryFindAttendancePeriodsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendancePeriods", reflect.TypeOf((*MockRepository)(nil).FindAttendancePeriods), ctx)
+	return &MockRepositoryFindAttendancePeriodsCall{Call: call}
+}
+
+// MockRepositoryFindAttendancePeriodsCall wrap *gomock.Call
+type MockRepositoryFindAttendancePeriodsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendancePeriodsCall) Return(arg0 []entity.AttendancePeriodDetail, arg1 error) *MockRepositoryFindAttendancePeriodsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendancePeriodsCall) Do(f func(context.Context) ([]entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendancePeriodsCall) DoAndReturn(f func(context.Context) ([]entity.AttendancePeriodDetail, error)) *MockRepositoryFindAttendancePeriodsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindAttendances mocks base method.
+func (m *MockRepository) FindAttendances(ctx context.Context, filter entity.FindAttendanceFilter, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, filter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindAttendances", varargs...)
+	ret0, _ := ret[0].(entity.FindAttendanceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAttendances indicates an expected call of FindAttendances.
+func (mr *MockRepositoryMockRecorder) FindAttendances(ctx, filter any, opts ...any) *MockRepositoryFindAttendancesCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, filter}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendances", reflect.TypeOf((*MockRepository)(nil).FindAttendances), varargs...)
+	return &MockRepositoryFindAttendancesCall{Call: call}
+}
+
+// MockRepositoryFindAttendancesCall wrap *gomock.Call
+type MockRepositoryFindAttendancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindAttendancesCall) Return(arg0 entity.FindAttendanceResult, arg1 error) *MockRepositoryFindAttendancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindAttendancesCall) Do(f func(context.Context, entity.FindAttendanceFilter, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindAttendancesCall) DoAndReturn(f func(context.Context, entity.FindAttendanceFilter, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindClosedPeriodByDate mocks base method.
+func (m *MockRepository) FindClosedPeriodByDate(ctx context.Context, date time.Time) (*entity.ClosedAttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindClosedPeriodByDate", ctx, date)
+	ret0, _ := ret[0].(*entity.ClosedAttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindClosedPeriodByDate indicates an expected call of FindClosedPeriodByDate.
+func (mr *MockRepositoryMockRecorder) FindClosedPeriodByDate(ctx, date any) *MockRepositoryFindClosedPeriodByDateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindClosedPeriodByDate", reflect.TypeOf((*MockRepository)(nil).FindClosedPeriodByDate), ctx, date)
+	return &MockRepositoryFindClosedPeriodByDateCall{Call: call}
+}
+
+// MockRepositoryFindClosedPeriodByDateCall wrap *gomock.Call
+type MockRepositoryFindClosedPeriodByDateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindClosedPeriodByDateCall) Return(arg0 *entity.ClosedAttendancePeriod, arg1 error) *MockRepositoryFindClosedPeriodByDateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindClosedPeriodByDateCall) Do(f func(context.Context, time.Time) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByDateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindClosedPeriodByDateCall) DoAndReturn(f func(context.Context, time.Time) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByDateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindClosedPeriodByID mocks base method.
+func (m *MockRepository) FindClosedPeriodByID(ctx context.Context, periodID string) (*entity.ClosedAttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindClosedPeriodByID", ctx, periodID)
+	ret0, _ := ret[0].(*entity.ClosedAttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindClosedPeriodByID indicates an expected call of FindClosedPeriodByID.
+func (mr *MockRepositoryMockRecorder) FindClosedPeriodByID(ctx, periodID any) *MockRepositoryFindClosedPeriodByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindClosedPeriodByID", reflect.TypeOf((*MockRepository)(nil).FindClosedPeriodByID), ctx, periodID)
+	return &MockRepositoryFindClosedPeriodByIDCall{Call: call}
+}
+
+// MockRepositoryFindClosedPeriodByIDCall wrap *gomock.Call
+type MockRepositoryFindClosedPeriodByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindClosedPeriodByIDCall) Return(arg0 *entity.ClosedAttendancePeriod, arg1 error) *MockRepositoryFindClosedPeriodByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindClosedPeriodByIDCall) Do(f func(context.Context, string) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindClosedPeriodByIDCall) DoAndReturn(f func(context.Context, string) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindClosedPeriodByRange mocks base method.
+func (m *MockRepository) FindClosedPeriodByRange(ctx context.Context, startDate, endDate time.Time) (*entity.ClosedAttendancePeriod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindClosedPeriodByRange", ctx, startDate, endDate)
+	ret0, _ := ret[0].(*entity.ClosedAttendancePeriod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindClosedPeriodByRange indicates an expected call of FindClosedPeriodByRange.
+func (mr *MockRepositoryMockRecorder) FindClosedPeriodByRange(ctx, startDate, endDate any) *MockRepositoryFindClosedPeriodByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindClosedPeriodByRange", reflect.TypeOf((*MockRepository)(nil).FindClosedPeriodByRange), ctx, startDate, endDate)
+	return &MockRepositoryFindClosedPeriodByRangeCall{Call: call}
+}
+
+// MockRepositoryFindClosedPeriodByRangeCall wrap *gomock.Call
+type MockRepositoryFindClosedPeriodByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindClosedPeriodByRangeCall) Return(arg0 *entity.ClosedAttendancePeriod, arg1 error) *MockRepositoryFindClosedPeriodByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindClosedPeriodByRangeCall) Do(f func(context.Context, time.Time, time.Time) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindClosedPeriodByRangeCall) DoAndReturn(f func(context.Context, time.Time, time.Time) (*entity.ClosedAttendancePeriod, error)) *MockRepositoryFindClosedPeriodByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindOpenAttendanceByUserID mocks base method.
+func (m *MockRepository) FindOpenAttendanceByUserID(ctx context.Context, userID string, since time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, since}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "FindAttendanceByPeriod", varargs...)
-	ret0, _ := ret[0].(entity.FindAttendanceResult)
+	ret := m.ctrl.Call(m, "FindOpenAttendanceByUserID", varargs...)
+	ret0, _ := ret[0].(*entity.Attendance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAttendanceByPeriod indicates an expected call of FindAttendanceByPeriod.
-func (mr *MockRepositoryMockRecorder) FindAttendanceByPeriod(ctx, startDate, endDate any, opts ...any) *MockRepositoryFindAttendanceByPeriodCall {
+// FindOpenAttendanceByUserID indicates an expected call of FindOpenAttendanceByUserID.
+func (mr *MockRepositoryMockRecorder) FindOpenAttendanceByUserID(ctx, userID, since any, opts ...any) *MockRepositoryFindOpenAttendanceByUserIDCall {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{ctx, startDate, endDate}, opts...)
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendanceByPeriod", reflect.TypeOf((*MockRepository)(nil).FindAttendanceByPeriod), varargs...)
-	return &MockRepositoryFindAttendanceByPeriodCall{Call: call}
+	varargs := append([]any{ctx, userID, since}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenAttendanceByUserID", reflect.TypeOf((*MockRepository)(nil).FindOpenAttendanceByUserID), varargs...)
+	return &MockRepositoryFindOpenAttendanceByUserIDCall{Call: call}
 }
 
-// MockRepositoryFindAttendanceByPeriodCall wrap *gomock.Call
-type MockRepositoryFindAttendanceByPeriodCall struct {
+// MockRepositoryFindOpenAttendanceByUserIDCall wrap *gomock.Call
+type MockRepositoryFindOpenAttendanceByUserIDCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockRepositoryFindAttendanceByPeriodCall) Return(arg0 entity.FindAttendanceResult, arg1 error) *MockRepositoryFindAttendanceByPeriodCall {
+func (c *MockRepositoryFindOpenAttendanceByUserIDCall) Return(arg0 *entity.Attendance, arg1 error) *MockRepositoryFindOpenAttendanceByUserIDCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRepositoryFindAttendanceByPeriodCall) Do(f func(context.Context, time.Time, time.Time, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendanceByPeriodCall {
+func (c *MockRepositoryFindOpenAttendanceByUserIDCall) Do(f func(context.Context, string, time.Time, ...entity.FindAttendanceOptions) (*entity.Attendance, error)) *MockRepositoryFindOpenAttendanceByUserIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRepositoryFindAttendanceByPeriodCall) DoAndReturn(f func(context.Context, time.Time, time.Time, ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)) *MockRepositoryFindAttendanceByPeriodCall {
+func (c *MockRepositoryFindOpenAttendanceByUserIDCall) DoAndReturn(f func(context.Context, string, time.Time, ...entity.FindAttendanceOptions) (*entity.Attendance, error)) *MockRepositoryFindOpenAttendanceByUserIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// FindAttendancePeriodByPayrollID mocks base method.
-func (m *MockRepository) FindAttendancePeriodByPayrollID(ctx context.Context, payrollID string) (*entity.AttendancePeriod, error) {
+// FindOverlappingPeriod mocks base method.
+func (m *MockRepository) FindOverlappingPeriod(ctx context.Context, startDate, endDate time.Time, excludePeriodID string) (*entity.AttendancePeriod, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAttendancePeriodByPayrollID", ctx, payrollID)
+	ret := m.ctrl.Call(m, "FindOverlappingPeriod", ctx, startDate, endDate, excludePeriodID)
 	ret0, _ := ret[0].(*entity.AttendancePeriod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FindAttendancePeriodByPayrollID indicates an expected call of FindAttendancePeriodByPayrollID.
-func (mr *MockRepositoryMockRecorder) FindAttendancePeriodByPayrollID(ctx, payrollID any) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+// FindOverlappingPeriod indicates an expected call of FindOverlappingPeriod.
+func (mr *MockRepositoryMockRecorder) FindOverlappingPeriod(ctx, startDate, endDate, excludePeriodID any) *MockRepositoryFindOverlappingPeriodCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAttendancePeriodByPayrollID", reflect.TypeOf((*MockRepository)(nil).FindAttendancePeriodByPayrollID), ctx, payrollID)
-	return &MockRepositoryFindAttendancePeriodByPayrollIDCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverlappingPeriod", reflect.TypeOf((*MockRepository)(nil).FindOverlappingPeriod), ctx, startDate, endDate, excludePeriodID)
+	return &MockRepositoryFindOverlappingPeriodCall{Call: call}
 }
 
-// MockRepositoryFindAttendancePeriodByPayrollIDCall wrap *gomock.Call
-type MockRepositoryFindAttendancePeriodByPayrollIDCall struct {
+// MockRepositoryFindOverlappingPeriodCall wrap *gomock.Call
+type MockRepositoryFindOverlappingPeriodCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) Return(arg0 *entity.AttendancePeriod, arg1 error) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+func (c *MockRepositoryFindOverlappingPeriodCall) Return(arg0 *entity.AttendancePeriod, arg1 error) *MockRepositoryFindOverlappingPeriodCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) Do(f func(context.Context, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+func (c *MockRepositoryFindOverlappingPeriodCall) Do(f func(context.Context, time.Time, time.Time, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindOverlappingPeriodCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRepositoryFindAttendancePeriodByPayrollIDCall) DoAndReturn(f func(context.Context, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindAttendancePeriodByPayrollIDCall {
+func (c *MockRepositoryFindOverlappingPeriodCall) DoAndReturn(f func(context.Context, time.Time, time.Time, string) (*entity.AttendancePeriod, error)) *MockRepositoryFindOverlappingPeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPendingAttendanceCorrectionByUserIDDate mocks base method.
+func (m *MockRepository) FindPendingAttendanceCorrectionByUserIDDate(ctx context.Context, userID string, date time.Time) (*entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingAttendanceCorrectionByUserIDDate", ctx, userID, date)
+	ret0, _ := ret[0].(*entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingAttendanceCorrectionByUserIDDate indicates an expected call of FindPendingAttendanceCorrectionByUserIDDate.
+func (mr *MockRepositoryMockRecorder) FindPendingAttendanceCorrectionByUserIDDate(ctx, userID, date any) *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingAttendanceCorrectionByUserIDDate", reflect.TypeOf((*MockRepository)(nil).FindPendingAttendanceCorrectionByUserIDDate), ctx, userID, date)
+	return &MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall{Call: call}
+}
+
+// MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall wrap *gomock.Call
+type MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall) Return(arg0 *entity.AttendanceCorrection, arg1 error) *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall) Do(f func(context.Context, string, time.Time) (*entity.AttendanceCorrection, error)) *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall) DoAndReturn(f func(context.Context, string, time.Time) (*entity.AttendanceCorrection, error)) *MockRepositoryFindPendingAttendanceCorrectionByUserIDDateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPendingAttendanceCorrections mocks base method.
+func (m *MockRepository) FindPendingAttendanceCorrections(ctx context.Context, managerID string) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingAttendanceCorrections", ctx, managerID)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingAttendanceCorrections indicates an expected call of FindPendingAttendanceCorrections.
+func (mr *MockRepositoryMockRecorder) FindPendingAttendanceCorrections(ctx, managerID any) *MockRepositoryFindPendingAttendanceCorrectionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingAttendanceCorrections", reflect.TypeOf((*MockRepository)(nil).FindPendingAttendanceCorrections), ctx, managerID)
+	return &MockRepositoryFindPendingAttendanceCorrectionsCall{Call: call}
+}
+
+// MockRepositoryFindPendingAttendanceCorrectionsCall wrap *gomock.Call
+type MockRepositoryFindPendingAttendanceCorrectionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPendingAttendanceCorrectionsCall) Return(arg0 []entity.AttendanceCorrection, arg1 error) *MockRepositoryFindPendingAttendanceCorrectionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPendingAttendanceCorrectionsCall) Do(f func(context.Context, string) ([]entity.AttendanceCorrection, error)) *MockRepositoryFindPendingAttendanceCorrectionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPendingAttendanceCorrectionsCall) DoAndReturn(f func(context.Context, string) ([]entity.AttendanceCorrection, error)) *MockRepositoryFindPendingAttendanceCorrectionsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -166,6 +770,44 @@ func (c *MockRepositoryFindPeriodByIDCall) DoAndReturn(f func(context.Context, s
 	return c
 }
 
+// StoreAttendanceClockIn mocks base method.
+func (m *MockRepository) StoreAttendanceClockIn(ctx context.Context, arg1 entity.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAttendanceClockIn", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAttendanceClockIn indicates an expected call of StoreAttendanceClockIn.
+func (mr *MockRepositoryMockRecorder) StoreAttendanceClockIn(ctx, arg1 any) *MockRepositoryStoreAttendanceClockInCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAttendanceClockIn", reflect.TypeOf((*MockRepository)(nil).StoreAttendanceClockIn), ctx, arg1)
+	return &MockRepositoryStoreAttendanceClockInCall{Call: call}
+}
+
+// MockRepositoryStoreAttendanceClockInCall wrap *gomock.Call
+type MockRepositoryStoreAttendanceClockInCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreAttendanceClockInCall) Return(arg0 error) *MockRepositoryStoreAttendanceClockInCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreAttendanceClockInCall) Do(f func(context.Context, entity.Attendance) error) *MockRepositoryStoreAttendanceClockInCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreAttendanceClockInCall) DoAndReturn(f func(context.Context, entity.Attendance) error) *MockRepositoryStoreAttendanceClockInCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StoreNewAttendance mocks base method.
 func (m *MockRepository) StoreNewAttendance(ctx context.Context, arg1 entity.Attendance) error {
 	m.ctrl.T.Helper()
@@ -204,6 +846,44 @@ func (c *MockRepositoryStoreNewAttendanceCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// StoreNewAttendanceCorrection mocks base method.
+func (m *MockRepository) StoreNewAttendanceCorrection(ctx context.Context, correction entity.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewAttendanceCorrection", ctx, correction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewAttendanceCorrection indicates an expected call of StoreNewAttendanceCorrection.
+func (mr *MockRepositoryMockRecorder) StoreNewAttendanceCorrection(ctx, correction any) *MockRepositoryStoreNewAttendanceCorrectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewAttendanceCorrection", reflect.TypeOf((*MockRepository)(nil).StoreNewAttendanceCorrection), ctx, correction)
+	return &MockRepositoryStoreNewAttendanceCorrectionCall{Call: call}
+}
+
+// MockRepositoryStoreNewAttendanceCorrectionCall wrap *gomock.Call
+type MockRepositoryStoreNewAttendanceCorrectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewAttendanceCorrectionCall) Return(arg0 error) *MockRepositoryStoreNewAttendanceCorrectionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewAttendanceCorrectionCall) Do(f func(context.Context, entity.AttendanceCorrection) error) *MockRepositoryStoreNewAttendanceCorrectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewAttendanceCorrectionCall) DoAndReturn(f func(context.Context, entity.AttendanceCorrection) error) *MockRepositoryStoreNewAttendanceCorrectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StoreNewAttendancePeriod mocks base method.
 func (m *MockRepository) StoreNewAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error {
 	m.ctrl.T.Helper()
@@ -242,6 +922,158 @@ func (c *MockRepositoryStoreNewAttendancePeriodCall) DoAndReturn(f func(context.
 	return c
 }
 
+// StoreNewAttendancePeriodReopening mocks base method.
+func (m *MockRepository) StoreNewAttendancePeriodReopening(ctx context.Context, reopening entity.AttendancePeriodReopening) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewAttendancePeriodReopening", ctx, reopening)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewAttendancePeriodReopening indicates an expected call of StoreNewAttendancePeriodReopening.
+func (mr *MockRepositoryMockRecorder) StoreNewAttendancePeriodReopening(ctx, reopening any) *MockRepositoryStoreNewAttendancePeriodReopeningCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewAttendancePeriodReopening", reflect.TypeOf((*MockRepository)(nil).StoreNewAttendancePeriodReopening), ctx, reopening)
+	return &MockRepositoryStoreNewAttendancePeriodReopeningCall{Call: call}
+}
+
+// MockRepositoryStoreNewAttendancePeriodReopeningCall wrap *gomock.Call
+type MockRepositoryStoreNewAttendancePeriodReopeningCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewAttendancePeriodReopeningCall) Return(arg0 error) *MockRepositoryStoreNewAttendancePeriodReopeningCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewAttendancePeriodReopeningCall) Do(f func(context.Context, entity.AttendancePeriodReopening) error) *MockRepositoryStoreNewAttendancePeriodReopeningCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewAttendancePeriodReopeningCall) DoAndReturn(f func(context.Context, entity.AttendancePeriodReopening) error) *MockRepositoryStoreNewAttendancePeriodReopeningCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAttendanceClockOut mocks base method.
+func (m *MockRepository) UpdateAttendanceClockOut(ctx context.Context, arg1 entity.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceClockOut", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceClockOut indicates an expected call of UpdateAttendanceClockOut.
+func (mr *MockRepositoryMockRecorder) UpdateAttendanceClockOut(ctx, arg1 any) *MockRepositoryUpdateAttendanceClockOutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceClockOut", reflect.TypeOf((*MockRepository)(nil).UpdateAttendanceClockOut), ctx, arg1)
+	return &MockRepositoryUpdateAttendanceClockOutCall{Call: call}
+}
+
+// MockRepositoryUpdateAttendanceClockOutCall wrap *gomock.Call
+type MockRepositoryUpdateAttendanceClockOutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateAttendanceClockOutCall) Return(arg0 error) *MockRepositoryUpdateAttendanceClockOutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateAttendanceClockOutCall) Do(f func(context.Context, entity.Attendance) error) *MockRepositoryUpdateAttendanceClockOutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateAttendanceClockOutCall) DoAndReturn(f func(context.Context, entity.Attendance) error) *MockRepositoryUpdateAttendanceClockOutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAttendanceCorrectionStatus mocks base method.
+func (m *MockRepository) UpdateAttendanceCorrectionStatus(ctx context.Context, correction entity.AttendanceCorrection) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendanceCorrectionStatus", ctx, correction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendanceCorrectionStatus indicates an expected call of UpdateAttendanceCorrectionStatus.
+func (mr *MockRepositoryMockRecorder) UpdateAttendanceCorrectionStatus(ctx, correction any) *MockRepositoryUpdateAttendanceCorrectionStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendanceCorrectionStatus", reflect.TypeOf((*MockRepository)(nil).UpdateAttendanceCorrectionStatus), ctx, correction)
+	return &MockRepositoryUpdateAttendanceCorrectionStatusCall{Call: call}
+}
+
+// MockRepositoryUpdateAttendanceCorrectionStatusCall wrap *gomock.Call
+type MockRepositoryUpdateAttendanceCorrectionStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateAttendanceCorrectionStatusCall) Return(arg0 error) *MockRepositoryUpdateAttendanceCorrectionStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateAttendanceCorrectionStatusCall) Do(f func(context.Context, entity.AttendanceCorrection) error) *MockRepositoryUpdateAttendanceCorrectionStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateAttendanceCorrectionStatusCall) DoAndReturn(f func(context.Context, entity.AttendanceCorrection) error) *MockRepositoryUpdateAttendanceCorrectionStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAttendancePeriod mocks base method.
+func (m *MockRepository) UpdateAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendancePeriod", ctx, period)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAttendancePeriod indicates an expected call of UpdateAttendancePeriod.
+func (mr *MockRepositoryMockRecorder) UpdateAttendancePeriod(ctx, period any) *MockRepositoryUpdateAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendancePeriod", reflect.TypeOf((*MockRepository)(nil).UpdateAttendancePeriod), ctx, period)
+	return &MockRepositoryUpdateAttendancePeriodCall{Call: call}
+}
+
+// MockRepositoryUpdateAttendancePeriodCall wrap *gomock.Call
+type MockRepositoryUpdateAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateAttendancePeriodCall) Return(arg0 error) *MockRepositoryUpdateAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateAttendancePeriodCall) Do(f func(context.Context, entity.AttendancePeriod) error) *MockRepositoryUpdateAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateAttendancePeriodCall) DoAndReturn(f func(context.Context, entity.AttendancePeriod) error) *MockRepositoryUpdateAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpsertAttendance mocks base method.
 func (m *MockRepository) UpsertAttendance(ctx context.Context, arg1 entity.Attendance) error {
 	m.ctrl.T.Helper()
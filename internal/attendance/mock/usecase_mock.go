@@ -12,9 +12,12 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/vnnyx/employee-management/internal/attendance/entity"
 	entity0 "github.com/vnnyx/employee-management/internal/auth/entity"
+	dtos "github.com/vnnyx/employee-management/internal/dtos"
+	resourceful "github.com/vnnyx/employee-management/pkg/resourceful"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +45,123 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// ApproveAttendanceCorrection mocks base method.
+func (m *MockUseCase) ApproveAttendanceCorrection(ctx context.Context, authCredential entity0.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveAttendanceCorrection", ctx, authCredential, correctionID, payload)
+	ret0, _ := ret[0].(entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveAttendanceCorrection indicates an expected call of ApproveAttendanceCorrection.
+func (mr *MockUseCaseMockRecorder) ApproveAttendanceCorrection(ctx, authCredential, correctionID, payload any) *MockUseCaseApproveAttendanceCorrectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveAttendanceCorrection", reflect.TypeOf((*MockUseCase)(nil).ApproveAttendanceCorrection), ctx, authCredential, correctionID, payload)
+	return &MockUseCaseApproveAttendanceCorrectionCall{Call: call}
+}
+
+// MockUseCaseApproveAttendanceCorrectionCall wrap *gomock.Call
+type MockUseCaseApproveAttendanceCorrectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseApproveAttendanceCorrectionCall) Return(arg0 entity.AttendanceCorrection, arg1 error) *MockUseCaseApproveAttendanceCorrectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseApproveAttendanceCorrectionCall) Do(f func(context.Context, entity0.Credential, string, entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseApproveAttendanceCorrectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseApproveAttendanceCorrectionCall) DoAndReturn(f func(context.Context, entity0.Credential, string, entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseApproveAttendanceCorrectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClockIn mocks base method.
+func (m *MockUseCase) ClockIn(ctx context.Context, authCredential entity0.Credential) (entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockIn", ctx, authCredential)
+	ret0, _ := ret[0].(entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockIn indicates an expected call of ClockIn.
+func (mr *MockUseCaseMockRecorder) ClockIn(ctx, authCredential any) *MockUseCaseClockInCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockIn", reflect.TypeOf((*MockUseCase)(nil).ClockIn), ctx, authCredential)
+	return &MockUseCaseClockInCall{Call: call}
+}
+
+// MockUseCaseClockInCall wrap *gomock.Call
+type MockUseCaseClockInCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseClockInCall) Return(arg0 entity.Attendance, arg1 error) *MockUseCaseClockInCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseClockInCall) Do(f func(context.Context, entity0.Credential) (entity.Attendance, error)) *MockUseCaseClockInCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseClockInCall) DoAndReturn(f func(context.Context, entity0.Credential) (entity.Attendance, error)) *MockUseCaseClockInCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ClockOut mocks base method.
+func (m *MockUseCase) ClockOut(ctx context.Context, authCredential entity0.Credential) (entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockOut", ctx, authCredential)
+	ret0, _ := ret[0].(entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockOut indicates an expected call of ClockOut.
+func (mr *MockUseCaseMockRecorder) ClockOut(ctx, authCredential any) *MockUseCaseClockOutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOut", reflect.TypeOf((*MockUseCase)(nil).ClockOut), ctx, authCredential)
+	return &MockUseCaseClockOutCall{Call: call}
+}
+
+// MockUseCaseClockOutCall wrap *gomock.Call
+type MockUseCaseClockOutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseClockOutCall) Return(arg0 entity.Attendance, arg1 error) *MockUseCaseClockOutCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseClockOutCall) Do(f func(context.Context, entity0.Credential) (entity.Attendance, error)) *MockUseCaseClockOutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseClockOutCall) DoAndReturn(f func(context.Context, entity0.Credential) (entity.Attendance, error)) *MockUseCaseClockOutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreateAttendancePeriod mocks base method.
 func (m *MockUseCase) CreateAttendancePeriod(ctx context.Context, authCredential entity0.Credential, payload entity.CreateAttendancePeriod) (string, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +201,434 @@ func (c *MockUseCaseCreateAttendancePeriodCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// DeleteAttendancePeriod mocks base method.
+func (m *MockUseCase) DeleteAttendancePeriod(ctx context.Context, authCredential entity0.Credential, periodID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttendancePeriod", ctx, authCredential, periodID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttendancePeriod indicates an expected call of DeleteAttendancePeriod.
+func (mr *MockUseCaseMockRecorder) DeleteAttendancePeriod(ctx, authCredential, periodID any) *MockUseCaseDeleteAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttendancePeriod", reflect.TypeOf((*MockUseCase)(nil).DeleteAttendancePeriod), ctx, authCredential, periodID)
+	return &MockUseCaseDeleteAttendancePeriodCall{Call: call}
+}
+
+// MockUseCaseDeleteAttendancePeriodCall wrap *gomock.Call
+type MockUseCaseDeleteAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseDeleteAttendancePeriodCall) Return(arg0 error) *MockUseCaseDeleteAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseDeleteAttendancePeriodCall) Do(f func(context.Context, entity0.Credential, string) error) *MockUseCaseDeleteAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseDeleteAttendancePeriodCall) DoAndReturn(f func(context.Context, entity0.Credential, string) error) *MockUseCaseDeleteAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAttendancePeriods mocks base method.
+func (m *MockUseCase) ListAttendancePeriods(ctx context.Context, authCredential entity0.Credential) ([]entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendancePeriods", ctx, authCredential)
+	ret0, _ := ret[0].([]entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendancePeriods indicates an expected call of ListAttendancePeriods.
+func (mr *MockUseCaseMockRecorder) ListAttendancePeriods(ctx, authCredential any) *MockUseCaseListAttendancePeriodsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendancePeriods", reflect.TypeOf((*MockUseCase)(nil).ListAttendancePeriods), ctx, authCredential)
+	return &MockUseCaseListAttendancePeriodsCall{Call: call}
+}
+
+// MockUseCaseListAttendancePeriodsCall wrap *gomock.Call
+type MockUseCaseListAttendancePeriodsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListAttendancePeriodsCall) Return(arg0 []entity.AttendancePeriodDetail, arg1 error) *MockUseCaseListAttendancePeriodsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListAttendancePeriodsCall) Do(f func(context.Context, entity0.Credential) ([]entity.AttendancePeriodDetail, error)) *MockUseCaseListAttendancePeriodsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListAttendancePeriodsCall) DoAndReturn(f func(context.Context, entity0.Credential) ([]entity.AttendancePeriodDetail, error)) *MockUseCaseListAttendancePeriodsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAttendances mocks base method.
+func (m *MockUseCase) ListAttendances(ctx context.Context, authCredential entity0.Credential, filter entity.ListAttendanceFilter, resource *resourceful.Resource[string, dtos.AttendanceResponse]) (*resourceful.Resource[string, dtos.AttendanceResponse], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttendances", ctx, authCredential, filter, resource)
+	ret0, _ := ret[0].(*resourceful.Resource[string, dtos.AttendanceResponse])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttendances indicates an expected call of ListAttendances.
+func (mr *MockUseCaseMockRecorder) ListAttendances(ctx, authCredential, filter, resource any) *MockUseCaseListAttendancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttendances", reflect.TypeOf((*MockUseCase)(nil).ListAttendances), ctx, authCredential, filter, resource)
+	return &MockUseCaseListAttendancesCall{Call: call}
+}
+
+// MockUseCaseListAttendancesCall wrap *gomock.Call
+type MockUseCaseListAttendancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListAttendancesCall) Return(arg0 *resourceful.Resource[string, dtos.AttendanceResponse], arg1 error) *MockUseCaseListAttendancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListAttendancesCall) Do(f func(context.Context, entity0.Credential, entity.ListAttendanceFilter, *resourceful.Resource[string, dtos.AttendanceResponse]) (*resourceful.Resource[string, dtos.AttendanceResponse], error)) *MockUseCaseListAttendancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListAttendancesCall) DoAndReturn(f func(context.Context, entity0.Credential, entity.ListAttendanceFilter, *resourceful.Resource[string, dtos.AttendanceResponse]) (*resourceful.Resource[string, dtos.AttendanceResponse], error)) *MockUseCaseListAttendancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyAttendance mocks base method.
+func (m *MockUseCase) ListMyAttendance(ctx context.Context, authCredential entity0.Credential, startDate, endDate time.Time) ([]entity.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyAttendance", ctx, authCredential, startDate, endDate)
+	ret0, _ := ret[0].([]entity.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyAttendance indicates an expected call of ListMyAttendance.
+func (mr *MockUseCaseMockRecorder) ListMyAttendance(ctx, authCredential, startDate, endDate any) *MockUseCaseListMyAttendanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyAttendance", reflect.TypeOf((*MockUseCase)(nil).ListMyAttendance), ctx, authCredential, startDate, endDate)
+	return &MockUseCaseListMyAttendanceCall{Call: call}
+}
+
+// MockUseCaseListMyAttendanceCall wrap *gomock.Call
+type MockUseCaseListMyAttendanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyAttendanceCall) Return(arg0 []entity.Attendance, arg1 error) *MockUseCaseListMyAttendanceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyAttendanceCall) Do(f func(context.Context, entity0.Credential, time.Time, time.Time) ([]entity.Attendance, error)) *MockUseCaseListMyAttendanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyAttendanceCall) DoAndReturn(f func(context.Context, entity0.Credential, time.Time, time.Time) ([]entity.Attendance, error)) *MockUseCaseListMyAttendanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyAttendanceCorrections mocks base method.
+func (m *MockUseCase) ListMyAttendanceCorrections(ctx context.Context, authCredential entity0.Credential) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyAttendanceCorrections", ctx, authCredential)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyAttendanceCorrections indicates an expected call of ListMyAttendanceCorrections.
+func (mr *MockUseCaseMockRecorder) ListMyAttendanceCorrections(ctx, authCredential any) *MockUseCaseListMyAttendanceCorrectionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyAttendanceCorrections", reflect.TypeOf((*MockUseCase)(nil).ListMyAttendanceCorrections), ctx, authCredential)
+	return &MockUseCaseListMyAttendanceCorrectionsCall{Call: call}
+}
+
+// MockUseCaseListMyAttendanceCorrectionsCall wrap *gomock.Call
+type MockUseCaseListMyAttendanceCorrectionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyAttendanceCorrectionsCall) Return(arg0 []entity.AttendanceCorrection, arg1 error) *MockUseCaseListMyAttendanceCorrectionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyAttendanceCorrectionsCall) Do(f func(context.Context, entity0.Credential) ([]entity.AttendanceCorrection, error)) *MockUseCaseListMyAttendanceCorrectionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyAttendanceCorrectionsCall) DoAndReturn(f func(context.Context, entity0.Credential) ([]entity.AttendanceCorrection, error)) *MockUseCaseListMyAttendanceCorrectionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPendingAttendanceCorrections mocks base method.
+func (m *MockUseCase) ListPendingAttendanceCorrections(ctx context.Context, authCredential entity0.Credential) ([]entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingAttendanceCorrections", ctx, authCredential)
+	ret0, _ := ret[0].([]entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingAttendanceCorrections indicates an expected call of ListPendingAttendanceCorrections.
+func (mr *MockUseCaseMockRecorder) ListPendingAttendanceCorrections(ctx, authCredential any) *MockUseCaseListPendingAttendanceCorrectionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingAttendanceCorrections", reflect.TypeOf((*MockUseCase)(nil).ListPendingAttendanceCorrections), ctx, authCredential)
+	return &MockUseCaseListPendingAttendanceCorrectionsCall{Call: call}
+}
+
+// MockUseCaseListPendingAttendanceCorrectionsCall wrap *gomock.Call
+type MockUseCaseListPendingAttendanceCorrectionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListPendingAttendanceCorrectionsCall) Return(arg0 []entity.AttendanceCorrection, arg1 error) *MockUseCaseListPendingAttendanceCorrectionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListPendingAttendanceCorrectionsCall) Do(f func(context.Context, entity0.Credential) ([]entity.AttendanceCorrection, error)) *MockUseCaseListPendingAttendanceCorrectionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListPendingAttendanceCorrectionsCall) DoAndReturn(f func(context.Context, entity0.Credential) ([]entity.AttendanceCorrection, error)) *MockUseCaseListPendingAttendanceCorrectionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RejectAttendanceCorrection mocks base method.
+func (m *MockUseCase) RejectAttendanceCorrection(ctx context.Context, authCredential entity0.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectAttendanceCorrection", ctx, authCredential, correctionID, payload)
+	ret0, _ := ret[0].(entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectAttendanceCorrection indicates an expected call of RejectAttendanceCorrection.
+func (mr *MockUseCaseMockRecorder) RejectAttendanceCorrection(ctx, authCredential, correctionID, payload any) *MockUseCaseRejectAttendanceCorrectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectAttendanceCorrection", reflect.TypeOf((*MockUseCase)(nil).RejectAttendanceCorrection), ctx, authCredential, correctionID, payload)
+	return &MockUseCaseRejectAttendanceCorrectionCall{Call: call}
+}
+
+// MockUseCaseRejectAttendanceCorrectionCall wrap *gomock.Call
+type MockUseCaseRejectAttendanceCorrectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseRejectAttendanceCorrectionCall) Return(arg0 entity.AttendanceCorrection, arg1 error) *MockUseCaseRejectAttendanceCorrectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseRejectAttendanceCorrectionCall) Do(f func(context.Context, entity0.Credential, string, entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseRejectAttendanceCorrectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseRejectAttendanceCorrectionCall) DoAndReturn(f func(context.Context, entity0.Credential, string, entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseRejectAttendanceCorrectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReopenAttendancePeriod mocks base method.
+func (m *MockUseCase) ReopenAttendancePeriod(ctx context.Context, authCredential entity0.Credential, periodID, reason string) (entity.AttendancePeriodReopening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenAttendancePeriod", ctx, authCredential, periodID, reason)
+	ret0, _ := ret[0].(entity.AttendancePeriodReopening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenAttendancePeriod indicates an expected call of ReopenAttendancePeriod.
+func (mr *MockUseCaseMockRecorder) ReopenAttendancePeriod(ctx, authCredential, periodID, reason any) *MockUseCaseReopenAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenAttendancePeriod", reflect.TypeOf((*MockUseCase)(nil).ReopenAttendancePeriod), ctx, authCredential, periodID, reason)
+	return &MockUseCaseReopenAttendancePeriodCall{Call: call}
+}
+
+// MockUseCaseReopenAttendancePeriodCall wrap *gomock.Call
+type MockUseCaseReopenAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseReopenAttendancePeriodCall) Return(arg0 entity.AttendancePeriodReopening, arg1 error) *MockUseCaseReopenAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseReopenAttendancePeriodCall) Do(f func(context.Context, entity0.Credential, string, string) (entity.AttendancePeriodReopening, error)) *MockUseCaseReopenAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseReopenAttendancePeriodCall) DoAndReturn(f func(context.Context, entity0.Credential, string, string) (entity.AttendancePeriodReopening, error)) *MockUseCaseReopenAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequestAttendanceCorrection mocks base method.
+func (m *MockUseCase) RequestAttendanceCorrection(ctx context.Context, authCredential entity0.Credential, payload entity.RequestAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestAttendanceCorrection", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity.AttendanceCorrection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestAttendanceCorrection indicates an expected call of RequestAttendanceCorrection.
+func (mr *MockUseCaseMockRecorder) RequestAttendanceCorrection(ctx, authCredential, payload any) *MockUseCaseRequestAttendanceCorrectionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestAttendanceCorrection", reflect.TypeOf((*MockUseCase)(nil).RequestAttendanceCorrection), ctx, authCredential, payload)
+	return &MockUseCaseRequestAttendanceCorrectionCall{Call: call}
+}
+
+// MockUseCaseRequestAttendanceCorrectionCall wrap *gomock.Call
+type MockUseCaseRequestAttendanceCorrectionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseRequestAttendanceCorrectionCall) Return(arg0 entity.AttendanceCorrection, arg1 error) *MockUseCaseRequestAttendanceCorrectionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseRequestAttendanceCorrectionCall) Do(f func(context.Context, entity0.Credential, entity.RequestAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseRequestAttendanceCorrectionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseRequestAttendanceCorrectionCall) DoAndReturn(f func(context.Context, entity0.Credential, entity.RequestAttendanceCorrection) (entity.AttendanceCorrection, error)) *MockUseCaseRequestAttendanceCorrectionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowAttendanceCalendar mocks base method.
+func (m *MockUseCase) ShowAttendanceCalendar(ctx context.Context, authCredential entity0.Credential, month time.Time) (entity.AttendanceCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowAttendanceCalendar", ctx, authCredential, month)
+	ret0, _ := ret[0].(entity.AttendanceCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowAttendanceCalendar indicates an expected call of ShowAttendanceCalendar.
+func (mr *MockUseCaseMockRecorder) ShowAttendanceCalendar(ctx, authCredential, month any) *MockUseCaseShowAttendanceCalendarCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAttendanceCalendar", reflect.TypeOf((*MockUseCase)(nil).ShowAttendanceCalendar), ctx, authCredential, month)
+	return &MockUseCaseShowAttendanceCalendarCall{Call: call}
+}
+
+// MockUseCaseShowAttendanceCalendarCall wrap *gomock.Call
+type MockUseCaseShowAttendanceCalendarCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowAttendanceCalendarCall) Return(arg0 entity.AttendanceCalendar, arg1 error) *MockUseCaseShowAttendanceCalendarCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowAttendanceCalendarCall) Do(f func(context.Context, entity0.Credential, time.Time) (entity.AttendanceCalendar, error)) *MockUseCaseShowAttendanceCalendarCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowAttendanceCalendarCall) DoAndReturn(f func(context.Context, entity0.Credential, time.Time) (entity.AttendanceCalendar, error)) *MockUseCaseShowAttendanceCalendarCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowAttendancePeriod mocks base method.
+func (m *MockUseCase) ShowAttendancePeriod(ctx context.Context, authCredential entity0.Credential, periodID string) (entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowAttendancePeriod", ctx, authCredential, periodID)
+	ret0, _ := ret[0].(entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowAttendancePeriod indicates an expected call of ShowAttendancePeriod.
+func (mr *MockUseCaseMockRecorder) ShowAttendancePeriod(ctx, authCredential, periodID any) *MockUseCaseShowAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowAttendancePeriod", reflect.TypeOf((*MockUseCase)(nil).ShowAttendancePeriod), ctx, authCredential, periodID)
+	return &MockUseCaseShowAttendancePeriodCall{Call: call}
+}
+
+// MockUseCaseShowAttendancePeriodCall wrap *gomock.Call
+type MockUseCaseShowAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowAttendancePeriodCall) Return(arg0 entity.AttendancePeriodDetail, arg1 error) *MockUseCaseShowAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowAttendancePeriodCall) Do(f func(context.Context, entity0.Credential, string) (entity.AttendancePeriodDetail, error)) *MockUseCaseShowAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowAttendancePeriodCall) DoAndReturn(f func(context.Context, entity0.Credential, string) (entity.AttendancePeriodDetail, error)) *MockUseCaseShowAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SubmitAttendance mocks base method.
 func (m *MockUseCase) SubmitAttendance(ctx context.Context, authCredential entity0.Credential) error {
 	m.ctrl.T.Helper()
@@ -118,3 +666,42 @@ func (c *MockUseCaseSubmitAttendanceCall) DoAndReturn(f func(context.Context, en
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateAttendancePeriod mocks base method.
+func (m *MockUseCase) UpdateAttendancePeriod(ctx context.Context, authCredential entity0.Credential, periodID string, payload entity.UpdateAttendancePeriod) (entity.AttendancePeriodDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttendancePeriod", ctx, authCredential, periodID, payload)
+	ret0, _ := ret[0].(entity.AttendancePeriodDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttendancePeriod indicates an expected call of UpdateAttendancePeriod.
+func (mr *MockUseCaseMockRecorder) UpdateAttendancePeriod(ctx, authCredential, periodID, payload any) *MockUseCaseUpdateAttendancePeriodCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttendancePeriod", reflect.TypeOf((*MockUseCase)(nil).UpdateAttendancePeriod), ctx, authCredential, periodID, payload)
+	return &MockUseCaseUpdateAttendancePeriodCall{Call: call}
+}
+
+// MockUseCaseUpdateAttendancePeriodCall wrap *gomock.Call
+type MockUseCaseUpdateAttendancePeriodCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateAttendancePeriodCall) Return(arg0 entity.AttendancePeriodDetail, arg1 error) *MockUseCaseUpdateAttendancePeriodCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateAttendancePeriodCall) Do(f func(context.Context, entity0.Credential, string, entity.UpdateAttendancePeriod) (entity.AttendancePeriodDetail, error)) *MockUseCaseUpdateAttendancePeriodCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateAttendancePeriodCall) DoAndReturn(f func(context.Context, entity0.Credential, string, entity.UpdateAttendancePeriod) (entity.AttendancePeriodDetail, error)) *MockUseCaseUpdateAttendancePeriodCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
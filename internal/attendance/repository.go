@@ -13,8 +13,29 @@ type Repository interface {
 
 	StoreNewAttendance(ctx context.Context, attendance entity.Attendance) error
 	UpsertAttendance(ctx context.Context, attendance entity.Attendance) error
+	StoreAttendanceClockIn(ctx context.Context, attendance entity.Attendance) error
+	UpdateAttendanceClockOut(ctx context.Context, attendance entity.Attendance) error
+	FindAttendanceByUserIDDate(ctx context.Context, userID string, date time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error)
+	FindOpenAttendanceByUserID(ctx context.Context, userID string, since time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error)
 	StoreNewAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error
 	FindPeriodByID(ctx context.Context, periodID string) (*entity.AttendancePeriod, error)
 	FindAttendanceByPeriod(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)
+	FindAttendances(ctx context.Context, filter entity.FindAttendanceFilter, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error)
 	FindAttendancePeriodByPayrollID(ctx context.Context, payrollID string) (*entity.AttendancePeriod, error)
+	FindClosedPeriodByDate(ctx context.Context, date time.Time) (*entity.ClosedAttendancePeriod, error)
+	FindClosedPeriodByRange(ctx context.Context, startDate, endDate time.Time) (*entity.ClosedAttendancePeriod, error)
+	FindClosedPeriodByID(ctx context.Context, periodID string) (*entity.ClosedAttendancePeriod, error)
+	StoreNewAttendancePeriodReopening(ctx context.Context, reopening entity.AttendancePeriodReopening) error
+	FindAttendancePeriods(ctx context.Context) ([]entity.AttendancePeriodDetail, error)
+	FindAttendancePeriodDetailByID(ctx context.Context, periodID string) (*entity.AttendancePeriodDetail, error)
+	FindAttendancePeriodDetailByDate(ctx context.Context, date time.Time) (*entity.AttendancePeriodDetail, error)
+	FindOverlappingPeriod(ctx context.Context, startDate, endDate time.Time, excludePeriodID string) (*entity.AttendancePeriod, error)
+	UpdateAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error
+	DeleteAttendancePeriod(ctx context.Context, periodID string) error
+	StoreNewAttendanceCorrection(ctx context.Context, correction entity.AttendanceCorrection) error
+	FindAttendanceCorrectionByID(ctx context.Context, correctionID string, opts ...entity.FindAttendanceCorrectionOptions) (*entity.AttendanceCorrection, error)
+	FindAttendanceCorrectionsByUserID(ctx context.Context, userID string) ([]entity.AttendanceCorrection, error)
+	FindPendingAttendanceCorrectionByUserIDDate(ctx context.Context, userID string, date time.Time) (*entity.AttendanceCorrection, error)
+	FindPendingAttendanceCorrections(ctx context.Context, managerID string) ([]entity.AttendanceCorrection, error)
+	UpdateAttendanceCorrectionStatus(ctx context.Context, correction entity.AttendanceCorrection) error
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
@@ -13,6 +14,7 @@ import (
 	"github.com/vnnyx/employee-management/internal/constants"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
 type attendanceRepo struct {
@@ -83,6 +85,110 @@ func (r *attendanceRepo) UpsertAttendance(ctx context.Context, attendance entity
 	return nil
 }
 
+// StoreAttendanceClockIn records the clock in of the day, keeping a presence
+// already submitted for it. A day that was already clocked in is not changed.
+func (r *attendanceRepo) StoreAttendanceClockIn(ctx context.Context, attendance entity.Attendance) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.StoreAttendanceClockIn()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(clockInAttendanceQuery, attendance)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to clock in attendance"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) UpdateAttendanceClockOut(ctx context.Context, attendance entity.Attendance) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.UpdateAttendanceClockOut()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(clockOutAttendanceQuery, attendance)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to clock out attendance"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) FindAttendanceByUserIDDate(ctx context.Context, userID string, date time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendanceByUserIDDate()",
+	)
+	defer span.End()
+
+	query := findAttendanceByUserIDDateQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var attendance entity.Attendance
+	err := pgxscan.Get(ctx, r.db, &attendance, query, userID, date)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &attendance, nil
+}
+
+// FindOpenAttendanceByUserID finds the latest attendance clocked in since the
+// given time the user has not clocked out of yet.
+func (r *attendanceRepo) FindOpenAttendanceByUserID(ctx context.Context, userID string, since time.Time, opts ...entity.FindAttendanceOptions) (*entity.Attendance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindOpenAttendanceByUserID()",
+	)
+	defer span.End()
+
+	query := findOpenAttendanceByUserIDQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var attendance entity.Attendance
+	err := pgxscan.Get(ctx, r.db, &attendance, query, userID, since)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &attendance, nil
+}
+
 func (r *attendanceRepo) StoreNewAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error {
 	ctx, span := instrumentation.NewTraceSpan(
 		ctx,
@@ -155,6 +261,10 @@ func (r *attendanceRepo) FindAttendanceByPeriod(ctx context.Context, startDate,
 			&attendance.ID,
 			&attendance.UserID,
 			&attendance.AttendanceDate,
+			&attendance.ClockInAt,
+			&attendance.ClockOutAt,
+			&attendance.LateBy,
+			&attendance.EarlyLeaveBy,
 			&attendance.CreatedAt,
 			&attendance.UpdatedAt,
 			&attendance.CreatedBy,
@@ -168,7 +278,98 @@ func (r *attendanceRepo) FindAttendanceByPeriod(ctx context.Context, startDate,
 		attendances = append(attendances, attendance)
 	}
 
-	result.List = attendances
+	return newFindAttendanceResult(attendances, opts...)
+}
+
+// FindAttendances lists the attendance matching the filter by date. With a
+// resourceful parameter it returns the page after the cursor newest first, and
+// sets the cursor of the next page in the metadata.
+func (r *attendanceRepo) FindAttendances(ctx context.Context, filter entity.FindAttendanceFilter, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendances()",
+	)
+	defer span.End()
+
+	var (
+		conditions []string
+		args       []any
+	)
+	if userID, ok := filter.UserID.Get(); ok {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, userID)
+	}
+	if startDate, ok := filter.StartDate.Get(); ok {
+		conditions = append(conditions, "attendance_date >= ?::DATE")
+		args = append(args, startDate)
+	}
+	if endDate, ok := filter.EndDate.Get(); ok {
+		conditions = append(conditions, "attendance_date <= ?::DATE")
+		args = append(args, endDate)
+	}
+
+	var parameter *resourceful.Parameter
+	if len(opts) > 0 {
+		parameter = opts[0].ResourcefulParameter
+	}
+	if parameter != nil && parameter.Cursor != nil {
+		conditions = append(conditions, "(attendance_date, id) < (?::DATE, ?::UUID)")
+		args = append(args, parameter.Cursor.Key, parameter.Cursor.Value)
+	}
+
+	query := findAttendancesQuery
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+
+	var limit int64
+	if parameter != nil {
+		// One row past the limit tells whether another page follows
+		limit = parameter.Limit.MustGet()
+		query += "ORDER BY attendance_date DESC, id DESC LIMIT ?"
+		args = append(args, limit+1)
+	} else {
+		query += "ORDER BY attendance_date, id"
+	}
+
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var attendances []entity.Attendance
+	err := pgxscan.Select(ctx, r.db, &attendances, database.Rebind(query), args...)
+	if err != nil {
+		return entity.FindAttendanceResult{}, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	if parameter != nil {
+		metadata := entity.ListAttendanceMetadata{
+			Limit: limit,
+		}
+		if int64(len(attendances)) > limit {
+			attendances = attendances[:limit]
+			last := attendances[limit-1]
+			metadata.Cursor = &resourceful.Cursor{
+				Key:   last.AttendanceDate.Format("2006-01-02"),
+				Value: last.ID,
+			}
+		}
+		metadata.IDs = make([]string, 0, len(attendances))
+		for _, attendance := range attendances {
+			metadata.IDs = append(metadata.IDs, attendance.ID)
+		}
+		metadata.Count = int64(len(attendances))
+
+		parameter.SetAdditionalData(metadata)
+	}
+
+	return newFindAttendanceResult(attendances, opts...)
+}
+
+func newFindAttendanceResult(attendances []entity.Attendance, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
+	result := entity.FindAttendanceResult{
+		List: attendances,
+	}
 
 	if len(opts) > 0 && opts[0].MappedOptions != nil {
 		result.IsMapped = true
@@ -219,3 +420,333 @@ func (r *attendanceRepo) FindAttendancePeriodByPayrollID(ctx context.Context, pa
 
 	return &period, nil
 }
+
+// FindClosedPeriodByDate returns the closed period containing the date, nil
+// when the date can still be written.
+func (r *attendanceRepo) FindClosedPeriodByDate(ctx context.Context, date time.Time) (*entity.ClosedAttendancePeriod, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindClosedPeriodByDate()",
+	)
+	defer span.End()
+
+	var period entity.ClosedAttendancePeriod
+	err := pgxscan.Get(ctx, r.db, &period, findClosedPeriodByDateQuery, date)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+func (r *attendanceRepo) FindClosedPeriodByRange(ctx context.Context, startDate, endDate time.Time) (*entity.ClosedAttendancePeriod, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindClosedPeriodByRange()",
+	)
+	defer span.End()
+
+	var period entity.ClosedAttendancePeriod
+	err := pgxscan.Get(ctx, r.db, &period, findClosedPeriodByRangeQuery, startDate, endDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+func (r *attendanceRepo) FindClosedPeriodByID(ctx context.Context, periodID string) (*entity.ClosedAttendancePeriod, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindClosedPeriodByID()",
+	)
+	defer span.End()
+
+	var period entity.ClosedAttendancePeriod
+	err := pgxscan.Get(ctx, r.db, &period, findClosedPeriodByIDQuery, periodID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+func (r *attendanceRepo) StoreNewAttendancePeriodReopening(ctx context.Context, reopening entity.AttendancePeriodReopening) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.StoreNewAttendancePeriodReopening()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertAttendancePeriodReopeningQuery, reopening)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert attendance period reopening"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) FindAttendancePeriods(ctx context.Context) ([]entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendancePeriods()",
+	)
+	defer span.End()
+
+	var periods []entity.AttendancePeriodDetail
+	err := pgxscan.Select(ctx, r.db, &periods, findAttendancePeriodsQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return periods, nil
+}
+
+func (r *attendanceRepo) FindAttendancePeriodDetailByID(ctx context.Context, periodID string) (*entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendancePeriodDetailByID()",
+	)
+	defer span.End()
+
+	var period entity.AttendancePeriodDetail
+	err := pgxscan.Get(ctx, r.db, &period, findAttendancePeriodDetailByIDQuery, periodID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+// FindAttendancePeriodDetailByDate returns the period the date falls in with
+// its payroll, nil when no period covers the date.
+func (r *attendanceRepo) FindAttendancePeriodDetailByDate(ctx context.Context, date time.Time) (*entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendancePeriodDetailByDate()",
+	)
+	defer span.End()
+
+	var period entity.AttendancePeriodDetail
+	err := pgxscan.Get(ctx, r.db, &period, findAttendancePeriodDetailByDateQuery, date)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+// FindOverlappingPeriod returns a period sharing at least one day with the
+// range, the period being edited is left out with excludePeriodID.
+func (r *attendanceRepo) FindOverlappingPeriod(ctx context.Context, startDate, endDate time.Time, excludePeriodID string) (*entity.AttendancePeriod, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindOverlappingPeriod()",
+	)
+	defer span.End()
+
+	var period entity.AttendancePeriod
+	err := pgxscan.Get(ctx, r.db, &period, findOverlappingPeriodQuery, startDate, endDate, excludePeriodID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &period, nil
+}
+
+func (r *attendanceRepo) UpdateAttendancePeriod(ctx context.Context, period entity.AttendancePeriod) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.UpdateAttendancePeriod()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateAttendancePeriodQuery, period)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update attendance period"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) DeleteAttendancePeriod(ctx context.Context, periodID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.DeleteAttendancePeriod()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, deleteAttendancePeriodQuery, periodID)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) StoreNewAttendanceCorrection(ctx context.Context, correction entity.AttendanceCorrection) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.StoreNewAttendanceCorrection()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertAttendanceCorrectionQuery, correction)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert attendance correction"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *attendanceRepo) FindAttendanceCorrectionByID(ctx context.Context, correctionID string, opts ...entity.FindAttendanceCorrectionOptions) (*entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendanceCorrectionByID()",
+	)
+	defer span.End()
+
+	query := findAttendanceCorrectionByIDQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE OF ac"
+	}
+
+	var correction entity.AttendanceCorrection
+	err := pgxscan.Get(ctx, r.db, &correction, query, correctionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &correction, nil
+}
+
+func (r *attendanceRepo) FindAttendanceCorrectionsByUserID(ctx context.Context, userID string) ([]entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindAttendanceCorrectionsByUserID()",
+	)
+	defer span.End()
+
+	var corrections []entity.AttendanceCorrection
+	err := pgxscan.Select(ctx, r.db, &corrections, findAttendanceCorrectionsByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return corrections, nil
+}
+
+func (r *attendanceRepo) FindPendingAttendanceCorrectionByUserIDDate(ctx context.Context, userID string, date time.Time) (*entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindPendingAttendanceCorrectionByUserIDDate()",
+	)
+	defer span.End()
+
+	var correction entity.AttendanceCorrection
+	err := pgxscan.Get(ctx, r.db, &correction, findPendingAttendanceCorrectionByUserIDDateQuery, userID, date)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &correction, nil
+}
+
+func (r *attendanceRepo) FindPendingAttendanceCorrections(ctx context.Context, managerID string) ([]entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.FindPendingAttendanceCorrections()",
+	)
+	defer span.End()
+
+	var corrections []entity.AttendanceCorrection
+	err := pgxscan.Select(ctx, r.db, &corrections, findPendingAttendanceCorrectionsQuery, managerID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return corrections, nil
+}
+
+func (r *attendanceRepo) UpdateAttendanceCorrectionStatus(ctx context.Context, correction entity.AttendanceCorrection) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceRepository.UpdateAttendanceCorrectionStatus()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateAttendanceCorrectionStatusQuery, correction)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update attendance correction"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
@@ -11,8 +11,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vnnyx/employee-management/internal/attendance/entity"
 	"github.com/vnnyx/employee-management/internal/attendance/repository"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
+var attendanceColumns = []string{
+	"id", "user_id", "attendance_date", "clock_in_at", "clock_out_at", "late_by", "early_leave_by",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
 func TestStoreNewAttendance(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
@@ -194,6 +201,276 @@ func TestUpsertAttendance(t *testing.T) {
 	}
 }
 
+func TestStoreAttendanceClockIn(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.Attendance{
+		ID:             "att-id-1",
+		UserID:         "user-1",
+		AttendanceDate: now,
+		ClockInAt:      optional.NewTime(now),
+		LateBy:         optional.NewDuration(15 * time.Minute),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedBy:      "user-1",
+		UpdatedBy:      "user-1",
+		IPAddress:      "127.0.0.1",
+	}
+	args := make([]any, 10)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendances (.+) ON CONFLICT").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("att-id-1"))
+			},
+		},
+		{
+			name: "error - already clocked in",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendances (.+) ON CONFLICT").
+					WithArgs(args...).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectErr: true,
+		},
+		{
+			name: "error - empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendances (.+) ON CONFLICT").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreAttendanceClockIn(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateAttendanceClockOut(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.Attendance{
+		ID:           "att-id-1",
+		ClockOutAt:   optional.NewTime(now),
+		EarlyLeaveBy: optional.NewDuration(0),
+		UpdatedAt:    now,
+		UpdatedBy:    "user-1",
+		IPAddress:    "127.0.0.1",
+	}
+	args := make([]any, 6)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendances SET").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("att-id-1"))
+			},
+		},
+		{
+			name: "error - db query fails",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendances SET").
+					WithArgs(args...).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateAttendanceClockOut(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAttendanceByUserIDDate(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	date := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	clockIn := time.Date(2025, 6, 4, 9, 10, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		opts      []entity.FindAttendanceOptions
+		setupMock func()
+		expected  *entity.Attendance
+		expectErr bool
+	}{
+		{
+			name: "found with lock",
+			opts: []entity.FindAttendanceOptions{{PessimisticLock: true}},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances (.+) FOR UPDATE").
+					WithArgs("user-1", date).
+					WillReturnRows(pgxmock.NewRows(attendanceColumns).
+						AddRow("att-id-1", "user-1", date, clockIn, nil, "00:10:00", nil, date, date, "user-1", "user-1", "127.0.0.1"))
+			},
+			expected: &entity.Attendance{
+				ID:             "att-id-1",
+				UserID:         "user-1",
+				AttendanceDate: date,
+				ClockInAt:      optional.NewTime(clockIn),
+				LateBy:         optional.NewDuration(10 * time.Minute),
+				CreatedAt:      date,
+				UpdatedAt:      date,
+				CreatedBy:      "user-1",
+				UpdatedBy:      "user-1",
+				IPAddress:      "127.0.0.1",
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances").
+					WithArgs("user-1", date).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances").
+					WithArgs("user-1", date).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAttendanceByUserIDDate(context.Background(), "user-1", date, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.expected == nil {
+				assert.Nil(t, result)
+				return
+			}
+			assert.Equal(t, tt.expected.ID, result.ID)
+			assert.Equal(t, tt.expected.ClockInAt.MustGet(), result.ClockInAt.MustGet())
+			assert.Equal(t, tt.expected.LateBy.MustGet(), result.LateBy.MustGet())
+			assert.False(t, result.ClockOutAt.IsPresent())
+			assert.False(t, result.EarlyLeaveBy.IsPresent())
+		})
+	}
+}
+
+func TestFindOpenAttendanceByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	date := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	clockIn := time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC)
+	since := clockIn.Add(-time.Hour)
+
+	tests := []struct {
+		name       string
+		setupMock  func()
+		expectedID string
+		expectErr  bool
+	}{
+		{
+			name: "open",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances (.+) clock_out_at IS NULL").
+					WithArgs("user-1", since).
+					WillReturnRows(pgxmock.NewRows(attendanceColumns).
+						AddRow("att-id-1", "user-1", date, clockIn, nil, "00:00:00", nil, date, date, "user-1", "user-1", "127.0.0.1"))
+			},
+			expectedID: "att-id-1",
+		},
+		{
+			name: "none open",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances").
+					WithArgs("user-1", since).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances").
+					WithArgs("user-1", since).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindOpenAttendanceByUserID(context.Background(), "user-1", since)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			if tt.expectedID == "" {
+				assert.Nil(t, result)
+				return
+			}
+			assert.Equal(t, tt.expectedID, result.ID)
+			assert.Equal(t, clockIn, result.ClockInAt.MustGet())
+		})
+	}
+}
+
 func TestStoreNewAttendancePeriod(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
@@ -384,12 +661,9 @@ func TestFindAttendanceByPeriod(t *testing.T) {
 		{
 			name: "success - mapped by user ID",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "attendance_date", "created_at",
-					"updated_at", "created_by", "updated_by", "ip_address",
-				}).
-					AddRow("1", "user-1", now, now, now, "admin", "admin", "127.0.0.1").
-					AddRow("2", "user-1", now, now, now, "admin", "admin", "127.0.0.1")
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("1", "user-1", now, now, now.Add(8*time.Hour), "00:30:00", "00:00:00", now, now, "admin", "admin", "127.0.0.1").
+					AddRow("2", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1")
 
 				mock.ExpectQuery("SELECT (.+) FROM attendances").
 					WithArgs(startDate, endDate).
@@ -405,12 +679,9 @@ func TestFindAttendanceByPeriod(t *testing.T) {
 		{
 			name: "success - mapped by attendance_date",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "attendance_date", "created_at",
-					"updated_at", "created_by", "updated_by", "ip_address",
-				}).
-					AddRow("1", "user-1", now, now, now, "admin", "admin", "127.0.0.1").
-					AddRow("2", "user-2", now, now, now, "admin", "admin", "127.0.0.1")
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("1", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1").
+					AddRow("2", "user-2", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1")
 
 				mock.ExpectQuery("SELECT (.+) FROM attendances").
 					WithArgs(startDate, endDate).
@@ -426,11 +697,8 @@ func TestFindAttendanceByPeriod(t *testing.T) {
 		{
 			name: "error - scan fails",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{
-					"id", "user_id", "attendance_date", "created_at",
-					"updated_at", "created_by", "updated_by", "ip_address",
-				}).
-					AddRow("bad", "user-1", now, now, now, "admin", "admin", "127.0.0.1").
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("bad", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1").
 					RowError(0, errors.New("scan error"))
 
 				mock.ExpectQuery("SELECT (.+) FROM attendances").
@@ -466,6 +734,134 @@ func TestFindAttendanceByPeriod(t *testing.T) {
 	}
 }
 
+func TestFindAttendances(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+
+	now := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	startDate := now.AddDate(0, 0, -7)
+	endDate := now.AddDate(0, 0, 7)
+	filter := entity.FindAttendanceFilter{
+		UserID:    optional.NewString("user-1"),
+		StartDate: optional.NewTime(startDate),
+		EndDate:   optional.NewTime(endDate),
+	}
+
+	tests := []struct {
+		name             string
+		filter           entity.FindAttendanceFilter
+		parameter        *resourceful.Parameter
+		setupMock        func()
+		expectErr        bool
+		expectedIDs      []string
+		expectedMetadata *entity.ListAttendanceMetadata
+	}{
+		{
+			name:   "success - without filter or pagination",
+			filter: entity.FindAttendanceFilter{},
+			setupMock: func() {
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("1", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1").
+					AddRow("2", "user-2", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1")
+
+				mock.ExpectQuery("SELECT (.+) FROM attendances\\s+ORDER BY attendance_date, id$").
+					WillReturnRows(rows)
+			},
+			expectErr:   false,
+			expectedIDs: []string{"1", "2"},
+		},
+		{
+			name:   "success - next page follows",
+			filter: filter,
+			parameter: &resourceful.Parameter{
+				Limit:  optional.NewInt64(1),
+				Cursor: &resourceful.Cursor{Key: "2024-01-03", Value: "3"},
+			},
+			setupMock: func() {
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("2", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1").
+					AddRow("1", "user-1", now.AddDate(0, 0, -1), nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1")
+
+				mock.ExpectQuery("SELECT (.+) FROM attendances\\s+WHERE user_id = \\$1 AND attendance_date >= \\$2::DATE AND attendance_date <= \\$3::DATE AND \\(attendance_date, id\\) < \\(\\$4::DATE, \\$5::UUID\\)\\s+ORDER BY attendance_date DESC, id DESC LIMIT \\$6").
+					WithArgs("user-1", startDate, endDate, "2024-01-03", "3", int64(2)).
+					WillReturnRows(rows)
+			},
+			expectErr:   false,
+			expectedIDs: []string{"2"},
+			expectedMetadata: &entity.ListAttendanceMetadata{
+				Count:  1,
+				Limit:  1,
+				Cursor: &resourceful.Cursor{Key: "2024-01-02", Value: "2"},
+				IDs:    []string{"2"},
+			},
+		},
+		{
+			name:   "success - last page",
+			filter: filter,
+			parameter: &resourceful.Parameter{
+				Limit: optional.NewInt64(2),
+			},
+			setupMock: func() {
+				rows := pgxmock.NewRows(attendanceColumns).
+					AddRow("2", "user-1", now, nil, nil, nil, nil, now, now, "admin", "admin", "127.0.0.1")
+
+				mock.ExpectQuery("SELECT (.+) FROM attendances\\s+WHERE (.+) LIMIT \\$4").
+					WithArgs("user-1", startDate, endDate, int64(3)).
+					WillReturnRows(rows)
+			},
+			expectErr:   false,
+			expectedIDs: []string{"2"},
+			expectedMetadata: &entity.ListAttendanceMetadata{
+				Count: 1,
+				Limit: 2,
+				IDs:   []string{"2"},
+			},
+		},
+		{
+			name:   "error - query fails",
+			filter: filter,
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendances").
+					WithArgs("user-1", startDate, endDate).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+
+			var opts []entity.FindAttendanceOptions
+			if tt.parameter != nil {
+				opts = append(opts, entity.FindAttendanceOptions{ResourcefulParameter: tt.parameter})
+			}
+			result, err := repo.FindAttendances(context.Background(), tt.filter, opts...)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			ids := make([]string, 0, len(result.List))
+			for _, attendance := range result.List {
+				ids = append(ids, attendance.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+
+			if tt.expectedMetadata != nil {
+				assert.Equal(t, *tt.expectedMetadata, tt.parameter.GetAdditionalData())
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestFindAttendancePeriodByPayrollID(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
@@ -535,3 +931,913 @@ func TestFindAttendancePeriodByPayrollID(t *testing.T) {
 		})
 	}
 }
+
+func TestFindClosedPeriodByDate(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	date := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  *entity.ClosedAttendancePeriod
+		expectErr bool
+	}{
+		{
+			name: "closed",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date).
+					WillReturnRows(
+						pgxmock.NewRows([]string{
+							"id", "start_date", "end_date", "created_at", "updated_at", "created_by", "updated_by", "ip_address", "payroll_id",
+						}).AddRow("period-1", date, date, date, date, "admin", "admin", "127.0.0.1", "payroll-1"),
+					)
+			},
+			expected: &entity.ClosedAttendancePeriod{
+				AttendancePeriod: entity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: date,
+					EndDate:   date,
+					CreatedAt: date,
+					UpdatedAt: date,
+					CreatedBy: "admin",
+					UpdatedBy: "admin",
+					IPAddress: "127.0.0.1",
+				},
+				PayrollID: "payroll-1",
+			},
+		},
+		{
+			name: "open",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindClosedPeriodByDate(context.Background(), date)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFindClosedPeriodByRange(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	date := time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  *entity.ClosedAttendancePeriod
+		expectErr bool
+	}{
+		{
+			name: "closed",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date, endDate).
+					WillReturnRows(
+						pgxmock.NewRows([]string{
+							"id", "start_date", "end_date", "created_at", "updated_at", "created_by", "updated_by", "ip_address", "payroll_id",
+						}).AddRow("period-1", date, date, date, date, "admin", "admin", "127.0.0.1", "payroll-1"),
+					)
+			},
+			expected: &entity.ClosedAttendancePeriod{
+				AttendancePeriod: entity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: date,
+					EndDate:   date,
+					CreatedAt: date,
+					UpdatedAt: date,
+					CreatedBy: "admin",
+					UpdatedBy: "admin",
+					IPAddress: "127.0.0.1",
+				},
+				PayrollID: "payroll-1",
+			},
+		},
+		{
+			name: "open",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date, endDate).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs(date, endDate).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindClosedPeriodByRange(context.Background(), date, endDate)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFindClosedPeriodByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "closed",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs("period-1").
+					WillReturnRows(
+						pgxmock.NewRows([]string{
+							"id", "start_date", "end_date", "created_at", "updated_at", "created_by", "updated_by", "ip_address", "payroll_id",
+						}).AddRow("period-1", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-1"),
+					)
+			},
+		},
+		{
+			name: "open",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs("period-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectNil: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap JOIN payrolls p").
+					WithArgs("period-1").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindClosedPeriodByID(context.Background(), "period-1")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "payroll-1", result.PayrollID)
+			}
+		})
+	}
+}
+
+func TestStoreNewAttendancePeriodReopening(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.AttendancePeriodReopening{
+		ID:        "reopening-1",
+		PeriodID:  "period-1",
+		PayrollID: "payroll-1",
+		Reason:    "Late overtime claims",
+		CreatedAt: now,
+		UpdatedAt: now,
+		CreatedBy: "admin",
+		UpdatedBy: "admin",
+		IPAddress: "127.0.0.1",
+	}
+	args := []any{
+		input.ID, input.PeriodID, input.PayrollID, input.Reason,
+		input.CreatedAt, input.UpdatedAt, input.CreatedBy, input.UpdatedBy, input.IPAddress,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_period_reopenings").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("reopening-1"))
+			},
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_period_reopenings").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_period_reopenings").
+					WithArgs(args...).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewAttendancePeriodReopening(context.Background(), input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+var attendancePeriodDetailColumns = []string{
+	"id", "start_date", "end_date", "created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	"payroll_id", "payroll_status", "reopened", "has_payroll",
+}
+
+func TestFindAttendancePeriods(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name             string
+		setupMock        func()
+		expectedStatuses []entity.AttendancePeriodStatus
+		expectErr        bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p").
+					WithArgs().
+					WillReturnRows(pgxmock.NewRows(attendancePeriodDetailColumns).
+						AddRow("period-4", now, now, now, now, "admin", "admin", "127.0.0.1", nil, nil, false, false).
+						AddRow("period-3", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-3", "draft", true, true).
+						AddRow("period-2", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-2", "approved", false, true).
+						AddRow("period-1", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-1", "paid", false, true))
+			},
+			expectedStatuses: []entity.AttendancePeriodStatus{
+				entity.AttendancePeriodStatusOpen,
+				entity.AttendancePeriodStatusOpen,
+				entity.AttendancePeriodStatusClosed,
+				entity.AttendancePeriodStatusPaid,
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p").
+					WithArgs().
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			periods, err := repo.FindAttendancePeriods(context.Background())
+
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			statuses := make([]entity.AttendancePeriodStatus, len(periods))
+			for i, period := range periods {
+				statuses[i] = period.Status()
+			}
+			assert.Equal(t, tt.expectedStatuses, statuses)
+		})
+	}
+}
+
+func TestFindAttendancePeriodDetailByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE ap.id =").
+					WithArgs("period-1").
+					WillReturnRows(pgxmock.NewRows(attendancePeriodDetailColumns).
+						AddRow("period-1", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-1", "approved", false, true))
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE ap.id =").
+					WithArgs("period-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectNil: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE ap.id =").
+					WithArgs("period-1").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAttendancePeriodDetailByID(context.Background(), "period-1")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "payroll-1", result.PayrollID.GetOrDefault())
+				assert.True(t, result.HasPayroll)
+				assert.Equal(t, entity.AttendancePeriodStatusClosed, result.Status())
+			}
+		})
+	}
+}
+
+func TestFindOverlappingPeriod(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	startDate := time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "overlapping",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods WHERE start_date <=").
+					WithArgs(startDate, endDate, "period-2").
+					WillReturnRows(
+						pgxmock.NewRows([]string{
+							"id", "start_date", "end_date", "created_at", "updated_at", "created_by", "updated_by", "ip_address",
+						}).AddRow("period-1", startDate, endDate, startDate, startDate, "admin", "admin", "127.0.0.1"),
+					)
+			},
+		},
+		{
+			name: "no overlap",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods WHERE start_date <=").
+					WithArgs(startDate, endDate, "period-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectNil: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods WHERE start_date <=").
+					WithArgs(startDate, endDate, "period-2").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindOverlappingPeriod(context.Background(), startDate, endDate, "period-2")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "period-1", result.ID)
+			}
+		})
+	}
+}
+
+func TestUpdateAttendancePeriod(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.AttendancePeriod{
+		ID:        "period-1",
+		StartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC),
+		UpdatedAt: now,
+		UpdatedBy: "admin",
+		IPAddress: "127.0.0.1",
+	}
+	args := []any{input.StartDate, input.EndDate, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.ID}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_periods SET").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("period-1"))
+			},
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_periods SET").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_periods SET").
+					WithArgs(args...).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateAttendancePeriod(context.Background(), input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteAttendancePeriod(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM attendance_periods").
+					WithArgs("period-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+		},
+		{
+			name: "exec error",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM attendance_periods").
+					WithArgs("period-1").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.DeleteAttendancePeriod(context.Background(), "period-1")
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAttendancePeriodDetailByDate(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	date := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE (.+) BETWEEN ap.start_date AND ap.end_date").
+					WithArgs(date).
+					WillReturnRows(pgxmock.NewRows(attendancePeriodDetailColumns).
+						AddRow("period-1", now, now, now, now, "admin", "admin", "127.0.0.1", "payroll-1", "paid", true, true))
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE (.+) BETWEEN ap.start_date AND ap.end_date").
+					WithArgs(date).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectNil: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_periods ap LEFT JOIN payrolls p (.+) WHERE (.+) BETWEEN ap.start_date AND ap.end_date").
+					WithArgs(date).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAttendancePeriodDetailByDate(context.Background(), date)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "period-1", result.ID)
+				assert.Equal(t, entity.AttendancePeriodStatusPaid, result.Status())
+			}
+		})
+	}
+}
+
+var attendanceCorrectionColumns = []string{
+	"id", "user_id", "username", "attendance_date", "reason", "status", "attendance_id",
+	"decided_by", "decided_at", "decision_comment",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func TestStoreNewAttendanceCorrection(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.AttendanceCorrection{
+		ID:             "correction-1",
+		UserID:         "user-1",
+		AttendanceDate: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		Reason:         "Forgot to submit",
+		Status:         entity.AttendanceCorrectionStatusPending,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		CreatedBy:      "user-1",
+		UpdatedBy:      "user-1",
+		IPAddress:      "127.0.0.1",
+	}
+	args := []any{
+		input.ID, input.UserID, input.AttendanceDate, input.Reason, input.Status,
+		input.CreatedAt, input.UpdatedAt, input.CreatedBy, input.UpdatedBy, input.IPAddress,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_corrections").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("correction-1"))
+			},
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_corrections").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO attendance_corrections").
+					WithArgs(args...).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewAttendanceCorrection(context.Background(), input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindAttendanceCorrectionByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	date := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		opts      []entity.FindAttendanceCorrectionOptions
+		expected  *entity.AttendanceCorrection
+		expectErr bool
+	}{
+		{
+			name: "success - locked",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_corrections ac (.+) FOR UPDATE OF ac").
+					WithArgs("correction-1").
+					WillReturnRows(pgxmock.NewRows(attendanceCorrectionColumns).
+						AddRow("correction-1", "user-1", "employee", date, "Forgot to submit", entity.AttendanceCorrectionStatusPending,
+							nil, nil, nil, nil, now, now, "user-1", "user-1", "127.0.0.1"))
+			},
+			opts: []entity.FindAttendanceCorrectionOptions{{PessimisticLock: true}},
+			expected: &entity.AttendanceCorrection{
+				ID:             "correction-1",
+				UserID:         "user-1",
+				Username:       "employee",
+				AttendanceDate: date,
+				Reason:         "Forgot to submit",
+				Status:         entity.AttendanceCorrectionStatusPending,
+				CreatedAt:      now,
+				UpdatedAt:      now,
+				CreatedBy:      "user-1",
+				UpdatedBy:      "user-1",
+				IPAddress:      "127.0.0.1",
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_corrections ac").
+					WithArgs("correction-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_corrections ac").
+					WithArgs("correction-1").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindAttendanceCorrectionByID(context.Background(), "correction-1", tt.opts...)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFindPendingAttendanceCorrections(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	date := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		managerID   string
+		setupMock   func()
+		expectedLen int
+		expectErr   bool
+	}{
+		{
+			name:      "success - direct reports of a manager",
+			managerID: "manager-1",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_corrections ac (.+) WHERE ac.status = 'pending'").
+					WithArgs("manager-1").
+					WillReturnRows(pgxmock.NewRows(attendanceCorrectionColumns).
+						AddRow("correction-1", "user-1", "employee", date, "Forgot to submit", entity.AttendanceCorrectionStatusPending,
+							nil, nil, nil, nil, now, now, "user-1", "user-1", "127.0.0.1"))
+			},
+			expectedLen: 1,
+		},
+		{
+			name:      "query error",
+			managerID: "",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM attendance_corrections ac (.+) WHERE ac.status = 'pending'").
+					WithArgs("").
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindPendingAttendanceCorrections(context.Background(), tt.managerID)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestUpdateAttendanceCorrectionStatus(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewAttendanceRepository(mock)
+	now := time.Now()
+	input := entity.AttendanceCorrection{
+		ID:              "correction-1",
+		Status:          entity.AttendanceCorrectionStatusApproved,
+		AttendanceID:    optional.NewString("attendance-1"),
+		DecidedBy:       optional.NewString("manager-1"),
+		DecidedAt:       optional.NewTime(now),
+		DecisionComment: optional.NewString("Confirmed"),
+		UpdatedAt:       now,
+		UpdatedBy:       "manager-1",
+		IPAddress:       "127.0.0.1",
+	}
+	args := []any{
+		input.Status, input.AttendanceID, input.DecidedBy, input.DecidedAt, input.DecisionComment,
+		input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.ID,
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_corrections").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("correction-1"))
+			},
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_corrections").
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE attendance_corrections").
+					WithArgs(args...).
+					WillReturnError(errors.New("db fail"))
+			},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateAttendanceCorrectionStatus(context.Background(), input)
+
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -52,6 +52,90 @@ ON CONFLICT (user_id, attendance_date) DO UPDATE SET
 RETURNING id
 `
 
+const clockInAttendanceQuery = `
+INSERT INTO attendances (
+	id,
+	user_id,
+	attendance_date,
+	clock_in_at,
+	late_by,
+	created_at,
+	updated_at,
+	updated_by,
+	created_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:attendance_date,
+	:clock_in_at,
+	:late_by,
+	:created_at,
+	:updated_at,
+	:updated_by,
+	:created_by,
+	:ip_address
+)
+ON CONFLICT (user_id, attendance_date) DO UPDATE SET
+	clock_in_at = EXCLUDED.clock_in_at,
+	late_by = EXCLUDED.late_by,
+	updated_at = EXCLUDED.updated_at,
+	updated_by = EXCLUDED.updated_by,
+	ip_address = EXCLUDED.ip_address
+WHERE attendances.clock_in_at IS NULL
+RETURNING id
+`
+
+const clockOutAttendanceQuery = `
+UPDATE attendances SET
+	clock_out_at = :clock_out_at,
+	early_leave_by = :early_leave_by,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :id AND clock_in_at IS NOT NULL AND clock_out_at IS NULL
+RETURNING id
+`
+
+const findAttendanceByUserIDDateQuery = `
+SELECT
+	id,
+	user_id,
+	attendance_date,
+	clock_in_at,
+	clock_out_at,
+	late_by,
+	early_leave_by,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM attendances
+WHERE user_id = $1 AND attendance_date = $2::DATE
+`
+
+const findOpenAttendanceByUserIDQuery = `
+SELECT
+	id,
+	user_id,
+	attendance_date,
+	clock_in_at,
+	clock_out_at,
+	late_by,
+	early_leave_by,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM attendances
+WHERE user_id = $1 AND clock_in_at >= $2 AND clock_out_at IS NULL
+ORDER BY clock_in_at DESC
+LIMIT 1
+`
+
 const insertAttendancePeriodQuery = `
 INSERT INTO attendance_periods (
 	id,
@@ -95,6 +179,10 @@ SELECT
 	id,
 	user_id,
 	attendance_date,
+	clock_in_at,
+	clock_out_at,
+	late_by,
+	early_leave_by,
 	created_at,
 	updated_at,
 	created_by,
@@ -105,6 +193,23 @@ WHERE attendance_date BETWEEN $1::DATE AND $2::DATE
 ORDER BY attendance_date
 `
 
+const findAttendancesQuery = `
+SELECT
+	id,
+	user_id,
+	attendance_date,
+	clock_in_at,
+	clock_out_at,
+	late_by,
+	early_leave_by,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM attendances
+`
+
 const findAttendancePeriodByPayrollIDQuery = `
 SELECT
 	ad.id,
@@ -119,3 +224,240 @@ FROM attendance_periods ad
 JOIN payrolls p ON ad.id = p.period_id
 WHERE p.id = $1
 `
+
+// A period is closed by its active payroll until it is reopened, a paid
+// payroll keeps it closed regardless of reopenings.
+const findClosedPeriodByDateQuery = `
+SELECT
+	ap.id,
+	ap.start_date,
+	ap.end_date,
+	ap.created_at,
+	ap.updated_at,
+	ap.created_by,
+	ap.updated_by,
+	ap.ip_address,
+	p.id AS payroll_id
+FROM attendance_periods ap
+JOIN payrolls p ON ap.id = p.period_id AND p.voided_at IS NULL
+WHERE $1::DATE BETWEEN ap.start_date AND ap.end_date
+AND (p.status = 'paid' OR NOT EXISTS (
+	SELECT 1 FROM attendance_period_reopenings apr WHERE apr.payroll_id = p.id
+))
+ORDER BY ap.start_date
+LIMIT 1
+`
+
+const findClosedPeriodByRangeQuery = `
+SELECT
+	ap.id,
+	ap.start_date,
+	ap.end_date,
+	ap.created_at,
+	ap.updated_at,
+	ap.created_by,
+	ap.updated_by,
+	ap.ip_address,
+	p.id AS payroll_id
+FROM attendance_periods ap
+JOIN payrolls p ON ap.id = p.period_id AND p.voided_at IS NULL
+WHERE ap.start_date <= $2::DATE AND ap.end_date >= $1::DATE
+AND (p.status = 'paid' OR NOT EXISTS (
+	SELECT 1 FROM attendance_period_reopenings apr WHERE apr.payroll_id = p.id
+))
+ORDER BY ap.start_date
+LIMIT 1
+`
+
+const findClosedPeriodByIDQuery = `
+SELECT
+	ap.id,
+	ap.start_date,
+	ap.end_date,
+	ap.created_at,
+	ap.updated_at,
+	ap.created_by,
+	ap.updated_by,
+	ap.ip_address,
+	p.id AS payroll_id
+FROM attendance_periods ap
+JOIN payrolls p ON ap.id = p.period_id AND p.voided_at IS NULL
+WHERE ap.id = $1
+AND (p.status = 'paid' OR NOT EXISTS (
+	SELECT 1 FROM attendance_period_reopenings apr WHERE apr.payroll_id = p.id
+))
+`
+
+const insertAttendancePeriodReopeningQuery = `
+INSERT INTO attendance_period_reopenings (
+	id,
+	period_id,
+	payroll_id,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:period_id,
+	:payroll_id,
+	:reason,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findAttendancePeriodDetailsQuery = `
+SELECT
+	ap.id,
+	ap.start_date,
+	ap.end_date,
+	ap.created_at,
+	ap.updated_at,
+	ap.created_by,
+	ap.updated_by,
+	ap.ip_address,
+	p.id AS payroll_id,
+	p.status AS payroll_status,
+	apr.id IS NOT NULL AS reopened,
+	EXISTS (SELECT 1 FROM payrolls hp WHERE hp.period_id = ap.id) AS has_payroll
+FROM attendance_periods ap
+LEFT JOIN payrolls p ON ap.id = p.period_id AND p.voided_at IS NULL
+LEFT JOIN attendance_period_reopenings apr ON apr.payroll_id = p.id
+`
+
+const findAttendancePeriodsQuery = findAttendancePeriodDetailsQuery + `
+ORDER BY ap.start_date DESC
+`
+
+const findAttendancePeriodDetailByIDQuery = findAttendancePeriodDetailsQuery + `
+WHERE ap.id = $1
+`
+
+const findAttendancePeriodDetailByDateQuery = findAttendancePeriodDetailsQuery + `
+WHERE $1::DATE BETWEEN ap.start_date AND ap.end_date
+ORDER BY ap.start_date
+LIMIT 1
+`
+
+const findOverlappingPeriodQuery = `
+SELECT
+	id,
+	start_date,
+	end_date,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM attendance_periods
+WHERE start_date <= $2::DATE
+AND end_date >= $1::DATE
+AND id::TEXT <> $3
+ORDER BY start_date
+LIMIT 1
+`
+
+const updateAttendancePeriodQuery = `
+UPDATE attendance_periods SET
+	start_date = :start_date,
+	end_date = :end_date,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :id
+RETURNING id
+`
+
+const deleteAttendancePeriodQuery = `
+DELETE FROM attendance_periods
+WHERE id = $1
+`
+
+const insertAttendanceCorrectionQuery = `
+INSERT INTO attendance_corrections (
+	id,
+	user_id,
+	attendance_date,
+	reason,
+	status,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:attendance_date,
+	:reason,
+	:status,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const selectAttendanceCorrectionColumns = `
+SELECT
+	ac.id,
+	ac.user_id,
+	u.username,
+	ac.attendance_date,
+	ac.reason,
+	ac.status,
+	ac.attendance_id,
+	ac.decided_by,
+	ac.decided_at,
+	ac.decision_comment,
+	ac.created_at,
+	ac.updated_at,
+	ac.created_by,
+	ac.updated_by,
+	ac.ip_address
+FROM attendance_corrections ac
+JOIN users u ON u.id = ac.user_id
+`
+
+const findAttendanceCorrectionByIDQuery = selectAttendanceCorrectionColumns + `WHERE ac.id = $1`
+
+const findAttendanceCorrectionsByUserIDQuery = selectAttendanceCorrectionColumns + `WHERE ac.user_id = $1
+ORDER BY ac.attendance_date DESC, ac.created_at DESC
+`
+
+const findPendingAttendanceCorrectionByUserIDDateQuery = selectAttendanceCorrectionColumns + `WHERE ac.user_id = $1
+AND ac.attendance_date = $2::DATE
+AND ac.status = 'pending'
+`
+
+// An empty manager lists the pending corrections of everyone.
+const findPendingAttendanceCorrectionsQuery = selectAttendanceCorrectionColumns + `WHERE ac.status = 'pending'
+AND ($1 = '' OR u.manager_id::TEXT = $1)
+ORDER BY ac.attendance_date ASC, ac.created_at ASC
+`
+
+const updateAttendanceCorrectionStatusQuery = `
+UPDATE attendance_corrections
+SET
+	status = :status,
+	attendance_id = :attendance_id,
+	decided_by = :decided_by,
+	decided_at = :decided_at,
+	decision_comment = :decision_comment,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :id
+RETURNING id
+`
@@ -2,12 +2,30 @@ package attendance
 
 import (
 	"context"
+	"time"
 
 	"github.com/vnnyx/employee-management/internal/attendance/entity"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
 type UseCase interface {
 	SubmitAttendance(ctx context.Context, authCredential authCredential.Credential) error
+	ClockIn(ctx context.Context, authCredential authCredential.Credential) (entity.Attendance, error)
+	ClockOut(ctx context.Context, authCredential authCredential.Credential) (entity.Attendance, error)
 	CreateAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, payload entity.CreateAttendancePeriod) (string, error)
+	ListAttendancePeriods(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendancePeriodDetail, error)
+	ShowAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.AttendancePeriodDetail, error)
+	UpdateAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string, payload entity.UpdateAttendancePeriod) (entity.AttendancePeriodDetail, error)
+	DeleteAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string) error
+	ReopenAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID, reason string) (entity.AttendancePeriodReopening, error)
+	RequestAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, payload entity.RequestAttendanceCorrection) (entity.AttendanceCorrection, error)
+	ListMyAttendanceCorrections(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendanceCorrection, error)
+	ListPendingAttendanceCorrections(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendanceCorrection, error)
+	ApproveAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)
+	RejectAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error)
+	ListMyAttendance(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.Attendance, error)
+	ListAttendances(ctx context.Context, authCredential authCredential.Credential, filter entity.ListAttendanceFilter, resource *resourceful.Resource[string, dtos.AttendanceResponse]) (*resourceful.Resource[string, dtos.AttendanceResponse], error)
+	ShowAttendanceCalendar(ctx context.Context, authCredential authCredential.Credential, month time.Time) (entity.AttendanceCalendar, error)
 }
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
@@ -10,19 +11,67 @@ import (
 	"github.com/vnnyx/employee-management/internal/attendance"
 	"github.com/vnnyx/employee-management/internal/attendance/entity"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/internal/leave"
+	leaveEntity "github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
+	"github.com/vnnyx/employee-management/pkg/timezone"
 )
 
+// openAttendanceWindow is how long after clocking in a clock out is still
+// matched to it, an older clock in without a clock out stays open.
+const openAttendanceWindow = 24 * time.Hour
+
 type attendanceUseCase struct {
 	attendanceRepo attendance.Repository
+	holidayRepo    holiday.Repository
+	userRepo       users.Repository
+	leaveRepo      leave.Repository
+	schedule       entity.WorkSchedule
+}
+
+type AttendanceConfig struct {
+	// Schedule is the working day lateness and early leave are measured
+	// against.
+	Schedule entity.WorkSchedule
 }
 
-func NewAttendanceUseCase(attendanceRepo attendance.Repository) attendance.UseCase {
+func NewAttendanceUseCase(attendanceRepo attendance.Repository, holidayRepo holiday.Repository, userRepo users.Repository, leaveRepo leave.Repository, attendanceConfig AttendanceConfig) attendance.UseCase {
 	return &attendanceUseCase{
 		attendanceRepo: attendanceRepo,
+		holidayRepo:    holidayRepo,
+		userRepo:       userRepo,
+		leaveRepo:      leaveRepo,
+		schedule:       attendanceConfig.Schedule,
+	}
+}
+
+// localNow is the current time in the timezone the user works in, the day it
+// falls on is the day attendance is recorded for.
+func (u *attendanceUseCase) localNow(ctx context.Context, userID string) (time.Time, error) {
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "AttendanceUseCase.localNow().FindUserByID()")
+	}
+	if user == nil {
+		return time.Time{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendanceUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceUserNotFound),
+				Received:  userID,
+			},
+		)
 	}
+
+	return time.Now().In(user.Location()), nil
 }
 
 func (u *attendanceUseCase) SubmitAttendance(ctx context.Context, authCredential authCredential.Credential) error {
@@ -32,10 +81,19 @@ func (u *attendanceUseCase) SubmitAttendance(ctx context.Context, authCredential
 	)
 	defer span.End()
 
-	timeNow := time.Now()
+	timeNow, err := u.localNow(ctx, authCredential.UserID)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceUseCase.SubmitAttendance().localNow()")
+	}
+	today := timezone.Date(timeNow)
+
+	holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, today, today)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceUseCase.SubmitAttendance().FindHolidaysByRange()")
+	}
 
-	// Validate if current date is not weekend
-	if timeNow.Weekday() == time.Saturday || timeNow.Weekday() == time.Sunday {
+	// Validate if current date is a working day
+	if !holidayEntity.NewCalendar(holidays).IsWorkingDay(today) {
 		return apperror.BadRequest(
 			apperror.AppError{
 				IssueCode: entity.AttendanceInvalidDay,
@@ -44,13 +102,27 @@ func (u *attendanceUseCase) SubmitAttendance(ctx context.Context, authCredential
 		)
 	}
 
-	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
 		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
 
-		err := attendanceRepoTx.UpsertAttendance(ctx, entity.Attendance{
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByDate(ctx, today)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.SubmitAttendance().FindClosedPeriodByDate()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
+
+		err = attendanceRepoTx.UpsertAttendance(ctx, entity.Attendance{
 			ID:             uuid.NewString(),
 			UserID:         authCredential.UserID,
-			AttendanceDate: timeNow,
+			AttendanceDate: today,
 			UpdatedAt:      timeNow,
 			CreatedAt:      timeNow,
 			IPAddress:      authCredential.IPAddress,
@@ -70,6 +142,168 @@ func (u *attendanceUseCase) SubmitAttendance(ctx context.Context, authCredential
 	return nil
 }
 
+// ClockIn records the arrival of the logged in employee for today. A presence
+// already submitted for the day is kept and given the clock in time.
+func (u *attendanceUseCase) ClockIn(ctx context.Context, authCredential authCredential.Credential) (entity.Attendance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ClockIn()",
+	)
+	defer span.End()
+
+	timeNow, err := u.localNow(ctx, authCredential.UserID)
+	if err != nil {
+		return entity.Attendance{}, errors.Wrap(err, "AttendanceUseCase.ClockIn().localNow()")
+	}
+	today := timezone.Date(timeNow)
+
+	holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, today, today)
+	if err != nil {
+		return entity.Attendance{}, errors.Wrap(err, "AttendanceUseCase.ClockIn().FindHolidaysByRange()")
+	}
+
+	if !holidayEntity.NewCalendar(holidays).IsWorkingDay(today) {
+		return entity.Attendance{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidDay,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
+			},
+		)
+	}
+
+	clockedIn := entity.Attendance{
+		ID:             uuid.NewString(),
+		UserID:         authCredential.UserID,
+		AttendanceDate: today,
+		ClockInAt:      optional.NewTime(timeNow),
+		LateBy:         optional.NewDuration(u.schedule.LateBy(timeNow)),
+		CreatedAt:      timeNow,
+		UpdatedAt:      timeNow,
+		CreatedBy:      authCredential.UserID,
+		UpdatedBy:      authCredential.UserID,
+		IPAddress:      authCredential.IPAddress,
+	}
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByDate(ctx, today)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockIn().FindClosedPeriodByDate()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
+
+		existing, err := attendanceRepoTx.FindAttendanceByUserIDDate(ctx, authCredential.UserID, today, entity.FindAttendanceOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockIn().FindAttendanceByUserIDDate()")
+		}
+		if existing != nil {
+			if existing.HasClockEvents() {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendanceAlreadyClockedIn,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyClockedIn),
+						Received:  existing.ID,
+					},
+				)
+			}
+			clockedIn.ID = existing.ID
+			clockedIn.CreatedAt = existing.CreatedAt
+			clockedIn.CreatedBy = existing.CreatedBy
+		}
+
+		err = attendanceRepoTx.StoreAttendanceClockIn(ctx, clockedIn)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockIn().StoreAttendanceClockIn()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Attendance{}, errors.Wrap(err, "AttendanceUseCase.ClockIn().WithAuditContext()")
+	}
+
+	return clockedIn, nil
+}
+
+// ClockOut closes the latest clock in of the logged in employee, a shift
+// running past midnight is kept on the day it started.
+func (u *attendanceUseCase) ClockOut(ctx context.Context, authCredential authCredential.Credential) (entity.Attendance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ClockOut()",
+	)
+	defer span.End()
+
+	timeNow, err := u.localNow(ctx, authCredential.UserID)
+	if err != nil {
+		return entity.Attendance{}, errors.Wrap(err, "AttendanceUseCase.ClockOut().localNow()")
+	}
+
+	var clockedOut entity.Attendance
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		open, err := attendanceRepoTx.FindOpenAttendanceByUserID(ctx, authCredential.UserID, timeNow.Add(-openAttendanceWindow), entity.FindAttendanceOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockOut().FindOpenAttendanceByUserID()")
+		}
+		if open == nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotClockedIn,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotClockedIn),
+				},
+			)
+		}
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByDate(ctx, open.AttendanceDate)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockOut().FindClosedPeriodByDate()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
+
+		clockedOut = *open
+		clockedOut.ClockOutAt = optional.NewTime(timeNow)
+		clockedOut.EarlyLeaveBy = optional.NewDuration(u.schedule.EarlyLeaveBy(open.ClockInAt.MustGet().In(timeNow.Location()), timeNow))
+		clockedOut.UpdatedAt = timeNow
+		clockedOut.UpdatedBy = authCredential.UserID
+		clockedOut.IPAddress = authCredential.IPAddress
+
+		err = attendanceRepoTx.UpdateAttendanceClockOut(ctx, clockedOut)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ClockOut().UpdateAttendanceClockOut()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Attendance{}, errors.Wrap(err, "AttendanceUseCase.ClockOut().WithAuditContext()")
+	}
+
+	return clockedOut, nil
+}
+
 func (u *attendanceUseCase) CreateAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, payload entity.CreateAttendancePeriod) (string, error) {
 	ctx, span := instrumentation.NewTraceSpan(
 		ctx,
@@ -100,7 +334,21 @@ func (u *attendanceUseCase) CreateAttendancePeriod(ctx context.Context, authCred
 	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
 		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
 
-		err := attendanceRepoTx.StoreNewAttendancePeriod(ctx, entity.AttendancePeriod{
+		overlapping, err := attendanceRepoTx.FindOverlappingPeriod(ctx, payload.StartDate, payload.EndDate, "")
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.CreateAttendancePeriod().FindOverlappingPeriod()")
+		}
+		if overlapping != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+					Received:  overlapping.ID,
+				},
+			)
+		}
+
+		err = attendanceRepoTx.StoreNewAttendancePeriod(ctx, entity.AttendancePeriod{
 			ID:        uuidString,
 			StartDate: payload.StartDate,
 			EndDate:   payload.EndDate,
@@ -119,6 +367,15 @@ func (u *attendanceUseCase) CreateAttendancePeriod(ctx context.Context, authCred
 					},
 				)
 			}
+			// A concurrent request stored an overlapping period after the check
+			if database.IsExclusionViolation(err, "attendance_periods_no_overlap") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendancePeriodOverlaps,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+					},
+				)
+			}
 			return errors.Wrap(err, "AttendanceUseCase.CreateAttendancePeriod().StoreNewAttendancePeriod()")
 		}
 
@@ -130,3 +387,926 @@ func (u *attendanceUseCase) CreateAttendancePeriod(ctx context.Context, authCred
 
 	return uuidString, nil
 }
+
+func (u *attendanceUseCase) ListAttendancePeriods(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ListAttendancePeriods()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	periods, err := u.attendanceRepo.FindAttendancePeriods(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListAttendancePeriods().FindAttendancePeriods()")
+	}
+
+	return periods, nil
+}
+
+func (u *attendanceUseCase) ShowAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ShowAttendancePeriod()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.AttendancePeriodDetail{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	period, err := u.attendanceRepo.FindAttendancePeriodDetailByID(ctx, periodID)
+	if err != nil {
+		return entity.AttendancePeriodDetail{}, errors.Wrap(err, "AttendanceUseCase.ShowAttendancePeriod().FindAttendancePeriodDetailByID()")
+	}
+	if period == nil {
+		return entity.AttendancePeriodDetail{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				Received:  periodID,
+			},
+		)
+	}
+
+	return *period, nil
+}
+
+// UpdateAttendancePeriod moves the dates of a period, only while no payroll
+// has been generated for it since payslips keep referring to the old range.
+func (u *attendanceUseCase) UpdateAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string, payload entity.UpdateAttendancePeriod) (entity.AttendancePeriodDetail, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.UpdateAttendancePeriod()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.AttendancePeriodDetail{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	if payload.StartDate.After(payload.EndDate) {
+		return entity.AttendancePeriodDetail{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidPeriod,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidPeriod),
+			},
+		)
+	}
+
+	var updatedPeriod entity.AttendancePeriodDetail
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		existingPeriod, err := attendanceRepoTx.FindAttendancePeriodDetailByID(ctx, periodID)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.UpdateAttendancePeriod().FindAttendancePeriodDetailByID()")
+		}
+		if existingPeriod == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  periodID,
+				},
+			)
+		}
+		if existingPeriod.HasPayroll {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodHasPayroll,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodHasPayroll),
+					Received:  periodID,
+				},
+			)
+		}
+
+		overlapping, err := attendanceRepoTx.FindOverlappingPeriod(ctx, payload.StartDate, payload.EndDate, periodID)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.UpdateAttendancePeriod().FindOverlappingPeriod()")
+		}
+		if overlapping != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+					Received:  overlapping.ID,
+				},
+			)
+		}
+
+		updatedPeriod = *existingPeriod
+		updatedPeriod.StartDate = payload.StartDate
+		updatedPeriod.EndDate = payload.EndDate
+		updatedPeriod.UpdatedAt = time.Now()
+		updatedPeriod.UpdatedBy = authCredential.UserID
+		updatedPeriod.IPAddress = authCredential.IPAddress
+
+		err = attendanceRepoTx.UpdateAttendancePeriod(ctx, updatedPeriod.AttendancePeriod)
+		if err != nil {
+			if database.IsUniqueViolation(err, "attendance_periods_start_date_end_date_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendancePeriodAlreadyExists,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodAlreadyExists),
+					},
+				)
+			}
+			// A concurrent request stored an overlapping period after the check
+			if database.IsExclusionViolation(err, "attendance_periods_no_overlap") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendancePeriodOverlaps,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+					},
+				)
+			}
+			return errors.Wrap(err, "AttendanceUseCase.UpdateAttendancePeriod().UpdateAttendancePeriod()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.AttendancePeriodDetail{}, errors.Wrap(err, "AttendanceUseCase.UpdateAttendancePeriod().WithAuditContext()")
+	}
+
+	return updatedPeriod, nil
+}
+
+func (u *attendanceUseCase) DeleteAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.DeleteAttendancePeriod()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		existingPeriod, err := attendanceRepoTx.FindAttendancePeriodDetailByID(ctx, periodID)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.DeleteAttendancePeriod().FindAttendancePeriodDetailByID()")
+		}
+		if existingPeriod == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  periodID,
+				},
+			)
+		}
+		if existingPeriod.HasPayroll {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodHasPayroll,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodHasPayroll),
+					Received:  periodID,
+				},
+			)
+		}
+
+		err = attendanceRepoTx.DeleteAttendancePeriod(ctx, periodID)
+		if err != nil {
+			// Queued payroll jobs and one-off adjustments point at the period
+			if database.IsForeignKeyViolation(err, "") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendancePeriodInUse,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodInUse),
+						Received:  periodID,
+					},
+				)
+			}
+			return errors.Wrap(err, "AttendanceUseCase.DeleteAttendancePeriod().DeleteAttendancePeriod()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "AttendanceUseCase.DeleteAttendancePeriod().WithAuditContext()")
+	}
+
+	return nil
+}
+
+// ReopenAttendancePeriod unlocks a period closed by its payroll so late
+// attendance, overtime and reimbursements can be recorded before the payroll
+// is regenerated.
+func (u *attendanceUseCase) ReopenAttendancePeriod(ctx context.Context, authCredential authCredential.Credential, periodID, reason string) (entity.AttendancePeriodReopening, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ReopenAttendancePeriod()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.AttendancePeriodReopening{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	reopening := entity.AttendancePeriodReopening{
+		ID:        uuid.NewString(),
+		PeriodID:  periodID,
+		Reason:    reason,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+		CreatedBy: authCredential.UserID,
+		UpdatedBy: authCredential.UserID,
+		IPAddress: authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		period, err := attendanceRepoTx.FindAttendancePeriodDetailByID(ctx, periodID)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ReopenAttendancePeriod().FindAttendancePeriodDetailByID()")
+		}
+		if period == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  periodID,
+				},
+			)
+		}
+
+		// A paid payroll cannot be regenerated, its period stays locked
+		if period.Status() == entity.AttendancePeriodStatusPaid {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodReopenPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodReopenPaid),
+					Received:  periodID,
+				},
+			)
+		}
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByID(ctx, periodID)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.ReopenAttendancePeriod().FindClosedPeriodByID()")
+		}
+		if closedPeriod == nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotClosed),
+					Received:  periodID,
+				},
+			)
+		}
+		reopening.PayrollID = closedPeriod.PayrollID
+
+		err = attendanceRepoTx.StoreNewAttendancePeriodReopening(ctx, reopening)
+		if err != nil {
+			if database.IsUniqueViolation(err, "attendance_period_reopenings_payroll_id_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendancePeriodNotClosed,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotClosed),
+						Received:  periodID,
+					},
+				)
+			}
+			return errors.Wrap(err, "AttendanceUseCase.ReopenAttendancePeriod().StoreNewAttendancePeriodReopening()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.AttendancePeriodReopening{}, errors.Wrap(err, "AttendanceUseCase.ReopenAttendancePeriod().WithAuditContext()")
+	}
+
+	return reopening, nil
+}
+
+// RequestAttendanceCorrection asks to record attendance for a past working
+// day the logged in employee did not submit, an admin or their manager
+// decides on it.
+func (u *attendanceUseCase) RequestAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, payload entity.RequestAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.RequestAttendanceCorrection()",
+	)
+	defer span.End()
+
+	timeNow, err := u.localNow(ctx, authCredential.UserID)
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().localNow()")
+	}
+
+	attendanceDate := timezone.Date(payload.AttendanceDate)
+	if !attendanceDate.Before(timezone.Date(timeNow)) {
+		return entity.AttendanceCorrection{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceCorrectionNotPast,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionNotPast),
+				Received:  attendanceDate.Format(time.DateOnly),
+			},
+		)
+	}
+
+	holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, attendanceDate, attendanceDate)
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().FindHolidaysByRange()")
+	}
+
+	if !holidayEntity.NewCalendar(holidays).IsWorkingDay(attendanceDate) {
+		return entity.AttendanceCorrection{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidDay,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
+			},
+		)
+	}
+
+	correction := entity.AttendanceCorrection{
+		ID:             uuid.NewString(),
+		UserID:         authCredential.UserID,
+		Username:       authCredential.Username,
+		AttendanceDate: attendanceDate,
+		Reason:         payload.Reason,
+		Status:         entity.AttendanceCorrectionStatusPending,
+		CreatedAt:      timeNow,
+		UpdatedAt:      timeNow,
+		CreatedBy:      authCredential.UserID,
+		UpdatedBy:      authCredential.UserID,
+		IPAddress:      authCredential.IPAddress,
+	}
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		err := checkCorrectionPeriod(ctx, attendanceRepoTx, attendanceDate)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().checkCorrectionPeriod()")
+		}
+
+		existing, err := attendanceRepoTx.FindAttendanceByUserIDDate(ctx, authCredential.UserID, attendanceDate)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().FindAttendanceByUserIDDate()")
+		}
+		if existing != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceAlreadyRecorded,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyRecorded),
+					Received:  attendanceDate.Format(time.DateOnly),
+				},
+			)
+		}
+
+		pending, err := attendanceRepoTx.FindPendingAttendanceCorrectionByUserIDDate(ctx, authCredential.UserID, attendanceDate)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().FindPendingAttendanceCorrectionByUserIDDate()")
+		}
+		if pending != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionExists),
+					Received:  pending.ID,
+				},
+			)
+		}
+
+		err = attendanceRepoTx.StoreNewAttendanceCorrection(ctx, correction)
+		if err != nil {
+			if database.IsUniqueViolation(err, "idx_attendance_corrections_pending") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendanceCorrectionExists,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionExists),
+						Received:  attendanceDate.Format(time.DateOnly),
+					},
+				)
+			}
+			return errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().StoreNewAttendanceCorrection()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.RequestAttendanceCorrection().WithAuditContext()")
+	}
+
+	return correction, nil
+}
+
+func (u *attendanceUseCase) ListMyAttendanceCorrections(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ListMyAttendanceCorrections()",
+	)
+	defer span.End()
+
+	corrections, err := u.attendanceRepo.FindAttendanceCorrectionsByUserID(ctx, authCredential.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListMyAttendanceCorrections().FindAttendanceCorrectionsByUserID()")
+	}
+
+	return corrections, nil
+}
+
+// ListPendingAttendanceCorrections lists the corrections waiting for a
+// decision of the caller, admins decide on everyone and managers on their
+// direct reports.
+func (u *attendanceUseCase) ListPendingAttendanceCorrections(ctx context.Context, authCredential authCredential.Credential) ([]entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ListPendingAttendanceCorrections()",
+	)
+	defer span.End()
+
+	managerID := authCredential.UserID
+	if *authCredential.IsAdmin {
+		managerID = ""
+	}
+
+	corrections, err := u.attendanceRepo.FindPendingAttendanceCorrections(ctx, managerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListPendingAttendanceCorrections().FindPendingAttendanceCorrections()")
+	}
+
+	return corrections, nil
+}
+
+func (u *attendanceUseCase) ApproveAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ApproveAttendanceCorrection()",
+	)
+	defer span.End()
+
+	correction, err := u.decideAttendanceCorrection(ctx, authCredential, correctionID, entity.AttendanceCorrectionStatusApproved, payload)
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.ApproveAttendanceCorrection().decideAttendanceCorrection()")
+	}
+
+	return correction, nil
+}
+
+func (u *attendanceUseCase) RejectAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, correctionID string, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.RejectAttendanceCorrection()",
+	)
+	defer span.End()
+
+	correction, err := u.decideAttendanceCorrection(ctx, authCredential, correctionID, entity.AttendanceCorrectionStatusRejected, payload)
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.RejectAttendanceCorrection().decideAttendanceCorrection()")
+	}
+
+	return correction, nil
+}
+
+// decideAttendanceCorrection lets an admin or the manager of the requester
+// decide on a pending correction. Approving inserts the attendance of the
+// day in the same transaction, so it is audited as the approver.
+func (u *attendanceUseCase) decideAttendanceCorrection(ctx context.Context, authCredential authCredential.Credential, correctionID string, status entity.AttendanceCorrectionStatus, payload entity.DecideAttendanceCorrection) (entity.AttendanceCorrection, error) {
+	var decided entity.AttendanceCorrection
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		correction, err := attendanceRepoTx.FindAttendanceCorrectionByID(ctx, correctionID, entity.FindAttendanceCorrectionOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().FindAttendanceCorrectionByID()")
+		}
+		if correction == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionNotFound),
+					Received:  correctionID,
+				},
+			)
+		}
+
+		if correction.UserID == authCredential.UserID {
+			return apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionSelf,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionSelf),
+					Received:  correctionID,
+				},
+			)
+		}
+
+		if !*authCredential.IsAdmin {
+			requester, err := userRepoTx.FindUserByID(ctx, correction.UserID)
+			if err != nil {
+				return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().FindUserByID()")
+			}
+			if requester == nil || requester.ManagerID.GetOrDefault() != authCredential.UserID {
+				return apperror.Forbidden(
+					apperror.AppError{
+						IssueCode: entity.AttendanceNotAuthorized,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+					},
+				)
+			}
+		}
+
+		if correction.Status != entity.AttendanceCorrectionStatusPending {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionDecided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionDecided),
+					Received:  string(correction.Status),
+				},
+			)
+		}
+
+		timeNow := time.Now()
+		decided = *correction
+
+		if status == entity.AttendanceCorrectionStatusApproved {
+			err = checkCorrectionPeriod(ctx, attendanceRepoTx, correction.AttendanceDate)
+			if err != nil {
+				return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().checkCorrectionPeriod()")
+			}
+
+			existing, err := attendanceRepoTx.FindAttendanceByUserIDDate(ctx, correction.UserID, correction.AttendanceDate, entity.FindAttendanceOptions{
+				PessimisticLock: true,
+			})
+			if err != nil {
+				return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().FindAttendanceByUserIDDate()")
+			}
+			if existing != nil {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.AttendanceAlreadyRecorded,
+						Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyRecorded),
+						Received:  correction.AttendanceDate.Format(time.DateOnly),
+					},
+				)
+			}
+
+			attendance := entity.Attendance{
+				ID:             uuid.NewString(),
+				UserID:         correction.UserID,
+				AttendanceDate: correction.AttendanceDate,
+				CreatedAt:      timeNow,
+				UpdatedAt:      timeNow,
+				CreatedBy:      authCredential.UserID,
+				UpdatedBy:      authCredential.UserID,
+				IPAddress:      authCredential.IPAddress,
+			}
+			err = attendanceRepoTx.StoreNewAttendance(ctx, attendance)
+			if err != nil {
+				return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().StoreNewAttendance()")
+			}
+			decided.AttendanceID = optional.NewString(attendance.ID)
+		}
+
+		decided.Status = status
+		decided.DecidedBy = optional.NewString(authCredential.UserID)
+		decided.DecidedAt = optional.NewTime(timeNow)
+		decided.DecisionComment = payload.Comment
+		decided.UpdatedAt = timeNow
+		decided.UpdatedBy = authCredential.UserID
+		decided.IPAddress = authCredential.IPAddress
+
+		err = attendanceRepoTx.UpdateAttendanceCorrectionStatus(ctx, decided)
+		if err != nil {
+			return errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().UpdateAttendanceCorrectionStatus()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.AttendanceCorrection{}, errors.Wrap(err, "AttendanceUseCase.decideAttendanceCorrection().WithAuditContext()")
+	}
+
+	return decided, nil
+}
+
+// checkCorrectionPeriod refuses corrections dated in a period whose payroll
+// has been generated, or paid even if the period was reopened since.
+func checkCorrectionPeriod(ctx context.Context, attendanceRepo attendance.Repository, date time.Time) error {
+	period, err := attendanceRepo.FindAttendancePeriodDetailByDate(ctx, date)
+	if err != nil {
+		return errors.Wrap(err, "AttendanceUseCase.checkCorrectionPeriod().FindAttendancePeriodDetailByDate()")
+	}
+	if period == nil {
+		return nil
+	}
+
+	switch period.Status() {
+	case entity.AttendancePeriodStatusPaid:
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodPaid,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodPaid),
+				Received:  period.ID,
+			},
+		)
+	case entity.AttendancePeriodStatusClosed:
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodClosed,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+				Received:  period.ID,
+			},
+		)
+	}
+	return nil
+}
+
+// ListMyAttendance lists the attendance of the requester between startDate
+// and endDate, both included.
+func (u *attendanceUseCase) ListMyAttendance(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.Attendance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ListMyAttendance()",
+	)
+	defer span.End()
+
+	if endDate.Before(startDate) {
+		return nil, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidRange,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidRange),
+			},
+		)
+	}
+
+	result, err := u.attendanceRepo.FindAttendances(ctx, entity.FindAttendanceFilter{
+		UserID:    optional.NewString(authCredential.UserID),
+		StartDate: optional.NewTime(startDate),
+		EndDate:   optional.NewTime(endDate),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListMyAttendance().FindAttendances()")
+	}
+
+	return result.List, nil
+}
+
+// ListAttendances pages through the attendance of every user newest first,
+// the next page starts after the cursor of the metadata.
+func (u *attendanceUseCase) ListAttendances(ctx context.Context, authCredential authCredential.Credential, filter entity.ListAttendanceFilter, resource *resourceful.Resource[string, dtos.AttendanceResponse]) (*resourceful.Resource[string, dtos.AttendanceResponse], error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ListAttendances()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+
+	if cursor := resource.Parameter.Cursor; cursor != nil {
+		_, dateErr := time.Parse("2006-01-02", cursor.Key)
+		_, idErr := uuid.Parse(cursor.Value)
+		if dateErr != nil || idErr != nil {
+			return nil, apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidCursor,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidCursor),
+				},
+			)
+		}
+	}
+
+	startDate, hasStart := filter.StartDate.Get()
+	endDate, hasEnd := filter.EndDate.Get()
+	if hasStart && hasEnd && endDate.Before(startDate) {
+		return nil, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.AttendanceInvalidRange,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidRange),
+			},
+		)
+	}
+
+	if periodID, ok := filter.PeriodID.Get(); ok {
+		period, err := u.attendanceRepo.FindPeriodByID(ctx, periodID)
+		if err != nil {
+			return nil, errors.Wrap(err, "AttendanceUseCase.ListAttendances().FindPeriodByID()")
+		}
+		if period == nil {
+			return nil, apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  periodID,
+				},
+			)
+		}
+
+		// A range outside the period lists nothing
+		if !hasStart || startDate.Before(period.StartDate) {
+			filter.StartDate = optional.NewTime(period.StartDate)
+		}
+		if !hasEnd || endDate.After(period.EndDate) {
+			filter.EndDate = optional.NewTime(period.EndDate)
+		}
+	}
+
+	result, err := u.attendanceRepo.FindAttendances(ctx, entity.FindAttendanceFilter{
+		UserID:    filter.UserID,
+		StartDate: filter.StartDate,
+		EndDate:   filter.EndDate,
+	}, entity.FindAttendanceOptions{
+		ResourcefulParameter: resource.Parameter,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListAttendances().FindAttendances()")
+	}
+
+	metadata := resource.Parameter.GetAdditionalData().(entity.ListAttendanceMetadata)
+	metadata.NextCursor, err = resourceful.EncodeCursor(metadata.Cursor)
+	if err != nil {
+		return nil, errors.Wrap(err, "AttendanceUseCase.ListAttendances().EncodeCursor()")
+	}
+
+	resource.SetResult(resourceful.Result[string, dtos.AttendanceResponse]{
+		PaginationResult: dtos.NewListAttendanceResponse(result.List),
+		IDs:              metadata.IDs,
+	})
+	resource.SetMetadata(metadata)
+
+	return resource, nil
+}
+
+// ShowAttendanceCalendar shows the month of month as a user by day matrix.
+// Admins see every user employed in the month, team leads their direct
+// reports.
+func (u *attendanceUseCase) ShowAttendanceCalendar(ctx context.Context, authCredential authCredential.Credential, month time.Time) (entity.AttendanceCalendar, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"AttendanceUseCase.ShowAttendanceCalendar()",
+	)
+	defer span.End()
+
+	startDate := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 1, -1)
+
+	users, err := u.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{})
+	if err != nil {
+		return entity.AttendanceCalendar{}, errors.Wrap(err, "AttendanceUseCase.ShowAttendanceCalendar().FindAllUsers()")
+	}
+
+	var members []userEntity.User
+	hasReports := false
+	for _, user := range users.List {
+		if !*authCredential.IsAdmin && user.ManagerID.GetOrDefault() != authCredential.UserID {
+			continue
+		}
+		hasReports = true
+		if _, _, employed := user.EmployedWithin(startDate, endDate); employed {
+			members = append(members, user)
+		}
+	}
+	if !*authCredential.IsAdmin && !hasReports {
+		return entity.AttendanceCalendar{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.AttendanceNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+			},
+		)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Username < members[j].Username
+	})
+
+	holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, startDate, endDate)
+	if err != nil {
+		return entity.AttendanceCalendar{}, errors.Wrap(err, "AttendanceUseCase.ShowAttendanceCalendar().FindHolidaysByRange()")
+	}
+	calendar := holidayEntity.NewCalendar(holidays)
+
+	attendances, err := u.attendanceRepo.FindAttendanceByPeriod(ctx, startDate, endDate, entity.FindAttendanceOptions{
+		MappedOptions: &entity.MappedOptions{
+			MappedBy: entity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return entity.AttendanceCalendar{}, errors.Wrap(err, "AttendanceUseCase.ShowAttendanceCalendar().FindAttendanceByPeriod()")
+	}
+
+	leaves, err := u.leaveRepo.FindApprovedLeaveByRange(ctx, startDate, endDate, leaveEntity.FindLeaveRequestOptions{
+		MappedOptions: &leaveEntity.MappedOptions{
+			MappedBy: leaveEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return entity.AttendanceCalendar{}, errors.Wrap(err, "AttendanceUseCase.ShowAttendanceCalendar().FindApprovedLeaveByRange()")
+	}
+
+	result := entity.AttendanceCalendar{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Rows:      make([]entity.AttendanceCalendarRow, 0, len(members)),
+	}
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		result.Dates = append(result.Dates, date)
+	}
+
+	for _, user := range members {
+		employedFrom, employedTo, _ := user.EmployedWithin(startDate, endDate)
+		today := timezone.Date(time.Now().In(user.Location()))
+
+		presentDates := make(map[string]bool)
+		for _, attendance := range attendances.Mapped[user.ID] {
+			presentDates[attendance.AttendanceDate.Format("2006-01-02")] = true
+		}
+		leaveDates := make(map[string]bool)
+		for _, request := range leaves.Mapped[user.ID] {
+			for _, date := range request.WorkingDates(calendar, startDate, endDate) {
+				leaveDates[date.Format("2006-01-02")] = true
+			}
+		}
+
+		row := entity.AttendanceCalendarRow{
+			UserID:   user.ID,
+			Username: user.Username,
+			Days:     make([]entity.AttendanceCalendarStatus, 0, len(result.Dates)),
+		}
+		for _, date := range result.Dates {
+			status := calendarStatus(calendar, date, today, employedFrom, employedTo, presentDates, leaveDates)
+			switch status {
+			case entity.AttendanceCalendarStatusPresent:
+				row.PresentDays++
+			case entity.AttendanceCalendarStatusAbsent:
+				row.AbsentDays++
+			case entity.AttendanceCalendarStatusLeave:
+				row.LeaveDays++
+			}
+			row.Days = append(row.Days, status)
+		}
+
+		result.Rows = append(result.Rows, row)
+	}
+
+	return result, nil
+}
+
+// calendarStatus is the status of a user's day, attendance recorded on a
+// weekend or holiday still shows as present.
+func calendarStatus(calendar holidayEntity.Calendar, date, today, employedFrom, employedTo time.Time, presentDates, leaveDates map[string]bool) entity.AttendanceCalendarStatus {
+	key := date.Format("2006-01-02")
+
+	switch {
+	case date.Before(employedFrom) || date.After(employedTo):
+		return entity.AttendanceCalendarStatusNotEmployed
+	case presentDates[key]:
+		return entity.AttendanceCalendarStatusPresent
+	case calendar.IsHoliday(date):
+		return entity.AttendanceCalendarStatusHoliday
+	case calendar.IsWeekend(date):
+		return entity.AttendanceCalendarStatusWeekend
+	case leaveDates[key]:
+		return entity.AttendanceCalendarStatusLeave
+	case date.After(today):
+		return entity.AttendanceCalendarStatusUpcoming
+	default:
+		return entity.AttendanceCalendarStatusAbsent
+	}
+}
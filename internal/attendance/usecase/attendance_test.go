@@ -10,25 +10,38 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/vnnyx/employee-management/internal/attendance/entity"
 	mockAttendance "github.com/vnnyx/employee-management/internal/attendance/mock"
 	"github.com/vnnyx/employee-management/internal/attendance/usecase"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	mockHoliday "github.com/vnnyx/employee-management/internal/holiday/mock"
+	leaveEntity "github.com/vnnyx/employee-management/internal/leave/entity"
+	mockLeave "github.com/vnnyx/employee-management/internal/leave/mock"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/resourceful"
 	"github.com/vnnyx/employee-management/pkg/testutil"
 	"go.uber.org/mock/gomock"
 )
 
 func TestSubmitAttendance(t *testing.T) {
+	utcUser := &userEntity.User{Timezone: "UTC"}
+
 	type testCase struct {
 		name           string
 		authCredential authCredential.Credential
+		user           *userEntity.User
 		mockNow        time.Time
 		expectedErr    error
-		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository)
 	}
 
 	tests := []testCase{
@@ -38,19 +51,27 @@ func TestSubmitAttendance(t *testing.T) {
 				UserID:    "user-1",
 				IPAddress: "127.0.0.1",
 			},
+			user:        utcUser,
 			mockNow:     time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC), // Wednesday
 			expectedErr: nil,
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
 				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
 
+				txRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+
 				txRepo.EXPECT().UpsertAttendance(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
 					expected := entity.Attendance{
-						UserID:    "user-1",
-						IPAddress: "127.0.0.1",
+						UserID:         "user-1",
+						AttendanceDate: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+						IPAddress:      "127.0.0.1",
 					}
 					return testutil.EqualVerbose(expected, att,
 						cmpopts.IgnoreFields(entity.Attendance{},
-							"ID", "AttendanceDate", "CreatedAt", "UpdatedAt", "CreatedBy", "UpdatedBy"),
+							"ID", "CreatedAt", "UpdatedAt", "CreatedBy", "UpdatedBy"),
 					)
 				})).Return(nil)
 			},
@@ -61,13 +82,125 @@ func TestSubmitAttendance(t *testing.T) {
 				UserID:    "user-2",
 				IPAddress: "192.168.0.1",
 			},
+			user:    utcUser,
 			mockNow: time.Date(2025, 6, 7, 10, 0, 0, 0, time.UTC), // Saturday
 			expectedErr: apperror.BadRequest(
 				apperror.AppError{
 					IssueCode: entity.AttendanceInvalidDay,
 					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
 				}),
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "error - holiday attendance",
+			authCredential: authCredential.Credential{
+				UserID:    "user-3",
+				IPAddress: "192.168.0.1",
+			},
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 6, 10, 0, 0, 0, time.UTC), // Friday
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidDay,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC)).
+					Return([]holidayEntity.Holiday{
+						{
+							ID:          "holiday-1",
+							HolidayDate: time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC),
+							Name:        "Eid al-Adha",
+						},
+					}, nil)
+			},
+		},
+		{
+			name: "error - period closed by its payroll",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+			},
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC), // Wednesday
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+
+				txRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC)).
+					Return(&entity.ClosedAttendancePeriod{
+						AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+						PayrollID:        "payroll-1",
+					}, nil)
+				// No Upsert expected
+			},
+		},
+		{
+			name: "success - dated on the local day ahead of UTC",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+			},
+			user:        &userEntity.User{Timezone: "Asia/Jakarta"},
+			mockNow:     time.Date(2025, 6, 5, 20, 0, 0, 0, time.UTC), // Friday 03:00 in Jakarta
+			expectedErr: nil,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+
+				txRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+
+				txRepo.EXPECT().UpsertAttendance(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
+					return att.AttendanceDate.Equal(time.Date(2025, 6, 6, 0, 0, 0, 0, time.UTC))
+				})).Return(nil)
+			},
+		},
+		{
+			name: "error - weekend in the timezone of the user",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+			},
+			user:    &userEntity.User{Timezone: "America/Los_Angeles"},
+			mockNow: time.Date(2025, 6, 9, 3, 0, 0, 0, time.UTC), // Monday in UTC, Sunday 20:00 in Los Angeles
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidDay,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not found",
+			authCredential: authCredential.Credential{
+				UserID:    "user-9",
+				IPAddress: "127.0.0.1",
+			},
+			user:    nil,
+			mockNow: time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC),
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendanceUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceUserNotFound),
+					Received:  "user-9",
+				}),
 		},
 	}
 
@@ -94,12 +227,15 @@ func TestSubmitAttendance(t *testing.T) {
 
 			mockRepo := mockAttendance.NewMockRepository(ctrl)
 			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
 
+			mockUserRepo.EXPECT().FindUserByID(gomock.Any(), tt.authCredential.UserID).Return(tt.user, nil)
 			if tt.setupMock != nil {
-				tt.setupMock(mockRepo, mockRepoTx)
+				tt.setupMock(mockRepo, mockRepoTx, mockHolidayRepo)
 			}
 
-			useCase := usecase.NewAttendanceUseCase(mockRepo)
+			useCase := usecase.NewAttendanceUseCase(mockRepo, mockHolidayRepo, mockUserRepo, nil, usecase.AttendanceConfig{})
 
 			err := useCase.SubmitAttendance(context.Background(), tt.authCredential)
 
@@ -112,132 +248,232 @@ func TestSubmitAttendance(t *testing.T) {
 	}
 }
 
-func TestCreateAttendancePeriod(t *testing.T) {
+func TestClockIn(t *testing.T) {
+	schedule := entity.WorkSchedule{Start: 9 * time.Hour, End: 17 * time.Hour, GracePeriod: 5 * time.Minute}
+	credential := authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+	}
+
+	utcUser := &userEntity.User{ID: "user-1", Timezone: "UTC"}
+	newYorkUser := &userEntity.User{ID: "user-1", Timezone: "America/New_York"}
+	newYork, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
 	type testCase struct {
-		name           string
-		authCredential authCredential.Credential
-		payload        entity.CreateAttendancePeriod
-		expectedID     string
-		expectedErr    error
-		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+		name        string
+		user        *userEntity.User
+		mockNow     time.Time
+		expected    entity.Attendance
+		expectedErr error
+		setupMock   func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository)
 	}
 
 	tests := []testCase{
 		{
-			name: "success - create attendance period",
-			authCredential: authCredential.Credential{
-				UserID:    "user-1",
-				IPAddress: "127.0.0.1",
-				Username:  "testuser",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-				RequestID: "req-123",
-			},
-			payload: entity.CreateAttendancePeriod{
-				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+			name:    "success - on time within the grace period",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 9, 4, 0, 0, time.UTC), // Wednesday
+			expected: entity.Attendance{
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 6, 4, 9, 4, 0, 0, time.UTC)),
+				LateBy:         optional.NewDuration(0),
 			},
-			expectedID:  "period-123",
-			expectedErr: nil,
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
-
-				txRepo.EXPECT().StoreNewAttendancePeriod(gomock.Any(), mock.MatchedBy(func(period entity.AttendancePeriod) bool {
-					expected := entity.AttendancePeriod{
-						StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-						EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
-						CreatedBy: "user-1",
-						UpdatedBy: "user-1",
-						IPAddress: "127.0.0.1",
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().
+					FindAttendanceByUserIDDate(gomock.Any(), "user-1", time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC), entity.FindAttendanceOptions{PessimisticLock: true}).
+					Return(nil, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
+					expected := entity.Attendance{
+						UserID:         "user-1",
+						AttendanceDate: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+						ClockInAt:      optional.NewTime(time.Date(2025, 6, 4, 9, 4, 0, 0, time.UTC)),
+						LateBy:         optional.NewDuration(0),
+						IPAddress:      "127.0.0.1",
 					}
-					return testutil.EqualVerbose(expected, period,
-						cmpopts.IgnoreFields(entity.AttendancePeriod{},
-							"ID", "CreatedAt", "UpdatedAt"),
+					return testutil.EqualVerbose(expected, att,
+						cmpopts.IgnoreFields(entity.Attendance{},
+							"ID", "CreatedAt", "UpdatedAt", "CreatedBy", "UpdatedBy"),
 					)
 				})).Return(nil)
-
 			},
 		},
 		{
-			name: "error - non-admin user",
-			authCredential: authCredential.Credential{
-				UserID:    "user-2",
-				IPAddress: "127.0.0.1",
-				Username:  "testuser2",
-				IsAdmin:   func(b bool) *bool { return &b }(false),
+			name:    "success - late after the grace period keeps the submitted presence",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 9, 30, 0, 0, time.UTC),
+			expected: entity.Attendance{
+				ID:             "attendance-1",
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 6, 4, 9, 30, 0, 0, time.UTC)),
+				LateBy:         optional.NewDuration(30 * time.Minute),
 			},
-			payload: entity.CreateAttendancePeriod{
-				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().
+					FindAttendanceByUserIDDate(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).
+					Return(&entity.Attendance{ID: "attendance-1", UserID: "user-1"}, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
+					return att.ID == "attendance-1" && att.LateBy.MustGet() == 30*time.Minute
+				})).Return(nil)
 			},
-			expectedID: "",
-			expectedErr: apperror.Forbidden(
+		},
+		{
+			name:    "error - weekend",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 7, 9, 0, 0, 0, time.UTC), // Saturday
+			expectedErr: apperror.BadRequest(
 				apperror.AppError{
-					IssueCode: entity.AttendanceNotAuthorized,
-					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+					IssueCode: entity.AttendanceInvalidDay,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
 				}),
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
 		},
 		{
-			name: "error - invalid period dates",
-			authCredential: authCredential.Credential{
-				UserID:    "user-3",
-				IPAddress: "127.0.0.1",
-				Username:  "testuser3",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-			},
-			payload: entity.CreateAttendancePeriod{
-				StartDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
-				EndDate:   time.Date(2025, 6, 1, 23, 59, 59, 999999999, time.UTC),
-			},
-			expectedID: "",
+			name:    "error - period closed by its payroll",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC),
 			expectedErr: apperror.BadRequest(
 				apperror.AppError{
-					IssueCode: entity.AttendanceInvalidPeriod,
-					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidPeriod),
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
 				}),
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).
+					Return(&entity.ClosedAttendancePeriod{AttendancePeriod: entity.AttendancePeriod{ID: "period-1"}}, nil)
+			},
 		},
 		{
-			name: "error - unique constraint violation",
-			authCredential: authCredential.Credential{
-				UserID:    "user-4",
-				IPAddress: "127.0.0.1",
-				Username:  "testuser4",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-			},
-			payload: entity.CreateAttendancePeriod{
-				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
-			},
-			expectedID: "",
+			name:    "error - already clocked in",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 13, 0, 0, 0, time.UTC),
 			expectedErr: apperror.BadRequest(
 				apperror.AppError{
-					IssueCode: entity.AttendancePeriodAlreadyExists,
-					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodAlreadyExists),
+					IssueCode: entity.AttendanceAlreadyClockedIn,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyClockedIn),
 				}),
-			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
-
-				txRepo.EXPECT().StoreNewAttendancePeriod(gomock.Any(), mock.MatchedBy(func(period entity.AttendancePeriod) bool {
-					expected := entity.AttendancePeriod{
-						StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
-						EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
-						CreatedBy: "user-4",
-						UpdatedBy: "user-4",
-						IPAddress: "127.0.0.1",
-					}
-					return testutil.EqualVerbose(expected, period,
-						cmpopts.IgnoreFields(entity.AttendancePeriod{},
-							"ID", "CreatedAt", "UpdatedAt"),
-					)
-				})).Return(errors.New("attendance_periods_start_date_end_date_key"))
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().
+					FindAttendanceByUserIDDate(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).
+					Return(&entity.Attendance{
+						ID:        "attendance-1",
+						ClockInAt: optional.NewTime(time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC)),
+					}, nil)
+			},
+		},
+		{
+			name:    "success - on time at 09:00 local before clocks spring forward",
+			user:    newYorkUser,
+			mockNow: time.Date(2025, 3, 7, 14, 0, 0, 0, time.UTC), // Friday 09:00 EST
+			expected: entity.Attendance{
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 3, 7, 9, 0, 0, 0, newYork)),
+				LateBy:         optional.NewDuration(0),
+			},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC)).Return(nil, nil)
+				txRepo.EXPECT().
+					FindAttendanceByUserIDDate(gomock.Any(), "user-1", time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC), gomock.Any()).
+					Return(nil, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "success - on time at 09:00 local after clocks spring forward",
+			user:    newYorkUser,
+			mockNow: time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC), // Monday 09:00 EDT
+			expected: entity.Attendance{
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 3, 10, 9, 0, 0, 0, newYork)),
+				LateBy:         optional.NewDuration(0),
+			},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)).Return(nil, nil)
+				txRepo.EXPECT().
+					FindAttendanceByUserIDDate(gomock.Any(), "user-1", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), gomock.Any()).
+					Return(nil, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "success - early at the UTC time that was 09:00 local before clocks fell back",
+			user:    newYorkUser,
+			mockNow: time.Date(2025, 11, 3, 13, 0, 0, 0, time.UTC), // Monday 08:00 EST
+			expected: entity.Attendance{
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 11, 3, 8, 0, 0, 0, newYork)),
+				LateBy:         optional.NewDuration(0),
+			},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "success - late by the hour clocks sprang forward",
+			user:    newYorkUser,
+			mockNow: time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC), // Monday 10:00 EDT
+			expected: entity.Attendance{
+				UserID:         "user-1",
+				AttendanceDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+				ClockInAt:      optional.NewTime(time.Date(2025, 3, 10, 10, 0, 0, 0, newYork)),
+				LateBy:         optional.NewDuration(time.Hour),
 			},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().StoreAttendanceClockIn(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "error - user not found",
+			user:    nil,
+			mockNow: time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC),
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendanceUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceUserNotFound),
+					Received:  "user-1",
+				}),
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+			patch := gomonkey.NewPatches()
+			defer patch.Reset()
+
+			patch.ApplyFunc(time.Now, func() time.Time {
+				return tt.mockNow
+			})
+
+			patch.ApplyFunc(database.WithAuditContext, func(
 				ctx context.Context,
 				cred authCredential.Credential,
 				txOpt pgx.TxOptions,
@@ -245,41 +481,1901 @@ func TestCreateAttendancePeriod(t *testing.T) {
 			) error {
 				return fn(nil)
 			})
-			defer patches.Reset()
-
-			if tt.expectedID != "" {
-				patches.ApplyFunc(uuid.NewString, func() string {
-					return tt.expectedID
-				})
-			}
-
-			if tt.name == "error - unique constraint violation" {
-				patches.ApplyFunc(database.IsUniqueViolation, func(err error, constraintName string) bool {
-					return constraintName == "attendance_periods_start_date_end_date_key"
-				})
-			}
 
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
 			mockRepo := mockAttendance.NewMockRepository(ctrl)
 			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
 
+			mockUserRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(tt.user, nil)
 			if tt.setupMock != nil {
-				tt.setupMock(mockRepo, mockRepoTx)
+				tt.setupMock(mockRepo, mockRepoTx, mockHolidayRepo)
 			}
 
-			useCase := usecase.NewAttendanceUseCase(mockRepo)
+			useCase := usecase.NewAttendanceUseCase(mockRepo, mockHolidayRepo, mockUserRepo, nil, usecase.AttendanceConfig{Schedule: schedule})
 
-			id, err := useCase.CreateAttendancePeriod(context.Background(), tt.authCredential, tt.payload)
+			result, err := useCase.ClockIn(context.Background(), credential)
 
 			if tt.expectedErr != nil {
 				assert.ErrorContains(t, err, tt.expectedErr.Error())
-				assert.Empty(t, id)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedID, id)
+				return
 			}
+			assert.NoError(t, err)
+			assert.True(t, testutil.EqualVerbose(tt.expected, result,
+				cmpopts.IgnoreFields(entity.Attendance{},
+					"ID", "CreatedAt", "UpdatedAt", "CreatedBy", "UpdatedBy", "IPAddress"),
+			))
+			if tt.expected.ID != "" {
+				assert.Equal(t, tt.expected.ID, result.ID)
+			}
+		})
+	}
+}
+
+func TestClockOut(t *testing.T) {
+	schedule := entity.WorkSchedule{Start: 9 * time.Hour, End: 17 * time.Hour}
+	credential := authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+	}
+	clockIn := time.Date(2025, 6, 4, 9, 0, 0, 0, time.UTC)
+	openAttendance := entity.Attendance{
+		ID:             "attendance-1",
+		UserID:         "user-1",
+		AttendanceDate: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+		ClockInAt:      optional.NewTime(clockIn),
+		LateBy:         optional.NewDuration(0),
+	}
+
+	utcUser := &userEntity.User{ID: "user-1", Timezone: "UTC"}
+	newYorkUser := &userEntity.User{ID: "user-1", Timezone: "America/New_York"}
+	newYorkAttendance := entity.Attendance{
+		ID:             "attendance-2",
+		UserID:         "user-1",
+		AttendanceDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+		ClockInAt:      optional.NewTime(time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)), // 09:00 EDT
+		LateBy:         optional.NewDuration(0),
+	}
+
+	type testCase struct {
+		name                   string
+		user                   *userEntity.User
+		mockNow                time.Time
+		expectedWorkedDuration time.Duration
+		expectedEarlyLeaveBy   time.Duration
+		expectedErr            error
+		setupMock              func(repo, txRepo *mockAttendance.MockRepository)
+	}
+
+	tests := []testCase{
+		{
+			name:                   "success - left early",
+			user:                   utcUser,
+			mockNow:                time.Date(2025, 6, 4, 16, 15, 0, 0, time.UTC),
+			expectedWorkedDuration: 7*time.Hour + 15*time.Minute,
+			expectedEarlyLeaveBy:   45 * time.Minute,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().
+					FindOpenAttendanceByUserID(gomock.Any(), "user-1", time.Date(2025, 6, 3, 16, 15, 0, 0, time.UTC), entity.FindAttendanceOptions{PessimisticLock: true}).
+					Return(&openAttendance, nil)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), openAttendance.AttendanceDate).Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendanceClockOut(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
+					return att.ID == "attendance-1" && att.ClockOutAt.MustGet().Equal(time.Date(2025, 6, 4, 16, 15, 0, 0, time.UTC))
+				})).Return(nil)
+			},
+		},
+		{
+			name:                   "success - shift past midnight stays on the day it started",
+			user:                   utcUser,
+			mockNow:                time.Date(2025, 6, 5, 1, 0, 0, 0, time.UTC),
+			expectedWorkedDuration: 16 * time.Hour,
+			expectedEarlyLeaveBy:   0,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOpenAttendanceByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(&openAttendance, nil)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), openAttendance.AttendanceDate).Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendanceClockOut(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "error - not clocked in",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 17, 0, 0, 0, time.UTC),
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotClockedIn,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotClockedIn),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOpenAttendanceByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error - period closed by its payroll",
+			user:    utcUser,
+			mockNow: time.Date(2025, 6, 4, 17, 0, 0, 0, time.UTC),
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOpenAttendanceByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(&openAttendance, nil)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), openAttendance.AttendanceDate).
+					Return(&entity.ClosedAttendancePeriod{AttendancePeriod: entity.AttendancePeriod{ID: "period-1"}}, nil)
+			},
+		},
+		{
+			name:        "error - update failed",
+			user:        utcUser,
+			mockNow:     time.Date(2025, 6, 4, 17, 0, 0, 0, time.UTC),
+			expectedErr: errors.New("update failed"),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOpenAttendanceByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(&openAttendance, nil)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), openAttendance.AttendanceDate).Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendanceClockOut(gomock.Any(), gomock.Any()).Return(errors.New("update failed"))
+			},
+		},
+		{
+			name:                   "success - left early by the local end of the day after clocks spring forward",
+			user:                   newYorkUser,
+			mockNow:                time.Date(2025, 3, 10, 20, 30, 0, 0, time.UTC), // 16:30 EDT
+			expectedWorkedDuration: 7*time.Hour + 30*time.Minute,
+			expectedEarlyLeaveBy:   30 * time.Minute,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOpenAttendanceByUserID(gomock.Any(), "user-1", gomock.Any(), gomock.Any()).Return(&newYorkAttendance, nil)
+				txRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), newYorkAttendance.AttendanceDate).Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendanceClockOut(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "error - user not found",
+			user:    nil,
+			mockNow: time.Date(2025, 6, 4, 17, 0, 0, 0, time.UTC),
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendanceUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceUserNotFound),
+					Received:  "user-1",
+				}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := gomonkey.NewPatches()
+			defer patch.Reset()
+
+			patch.ApplyFunc(time.Now, func() time.Time {
+				return tt.mockNow
+			})
+
+			patch.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
+
+			mockUserRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(tt.user, nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockRepoTx)
+			}
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, mockUserRepo, nil, usecase.AttendanceConfig{Schedule: schedule})
+
+			result, err := useCase.ClockOut(context.Background(), credential)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedWorkedDuration, result.WorkedDuration())
+			assert.Equal(t, tt.expectedEarlyLeaveBy, result.EarlyLeaveBy.MustGet())
+		})
+	}
+}
+
+func TestCreateAttendancePeriod(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.CreateAttendancePeriod
+		expectedID     string
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+	}
+
+	tests := []testCase{
+		{
+			name: "success - create attendance period",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+			},
+			expectedID:  "period-123",
+			expectedErr: nil,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+
+				txRepo.EXPECT().
+					FindOverlappingPeriod(gomock.Any(), time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC), "").
+					Return(nil, nil)
+
+				txRepo.EXPECT().StoreNewAttendancePeriod(gomock.Any(), mock.MatchedBy(func(period entity.AttendancePeriod) bool {
+					expected := entity.AttendancePeriod{
+						StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+						CreatedBy: "user-1",
+						UpdatedBy: "user-1",
+						IPAddress: "127.0.0.1",
+					}
+					return testutil.EqualVerbose(expected, period,
+						cmpopts.IgnoreFields(entity.AttendancePeriod{},
+							"ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+
+			},
+		},
+		{
+			name: "error - overlapping period stored concurrently",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindOverlappingPeriod(gomock.Any(), gomock.Any(), gomock.Any(), "").Return(nil, nil)
+				txRepo.EXPECT().StoreNewAttendancePeriod(gomock.Any(), gomock.Any()).Return(&pgconn.PgError{
+					Code:           "23P01",
+					ConstraintName: "attendance_periods_no_overlap",
+				})
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:    "user-2",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser2",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+			},
+			expectedID: "",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name: "error - invalid period dates",
+			authCredential: authCredential.Credential{
+				UserID:    "user-3",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser3",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 6, 1, 23, 59, 59, 999999999, time.UTC),
+			},
+			expectedID: "",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidPeriod,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidPeriod),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name: "error - unique constraint violation",
+			authCredential: authCredential.Credential{
+				UserID:    "user-4",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser4",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+			},
+			expectedID: "",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodAlreadyExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodAlreadyExists),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+
+				txRepo.EXPECT().
+					FindOverlappingPeriod(gomock.Any(), time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC), "").
+					Return(nil, nil)
+
+				txRepo.EXPECT().StoreNewAttendancePeriod(gomock.Any(), mock.MatchedBy(func(period entity.AttendancePeriod) bool {
+					expected := entity.AttendancePeriod{
+						StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2025, 6, 30, 23, 59, 59, 999999999, time.UTC),
+						CreatedBy: "user-4",
+						UpdatedBy: "user-4",
+						IPAddress: "127.0.0.1",
+					}
+					return testutil.EqualVerbose(expected, period,
+						cmpopts.IgnoreFields(entity.AttendancePeriod{},
+							"ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(errors.New("attendance_periods_start_date_end_date_key"))
+			},
+		},
+		{
+			name: "error - overlaps an existing period",
+			authCredential: authCredential.Credential{
+				UserID:    "user-5",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser5",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+			},
+			payload: entity.CreateAttendancePeriod{
+				StartDate: time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC),
+			},
+			expectedID: "",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+
+				txRepo.EXPECT().
+					FindOverlappingPeriod(gomock.Any(), time.Date(2025, 6, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 7, 14, 0, 0, 0, 0, time.UTC), "").
+					Return(&entity.AttendancePeriod{ID: "period-june"}, nil)
+				// No Store expected
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			if tt.expectedID != "" {
+				patches.ApplyFunc(uuid.NewString, func() string {
+					return tt.expectedID
+				})
+			}
+
+			if tt.name == "error - unique constraint violation" {
+				patches.ApplyFunc(database.IsUniqueViolation, func(err error, constraintName string) bool {
+					return constraintName == "attendance_periods_start_date_end_date_key"
+				})
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockRepoTx)
+			}
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, mockHolidayRepo, nil, nil, usecase.AttendanceConfig{})
+
+			id, err := useCase.CreateAttendancePeriod(context.Background(), tt.authCredential, tt.payload)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				assert.Empty(t, id)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedID, id)
+			}
+		})
+	}
+}
+
+func TestListAttendancePeriods(t *testing.T) {
+	periods := []entity.AttendancePeriodDetail{
+		{AttendancePeriod: entity.AttendancePeriod{ID: "period-2"}},
+		{AttendancePeriod: entity.AttendancePeriod{ID: "period-1"}, PayrollID: optional.NewString("payroll-1"), HasPayroll: true},
+	}
+
+	tests := []struct {
+		name           string
+		authCredential authCredential.Credential
+		expected       []entity.AttendancePeriodDetail
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository)
+	}{
+		{
+			name: "success - periods listed",
+			authCredential: authCredential.Credential{
+				UserID:  "admin-1",
+				IsAdmin: func(b bool) *bool { return &b }(true),
+			},
+			expected: periods,
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendancePeriods(gomock.Any()).Return(periods, nil)
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name: "error - find periods failed",
+			authCredential: authCredential.Credential{
+				UserID:  "admin-1",
+				IsAdmin: func(b bool) *bool { return &b }(true),
+			},
+			expectedErr: errors.New("AttendanceUseCase.ListAttendancePeriods().FindAttendancePeriods(): db error"),
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendancePeriods(gomock.Any()).Return(nil, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			result, err := useCase.ListAttendancePeriods(context.Background(), tt.authCredential)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestShowAttendancePeriod(t *testing.T) {
+	period := &entity.AttendancePeriodDetail{
+		AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+		PayrollID:        optional.NewString("payroll-1"),
+		PayrollStatus:    optional.NewString("paid"),
+		HasPayroll:       true,
+	}
+
+	tests := []struct {
+		name           string
+		authCredential authCredential.Credential
+		expected       entity.AttendancePeriodDetail
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository)
+	}{
+		{
+			name: "success - period found",
+			authCredential: authCredential.Credential{
+				UserID:  "admin-1",
+				IsAdmin: func(b bool) *bool { return &b }(true),
+			},
+			expected: *period,
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(period, nil)
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name: "error - period not found",
+			authCredential: authCredential.Credential{
+				UserID:  "admin-1",
+				IsAdmin: func(b bool) *bool { return &b }(true),
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			result, err := useCase.ShowAttendancePeriod(context.Background(), tt.authCredential, "period-1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestUpdateAttendancePeriod(t *testing.T) {
+	admin := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+	}
+	payload := entity.UpdateAttendancePeriod{
+		StartDate: time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2025, 6, 29, 0, 0, 0, 0, time.UTC),
+	}
+	openPeriod := &entity.AttendancePeriodDetail{
+		AttendancePeriod: entity.AttendancePeriod{
+			ID:        "period-1",
+			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+			CreatedBy: "admin-0",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateAttendancePeriod
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+	}{
+		{
+			name:           "success - dates moved",
+			authCredential: admin,
+			payload:        payload,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(openPeriod, nil)
+				txRepo.EXPECT().FindOverlappingPeriod(gomock.Any(), payload.StartDate, payload.EndDate, "period-1").Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendancePeriod(gomock.Any(), mock.MatchedBy(func(period entity.AttendancePeriod) bool {
+					expected := entity.AttendancePeriod{
+						ID:        "period-1",
+						StartDate: payload.StartDate,
+						EndDate:   payload.EndDate,
+						CreatedBy: "admin-0",
+						UpdatedBy: "admin-1",
+						IPAddress: "127.0.0.1",
+					}
+					return testutil.EqualVerbose(expected, period,
+						cmpopts.IgnoreFields(entity.AttendancePeriod{}, "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - overlapping period stored concurrently",
+			authCredential: admin,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(openPeriod, nil)
+				txRepo.EXPECT().FindOverlappingPeriod(gomock.Any(), payload.StartDate, payload.EndDate, "period-1").Return(nil, nil)
+				txRepo.EXPECT().UpdateAttendancePeriod(gomock.Any(), gomock.Any()).Return(&pgconn.PgError{
+					Code:           "23P01",
+					ConstraintName: "attendance_periods_no_overlap",
+				})
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			payload: payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - invalid period dates",
+			authCredential: admin,
+			payload: entity.UpdateAttendancePeriod{
+				StartDate: payload.EndDate,
+				EndDate:   payload.StartDate,
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidPeriod,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidPeriod),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: admin,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - payroll already generated",
+			authCredential: admin,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodHasPayroll,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodHasPayroll),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: openPeriod.AttendancePeriod,
+					HasPayroll:       true,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - overlaps another period",
+			authCredential: admin,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodOverlaps),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(openPeriod, nil)
+				txRepo.EXPECT().FindOverlappingPeriod(gomock.Any(), payload.StartDate, payload.EndDate, "period-1").
+					Return(&entity.AttendancePeriod{ID: "period-2"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			result, err := useCase.UpdateAttendancePeriod(context.Background(), tt.authCredential, "period-1", tt.payload)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.payload.StartDate, result.StartDate)
+			assert.Equal(t, tt.payload.EndDate, result.EndDate)
+			assert.Equal(t, entity.AttendancePeriodStatusOpen, result.Status())
+		})
+	}
+}
+
+func TestDeleteAttendancePeriod(t *testing.T) {
+	admin := authCredential.Credential{
+		UserID:  "admin-1",
+		IsAdmin: func(b bool) *bool { return &b }(true),
+	}
+	openPeriod := &entity.AttendancePeriodDetail{
+		AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+	}
+
+	tests := []struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+	}{
+		{
+			name:           "success - period deleted",
+			authCredential: admin,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(openPeriod, nil)
+				txRepo.EXPECT().DeleteAttendancePeriod(gomock.Any(), "period-1").Return(nil)
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: admin,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - voided payroll still blocks deletion",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodHasPayroll,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodHasPayroll),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: openPeriod.AttendancePeriod,
+					HasPayroll:       true,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - delete failed",
+			authCredential: admin,
+			expectedErr:    errors.New("AttendanceUseCase.DeleteAttendancePeriod().DeleteAttendancePeriod(): db error"),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(openPeriod, nil)
+				txRepo.EXPECT().DeleteAttendancePeriod(gomock.Any(), "period-1").Return(errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			err := useCase.DeleteAttendancePeriod(context.Background(), tt.authCredential, "period-1")
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReopenAttendancePeriod(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expected       entity.AttendancePeriodReopening
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository)
+	}
+
+	admin := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+	}
+	periodDetail := &entity.AttendancePeriodDetail{
+		AttendancePeriod: entity.AttendancePeriod{
+			ID:        "period-1",
+			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		},
+		PayrollID:     optional.NewString("payroll-1"),
+		PayrollStatus: optional.NewString("approved"),
+		HasPayroll:    true,
+	}
+	closedPeriod := &entity.ClosedAttendancePeriod{
+		AttendancePeriod: entity.AttendancePeriod{
+			ID:        "period-1",
+			StartDate: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			EndDate:   time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		},
+		PayrollID: "payroll-1",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - period reopened for its payroll",
+			authCredential: admin,
+			expected: entity.AttendancePeriodReopening{
+				PeriodID:  "period-1",
+				PayrollID: "payroll-1",
+				Reason:    "Late overtime claims",
+				CreatedBy: "admin-1",
+				UpdatedBy: "admin-1",
+				IPAddress: "127.0.0.1",
+			},
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(periodDetail, nil)
+				txRepo.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(closedPeriod, nil)
+				txRepo.EXPECT().StoreNewAttendancePeriodReopening(gomock.Any(), mock.MatchedBy(func(reopening entity.AttendancePeriodReopening) bool {
+					expected := entity.AttendancePeriodReopening{
+						PeriodID:  "period-1",
+						PayrollID: "payroll-1",
+						Reason:    "Late overtime claims",
+						CreatedBy: "admin-1",
+						UpdatedBy: "admin-1",
+						IPAddress: "127.0.0.1",
+					}
+					return testutil.EqualVerbose(expected, reopening,
+						cmpopts.IgnoreFields(entity.AttendancePeriodReopening{},
+							"ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: admin,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - payroll of the period already paid",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodReopenPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodReopenPaid),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				paidPeriod := *periodDetail
+				paidPeriod.PayrollStatus = optional.NewString("paid")
+
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(&paidPeriod, nil)
+			},
+		},
+		{
+			name:           "error - period without payroll or already reopened",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotClosed),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(periodDetail, nil)
+				txRepo.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - store reopening failed",
+			authCredential: admin,
+			expectedErr:    errors.New("AttendanceUseCase.ReopenAttendancePeriod().StoreNewAttendancePeriodReopening(): db error"),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByID(gomock.Any(), "period-1").Return(periodDetail, nil)
+				txRepo.EXPECT().FindClosedPeriodByID(gomock.Any(), "period-1").Return(closedPeriod, nil)
+				txRepo.EXPECT().StoreNewAttendancePeriodReopening(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockRepoTx)
+			}
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			reopening, err := useCase.ReopenAttendancePeriod(context.Background(), tt.authCredential, "period-1", "Late overtime claims")
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, testutil.EqualVerbose(tt.expected, reopening,
+				cmpopts.IgnoreFields(entity.AttendancePeriodReopening{}, "ID", "CreatedAt", "UpdatedAt"),
+			))
+		})
+	}
+}
+
+func TestRequestAttendanceCorrection(t *testing.T) {
+	type testCase struct {
+		name        string
+		payload     entity.RequestAttendanceCorrection
+		expectedErr error
+		setupMock   func(repo *mockAttendance.MockRepository, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository)
+	}
+
+	employee := authCredential.Credential{
+		UserID:    "user-1",
+		Username:  "employee",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+	}
+	mockNow := time.Date(2025, 6, 12, 9, 0, 0, 0, time.UTC) // Thursday
+	attendanceDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	payload := entity.RequestAttendanceCorrection{
+		AttendanceDate: attendanceDate,
+		Reason:         "Forgot to submit",
+	}
+
+	tests := []testCase{
+		{
+			name:    "success - correction requested",
+			payload: payload,
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), attendanceDate, attendanceDate).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+				}, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindPendingAttendanceCorrectionByUserIDDate(gomock.Any(), "user-1", attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().StoreNewAttendanceCorrection(gomock.Any(), mock.MatchedBy(func(correction entity.AttendanceCorrection) bool {
+					return testutil.EqualVerbose(entity.AttendanceCorrection{
+						UserID:         "user-1",
+						Username:       "employee",
+						AttendanceDate: attendanceDate,
+						Reason:         "Forgot to submit",
+						Status:         entity.AttendanceCorrectionStatusPending,
+						CreatedAt:      mockNow,
+						UpdatedAt:      mockNow,
+						CreatedBy:      "user-1",
+						UpdatedBy:      "user-1",
+						IPAddress:      "127.0.0.1",
+					}, correction, cmpopts.IgnoreFields(entity.AttendanceCorrection{}, "ID"))
+				})).Return(nil)
+			},
+		},
+		{
+			name: "error - date not in the past",
+			payload: entity.RequestAttendanceCorrection{
+				AttendanceDate: time.Date(2025, 6, 12, 0, 0, 0, 0, time.UTC),
+				Reason:         "Forgot to submit",
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionNotPast,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionNotPast),
+					Received:  "2025-06-12",
+				}),
+		},
+		{
+			name: "error - weekend date",
+			payload: entity.RequestAttendanceCorrection{
+				AttendanceDate: time.Date(2025, 6, 8, 0, 0, 0, 0, time.UTC),
+				Reason:         "Forgot to submit",
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidDay,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidDay),
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error - period already paid",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodPaid),
+					Received:  "period-1",
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), attendanceDate, attendanceDate).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        optional.NewString("payroll-1"),
+					PayrollStatus:    optional.NewString("paid"),
+					Reopened:         true,
+					HasPayroll:       true,
+				}, nil)
+			},
+		},
+		{
+			name:    "error - period closed by payroll",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodClosed),
+					Received:  "period-1",
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), attendanceDate, attendanceDate).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        optional.NewString("payroll-1"),
+					PayrollStatus:    optional.NewString("approved"),
+					HasPayroll:       true,
+				}, nil)
+			},
+		},
+		{
+			name:    "error - attendance already recorded",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceAlreadyRecorded,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyRecorded),
+					Received:  "2025-06-10",
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), attendanceDate, attendanceDate).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", attendanceDate).Return(&entity.Attendance{ID: "attendance-1"}, nil)
+			},
+		},
+		{
+			name:    "error - correction already pending",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionExists),
+					Received:  "correction-0",
+				}),
+			setupMock: func(repo, txRepo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository) {
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), attendanceDate, attendanceDate).Return(nil, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindPendingAttendanceCorrectionByUserIDDate(gomock.Any(), "user-1", attendanceDate).Return(&entity.AttendanceCorrection{ID: "correction-0"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := gomonkey.NewPatches()
+			defer patch.Reset()
+
+			patch.ApplyFunc(time.Now, func() time.Time {
+				return mockNow
+			})
+
+			patch.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
+
+			mockUserRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{Timezone: "UTC"}, nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo, mockRepoTx, mockHolidayRepo)
+			}
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, mockHolidayRepo, mockUserRepo, nil, usecase.AttendanceConfig{})
+
+			correction, err := useCase.RequestAttendanceCorrection(context.Background(), employee, tt.payload)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.AttendanceCorrectionStatusPending, correction.Status)
+		})
+	}
+}
+
+func TestApproveAttendanceCorrection(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository)
+	}
+
+	admin := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+	}
+	manager := authCredential.Credential{
+		UserID:    "manager-1",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+	}
+	mockNow := time.Date(2025, 6, 12, 9, 0, 0, 0, time.UTC)
+	attendanceDate := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	pending := entity.AttendanceCorrection{
+		ID:             "correction-1",
+		UserID:         "user-1",
+		AttendanceDate: attendanceDate,
+		Reason:         "Forgot to submit",
+		Status:         entity.AttendanceCorrectionStatusPending,
+	}
+	lockOpts := entity.FindAttendanceCorrectionOptions{PessimisticLock: true}
+
+	tests := []testCase{
+		{
+			name:           "success - approved by the manager",
+			authCredential: manager,
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&pending, nil)
+				userTxRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1", ManagerID: optional.NewString("manager-1")}, nil)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", attendanceDate, entity.FindAttendanceOptions{PessimisticLock: true}).Return(nil, nil)
+				var attendanceID string
+				txRepo.EXPECT().StoreNewAttendance(gomock.Any(), mock.MatchedBy(func(att entity.Attendance) bool {
+					attendanceID = att.ID
+					return testutil.EqualVerbose(entity.Attendance{
+						UserID:         "user-1",
+						AttendanceDate: attendanceDate,
+						CreatedAt:      mockNow,
+						UpdatedAt:      mockNow,
+						CreatedBy:      "manager-1",
+						UpdatedBy:      "manager-1",
+						IPAddress:      "127.0.0.1",
+					}, att, cmpopts.IgnoreFields(entity.Attendance{}, "ID"))
+				})).Return(nil)
+				txRepo.EXPECT().UpdateAttendanceCorrectionStatus(gomock.Any(), mock.MatchedBy(func(correction entity.AttendanceCorrection) bool {
+					expected := pending
+					expected.Status = entity.AttendanceCorrectionStatusApproved
+					expected.AttendanceID = optional.NewString(attendanceID)
+					expected.DecidedBy = optional.NewString("manager-1")
+					expected.DecidedAt = optional.NewTime(mockNow)
+					expected.DecisionComment = optional.NewString("Confirmed")
+					expected.UpdatedAt = mockNow
+					expected.UpdatedBy = "manager-1"
+					expected.IPAddress = "127.0.0.1"
+					return testutil.EqualVerbose(expected, correction)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - correction not found",
+			authCredential: admin,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionNotFound),
+					Received:  "correction-1",
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(nil, nil)
+			},
+		},
+		{
+			name: "error - own correction",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionSelf,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionSelf),
+					Received:  "correction-1",
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&pending, nil)
+			},
+		},
+		{
+			name:           "error - not the manager of the requester",
+			authCredential: manager,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&pending, nil)
+				userTxRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+			},
+		},
+		{
+			name:           "error - already decided",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceCorrectionDecided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceCorrectionDecided),
+					Received:  "rejected",
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				rejected := pending
+				rejected.Status = entity.AttendanceCorrectionStatusRejected
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&rejected, nil)
+			},
+		},
+		{
+			name:           "error - period paid since the request",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodPaid),
+					Received:  "period-1",
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&pending, nil)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(&entity.AttendancePeriodDetail{
+					AttendancePeriod: entity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        optional.NewString("payroll-1"),
+					PayrollStatus:    optional.NewString("paid"),
+					HasPayroll:       true,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - attendance recorded since the request",
+			authCredential: admin,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceAlreadyRecorded,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceAlreadyRecorded),
+					Received:  "2025-06-10",
+				}),
+			setupMock: func(txRepo *mockAttendance.MockRepository, userTxRepo *mockUser.MockRepository) {
+				txRepo.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", lockOpts).Return(&pending, nil)
+				txRepo.EXPECT().FindAttendancePeriodDetailByDate(gomock.Any(), attendanceDate).Return(nil, nil)
+				txRepo.EXPECT().FindAttendanceByUserIDDate(gomock.Any(), "user-1", attendanceDate, entity.FindAttendanceOptions{PessimisticLock: true}).Return(&entity.Attendance{ID: "attendance-1"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := gomonkey.NewPatches()
+			defer patch.Reset()
+
+			patch.ApplyFunc(time.Now, func() time.Time {
+				return mockNow
+			})
+
+			patch.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
+			mockUserRepoTx := mockUser.NewMockRepository(ctrl)
+
+			mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockRepoTx)
+			mockUserRepo.EXPECT().WithTx(gomock.Any()).Return(mockUserRepoTx)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepoTx, mockUserRepoTx)
+			}
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, mockUserRepo, nil, usecase.AttendanceConfig{})
+
+			correction, err := useCase.ApproveAttendanceCorrection(context.Background(), tt.authCredential, "correction-1", entity.DecideAttendanceCorrection{
+				Comment: optional.NewString("Confirmed"),
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.AttendanceCorrectionStatusApproved, correction.Status)
+			assert.True(t, correction.AttendanceID.IsPresent())
+		})
+	}
+}
+
+func TestRejectAttendanceCorrection(t *testing.T) {
+	patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+	defer patches.Reset()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mockAttendance.NewMockRepository(ctrl)
+	mockRepoTx := mockAttendance.NewMockRepository(ctrl)
+	mockUserRepo := mockUser.NewMockRepository(ctrl)
+	mockUserRepoTx := mockUser.NewMockRepository(ctrl)
+
+	mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockRepoTx)
+	mockUserRepo.EXPECT().WithTx(gomock.Any()).Return(mockUserRepoTx)
+	mockRepoTx.EXPECT().FindAttendanceCorrectionByID(gomock.Any(), "correction-1", entity.FindAttendanceCorrectionOptions{PessimisticLock: true}).Return(&entity.AttendanceCorrection{
+		ID:             "correction-1",
+		UserID:         "user-1",
+		AttendanceDate: time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		Status:         entity.AttendanceCorrectionStatusPending,
+	}, nil)
+	mockRepoTx.EXPECT().UpdateAttendanceCorrectionStatus(gomock.Any(), mock.MatchedBy(func(correction entity.AttendanceCorrection) bool {
+		return correction.Status == entity.AttendanceCorrectionStatusRejected &&
+			!correction.AttendanceID.IsPresent() &&
+			correction.DecidedBy.GetOrDefault() == "admin-1"
+	})).Return(nil)
+
+	useCase := usecase.NewAttendanceUseCase(mockRepo, nil, mockUserRepo, nil, usecase.AttendanceConfig{})
+
+	correction, err := useCase.RejectAttendanceCorrection(context.Background(), authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+	}, "correction-1", entity.DecideAttendanceCorrection{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.AttendanceCorrectionStatusRejected, correction.Status)
+}
+
+func TestListMyAttendance(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	attendances := []entity.Attendance{
+		{ID: "attendance-1", UserID: "user-1", AttendanceDate: startDate},
+	}
+
+	tests := []struct {
+		name        string
+		startDate   time.Time
+		endDate     time.Time
+		expected    []entity.Attendance
+		expectedErr error
+		setupMock   func(repo *mockAttendance.MockRepository)
+	}{
+		{
+			name:      "success - attendance listed",
+			startDate: startDate,
+			endDate:   endDate,
+			expected:  attendances,
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendances(gomock.Any(), entity.FindAttendanceFilter{
+					UserID:    optional.NewString("user-1"),
+					StartDate: optional.NewTime(startDate),
+					EndDate:   optional.NewTime(endDate),
+				}).Return(entity.FindAttendanceResult{List: attendances}, nil)
+			},
+		},
+		{
+			name:      "error - from after to",
+			startDate: endDate,
+			endDate:   startDate,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidRange),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:        "error - find attendances failed",
+			startDate:   startDate,
+			endDate:     endDate,
+			expectedErr: errors.New("AttendanceUseCase.ListMyAttendance().FindAttendances(): db error"),
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendances(gomock.Any(), gomock.Any()).Return(entity.FindAttendanceResult{}, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			result, err := useCase.ListMyAttendance(context.Background(), authCredential.Credential{UserID: "user-1"}, tt.startDate, tt.endDate)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestListAttendances(t *testing.T) {
+	period := &entity.AttendancePeriod{
+		ID:        "period-1",
+		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+	attendance := entity.Attendance{
+		ID:             "attendance-2",
+		UserID:         "user-1",
+		AttendanceDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+	}
+	nextCursor := &resourceful.Cursor{Key: "2024-01-10", Value: "attendance-2"}
+	encodedNextCursor, err := resourceful.EncodeCursor(nextCursor)
+	assert.NoError(t, err)
+
+	admin := authCredential.Credential{
+		UserID:  "admin-1",
+		IsAdmin: func(b bool) *bool { return &b }(true),
+	}
+
+	tests := []struct {
+		name             string
+		authCredential   authCredential.Credential
+		filter           entity.ListAttendanceFilter
+		cursor           *resourceful.Cursor
+		expectedMetadata entity.ListAttendanceMetadata
+		expectedErr      error
+		setupMock        func(repo *mockAttendance.MockRepository)
+	}{
+		{
+			name:           "success - period intersected with the range",
+			authCredential: admin,
+			filter: entity.ListAttendanceFilter{
+				UserID:    optional.NewString("user-1"),
+				PeriodID:  optional.NewString("period-1"),
+				StartDate: optional.NewTime(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)),
+			},
+			expectedMetadata: entity.ListAttendanceMetadata{
+				Count:      1,
+				Limit:      1,
+				NextCursor: encodedNextCursor,
+				Cursor:     nextCursor,
+				IDs:        []string{"attendance-2"},
+			},
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(period, nil)
+				repo.EXPECT().FindAttendances(gomock.Any(), entity.FindAttendanceFilter{
+					UserID:    optional.NewString("user-1"),
+					StartDate: optional.NewTime(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)),
+					EndDate:   optional.NewTime(period.EndDate),
+				}, gomock.Any()).DoAndReturn(func(ctx context.Context, filter entity.FindAttendanceFilter, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
+					opts[0].ResourcefulParameter.SetAdditionalData(entity.ListAttendanceMetadata{
+						Count:  1,
+						Limit:  1,
+						Cursor: nextCursor,
+						IDs:    []string{"attendance-2"},
+					})
+					return entity.FindAttendanceResult{List: []entity.Attendance{attendance}}, nil
+				})
+			},
+		},
+		{
+			name:           "success - last page has no next cursor",
+			authCredential: admin,
+			cursor:         &resourceful.Cursor{Key: "2024-01-11", Value: uuid.NewString()},
+			expectedMetadata: entity.ListAttendanceMetadata{
+				Count: 1,
+				Limit: 1,
+				IDs:   []string{"attendance-2"},
+			},
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendances(gomock.Any(), entity.FindAttendanceFilter{}, gomock.Any()).DoAndReturn(func(ctx context.Context, filter entity.FindAttendanceFilter, opts ...entity.FindAttendanceOptions) (entity.FindAttendanceResult, error) {
+					opts[0].ResourcefulParameter.SetAdditionalData(entity.ListAttendanceMetadata{
+						Count: 1,
+						Limit: 1,
+						IDs:   []string{"attendance-2"},
+					})
+					return entity.FindAttendanceResult{List: []entity.Attendance{attendance}}, nil
+				})
+			},
+		},
+		{
+			name: "error - non-admin user",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - invalid cursor",
+			authCredential: admin,
+			cursor:         &resourceful.Cursor{Key: "yesterday", Value: "attendance-2"},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidCursor,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidCursor),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - from after to",
+			authCredential: admin,
+			filter: entity.ListAttendanceFilter{
+				StartDate: optional.NewTime(period.EndDate),
+				EndDate:   optional.NewTime(period.StartDate),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.AttendanceInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceInvalidRange),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: admin,
+			filter: entity.ListAttendanceFilter{
+				PeriodID: optional.NewString("period-9"),
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  "period-9",
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindPeriodByID(gomock.Any(), "period-9").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - find attendances failed",
+			authCredential: admin,
+			expectedErr:    errors.New("AttendanceUseCase.ListAttendances().FindAttendances(): db error"),
+			setupMock: func(repo *mockAttendance.MockRepository) {
+				repo.EXPECT().FindAttendances(gomock.Any(), gomock.Any(), gomock.Any()).Return(entity.FindAttendanceResult{}, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, nil, nil, nil, usecase.AttendanceConfig{})
+
+			resource := resourceful.NewResource[string, dtos.AttendanceResponse](&resourceful.Parameter{
+				Limit:  optional.NewInt64(1),
+				Mode:   resourceful.ModeCursor,
+				Cursor: tt.cursor,
+			})
+			result, err := useCase.ListAttendances(context.Background(), tt.authCredential, tt.filter, resource)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedMetadata, result.Metadata)
+			assert.Equal(t, []string{"attendance-2"}, result.Result.IDs)
+			assert.Equal(t, dtos.NewListAttendanceResponse([]entity.Attendance{attendance}), result.Result.PaginationResult)
+		})
+	}
+}
+
+func TestShowAttendanceCalendar(t *testing.T) {
+	month := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mockNow := time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC)
+	lead := authCredential.Credential{
+		UserID:  "lead-1",
+		IsAdmin: func(b bool) *bool { return &b }(false),
+	}
+
+	users := userEntity.FindUserResult{
+		List: []userEntity.User{
+			{ID: "user-1", Username: "bob", ManagerID: optional.NewString("lead-1"), EmploymentStartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{ID: "user-2", Username: "alice", ManagerID: optional.NewString("lead-1"), EmploymentStartDate: time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+			{ID: "user-3", Username: "carol", ManagerID: optional.NewString("lead-2"), EmploymentStartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{ID: "user-4", Username: "dave", ManagerID: optional.NewString("lead-1"), EmploymentStartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	holidays := []holidayEntity.Holiday{
+		{ID: "holiday-1", HolidayDate: time.Date(2024, 2, 8, 0, 0, 0, 0, time.UTC)},
+	}
+	attendances := entity.FindAttendanceResult{
+		IsMapped: true,
+		MappedBy: entity.MappedByUserID,
+		Mapped: map[any][]entity.Attendance{
+			"user-1": {{ID: "attendance-1", UserID: "user-1", AttendanceDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}},
+		},
+	}
+	leaves := leaveEntity.FindLeaveRequestResult{
+		IsMapped: true,
+		MappedBy: leaveEntity.MappedByUserID,
+		Mapped: map[any][]leaveEntity.LeaveRequest{
+			"user-1": {{ID: "leave-1", UserID: "user-1", StartDate: time.Date(2024, 2, 5, 0, 0, 0, 0, time.UTC), EndDate: time.Date(2024, 2, 6, 0, 0, 0, 0, time.UTC)}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(repo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository, userRepo *mockUser.MockRepository, leaveRepo *mockLeave.MockRepository)
+	}{
+		{
+			name:           "success - direct reports shown",
+			authCredential: lead,
+			setupMock: func(repo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository, userRepo *mockUser.MockRepository, leaveRepo *mockLeave.MockRepository) {
+				userRepo.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(users, nil)
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), month, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)).Return(holidays, nil)
+				repo.EXPECT().FindAttendanceByPeriod(gomock.Any(), month, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), gomock.Any()).Return(attendances, nil)
+				leaveRepo.EXPECT().FindApprovedLeaveByRange(gomock.Any(), month, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), gomock.Any()).Return(leaves, nil)
+			},
+		},
+		{
+			name: "error - no direct reports",
+			authCredential: authCredential.Credential{
+				UserID:  "user-1",
+				IsAdmin: func(b bool) *bool { return &b }(false),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.AttendanceNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendanceNotAuthorized),
+				}),
+			setupMock: func(repo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository, userRepo *mockUser.MockRepository, leaveRepo *mockLeave.MockRepository) {
+				userRepo.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(users, nil)
+			},
+		},
+		{
+			name:           "error - find approved leave failed",
+			authCredential: lead,
+			expectedErr:    errors.New("AttendanceUseCase.ShowAttendanceCalendar().FindApprovedLeaveByRange(): db error"),
+			setupMock: func(repo *mockAttendance.MockRepository, holidayRepo *mockHoliday.MockRepository, userRepo *mockUser.MockRepository, leaveRepo *mockLeave.MockRepository) {
+				userRepo.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(users, nil)
+				holidayRepo.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(holidays, nil)
+				repo.EXPECT().FindAttendanceByPeriod(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(attendances, nil)
+				leaveRepo.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch := gomonkey.NewPatches()
+			defer patch.Reset()
+
+			patch.ApplyFunc(time.Now, func() time.Time {
+				return mockNow
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockAttendance.NewMockRepository(ctrl)
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
+			mockLeaveRepo := mockLeave.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockHolidayRepo, mockUserRepo, mockLeaveRepo)
+
+			useCase := usecase.NewAttendanceUseCase(mockRepo, mockHolidayRepo, mockUserRepo, mockLeaveRepo, usecase.AttendanceConfig{})
+
+			result, err := useCase.ShowAttendanceCalendar(context.Background(), tt.authCredential, month)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result.Dates, 29)
+			assert.Len(t, result.Rows, 2)
+
+			alice, bob := result.Rows[0], result.Rows[1]
+			assert.Equal(t, "alice", alice.Username)
+			assert.Equal(t, entity.AttendanceCalendarStatusNotEmployed, alice.Days[8])
+			assert.Equal(t, entity.AttendanceCalendarStatusWeekend, alice.Days[9])
+			assert.Equal(t, int64(4), alice.AbsentDays)
+
+			assert.Equal(t, "bob", bob.Username)
+			assert.Equal(t, []entity.AttendanceCalendarStatus{
+				entity.AttendanceCalendarStatusPresent,
+				entity.AttendanceCalendarStatusAbsent,
+				entity.AttendanceCalendarStatusWeekend,
+				entity.AttendanceCalendarStatusWeekend,
+				entity.AttendanceCalendarStatusLeave,
+				entity.AttendanceCalendarStatusLeave,
+				entity.AttendanceCalendarStatusAbsent,
+				entity.AttendanceCalendarStatusHoliday,
+			}, bob.Days[:8])
+			assert.Equal(t, entity.AttendanceCalendarStatusAbsent, bob.Days[14])
+			assert.Equal(t, entity.AttendanceCalendarStatusUpcoming, bob.Days[15])
+			assert.Equal(t, int64(1), bob.PresentDays)
+			assert.Equal(t, int64(2), bob.LeaveDays)
+			assert.Equal(t, int64(7), bob.AbsentDays)
 		})
 	}
 }
@@ -0,0 +1,111 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type BankAccountHandler struct {
+	uc bankaccount.UseCase
+}
+
+func NewBankAccountHandler(uc bankaccount.UseCase) *BankAccountHandler {
+	return &BankAccountHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      Show Bank Account
+// @Description  Show the bank account the take-home pay of a user is transferred to
+// @Tags         Bank Account
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} dtos.Response{data=dtos.BankAccountResponse} "Bank Account Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/bank-account [GET]
+// @Security     BearerAuth
+func (h *BankAccountHandler) ShowBankAccount(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"BankAccountHandler.ShowBankAccount()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "BankAccountHandler().ShowBankAccount().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowBankAccount(ctx, authCredential, param.UserID.String())
+	if err != nil {
+		return errors.Wrap(err, "BankAccountHandler().ShowBankAccount().uc.ShowBankAccount()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewBankAccountResponse(data),
+		},
+	)
+}
+
+// @Summary      Upsert Bank Account
+// @Description  Set the bank account of a user, replacing the previous one
+// @Tags         Bank Account
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.BankAccountRequest true "Bank Account Request"
+// @Success      200 {object} dtos.Response{data=dtos.BankAccountResponse} "Bank Account Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/bank-account [PUT]
+// @Security     BearerAuth
+func (h *BankAccountHandler) UpsertBankAccount(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"BankAccountHandler.UpsertBankAccount()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "BankAccountHandler().UpsertBankAccount().c.ParamsParser()")
+	}
+
+	var req dtos.BankAccountRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "BankAccountHandler().UpsertBankAccount().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "BankAccountHandler().UpsertBankAccount().req.Validate()")
+	}
+
+	data, err := h.uc.UpsertBankAccount(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "BankAccountHandler().UpsertBankAccount().uc.UpsertBankAccount()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewBankAccountResponse(data),
+		},
+	)
+}
@@ -0,0 +1,10 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapBankAccount(routes fiber.Router, h *BankAccountHandler) {
+	bankAccount := routes.Group("/users/:userId/bank-account")
+
+	bankAccount.Get("/", h.ShowBankAccount)
+	bankAccount.Put("/", h.UpsertBankAccount)
+}
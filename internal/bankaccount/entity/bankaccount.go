@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// BankAccount is the account the take-home pay of a user is transferred to.
+// AccountNumber holds the IBAN in countries that use one.
+type BankAccount struct {
+	ID                string          `db:"id"`
+	UserID            string          `db:"user_id"`
+	AccountHolderName string          `db:"account_holder_name"`
+	AccountNumber     string          `db:"account_number"`
+	BankName          string          `db:"bank_name"`
+	BankCode          string          `db:"bank_code"`
+	BIC               optional.String `db:"bic"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+	CreatedBy         string          `db:"created_by"`
+	UpdatedBy         string          `db:"updated_by"`
+	IPAddress         string          `db:"ip_address"`
+}
+
+type UpsertBankAccount struct {
+	AccountHolderName string
+	AccountNumber     string
+	BankName          string
+	BankCode          string
+	BIC               optional.String
+}
+
+type MappedBy string
+
+const (
+	MappedByUserID MappedBy = "user_id"
+)
+
+type MappedOptions struct {
+	MappedBy MappedBy
+}
+
+type FindBankAccountOptions struct {
+	*MappedOptions
+}
+
+type FindBankAccountResult struct {
+	List     []BankAccount
+	Mapped   map[any][]BankAccount
+	IsMapped bool
+	MappedBy MappedBy
+}
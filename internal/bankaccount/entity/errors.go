@@ -0,0 +1,20 @@
+package entity
+
+const (
+	BankAccountNotAuthorized = "BANK_ACCOUNT_NOT_AUTHORIZED"
+	BankAccountUserNotFound  = "BANK_ACCOUNT_USER_NOT_FOUND"
+	BankAccountNotFound      = "BANK_ACCOUNT_NOT_FOUND"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case BankAccountNotAuthorized:
+		return "You are not authorized to manage this bank account"
+	case BankAccountUserNotFound:
+		return "User not found"
+	case BankAccountNotFound:
+		return "Bank account not found"
+	default:
+		return "An unknown error occurred"
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/bankaccount/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/bankaccount/repository.go -destination internal/bankaccount/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	bankaccount "github.com/vnnyx/employee-management/internal/bankaccount"
+	entity "github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBankAccountByUserID mocks base method.
+func (m *MockRepository) FindBankAccountByUserID(ctx context.Context, userID string) (*entity.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBankAccountByUserID", ctx, userID)
+	ret0, _ := ret[0].(*entity.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBankAccountByUserID indicates an expected call of FindBankAccountByUserID.
+func (mr *MockRepositoryMockRecorder) FindBankAccountByUserID(ctx, userID any) *MockRepositoryFindBankAccountByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBankAccountByUserID", reflect.TypeOf((*MockRepository)(nil).FindBankAccountByUserID), ctx, userID)
+	return &MockRepositoryFindBankAccountByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindBankAccountByUserIDCall wrap *gomock.Call
+type MockRepositoryFindBankAccountByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindBankAccountByUserIDCall) Return(arg0 *entity.BankAccount, arg1 error) *MockRepositoryFindBankAccountByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindBankAccountByUserIDCall) Do(f func(context.Context, string) (*entity.BankAccount, error)) *MockRepositoryFindBankAccountByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindBankAccountByUserIDCall) DoAndReturn(f func(context.Context, string) (*entity.BankAccount, error)) *MockRepositoryFindBankAccountByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindBankAccountsByUserIDs mocks base method.
+func (m *MockRepository) FindBankAccountsByUserIDs(ctx context.Context, userIDs []string, opts ...entity.FindBankAccountOptions) (entity.FindBankAccountResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userIDs}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindBankAccountsByUserIDs", varargs...)
+	ret0, _ := ret[0].(entity.FindBankAccountResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBankAccountsByUserIDs indicates an expected call of FindBankAccountsByUserIDs.
+func (mr *MockRepositoryMockRecorder) FindBankAccountsByUserIDs(ctx, userIDs any, opts ...any) *MockRepositoryFindBankAccountsByUserIDsCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userIDs}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBankAccountsByUserIDs", reflect.TypeOf((*MockRepository)(nil).FindBankAccountsByUserIDs), varargs...)
+	return &MockRepositoryFindBankAccountsByUserIDsCall{Call: call}
+}
+
+// MockRepositoryFindBankAccountsByUserIDsCall wrap *gomock.Call
+type MockRepositoryFindBankAccountsByUserIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindBankAccountsByUserIDsCall) Return(arg0 entity.FindBankAccountResult, arg1 error) *MockRepositoryFindBankAccountsByUserIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindBankAccountsByUserIDsCall) Do(f func(context.Context, []string, ...entity.FindBankAccountOptions) (entity.FindBankAccountResult, error)) *MockRepositoryFindBankAccountsByUserIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindBankAccountsByUserIDsCall) DoAndReturn(f func(context.Context, []string, ...entity.FindBankAccountOptions) (entity.FindBankAccountResult, error)) *MockRepositoryFindBankAccountsByUserIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpsertBankAccount mocks base method.
+func (m *MockRepository) UpsertBankAccount(ctx context.Context, bankAccount entity.BankAccount) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBankAccount", ctx, bankAccount)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBankAccount indicates an expected call of UpsertBankAccount.
+func (mr *MockRepositoryMockRecorder) UpsertBankAccount(ctx, bankAccount any) *MockRepositoryUpsertBankAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBankAccount", reflect.TypeOf((*MockRepository)(nil).UpsertBankAccount), ctx, bankAccount)
+	return &MockRepositoryUpsertBankAccountCall{Call: call}
+}
+
+// MockRepositoryUpsertBankAccountCall wrap *gomock.Call
+type MockRepositoryUpsertBankAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpsertBankAccountCall) Return(arg0 error) *MockRepositoryUpsertBankAccountCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpsertBankAccountCall) Do(f func(context.Context, entity.BankAccount) error) *MockRepositoryUpsertBankAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpsertBankAccountCall) DoAndReturn(f func(context.Context, entity.BankAccount) error) *MockRepositoryUpsertBankAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) bankaccount.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(bankaccount.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 bankaccount.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) bankaccount.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) bankaccount.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
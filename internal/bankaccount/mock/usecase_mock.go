@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/bankaccount/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/bankaccount/usecase.go -destination internal/bankaccount/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ShowBankAccount mocks base method.
+func (m *MockUseCase) ShowBankAccount(ctx context.Context, authCredential entity.Credential, userID string) (entity0.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowBankAccount", ctx, authCredential, userID)
+	ret0, _ := ret[0].(entity0.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowBankAccount indicates an expected call of ShowBankAccount.
+func (mr *MockUseCaseMockRecorder) ShowBankAccount(ctx, authCredential, userID any) *MockUseCaseShowBankAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowBankAccount", reflect.TypeOf((*MockUseCase)(nil).ShowBankAccount), ctx, authCredential, userID)
+	return &MockUseCaseShowBankAccountCall{Call: call}
+}
+
+// MockUseCaseShowBankAccountCall wrap *gomock.Call
+type MockUseCaseShowBankAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowBankAccountCall) Return(arg0 entity0.BankAccount, arg1 error) *MockUseCaseShowBankAccountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowBankAccountCall) Do(f func(context.Context, entity.Credential, string) (entity0.BankAccount, error)) *MockUseCaseShowBankAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowBankAccountCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.BankAccount, error)) *MockUseCaseShowBankAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpsertBankAccount mocks base method.
+func (m *MockUseCase) UpsertBankAccount(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.UpsertBankAccount) (entity0.BankAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBankAccount", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.BankAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBankAccount indicates an expected call of UpsertBankAccount.
+func (mr *MockUseCaseMockRecorder) UpsertBankAccount(ctx, authCredential, userID, payload any) *MockUseCaseUpsertBankAccountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBankAccount", reflect.TypeOf((*MockUseCase)(nil).UpsertBankAccount), ctx, authCredential, userID, payload)
+	return &MockUseCaseUpsertBankAccountCall{Call: call}
+}
+
+// MockUseCaseUpsertBankAccountCall wrap *gomock.Call
+type MockUseCaseUpsertBankAccountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpsertBankAccountCall) Return(arg0 entity0.BankAccount, arg1 error) *MockUseCaseUpsertBankAccountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpsertBankAccountCall) Do(f func(context.Context, entity.Credential, string, entity0.UpsertBankAccount) (entity0.BankAccount, error)) *MockUseCaseUpsertBankAccountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpsertBankAccountCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpsertBankAccount) (entity0.BankAccount, error)) *MockUseCaseUpsertBankAccountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+package bankaccount
+
+import (
+	"context"
+
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	UpsertBankAccount(ctx context.Context, bankAccount entity.BankAccount) error
+	FindBankAccountByUserID(ctx context.Context, userID string) (*entity.BankAccount, error)
+	FindBankAccountsByUserIDs(ctx context.Context, userIDs []string, opts ...entity.FindBankAccountOptions) (entity.FindBankAccountResult, error)
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/bankaccount"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type bankAccountRepo struct {
+	db database.Queryer
+}
+
+func NewBankAccountRepository(db database.Queryer) bankaccount.Repository {
+	return &bankAccountRepo{
+		db: db,
+	}
+}
+
+func (r *bankAccountRepo) WithTx(tx database.DBTx) bankaccount.Repository {
+	return &bankAccountRepo{
+		db: tx,
+	}
+}
+
+func (r *bankAccountRepo) UpsertBankAccount(ctx context.Context, bankAccount entity.BankAccount) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"BankAccountRepository.UpsertBankAccount()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(upsertBankAccountQuery, bankAccount)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to upsert bank account"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *bankAccountRepo) FindBankAccountByUserID(ctx context.Context, userID string) (*entity.BankAccount, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"BankAccountRepository.FindBankAccountByUserID()",
+	)
+	defer span.End()
+
+	var bankAccount entity.BankAccount
+	err := pgxscan.Get(ctx, r.db, &bankAccount, findBankAccountByUserIDQuery, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &bankAccount, nil
+}
+
+func (r *bankAccountRepo) FindBankAccountsByUserIDs(ctx context.Context, userIDs []string, opts ...entity.FindBankAccountOptions) (entity.FindBankAccountResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"BankAccountRepository.FindBankAccountsByUserIDs()",
+	)
+	defer span.End()
+
+	var result entity.FindBankAccountResult
+
+	var bankAccounts []entity.BankAccount
+	err := pgxscan.Select(ctx, r.db, &bankAccounts, findBankAccountsByUserIDsQuery, userIDs)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = bankAccounts
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedBankAccounts := make(map[any][]entity.BankAccount)
+		var keyFunc func(bankAccount entity.BankAccount) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(bankAccount entity.BankAccount) any {
+				return bankAccount.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range bankAccounts {
+			key := keyFunc(item)
+			mappedBankAccounts[key] = append(mappedBankAccounts[key], item)
+		}
+
+		result.Mapped = mappedBankAccounts
+	}
+
+	return result, nil
+}
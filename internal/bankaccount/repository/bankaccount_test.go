@@ -0,0 +1,255 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount/repository"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var bankAccountColumns = []string{
+	"id", "user_id", "account_holder_name", "account_number", "bank_name", "bank_code", "bic",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func TestUpsertBankAccount(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewBankAccountRepository(mock)
+	now := time.Now()
+
+	anyArgs := func() []any {
+		args := make([]any, len(bankAccountColumns))
+		for i := range args {
+			args[i] = pgxmock.AnyArg()
+		}
+		return args
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.BankAccount
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO user_bank_accounts (.+) ON CONFLICT \\(user_id\\) DO UPDATE").
+					WithArgs(anyArgs()...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("account-1"))
+			},
+			input: entity.BankAccount{
+				ID:                "account-1",
+				UserID:            "user-1",
+				AccountHolderName: "Alice Smith",
+				AccountNumber:     "NL91ABNA0417164300",
+				BankName:          "ABN AMRO",
+				BankCode:          "ABNA",
+				BIC:               optional.NewString("ABNANL2A"),
+				CreatedAt:         now,
+				UpdatedAt:         now,
+				CreatedBy:         "admin",
+				UpdatedBy:         "admin",
+				IPAddress:         "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO user_bank_accounts").
+					WithArgs(anyArgs()...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.BankAccount{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO user_bank_accounts").
+					WithArgs(anyArgs()...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.BankAccount{ID: "account-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpsertBankAccount(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindBankAccountByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewBankAccountRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		userID    string
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id").
+					WithArgs("user-1").
+					WillReturnRows(pgxmock.NewRows(bankAccountColumns).
+						AddRow("account-1", "user-1", "Alice Smith", "NL91ABNA0417164300", "ABN AMRO", "ABNA", optional.NewString("ABNANL2A"), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			userID:    "user-1",
+			expectNil: false,
+			expectErr: false,
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id").
+					WithArgs("user-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			userID:    "user-2",
+			expectNil: true,
+			expectErr: false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id").
+					WithArgs("user-3").
+					WillReturnError(errors.New("db error"))
+			},
+			userID:    "user-3",
+			expectNil: true,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindBankAccountByUserID(context.Background(), tt.userID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, "NL91ABNA0417164300", result.AccountNumber)
+				assert.Equal(t, optional.NewString("ABNANL2A"), result.BIC)
+			}
+		})
+	}
+}
+
+func TestFindBankAccountsByUserIDs(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewBankAccountRepository(mock)
+	now := time.Now()
+	userIDs := []string{"user-1", "user-2"}
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindBankAccountOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id = ANY").
+					WithArgs(userIDs).
+					WillReturnRows(pgxmock.NewRows(bankAccountColumns).
+						AddRow("account-1", "user-1", "Alice Smith", "NL91ABNA0417164300", "ABN AMRO", "ABNA", optional.NewString("ABNANL2A"), now, now, "admin", "admin", "127.0.0.1").
+						AddRow("account-2", "user-2", "Bob Jones", "1234567890", "Bank Central Asia", "014", optional.String{}, now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindBankAccountOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID}},
+			},
+			expectedLen:    2,
+			expectedMapped: map[any]int{"user-1": 1, "user-2": 1},
+			expectErr:      false,
+		},
+		{
+			name: "success - not mapped",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id = ANY").
+					WithArgs(userIDs).
+					WillReturnRows(pgxmock.NewRows(bankAccountColumns).
+						AddRow("account-1", "user-1", "Alice Smith", "NL91ABNA0417164300", "ABN AMRO", "ABNA", optional.NewString("ABNANL2A"), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expectedLen: 1,
+			expectErr:   false,
+		},
+		{
+			name: "error - unsupported mapped by",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id = ANY").
+					WithArgs(userIDs).
+					WillReturnRows(pgxmock.NewRows(bankAccountColumns))
+			},
+			opts: []entity.FindBankAccountOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: "unknown"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM user_bank_accounts WHERE user_id = ANY").
+					WithArgs(userIDs).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindBankAccountsByUserIDs(context.Background(), userIDs, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.List, tt.expectedLen)
+				assert.Equal(t, tt.expectedMapped != nil, result.IsMapped)
+				for key, count := range tt.expectedMapped {
+					assert.Len(t, result.Mapped[key], count)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package repository
+
+// An existing account of the user is replaced, the previous values stay in
+// the audit log.
+const upsertBankAccountQuery = `
+INSERT INTO user_bank_accounts (
+	id,
+	user_id,
+	account_holder_name,
+	account_number,
+	bank_name,
+	bank_code,
+	bic,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:account_holder_name,
+	:account_number,
+	:bank_name,
+	:bank_code,
+	:bic,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+ON CONFLICT (user_id) DO UPDATE SET
+	account_holder_name = EXCLUDED.account_holder_name,
+	account_number = EXCLUDED.account_number,
+	bank_name = EXCLUDED.bank_name,
+	bank_code = EXCLUDED.bank_code,
+	bic = EXCLUDED.bic,
+	updated_at = EXCLUDED.updated_at,
+	updated_by = EXCLUDED.updated_by,
+	ip_address = EXCLUDED.ip_address
+RETURNING id
+`
+
+const findBankAccountByUserIDQuery = `
+SELECT
+	id,
+	user_id,
+	account_holder_name,
+	account_number,
+	bank_name,
+	bank_code,
+	bic,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM user_bank_accounts
+WHERE user_id = $1
+`
+
+const findBankAccountsByUserIDsQuery = `
+SELECT
+	id,
+	user_id,
+	account_holder_name,
+	account_number,
+	bank_name,
+	bank_code,
+	bic,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM user_bank_accounts
+WHERE user_id = ANY($1)
+`
@@ -0,0 +1,13 @@
+package bankaccount
+
+import (
+	"context"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+)
+
+type UseCase interface {
+	ShowBankAccount(ctx context.Context, authCredential authCredential.Credential, userID string) (entity.BankAccount, error)
+	UpsertBankAccount(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpsertBankAccount) (entity.BankAccount, error)
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type bankAccountUseCase struct {
+	bankAccountRepo bankaccount.Repository
+	userRepo        users.Repository
+}
+
+func NewBankAccountUseCase(bankAccountRepo bankaccount.Repository, userRepo users.Repository) bankaccount.UseCase {
+	return &bankAccountUseCase{
+		bankAccountRepo: bankAccountRepo,
+		userRepo:        userRepo,
+	}
+}
+
+// ShowBankAccount returns the bank account of a user, employees can see their
+// own account only.
+func (u *bankAccountUseCase) ShowBankAccount(ctx context.Context, authCredential authCredential.Credential, userID string) (entity.BankAccount, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"BankAccountUseCase.ShowBankAccount()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin && authCredential.UserID != userID {
+		return entity.BankAccount{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.BankAccountNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotAuthorized),
+			},
+		)
+	}
+
+	bankAccount, err := u.bankAccountRepo.FindBankAccountByUserID(ctx, userID)
+	if err != nil {
+		return entity.BankAccount{}, errors.Wrap(err, "BankAccountUseCase.ShowBankAccount().FindBankAccountByUserID()")
+	}
+	if bankAccount == nil {
+		return entity.BankAccount{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.BankAccountNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	return *bankAccount, nil
+}
+
+// UpsertBankAccount sets the bank account of a user, replacing the previous
+// one. Only admins can change where salaries are paid to.
+func (u *bankAccountUseCase) UpsertBankAccount(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpsertBankAccount) (entity.BankAccount, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"BankAccountUseCase.UpsertBankAccount()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.BankAccount{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.BankAccountNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotAuthorized),
+			},
+		)
+	}
+
+	var storedBankAccount entity.BankAccount
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		bankAccountRepoTx := u.bankAccountRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		user, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "BankAccountUseCase.UpsertBankAccount().FindUserByID()")
+		}
+		if user == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.BankAccountUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountUserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		timeNow := time.Now()
+		err = bankAccountRepoTx.UpsertBankAccount(ctx, entity.BankAccount{
+			ID:                uuid.NewString(),
+			UserID:            userID,
+			AccountHolderName: payload.AccountHolderName,
+			AccountNumber:     payload.AccountNumber,
+			BankName:          payload.BankName,
+			BankCode:          payload.BankCode,
+			BIC:               payload.BIC,
+			CreatedAt:         timeNow,
+			UpdatedAt:         timeNow,
+			CreatedBy:         authCredential.UserID,
+			UpdatedBy:         authCredential.UserID,
+			IPAddress:         authCredential.IPAddress,
+		})
+		if err != nil {
+			return errors.Wrap(err, "BankAccountUseCase.UpsertBankAccount().UpsertBankAccount()")
+		}
+
+		// An update keeps the ID and creation audit of the existing account
+		bankAccount, err := bankAccountRepoTx.FindBankAccountByUserID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "BankAccountUseCase.UpsertBankAccount().FindBankAccountByUserID()")
+		}
+		if bankAccount == nil {
+			return errors.New("BankAccountUseCase.UpsertBankAccount(): bank account not found after upsert")
+		}
+		storedBankAccount = *bankAccount
+
+		return nil
+	})
+	if err != nil {
+		return entity.BankAccount{}, errors.Wrap(err, "BankAccountUseCase.UpsertBankAccount().WithAuditContext()")
+	}
+
+	return storedBankAccount, nil
+}
@@ -0,0 +1,261 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	mockBankAccount "github.com/vnnyx/employee-management/internal/bankaccount/mock"
+	"github.com/vnnyx/employee-management/internal/bankaccount/usecase"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	bankAccountRepo   *mockBankAccount.MockRepository
+	bankAccountRepoTx *mockBankAccount.MockRepository
+	userRepo          *mockUser.MockRepository
+	userRepoTx        *mockUser.MockRepository
+}
+
+func TestShowBankAccount(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		userID         string
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	bankAccount := &entity.BankAccount{
+		ID:                "account-1",
+		UserID:            "user-1",
+		AccountHolderName: "Test User",
+		AccountNumber:     "NL91ABNA0417164300",
+		BankName:          "ABN AMRO",
+		BankCode:          "ABNA",
+		BIC:               optional.NewString("ABNANL2A"),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - admin sees any account",
+			authCredential: adminCredential,
+			userID:         "user-1",
+			setupMock: func(m mockParams) {
+				m.bankAccountRepo.EXPECT().FindBankAccountByUserID(gomock.Any(), "user-1").Return(bankAccount, nil)
+			},
+		},
+		{
+			name:           "success - employee sees own account",
+			authCredential: userCredential,
+			userID:         "user-1",
+			setupMock: func(m mockParams) {
+				m.bankAccountRepo.EXPECT().FindBankAccountByUserID(gomock.Any(), "user-1").Return(bankAccount, nil)
+			},
+		},
+		{
+			name:           "error - account not found",
+			authCredential: adminCredential,
+			userID:         "user-2",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.BankAccountNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotFound),
+					Received:  "user-2",
+				}),
+			setupMock: func(m mockParams) {
+				m.bankAccountRepo.EXPECT().FindBankAccountByUserID(gomock.Any(), "user-2").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - employee sees another account",
+			authCredential: userCredential,
+			userID:         "user-2",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.BankAccountNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				bankAccountRepoTx: mockBankAccount.NewMockRepository(ctrl),
+				userRepo:          mockUser.NewMockRepository(ctrl),
+				userRepoTx:        mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewBankAccountUseCase(m.bankAccountRepo, m.userRepo)
+
+			result, err := useCase.ShowBankAccount(context.Background(), tt.authCredential, tt.userID)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, *bankAccount, result)
+			}
+		})
+	}
+}
+
+func TestUpsertBankAccount(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.UpsertBankAccount{
+		AccountHolderName: "Test User",
+		AccountNumber:     "NL91ABNA0417164300",
+		BankName:          "ABN AMRO",
+		BankCode:          "ABNA",
+		BIC:               optional.NewString("ABNANL2A"),
+	}
+
+	stored := &entity.BankAccount{
+		ID:                "account-1",
+		UserID:            "user-1",
+		AccountHolderName: "Test User",
+		AccountNumber:     "NL91ABNA0417164300",
+		BankName:          "ABN AMRO",
+		BankCode:          "ABNA",
+		BIC:               optional.NewString("ABNANL2A"),
+		CreatedAt:         time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedBy:         "admin-1",
+		UpdatedBy:         "admin-1",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - account stored",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.bankAccountRepo.EXPECT().WithTx(gomock.Any()).Return(m.bankAccountRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.bankAccountRepoTx.EXPECT().UpsertBankAccount(gomock.Any(), mock.MatchedBy(func(args entity.BankAccount) bool {
+					return testutil.EqualVerbose(
+						entity.BankAccount{
+							UserID:            "user-1",
+							AccountHolderName: "Test User",
+							AccountNumber:     "NL91ABNA0417164300",
+							BankName:          "ABN AMRO",
+							BankCode:          "ABNA",
+							BIC:               optional.NewString("ABNANL2A"),
+							CreatedBy:         "admin-1",
+							UpdatedBy:         "admin-1",
+							IPAddress:         "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.BankAccount{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+				m.bankAccountRepoTx.EXPECT().FindBankAccountByUserID(gomock.Any(), "user-1").Return(stored, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.BankAccountUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.bankAccountRepo.EXPECT().WithTx(gomock.Any()).Return(m.bankAccountRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.BankAccountNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				bankAccountRepoTx: mockBankAccount.NewMockRepository(ctrl),
+				userRepo:          mockUser.NewMockRepository(ctrl),
+				userRepoTx:        mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewBankAccountUseCase(m.bankAccountRepo, m.userRepo)
+
+			result, err := useCase.UpsertBankAccount(context.Background(), tt.authCredential, "user-1", payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, *stored, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package dtos
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/invopop/validation/is"
+	"github.com/vnnyx/employee-management/internal/adjustment/entity"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// Codes are shown next to deduction codes on the payslip.
+var earningCodePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+type AllowanceRequest struct {
+	Code      string          `json:"code" validate:"required"`
+	Name      string          `json:"name" validate:"required"`
+	Amount    money.Money     `json:"amount" validate:"required"`
+	Taxable   *bool           `json:"taxable" validate:"required"`
+	StartDate string          `json:"start_date" validate:"required"`
+	EndDate   optional.String `json:"end_date,omitempty"`
+}
+
+func (r *AllowanceRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Code, validation.Required, validation.Match(earningCodePattern)),
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&r.Amount, validation.By(positiveAmount)),
+		validation.Field(&r.Taxable, validation.NotNil),
+		validation.Field(&r.StartDate, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.EndDate, validation.By(optionalDate)),
+	)
+}
+
+func (r *AllowanceRequest) ToRequestEntity() entity.CreateAllowance {
+	startDate, _ := time.Parse(dateFormat, r.StartDate)
+
+	endDate := optional.NewTime()
+	r.EndDate.IfPresent(func(value string) {
+		parsedDate, _ := time.Parse(dateFormat, value)
+		endDate.Set(parsedDate)
+	})
+
+	return entity.CreateAllowance{
+		Code:      r.Code,
+		Name:      strings.TrimSpace(r.Name),
+		Amount:    r.Amount,
+		Taxable:   *r.Taxable,
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+}
+
+type AllowanceResponse struct {
+	ID        string          `json:"id"`
+	UserID    string          `json:"user_id"`
+	Code      string          `json:"code"`
+	Name      string          `json:"name"`
+	Amount    money.Money     `json:"amount"`
+	Taxable   bool            `json:"taxable"`
+	StartDate string          `json:"start_date"`
+	EndDate   optional.String `json:"end_date"`
+	CreatedBy string          `json:"created_by"`
+	CreatedAt string          `json:"created_at"`
+}
+
+func NewAllowanceResponse(allowance entity.Allowance) AllowanceResponse {
+	response := AllowanceResponse{
+		ID:        allowance.ID,
+		UserID:    allowance.UserID,
+		Code:      allowance.Code,
+		Name:      allowance.Name,
+		Amount:    allowance.Amount,
+		Taxable:   allowance.Taxable,
+		StartDate: allowance.StartDate.Format(dateFormat),
+		EndDate:   optional.NewString(),
+		CreatedBy: allowance.CreatedBy,
+		CreatedAt: allowance.CreatedAt.Format(time.RFC3339),
+	}
+	if endDate, ok := allowance.EndDate.Get(); ok {
+		response.EndDate = optional.NewString(endDate.Format(dateFormat))
+	}
+	return response
+}
+
+func NewListAllowanceResponse(allowances []entity.Allowance) []AllowanceResponse {
+	responses := make([]AllowanceResponse, len(allowances))
+	for i, item := range allowances {
+		responses[i] = NewAllowanceResponse(item)
+	}
+	return responses
+}
+
+type AdjustmentRequest struct {
+	PeriodID string          `json:"period_id" validate:"required"`
+	Code     string          `json:"code" validate:"required"`
+	Name     string          `json:"name" validate:"required"`
+	Amount   money.Money     `json:"amount" validate:"required"`
+	Taxable  *bool           `json:"taxable" validate:"required"`
+	Reason   optional.String `json:"reason,omitempty"`
+}
+
+func (r *AdjustmentRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.PeriodID, validation.Required, is.UUIDv4),
+		validation.Field(&r.Code, validation.Required, validation.Match(earningCodePattern)),
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 255)),
+		validation.Field(&r.Amount, validation.By(nonZeroAmount)),
+		validation.Field(&r.Taxable, validation.NotNil),
+	)
+}
+
+func (r *AdjustmentRequest) ToRequestEntity() entity.CreateAdjustment {
+	return entity.CreateAdjustment{
+		PeriodID: r.PeriodID,
+		Code:     r.Code,
+		Name:     strings.TrimSpace(r.Name),
+		Amount:   r.Amount,
+		Taxable:  *r.Taxable,
+		Reason:   r.Reason,
+	}
+}
+
+// nonZeroAmount accepts negative amounts, a correction can take money back.
+func nonZeroAmount(value any) error {
+	amount, ok := value.(money.Money)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if amount.IsZero() {
+		return validation.ErrRequired
+	}
+	return nil
+}
+
+type AdjustmentResponse struct {
+	ID        string          `json:"id"`
+	UserID    string          `json:"user_id"`
+	PeriodID  string          `json:"period_id"`
+	Code      string          `json:"code"`
+	Name      string          `json:"name"`
+	Amount    money.Money     `json:"amount"`
+	Taxable   bool            `json:"taxable"`
+	Reason    optional.String `json:"reason"`
+	CreatedBy string          `json:"created_by"`
+	CreatedAt string          `json:"created_at"`
+}
+
+func NewAdjustmentResponse(adjustment entity.Adjustment) AdjustmentResponse {
+	return AdjustmentResponse{
+		ID:        adjustment.ID,
+		UserID:    adjustment.UserID,
+		PeriodID:  adjustment.PeriodID,
+		Code:      adjustment.Code,
+		Name:      adjustment.Name,
+		Amount:    adjustment.Amount,
+		Taxable:   adjustment.Taxable,
+		Reason:    adjustment.Reason,
+		CreatedBy: adjustment.CreatedBy,
+		CreatedAt: adjustment.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListAdjustmentResponse(adjustments []entity.Adjustment) []AdjustmentResponse {
+	responses := make([]AdjustmentResponse, len(adjustments))
+	for i, item := range adjustments {
+		responses[i] = NewAdjustmentResponse(item)
+	}
+	return responses
+}
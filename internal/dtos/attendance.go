@@ -4,7 +4,10 @@ import (
 	"time"
 
 	"github.com/invopop/validation"
+	"github.com/invopop/validation/is"
 	"github.com/vnnyx/employee-management/internal/attendance/entity"
+	"github.com/vnnyx/employee-management/pkg/iso8601"
+	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
 const dateFormat = "2006-01-02"
@@ -36,3 +39,320 @@ func (r *AttendancePeriodRequest) ToRequestEntity() entity.CreateAttendancePerio
 		EndDate:   parseEndDate,
 	}
 }
+
+func (r *AttendancePeriodRequest) ToUpdateEntity() entity.UpdateAttendancePeriod {
+	period := r.ToRequestEntity()
+	return entity.UpdateAttendancePeriod{
+		StartDate: period.StartDate,
+		EndDate:   period.EndDate,
+	}
+}
+
+// AttendanceResponse shows durations in ISO 8601, late_by and early_leave_by
+// are null until the matching clock event is recorded.
+type AttendanceResponse struct {
+	ID             string          `json:"id"`
+	UserID         string          `json:"user_id"`
+	AttendanceDate string          `json:"attendance_date"`
+	ClockInAt      optional.String `json:"clock_in_at"`
+	ClockOutAt     optional.String `json:"clock_out_at"`
+	WorkedDuration string          `json:"worked_duration"`
+	LateBy         optional.String `json:"late_by"`
+	EarlyLeaveBy   optional.String `json:"early_leave_by"`
+}
+
+func NewAttendanceResponse(attendance entity.Attendance) AttendanceResponse {
+	response := AttendanceResponse{
+		ID:             attendance.ID,
+		UserID:         attendance.UserID,
+		AttendanceDate: attendance.AttendanceDate.Format(dateFormat),
+		ClockInAt:      optional.NewString(),
+		ClockOutAt:     optional.NewString(),
+		WorkedDuration: iso8601.ToString(attendance.WorkedDuration()),
+		LateBy:         optional.NewString(),
+		EarlyLeaveBy:   optional.NewString(),
+	}
+	attendance.ClockInAt.IfPresent(func(t time.Time) {
+		response.ClockInAt.Set(t.Format(time.RFC3339))
+	})
+	attendance.ClockOutAt.IfPresent(func(t time.Time) {
+		response.ClockOutAt.Set(t.Format(time.RFC3339))
+	})
+	attendance.LateBy.IfPresent(func(d time.Duration) {
+		response.LateBy.Set(iso8601.ToString(d))
+	})
+	attendance.EarlyLeaveBy.IfPresent(func(d time.Duration) {
+		response.EarlyLeaveBy.Set(iso8601.ToString(d))
+	})
+	return response
+}
+
+func NewListAttendanceResponse(attendances []entity.Attendance) []AttendanceResponse {
+	responses := make([]AttendanceResponse, len(attendances))
+	for i, attendance := range attendances {
+		responses[i] = NewAttendanceResponse(attendance)
+	}
+	return responses
+}
+
+type MyAttendanceRequest struct {
+	From string `query:"from" validate:"required"`
+	To   string `query:"to" validate:"required"`
+}
+
+func (r *MyAttendanceRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.From, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.To, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *MyAttendanceRequest) ToRequestEntity() (time.Time, time.Time) {
+	from, _ := time.Parse(dateFormat, r.From)
+	to, _ := time.Parse(dateFormat, r.To)
+	return from, to
+}
+
+// defaultListAttendanceLimit is the page size when the limit is not given.
+const defaultListAttendanceLimit = 20
+
+type ListAttendancesRequest struct {
+	UserID   string          `query:"user_id"`
+	PeriodID string          `query:"period_id"`
+	From     string          `query:"from"`
+	To       string          `query:"to"`
+	Limit    int64           `query:"limit"`
+	Cursor   optional.String `query:"cursor"`
+}
+
+func (r *ListAttendancesRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.UserID, is.UUID),
+		validation.Field(&r.PeriodID, is.UUID),
+		validation.Field(&r.From, validation.Date(dateFormat)),
+		validation.Field(&r.To, validation.Date(dateFormat)),
+		validation.Field(&r.Limit, validation.Min(int64(0)), validation.Max(int64(100))),
+	)
+}
+
+func (r *ListAttendancesRequest) ToRequestEntity() entity.ListAttendanceFilter {
+	var filter entity.ListAttendanceFilter
+	if r.UserID != "" {
+		filter.UserID = optional.NewString(r.UserID)
+	}
+	if r.PeriodID != "" {
+		filter.PeriodID = optional.NewString(r.PeriodID)
+	}
+	if from, err := time.Parse(dateFormat, r.From); err == nil {
+		filter.StartDate = optional.NewTime(from)
+	}
+	if to, err := time.Parse(dateFormat, r.To); err == nil {
+		filter.EndDate = optional.NewTime(to)
+	}
+	return filter
+}
+
+// PageLimit is the requested page size, or the default when none was given.
+func (r *ListAttendancesRequest) PageLimit() optional.Int64 {
+	if r.Limit == 0 {
+		return optional.NewInt64(defaultListAttendanceLimit)
+	}
+	return optional.NewInt64(r.Limit)
+}
+
+const monthFormat = "2006-01"
+
+type AttendanceCalendarRequest struct {
+	Month string `query:"month" validate:"required"`
+}
+
+func (r *AttendanceCalendarRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Month, validation.Required, validation.Date(monthFormat)),
+	)
+}
+
+func (r *AttendanceCalendarRequest) ToRequestEntity() time.Time {
+	month, _ := time.Parse(monthFormat, r.Month)
+	return month
+}
+
+// AttendanceCalendarResponse lists the statuses of every user in the order
+// of dates.
+type AttendanceCalendarResponse struct {
+	StartDate string                          `json:"start_date"`
+	EndDate   string                          `json:"end_date"`
+	Dates     []string                        `json:"dates"`
+	Users     []AttendanceCalendarRowResponse `json:"users"`
+}
+
+type AttendanceCalendarRowResponse struct {
+	UserID      string   `json:"user_id"`
+	Username    string   `json:"username"`
+	Days        []string `json:"days"`
+	PresentDays int64    `json:"present_days"`
+	AbsentDays  int64    `json:"absent_days"`
+	LeaveDays   int64    `json:"leave_days"`
+}
+
+func NewAttendanceCalendarResponse(calendar entity.AttendanceCalendar) AttendanceCalendarResponse {
+	response := AttendanceCalendarResponse{
+		StartDate: calendar.StartDate.Format(dateFormat),
+		EndDate:   calendar.EndDate.Format(dateFormat),
+		Dates:     make([]string, len(calendar.Dates)),
+		Users:     make([]AttendanceCalendarRowResponse, len(calendar.Rows)),
+	}
+	for i, date := range calendar.Dates {
+		response.Dates[i] = date.Format(dateFormat)
+	}
+	for i, row := range calendar.Rows {
+		days := make([]string, len(row.Days))
+		for j, status := range row.Days {
+			days[j] = string(status)
+		}
+		response.Users[i] = AttendanceCalendarRowResponse{
+			UserID:      row.UserID,
+			Username:    row.Username,
+			Days:        days,
+			PresentDays: row.PresentDays,
+			AbsentDays:  row.AbsentDays,
+			LeaveDays:   row.LeaveDays,
+		}
+	}
+	return response
+}
+
+type AttendancePeriodResponse struct {
+	ID        string          `json:"id"`
+	StartDate string          `json:"start_date"`
+	EndDate   string          `json:"end_date"`
+	Status    string          `json:"status"`
+	PayrollID optional.String `json:"payroll_id"`
+	CreatedBy string          `json:"created_by"`
+	CreatedAt string          `json:"created_at"`
+	UpdatedAt string          `json:"updated_at"`
+}
+
+func NewAttendancePeriodResponse(period entity.AttendancePeriodDetail) AttendancePeriodResponse {
+	return AttendancePeriodResponse{
+		ID:        period.ID,
+		StartDate: period.StartDate.Format(dateFormat),
+		EndDate:   period.EndDate.Format(dateFormat),
+		Status:    string(period.Status()),
+		PayrollID: period.PayrollID,
+		CreatedBy: period.CreatedBy,
+		CreatedAt: period.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: period.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListAttendancePeriodResponse(periods []entity.AttendancePeriodDetail) []AttendancePeriodResponse {
+	responses := make([]AttendancePeriodResponse, len(periods))
+	for i, period := range periods {
+		responses[i] = NewAttendancePeriodResponse(period)
+	}
+	return responses
+}
+
+type ReopenAttendancePeriodRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+func (r *ReopenAttendancePeriodRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, 500)),
+	)
+}
+
+type AttendancePeriodReopeningResponse struct {
+	ID        string `json:"id"`
+	PeriodID  string `json:"period_id"`
+	PayrollID string `json:"payroll_id"`
+	Reason    string `json:"reason"`
+	CreatedBy string `json:"created_by"`
+	CreatedAt string `json:"created_at"`
+}
+
+func NewAttendancePeriodReopeningResponse(reopening entity.AttendancePeriodReopening) AttendancePeriodReopeningResponse {
+	return AttendancePeriodReopeningResponse{
+		ID:        reopening.ID,
+		PeriodID:  reopening.PeriodID,
+		PayrollID: reopening.PayrollID,
+		Reason:    reopening.Reason,
+		CreatedBy: reopening.CreatedBy,
+		CreatedAt: reopening.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type AttendanceCorrectionRequest struct {
+	AttendanceDate string `json:"attendance_date" validate:"required"`
+	Reason         string `json:"reason" validate:"required"`
+}
+
+func (r *AttendanceCorrectionRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.AttendanceDate, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, 500)),
+	)
+}
+
+func (r *AttendanceCorrectionRequest) ToRequestEntity() entity.RequestAttendanceCorrection {
+	attendanceDate, _ := time.Parse(dateFormat, r.AttendanceDate)
+
+	return entity.RequestAttendanceCorrection{
+		AttendanceDate: attendanceDate,
+		Reason:         r.Reason,
+	}
+}
+
+type AttendanceCorrectionDecisionRequest struct {
+	Comment optional.String `json:"comment,omitempty"`
+}
+
+func (r *AttendanceCorrectionDecisionRequest) ToRequestEntity() entity.DecideAttendanceCorrection {
+	return entity.DecideAttendanceCorrection{
+		Comment: r.Comment,
+	}
+}
+
+type AttendanceCorrectionResponse struct {
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id"`
+	Username        string          `json:"username"`
+	AttendanceDate  string          `json:"attendance_date"`
+	Reason          string          `json:"reason"`
+	Status          string          `json:"status"`
+	AttendanceID    optional.String `json:"attendance_id"`
+	DecidedBy       optional.String `json:"decided_by"`
+	DecidedAt       optional.String `json:"decided_at"`
+	DecisionComment optional.String `json:"decision_comment"`
+	CreatedAt       string          `json:"created_at"`
+}
+
+func NewAttendanceCorrectionResponse(correction entity.AttendanceCorrection) AttendanceCorrectionResponse {
+	response := AttendanceCorrectionResponse{
+		ID:              correction.ID,
+		UserID:          correction.UserID,
+		Username:        correction.Username,
+		AttendanceDate:  correction.AttendanceDate.Format(dateFormat),
+		Reason:          correction.Reason,
+		Status:          string(correction.Status),
+		AttendanceID:    correction.AttendanceID,
+		DecidedBy:       correction.DecidedBy,
+		DecidedAt:       optional.NewString(),
+		DecisionComment: correction.DecisionComment,
+		CreatedAt:       correction.CreatedAt.Format(time.RFC3339),
+	}
+	correction.DecidedAt.IfPresent(func(t time.Time) {
+		response.DecidedAt.Set(t.Format(time.RFC3339))
+	})
+	return response
+}
+
+func NewListAttendanceCorrectionResponse(corrections []entity.AttendanceCorrection) []AttendanceCorrectionResponse {
+	responses := make([]AttendanceCorrectionResponse, len(corrections))
+	for i, correction := range corrections {
+		responses[i] = NewAttendanceCorrectionResponse(correction)
+	}
+	return responses
+}
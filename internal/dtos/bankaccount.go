@@ -0,0 +1,81 @@
+package dtos
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var (
+	accountNumberPattern = regexp.MustCompile(`^[A-Za-z0-9]{4,34}$`)
+	bicPattern           = regexp.MustCompile(`^[A-Z]{6}[A-Z0-9]{2}([A-Z0-9]{3})?$`)
+)
+
+type BankAccountRequest struct {
+	AccountHolderName string          `json:"account_holder_name" validate:"required"`
+	AccountNumber     string          `json:"account_number" validate:"required"`
+	BankName          string          `json:"bank_name" validate:"required"`
+	BankCode          string          `json:"bank_code" validate:"required"`
+	BIC               optional.String `json:"bic,omitempty"`
+}
+
+func (r *BankAccountRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.AccountHolderName, validation.Required, validation.Length(1, 70)),
+		validation.Field(&r.AccountNumber, validation.Required, validation.Match(accountNumberPattern)),
+		validation.Field(&r.BankName, validation.Required, validation.Length(1, 255)),
+		validation.Field(&r.BankCode, validation.Required, validation.Length(1, 11)),
+		validation.Field(&r.BIC, validation.By(validBIC)),
+	)
+}
+
+func (r *BankAccountRequest) ToRequestEntity() entity.UpsertBankAccount {
+	return entity.UpsertBankAccount{
+		AccountHolderName: strings.TrimSpace(r.AccountHolderName),
+		AccountNumber:     strings.ToUpper(r.AccountNumber),
+		BankName:          strings.TrimSpace(r.BankName),
+		BankCode:          r.BankCode,
+		BIC:               r.BIC,
+	}
+}
+
+func validBIC(value any) error {
+	bic, ok := value.(optional.String)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if v, ok := bic.Get(); ok && !bicPattern.MatchString(v) {
+		return validation.ErrMatchInvalid
+	}
+	return nil
+}
+
+type BankAccountResponse struct {
+	ID                string          `json:"id"`
+	UserID            string          `json:"user_id"`
+	AccountHolderName string          `json:"account_holder_name"`
+	AccountNumber     string          `json:"account_number"`
+	BankName          string          `json:"bank_name"`
+	BankCode          string          `json:"bank_code"`
+	BIC               optional.String `json:"bic"`
+	UpdatedBy         string          `json:"updated_by"`
+	UpdatedAt         string          `json:"updated_at"`
+}
+
+func NewBankAccountResponse(bankAccount entity.BankAccount) BankAccountResponse {
+	return BankAccountResponse{
+		ID:                bankAccount.ID,
+		UserID:            bankAccount.UserID,
+		AccountHolderName: bankAccount.AccountHolderName,
+		AccountNumber:     bankAccount.AccountNumber,
+		BankName:          bankAccount.BankName,
+		BankCode:          bankAccount.BankCode,
+		BIC:               bankAccount.BIC,
+		UpdatedBy:         bankAccount.UpdatedBy,
+		UpdatedAt:         bankAccount.UpdatedAt.Format(time.RFC3339),
+	}
+}
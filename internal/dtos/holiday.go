@@ -0,0 +1,92 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+)
+
+type HolidayRequest struct {
+	Date string `json:"date" validate:"required"`
+	Name string `json:"name" validate:"required"`
+}
+
+func (r *HolidayRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Date, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 255)),
+	)
+}
+
+func (r *HolidayRequest) ToRequestEntity() entity.UpsertHoliday {
+	parsedDate, _ := time.Parse(dateFormat, r.Date)
+	return entity.UpsertHoliday{
+		HolidayDate: parsedDate,
+		Name:        r.Name,
+	}
+}
+
+type ListHolidaysRequest struct {
+	From string `query:"from" validate:"required"`
+	To   string `query:"to" validate:"required"`
+}
+
+func (r *ListHolidaysRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.From, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.To, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *ListHolidaysRequest) ToRequestEntity() (time.Time, time.Time) {
+	from, _ := time.Parse(dateFormat, r.From)
+	to, _ := time.Parse(dateFormat, r.To)
+	return from, to
+}
+
+type HolidayResponse struct {
+	ID   string `json:"id"`
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+func NewHolidayResponse(holiday entity.Holiday) HolidayResponse {
+	return HolidayResponse{
+		ID:   holiday.ID,
+		Date: holiday.HolidayDate.Format(dateFormat),
+		Name: holiday.Name,
+	}
+}
+
+func NewListHolidayResponse(holidays []entity.Holiday) []HolidayResponse {
+	holidayResponses := make([]HolidayResponse, len(holidays))
+	for i, holiday := range holidays {
+		holidayResponses[i] = NewHolidayResponse(holiday)
+	}
+	return holidayResponses
+}
+
+type ImportedHolidayResponse struct {
+	Date string `json:"date"`
+	Name string `json:"name"`
+}
+
+type ImportHolidaysResponse struct {
+	TotalImported int64                     `json:"total_imported"`
+	Holidays      []ImportedHolidayResponse `json:"holidays"`
+}
+
+func NewImportHolidaysResponse(imported entity.ImportedHolidays) ImportHolidaysResponse {
+	holidays := make([]ImportedHolidayResponse, len(imported.Holidays))
+	for i, holiday := range imported.Holidays {
+		holidays[i] = ImportedHolidayResponse{
+			Date: holiday.HolidayDate.Format(dateFormat),
+			Name: holiday.Name,
+		}
+	}
+	return ImportHolidaysResponse{
+		TotalImported: imported.TotalImported,
+		Holidays:      holidays,
+	}
+}
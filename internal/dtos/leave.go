@@ -0,0 +1,266 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/invopop/validation/is"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type LeaveTypeRequest struct {
+	Code            string        `json:"code" validate:"required"`
+	Name            string        `json:"name" validate:"required"`
+	Paid            optional.Bool `json:"paid,omitempty"`
+	AnnualAllowance int64         `json:"annual_allowance"`
+	MaxCarryOver    int64         `json:"max_carry_over"`
+}
+
+func (r *LeaveTypeRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Code, validation.Required, validation.Length(1, 32)),
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.AnnualAllowance, validation.Min(int64(0)), validation.Max(int64(366))),
+		validation.Field(&r.MaxCarryOver, validation.Min(int64(0)), validation.Max(int64(366))),
+	)
+}
+
+// ToRequestEntity defaults Paid to true, most kinds of leave are paid.
+func (r *LeaveTypeRequest) ToRequestEntity() entity.CreateLeaveType {
+	return entity.CreateLeaveType{
+		Code:            r.Code,
+		Name:            r.Name,
+		Paid:            r.Paid.GetOrDefault(true),
+		AnnualAllowance: r.AnnualAllowance,
+		MaxCarryOver:    r.MaxCarryOver,
+	}
+}
+
+type LeaveTypeResponse struct {
+	ID              string `json:"id"`
+	Code            string `json:"code"`
+	Name            string `json:"name"`
+	Paid            bool   `json:"paid"`
+	AnnualAllowance int64  `json:"annual_allowance"`
+	MaxCarryOver    int64  `json:"max_carry_over"`
+}
+
+func NewLeaveTypeResponse(leaveType entity.LeaveType) LeaveTypeResponse {
+	return LeaveTypeResponse{
+		ID:              leaveType.ID,
+		Code:            leaveType.Code,
+		Name:            leaveType.Name,
+		Paid:            leaveType.Paid,
+		AnnualAllowance: leaveType.AnnualAllowance,
+		MaxCarryOver:    leaveType.MaxCarryOver,
+	}
+}
+
+func NewListLeaveTypeResponse(leaveTypes []entity.LeaveType) []LeaveTypeResponse {
+	responses := make([]LeaveTypeResponse, len(leaveTypes))
+	for i, item := range leaveTypes {
+		responses[i] = NewLeaveTypeResponse(item)
+	}
+	return responses
+}
+
+type LeaveAccrualRequest struct {
+	Year int64 `json:"year" validate:"required"`
+}
+
+func (r *LeaveAccrualRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Year, validation.Required, validation.Min(int64(2000)), validation.Max(int64(9999))),
+	)
+}
+
+type LeaveBalancesRequest struct {
+	Year optional.Int64 `query:"year"`
+}
+
+func (r *LeaveBalancesRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Year, validation.Min(int64(2000)), validation.Max(int64(9999))),
+	)
+}
+
+// ToRequestEntity defaults to the current year.
+func (r *LeaveBalancesRequest) ToRequestEntity() int64 {
+	return r.Year.GetOrDefault(int64(time.Now().Year()))
+}
+
+type LeaveBalanceResponse struct {
+	ID              string `json:"id"`
+	UserID          string `json:"user_id"`
+	LeaveTypeID     string `json:"leave_type_id"`
+	LeaveTypeCode   string `json:"leave_type_code"`
+	LeaveTypeName   string `json:"leave_type_name"`
+	Year            int64  `json:"year"`
+	AccruedDays     int64  `json:"accrued_days"`
+	CarriedOverDays int64  `json:"carried_over_days"`
+	Entitlement     int64  `json:"entitlement"`
+	UsedDays        int64  `json:"used_days"`
+	PendingDays     int64  `json:"pending_days"`
+	AvailableDays   int64  `json:"available_days"`
+}
+
+func NewLeaveBalanceResponse(balance entity.LeaveBalance) LeaveBalanceResponse {
+	return LeaveBalanceResponse{
+		ID:              balance.ID,
+		UserID:          balance.UserID,
+		LeaveTypeID:     balance.LeaveTypeID,
+		LeaveTypeCode:   balance.LeaveTypeCode,
+		LeaveTypeName:   balance.LeaveTypeName,
+		Year:            balance.Year,
+		AccruedDays:     balance.AccruedDays,
+		CarriedOverDays: balance.CarriedOverDays,
+		Entitlement:     balance.Entitlement(),
+		UsedDays:        balance.UsedDays,
+		PendingDays:     balance.PendingDays,
+		AvailableDays:   balance.AvailableDays(),
+	}
+}
+
+func NewListLeaveBalanceResponse(balances []entity.LeaveBalance) []LeaveBalanceResponse {
+	responses := make([]LeaveBalanceResponse, len(balances))
+	for i, item := range balances {
+		responses[i] = NewLeaveBalanceResponse(item)
+	}
+	return responses
+}
+
+type LeaveAccrualResponse struct {
+	Year     int64                  `json:"year"`
+	Balances []LeaveBalanceResponse `json:"balances"`
+}
+
+func NewLeaveAccrualResponse(accrual entity.LeaveAccrual) LeaveAccrualResponse {
+	return LeaveAccrualResponse{
+		Year:     accrual.Year,
+		Balances: NewListLeaveBalanceResponse(accrual.Balances),
+	}
+}
+
+type LeaveRequestRequest struct {
+	LeaveTypeID string          `json:"leave_type_id" validate:"required"`
+	StartDate   string          `json:"start_date" validate:"required"`
+	EndDate     string          `json:"end_date" validate:"required"`
+	Reason      optional.String `json:"reason,omitempty"`
+}
+
+func (r *LeaveRequestRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.LeaveTypeID, validation.Required, is.UUID),
+		validation.Field(&r.StartDate, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.EndDate, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *LeaveRequestRequest) ToRequestEntity() entity.SubmitLeaveRequest {
+	startDate, _ := time.Parse(dateFormat, r.StartDate)
+	endDate, _ := time.Parse(dateFormat, r.EndDate)
+
+	return entity.SubmitLeaveRequest{
+		LeaveTypeID: r.LeaveTypeID,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Reason:      r.Reason,
+	}
+}
+
+type LeaveDecisionRequest struct {
+	Comment optional.String `json:"comment,omitempty"`
+}
+
+func (r *LeaveDecisionRequest) ToRequestEntity() entity.DecideLeaveRequest {
+	return entity.DecideLeaveRequest{
+		Comment: r.Comment,
+	}
+}
+
+type LeaveRequestResponse struct {
+	ID              string          `json:"id"`
+	UserID          string          `json:"user_id"`
+	Username        string          `json:"username"`
+	LeaveTypeID     string          `json:"leave_type_id"`
+	LeaveTypeCode   string          `json:"leave_type_code"`
+	LeaveTypeName   string          `json:"leave_type_name"`
+	Paid            bool            `json:"paid"`
+	StartDate       string          `json:"start_date"`
+	EndDate         string          `json:"end_date"`
+	Days            int64           `json:"days"`
+	Reason          optional.String `json:"reason"`
+	Status          string          `json:"status"`
+	DecidedBy       optional.String `json:"decided_by"`
+	DecidedAt       optional.String `json:"decided_at"`
+	DecisionComment optional.String `json:"decision_comment"`
+	CreatedAt       string          `json:"created_at"`
+}
+
+func NewLeaveRequestResponse(request entity.LeaveRequest) LeaveRequestResponse {
+	response := LeaveRequestResponse{
+		ID:              request.ID,
+		UserID:          request.UserID,
+		Username:        request.Username,
+		LeaveTypeID:     request.LeaveTypeID,
+		LeaveTypeCode:   request.LeaveTypeCode,
+		LeaveTypeName:   request.LeaveTypeName,
+		Paid:            request.Paid,
+		StartDate:       request.StartDate.Format(dateFormat),
+		EndDate:         request.EndDate.Format(dateFormat),
+		Days:            request.Days,
+		Reason:          request.Reason,
+		Status:          string(request.Status),
+		DecidedBy:       request.DecidedBy,
+		DecidedAt:       optional.NewString(),
+		DecisionComment: request.DecisionComment,
+		CreatedAt:       request.CreatedAt.Format(time.RFC3339),
+	}
+	request.DecidedAt.IfPresent(func(t time.Time) {
+		response.DecidedAt.Set(t.Format(time.RFC3339))
+	})
+	return response
+}
+
+func NewListLeaveRequestResponse(requests []entity.LeaveRequest) []LeaveRequestResponse {
+	responses := make([]LeaveRequestResponse, len(requests))
+	for i, item := range requests {
+		responses[i] = NewLeaveRequestResponse(item)
+	}
+	return responses
+}
+
+type LeaveCalendarRequest struct {
+	StartDate string `query:"start_date" validate:"required"`
+	EndDate   string `query:"end_date" validate:"required"`
+}
+
+func (r *LeaveCalendarRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.StartDate, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.EndDate, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *LeaveCalendarRequest) ToRequestEntity() (time.Time, time.Time) {
+	startDate, _ := time.Parse(dateFormat, r.StartDate)
+	endDate, _ := time.Parse(dateFormat, r.EndDate)
+	return startDate, endDate
+}
+
+type LeaveCalendarDayResponse struct {
+	Date   string                 `json:"date"`
+	Leaves []LeaveRequestResponse `json:"leaves"`
+}
+
+func NewLeaveCalendarResponse(days []entity.LeaveCalendarDay) []LeaveCalendarDayResponse {
+	responses := make([]LeaveCalendarDayResponse, len(days))
+	for i, day := range days {
+		responses[i] = LeaveCalendarDayResponse{
+			Date:   day.Date.Format(dateFormat),
+			Leaves: NewListLeaveRequestResponse(day.Leaves),
+		}
+	}
+	return responses
+}
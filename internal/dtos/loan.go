@@ -0,0 +1,124 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type LoanRequest struct {
+	Principal         money.Money     `json:"principal" validate:"required"`
+	InstallmentAmount money.Money     `json:"installment_amount" validate:"required"`
+	StartDate         string          `json:"start_date" validate:"required"`
+	Reason            optional.String `json:"reason,omitempty"`
+}
+
+func (r *LoanRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Principal, validation.By(positiveAmount)),
+		validation.Field(&r.InstallmentAmount, validation.By(positiveAmount)),
+		validation.Field(&r.StartDate, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *LoanRequest) ToRequestEntity() entity.CreateLoan {
+	startDate, _ := time.Parse(dateFormat, r.StartDate)
+
+	return entity.CreateLoan{
+		Principal:         r.Principal,
+		InstallmentAmount: r.InstallmentAmount,
+		StartDate:         startDate,
+		Reason:            r.Reason,
+	}
+}
+
+type LoanResponse struct {
+	ID                 string          `json:"id"`
+	UserID             string          `json:"user_id"`
+	Principal          money.Money     `json:"principal"`
+	InstallmentAmount  money.Money     `json:"installment_amount"`
+	StartDate          string          `json:"start_date"`
+	Reason             optional.String `json:"reason"`
+	RepaidAmount       money.Money     `json:"repaid_amount"`
+	OutstandingBalance money.Money     `json:"outstanding_balance"`
+	CreatedBy          string          `json:"created_by"`
+	CreatedAt          string          `json:"created_at"`
+}
+
+func NewLoanResponse(loan entity.Loan) LoanResponse {
+	return LoanResponse{
+		ID:                 loan.ID,
+		UserID:             loan.UserID,
+		Principal:          loan.Principal,
+		InstallmentAmount:  loan.InstallmentAmount,
+		StartDate:          loan.StartDate.Format(dateFormat),
+		Reason:             loan.Reason,
+		RepaidAmount:       loan.RepaidAmount,
+		OutstandingBalance: loan.OutstandingBalance(),
+		CreatedBy:          loan.CreatedBy,
+		CreatedAt:          loan.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListLoanResponse(loans []entity.Loan) []LoanResponse {
+	responses := make([]LoanResponse, len(loans))
+	for i, item := range loans {
+		responses[i] = NewLoanResponse(item)
+	}
+	return responses
+}
+
+type LoanInstallmentResponse struct {
+	Number       int64       `json:"number"`
+	Amount       money.Money `json:"amount"`
+	BalanceAfter money.Money `json:"balance_after"`
+}
+
+type LoanRepaymentResponse struct {
+	PayslipID       string      `json:"payslip_id"`
+	PayrollID       string      `json:"payroll_id"`
+	PayrollStatus   string      `json:"payroll_status"`
+	PeriodID        string      `json:"period_id"`
+	PeriodStartDate string      `json:"period_start_date"`
+	PeriodEndDate   string      `json:"period_end_date"`
+	Amount          money.Money `json:"amount"`
+}
+
+type LoanStatementResponse struct {
+	Loan       LoanResponse              `json:"loan"`
+	Schedule   []LoanInstallmentResponse `json:"schedule"`
+	Repayments []LoanRepaymentResponse   `json:"repayments"`
+}
+
+func NewLoanStatementResponse(statement entity.LoanStatement) LoanStatementResponse {
+	schedule := make([]LoanInstallmentResponse, len(statement.Schedule))
+	for i, installment := range statement.Schedule {
+		schedule[i] = LoanInstallmentResponse{
+			Number:       installment.Number,
+			Amount:       installment.Amount,
+			BalanceAfter: installment.BalanceAfter,
+		}
+	}
+
+	repayments := make([]LoanRepaymentResponse, len(statement.Repayments))
+	for i, repayment := range statement.Repayments {
+		repayments[i] = LoanRepaymentResponse{
+			PayslipID:       repayment.PayslipID,
+			PayrollID:       repayment.PayrollID,
+			PayrollStatus:   repayment.PayrollStatus,
+			PeriodID:        repayment.PeriodID,
+			PeriodStartDate: repayment.PeriodStartDate.Format(dateFormat),
+			PeriodEndDate:   repayment.PeriodEndDate.Format(dateFormat),
+			Amount:          repayment.Amount,
+		}
+	}
+
+	return LoanStatementResponse{
+		Loan:       NewLoanResponse(statement.Loan),
+		Schedule:   schedule,
+		Repayments: repayments,
+	}
+}
@@ -27,3 +27,80 @@ func (o *OvertimeRequest) ToRequestEntity() entity.SubmitOvertime {
 		Overtime:     o.Overtime,
 	}
 }
+
+type OvertimeTierRequest struct {
+	AfterHours int64   `json:"after_hours" validate:"required"`
+	Multiplier float64 `json:"multiplier" validate:"required"`
+}
+
+func (o OvertimeTierRequest) Validate() error {
+	return validation.ValidateStruct(&o,
+		validation.Field(&o.AfterHours, validation.Required, validation.Min(int64(1)), validation.Max(int64(24))),
+		validation.Field(&o.Multiplier, validation.Required, validation.Min(1.0)),
+	)
+}
+
+type OvertimePolicyRequest struct {
+	WeekdayMultiplier   float64               `json:"weekday_multiplier" validate:"required"`
+	WeekendMultiplier   float64               `json:"weekend_multiplier" validate:"required"`
+	StandardHoursPerDay int64                 `json:"standard_hours_per_day" validate:"required"`
+	Tiers               []OvertimeTierRequest `json:"tiers"`
+}
+
+func (o *OvertimePolicyRequest) Validate() error {
+	return validation.ValidateStruct(o,
+		validation.Field(&o.WeekdayMultiplier, validation.Required, validation.Min(1.0)),
+		validation.Field(&o.WeekendMultiplier, validation.Required, validation.Min(1.0)),
+		validation.Field(&o.StandardHoursPerDay, validation.Required, validation.Min(int64(1)), validation.Max(int64(24))),
+		validation.Field(&o.Tiers),
+	)
+}
+
+func (o *OvertimePolicyRequest) ToRequestEntity() entity.UpdateOvertimePolicy {
+	tiers := make([]entity.OvertimeTier, len(o.Tiers))
+	for i, tier := range o.Tiers {
+		tiers[i] = entity.OvertimeTier{
+			AfterHours: tier.AfterHours,
+			Multiplier: tier.Multiplier,
+		}
+	}
+	return entity.UpdateOvertimePolicy{
+		WeekdayMultiplier:   o.WeekdayMultiplier,
+		WeekendMultiplier:   o.WeekendMultiplier,
+		StandardHoursPerDay: o.StandardHoursPerDay,
+		Tiers:               tiers,
+	}
+}
+
+type OvertimeTierResponse struct {
+	AfterHours int64   `json:"after_hours"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+type OvertimePolicyResponse struct {
+	ID                  string                 `json:"id"`
+	Version             int64                  `json:"version"`
+	WeekdayMultiplier   float64                `json:"weekday_multiplier"`
+	WeekendMultiplier   float64                `json:"weekend_multiplier"`
+	StandardHoursPerDay int64                  `json:"standard_hours_per_day"`
+	Tiers               []OvertimeTierResponse `json:"tiers"`
+	CreatedBy           string                 `json:"created_by"`
+	CreatedAt           string                 `json:"created_at"`
+}
+
+func NewOvertimePolicyResponse(policy entity.OvertimePolicy) OvertimePolicyResponse {
+	tiers := make([]OvertimeTierResponse, len(policy.Tiers))
+	for i, tier := range policy.Tiers {
+		tiers[i] = OvertimeTierResponse(tier)
+	}
+	return OvertimePolicyResponse{
+		ID:                  policy.ID,
+		Version:             policy.Version,
+		WeekdayMultiplier:   policy.WeekdayMultiplier,
+		WeekendMultiplier:   policy.WeekendMultiplier,
+		StandardHoursPerDay: policy.StandardHoursPerDay,
+		Tiers:               tiers,
+		CreatedBy:           policy.CreatedBy,
+		CreatedAt:           policy.CreatedAt.Format(time.RFC3339),
+	}
+}
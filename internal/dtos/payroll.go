@@ -1,20 +1,30 @@
 package dtos
 
 import (
+	"time"
+
 	"github.com/invopop/validation"
 	"github.com/invopop/validation/is"
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
+	"github.com/vnnyx/employee-management/pkg/bankfile"
+	"github.com/vnnyx/employee-management/pkg/iso8601"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
 type GeneratedPayrollResponse struct {
-	PeriodID      string `json:"period_id"`
-	PayrollID     string `json:"payroll_id"`
-	TotalTakeHome int64  `json:"total_take_home_pay"`
-	TotalEmployee int64  `json:"total_employee"`
-	TotalPayslip  int64  `json:"total_payslip"`
-	GeneratedBy   string `json:"generated_by"`
-	GeneratedAt   string `json:"generated_at"`
+	PeriodID           string               `json:"period_id"`
+	PayrollID          string               `json:"payroll_id"`
+	Version            int64                `json:"version"`
+	Status             entity.PayrollStatus `json:"status"`
+	TotalGrossPay      money.Money          `json:"total_gross_pay"`
+	TotalReimbursement money.Money          `json:"total_reimbursement"`
+	TotalDeductions    money.Money          `json:"total_deductions"`
+	TotalTakeHome      money.Money          `json:"total_take_home_pay"`
+	TotalEmployee      int64                `json:"total_employee"`
+	TotalPayslip       int64                `json:"total_payslip"`
+	GeneratedBy        string               `json:"generated_by"`
+	GeneratedAt        string               `json:"generated_at"`
 }
 
 type GeneratePayrollRequest struct {
@@ -27,17 +37,177 @@ func (r *GeneratePayrollRequest) Validate() error {
 	)
 }
 
+type RegeneratePayrollRequest struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+func (r *RegeneratePayrollRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Reason, validation.Required, validation.Length(1, 500)),
+	)
+}
+
+type PayrollTransitionRequest struct {
+	Comment string `json:"comment"`
+}
+
+func (r *PayrollTransitionRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Comment, validation.Length(0, 1000)),
+	)
+}
+
+// RejectPayrollRequest requires the comment so the submitter knows what to
+// fix before submitting again.
+type RejectPayrollRequest struct {
+	Comment string `json:"comment" validate:"required"`
+}
+
+func (r *RejectPayrollRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Comment, validation.Required, validation.Length(1, 1000)),
+	)
+}
+
+type PayrollApprovalResponse struct {
+	ID         string          `json:"id"`
+	PayrollID  string          `json:"payroll_id"`
+	Action     string          `json:"action"`
+	FromStatus string          `json:"from_status"`
+	ToStatus   string          `json:"to_status"`
+	Comment    optional.String `json:"comment"`
+	CreatedBy  string          `json:"created_by"`
+	CreatedAt  string          `json:"created_at"`
+}
+
+func NewPayrollApprovalResponse(approval entity.PayrollApproval) PayrollApprovalResponse {
+	return PayrollApprovalResponse{
+		ID:         approval.ID,
+		PayrollID:  approval.PayrollID,
+		Action:     string(approval.Action),
+		FromStatus: string(approval.FromStatus),
+		ToStatus:   string(approval.ToStatus),
+		Comment:    approval.Comment,
+		CreatedBy:  approval.CreatedBy,
+		CreatedAt:  approval.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListPayrollApprovalResponse(approvals []entity.PayrollApproval) []PayrollApprovalResponse {
+	approvalResponses := make([]PayrollApprovalResponse, len(approvals))
+	for i, approval := range approvals {
+		approvalResponses[i] = NewPayrollApprovalResponse(approval)
+	}
+	return approvalResponses
+}
+
+type PayrollJobProgressResponse struct {
+	Stage   optional.String `json:"stage"`
+	Done    int64           `json:"done"`
+	Total   int64           `json:"total"`
+	Percent int64           `json:"percent"`
+}
+
+type PayrollJobFailureResponse struct {
+	IssueCode string `json:"issue_code"`
+	Message   string `json:"message"`
+}
+
+type PayrollJobResponse struct {
+	ID         string                     `json:"id"`
+	PeriodID   string                     `json:"period_id"`
+	Status     string                     `json:"status"`
+	Progress   PayrollJobProgressResponse `json:"progress"`
+	Attempts   int64                      `json:"attempts"`
+	Failure    *PayrollJobFailureResponse `json:"failure"`
+	Result     *GeneratedPayrollResponse  `json:"result"`
+	CreatedBy  string                     `json:"created_by"`
+	CreatedAt  string                     `json:"created_at"`
+	StartedAt  optional.String            `json:"started_at"`
+	FinishedAt optional.String            `json:"finished_at"`
+}
+
+func NewPayrollJobResponse(job entity.PayrollJob) PayrollJobResponse {
+	response := PayrollJobResponse{
+		ID:       job.ID,
+		PeriodID: job.PeriodID,
+		Status:   string(job.Status),
+		Progress: PayrollJobProgressResponse{
+			Stage: job.Stage,
+			Done:  job.ProgressDone,
+			Total: job.ProgressTotal,
+		},
+		Attempts:   job.Attempts,
+		CreatedBy:  job.CreatedBy,
+		CreatedAt:  job.CreatedAt.Format(time.RFC3339),
+		StartedAt:  optional.NewString(),
+		FinishedAt: optional.NewString(),
+	}
+
+	switch {
+	case job.Status == entity.PayrollJobSucceeded:
+		response.Progress.Percent = 100
+	case job.ProgressTotal > 0:
+		response.Progress.Percent = job.ProgressDone * 100 / job.ProgressTotal
+	}
+
+	if code, ok := job.FailureCode.Get(); ok {
+		response.Failure = &PayrollJobFailureResponse{
+			IssueCode: code,
+			Message:   job.FailureReason.GetOrDefault(),
+		}
+	}
+
+	if job.Result != nil {
+		result := GeneratedPayrollResponse(*job.Result)
+		response.Result = &result
+	}
+
+	job.StartedAt.IfPresent(func(startedAt time.Time) {
+		response.StartedAt = optional.NewString(startedAt.Format(time.RFC3339))
+	})
+	job.FinishedAt.IfPresent(func(finishedAt time.Time) {
+		response.FinishedAt = optional.NewString(finishedAt.Format(time.RFC3339))
+	})
+
+	return response
+}
+
 type ReimbursementDataResponse struct {
 	Description       optional.String `json:"description"`
-	Amount            int64           `json:"amount"`
+	Amount            money.Money     `json:"amount"`
 	ReimbursementDate string          `json:"reimbursement_date"`
 }
 
 type OvertimeDataResponse struct {
-	OvertimeHours string  `json:"overtime_hours"`
-	RatePerHour   int64   `json:"rate_per_hour"`
-	Multiplier    float64 `json:"multiplier"`
-	OvertimePay   int64   `json:"overtime_pay"`
+	OvertimeHours string         `json:"overtime_hours"`
+	RatePerHour   money.Money    `json:"rate_per_hour"`
+	Multiplier    float64        `json:"multiplier"`
+	OvertimePay   money.Money    `json:"overtime_pay"`
+	PolicyVersion optional.Int64 `json:"policy_version"`
+}
+
+type PayslipItemResponse struct {
+	Code   string      `json:"code"`
+	Name   string      `json:"name"`
+	Amount money.Money `json:"amount"`
+}
+
+type PayslipEarningResponse struct {
+	Code    string      `json:"code"`
+	Name    string      `json:"name"`
+	Amount  money.Money `json:"amount"`
+	Taxable bool        `json:"taxable"`
+}
+
+type SalarySegmentResponse struct {
+	StartDate           string      `json:"start_date"`
+	EndDate             string      `json:"end_date"`
+	Salary              money.Money `json:"salary"`
+	WorkingDays         int64       `json:"working_days"`
+	AttendanceDays      int64       `json:"attendance_days"`
+	AttendancePay       money.Money `json:"attendance_pay"`
+	OvertimeRatePerHour money.Money `json:"overtime_rate_per_hour"`
 }
 
 type UserDataResponse struct {
@@ -51,32 +221,50 @@ type AttendancePeriodDataResponse struct {
 }
 
 type PayslipDataResponse struct {
-	ID                 string                       `json:"id"`
-	User               UserDataResponse             `json:"user"`
-	AttendancePeriod   AttendancePeriodDataResponse `json:"attendance_period"`
-	BaseSalary         int64                        `json:"base_salary"`
-	WorkingDays        int64                        `json:"working_days"`
-	AttendanceDays     int64                        `json:"attendance_days"`
-	AttendancePay      int64                        `json:"attendance_pay"`
-	Overtime           OvertimeDataResponse         `json:"overtime"`
-	Reimbursements     []ReimbursementDataResponse  `json:"reimbursements"`
-	ReimbursementTotal int64                        `json:"reimbursement_total"`
-	TotalTakeHome      int64                        `json:"total_take_home_pay"`
+	ID                  string                       `json:"id"`
+	VerificationID      string                       `json:"verification_id,omitempty"`
+	User                UserDataResponse             `json:"user"`
+	AttendancePeriod    AttendancePeriodDataResponse `json:"attendance_period"`
+	BaseSalary          money.Money                  `json:"base_salary"`
+	WorkingDays         int64                        `json:"working_days"`
+	EligibleWorkingDays int64                        `json:"eligible_working_days"`
+	ProrationFactor     float64                      `json:"proration_factor"`
+	AttendanceDays      int64                        `json:"attendance_days"`
+	WorkedDuration      optional.String              `json:"worked_duration"`
+	AttendancePay       money.Money                  `json:"attendance_pay"`
+	SalarySegments      []SalarySegmentResponse      `json:"salary_segments"`
+	Overtime            OvertimeDataResponse         `json:"overtime"`
+	Earnings            []PayslipEarningResponse     `json:"earnings"`
+	GrossPay            money.Money                  `json:"gross_pay"`
+	Reimbursements      []ReimbursementDataResponse  `json:"reimbursements"`
+	ReimbursementTotal  money.Money                  `json:"reimbursement_total"`
+	Deductions          []PayslipItemResponse        `json:"deductions"`
+	TotalDeductions     money.Money                  `json:"total_deductions"`
+	TotalTakeHome       money.Money                  `json:"total_take_home_pay"`
 }
 
 func NewShowPayslipResponse(data *entity.PayslipData) *PayslipDataResponse {
 	return &PayslipDataResponse{
-		ID:                 data.ID,
-		User:               UserDataResponse{ID: data.User.ID, Username: data.User.Username},
-		AttendancePeriod:   AttendancePeriodDataResponse{StartDate: data.AttendancePeriod.StartDate, EndDate: data.AttendancePeriod.EndDate},
-		BaseSalary:         data.BaseSalary,
-		WorkingDays:        data.WorkingDays,
-		AttendanceDays:     data.AttendanceDays,
-		AttendancePay:      data.AttendancePay,
-		Overtime:           OvertimeDataResponse{OvertimeHours: data.Overtime.OvertimeHours, RatePerHour: data.Overtime.RatePerHour, Multiplier: data.Overtime.Multiplier, OvertimePay: data.Overtime.OvertimePay},
-		Reimbursements:     newReimbursementDataResponses(data.Reimbursements),
-		ReimbursementTotal: data.ReimbursementTotal,
-		TotalTakeHome:      data.TotalTakeHome,
+		ID:                  data.ID,
+		VerificationID:      data.VerificationID,
+		User:                UserDataResponse{ID: data.User.ID, Username: data.User.Username},
+		AttendancePeriod:    AttendancePeriodDataResponse{StartDate: data.AttendancePeriod.StartDate, EndDate: data.AttendancePeriod.EndDate},
+		BaseSalary:          data.BaseSalary,
+		WorkingDays:         data.WorkingDays,
+		EligibleWorkingDays: data.EligibleWorkingDays,
+		ProrationFactor:     data.ProrationFactor,
+		AttendanceDays:      data.AttendanceDays,
+		WorkedDuration:      data.WorkedDuration,
+		AttendancePay:       data.AttendancePay,
+		SalarySegments:      newSalarySegmentResponses(data.SalarySegments),
+		Overtime:            OvertimeDataResponse{OvertimeHours: data.Overtime.OvertimeHours, RatePerHour: data.Overtime.RatePerHour, Multiplier: data.Overtime.Multiplier, OvertimePay: data.Overtime.OvertimePay, PolicyVersion: data.Overtime.PolicyVersion},
+		Earnings:            newPayslipEarningResponses(data.Earnings),
+		GrossPay:            data.GrossPay,
+		Reimbursements:      newReimbursementDataResponses(data.Reimbursements),
+		ReimbursementTotal:  data.ReimbursementTotal,
+		Deductions:          newPayslipItemResponses(data.Deductions),
+		TotalDeductions:     data.TotalDeductions,
+		TotalTakeHome:       data.TotalTakeHome,
 	}
 }
 
@@ -88,8 +276,32 @@ func NewListPayslipResponse(listPayslip []entity.PayslipData) []PayslipDataRespo
 	return payslipResponses
 }
 
+type PayrollPreviewResponse struct {
+	PeriodID           string                `json:"period_id"`
+	TotalGrossPay      money.Money           `json:"total_gross_pay"`
+	TotalReimbursement money.Money           `json:"total_reimbursement"`
+	TotalDeductions    money.Money           `json:"total_deductions"`
+	TotalTakeHome      money.Money           `json:"total_take_home_pay"`
+	TotalEmployee      int64                 `json:"total_employee"`
+	TotalPayslip       int64                 `json:"total_payslip"`
+	Payslips           []PayslipDataResponse `json:"payslips"`
+}
+
+func NewPayrollPreviewResponse(data entity.PayrollPreview) PayrollPreviewResponse {
+	return PayrollPreviewResponse{
+		PeriodID:           data.PeriodID,
+		TotalGrossPay:      data.TotalGrossPay,
+		TotalReimbursement: data.TotalReimbursement,
+		TotalDeductions:    data.TotalDeductions,
+		TotalTakeHome:      data.TotalTakeHome,
+		TotalEmployee:      data.TotalEmployee,
+		TotalPayslip:       data.TotalPayslip,
+		Payslips:           NewListPayslipResponse(data.PayslipsData),
+	}
+}
+
 type AdditionalPayslipInformationResponse struct {
-	TotalTakeHome int64 `json:"total_take_home_pay"`
+	TotalTakeHome money.Money `json:"total_take_home_pay"`
 }
 
 func newReimbursementDataResponses(reimbursements []entity.ReimbursementData) []ReimbursementDataResponse {
@@ -104,9 +316,373 @@ func newReimbursementDataResponses(reimbursements []entity.ReimbursementData) []
 	return reimbursementResponses
 }
 
+func newPayslipItemResponses(items []entity.PayslipItemData) []PayslipItemResponse {
+	itemResponses := make([]PayslipItemResponse, len(items))
+	for i, item := range items {
+		itemResponses[i] = PayslipItemResponse{
+			Code:   item.Code,
+			Name:   item.Name,
+			Amount: item.Amount,
+		}
+	}
+	return itemResponses
+}
+
+func newPayslipEarningResponses(items []entity.PayslipItemData) []PayslipEarningResponse {
+	earningResponses := make([]PayslipEarningResponse, len(items))
+	for i, item := range items {
+		earningResponses[i] = PayslipEarningResponse{
+			Code:    item.Code,
+			Name:    item.Name,
+			Amount:  item.Amount,
+			Taxable: item.Taxable,
+		}
+	}
+	return earningResponses
+}
+
+func newSalarySegmentResponses(segments []entity.SalarySegmentData) []SalarySegmentResponse {
+	segmentResponses := make([]SalarySegmentResponse, len(segments))
+	for i, segment := range segments {
+		segmentResponses[i] = SalarySegmentResponse{
+			StartDate:           segment.StartDate,
+			EndDate:             segment.EndDate,
+			Salary:              segment.Salary,
+			WorkingDays:         segment.WorkingDays,
+			AttendanceDays:      segment.AttendanceDays,
+			AttendancePay:       segment.AttendancePay,
+			OvertimeRatePerHour: segment.OvertimeRatePerHour,
+		}
+	}
+	return segmentResponses
+}
+
 type ListPayslipsRequest struct {
 	Page   optional.Int64  `query:"page"`
 	Limit  optional.Int64  `query:"limit"`
 	Mode   optional.String `query:"mode"`
 	Cursor optional.String `query:"cursor"`
 }
+
+type ExportBankTransfersRequest struct {
+	Format        string `query:"format" validate:"required"`
+	ExecutionDate string `query:"execution_date"`
+}
+
+func (r *ExportBankTransfersRequest) Validate() error {
+	formats := make([]any, 0, len(bankfile.Formats))
+	for _, format := range bankfile.Formats {
+		formats = append(formats, string(format))
+	}
+
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Format, validation.Required, validation.In(formats...)),
+		validation.Field(&r.ExecutionDate, validation.Date(dateFormat)),
+	)
+}
+
+// ToRequestEntity returns the file format and the execution date, which
+// defaults to today.
+func (r *ExportBankTransfersRequest) ToRequestEntity() (bankfile.Format, time.Time) {
+	if r.ExecutionDate == "" {
+		year, month, day := time.Now().Date()
+		return bankfile.Format(r.Format), time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	executionDate, _ := time.Parse(dateFormat, r.ExecutionDate)
+	return bankfile.Format(r.Format), executionDate
+}
+
+type VerifyPayslipRequest struct {
+	VerificationID string `query:"verification_id"`
+}
+
+func (r *VerifyPayslipRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.VerificationID, validation.Required, validation.Length(1, 64)),
+	)
+}
+
+type PayslipVerificationResponse struct {
+	PayslipID     string      `json:"payslip_id"`
+	PayrollID     string      `json:"payroll_id"`
+	UserID        string      `json:"user_id"`
+	TotalTakeHome money.Money `json:"total_take_home_pay"`
+	Valid         bool        `json:"valid"`
+	Superseded    bool        `json:"superseded"`
+}
+
+func NewPayslipVerificationResponse(verification entity.PayslipVerification) PayslipVerificationResponse {
+	return PayslipVerificationResponse{
+		PayslipID:     verification.PayslipID,
+		PayrollID:     verification.PayrollID,
+		UserID:        verification.UserID,
+		TotalTakeHome: verification.TotalTakeHome,
+		Valid:         verification.Valid,
+		Superseded:    verification.Superseded,
+	}
+}
+
+// DiffPayrollsRequest holds the anomaly thresholds of a payroll diff, a zero
+// threshold turns its flag off.
+type DiffPayrollsRequest struct {
+	TakeHomeChangePercent   int64 `query:"take_home_change_percent"`
+	BaseSalaryChangePercent int64 `query:"base_salary_change_percent"`
+	AttendanceDaysChange    int64 `query:"attendance_days_change"`
+	OvertimeHoursChange     int64 `query:"overtime_hours_change"`
+}
+
+// NewDiffPayrollsRequest returns the default thresholds, the query only
+// overrides the ones it sets.
+func NewDiffPayrollsRequest() DiffPayrollsRequest {
+	return DiffPayrollsRequest{
+		TakeHomeChangePercent:   20,
+		BaseSalaryChangePercent: 10,
+		AttendanceDaysChange:    5,
+		OvertimeHoursChange:     10,
+	}
+}
+
+func (r *DiffPayrollsRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.TakeHomeChangePercent, validation.Min(int64(0)), validation.Max(int64(1000))),
+		validation.Field(&r.BaseSalaryChangePercent, validation.Min(int64(0)), validation.Max(int64(1000))),
+		validation.Field(&r.AttendanceDaysChange, validation.Min(int64(0)), validation.Max(int64(31))),
+		validation.Field(&r.OvertimeHoursChange, validation.Min(int64(0)), validation.Max(int64(744))),
+	)
+}
+
+func (r *DiffPayrollsRequest) ToRequestEntity() entity.PayrollDiffThresholds {
+	return entity.PayrollDiffThresholds{
+		TakeHomeChangePercent:   r.TakeHomeChangePercent,
+		BaseSalaryChangePercent: r.BaseSalaryChangePercent,
+		AttendanceDaysChange:    r.AttendanceDaysChange,
+		OvertimeHoursChange:     time.Duration(r.OvertimeHoursChange) * time.Hour,
+	}
+}
+
+type MoneyDiffResponse struct {
+	From  money.Money `json:"from"`
+	To    money.Money `json:"to"`
+	Delta money.Money `json:"delta"`
+}
+
+func newMoneyDiffResponse(diff entity.ValueDiff[money.Money]) MoneyDiffResponse {
+	return MoneyDiffResponse{From: diff.From, To: diff.To, Delta: diff.Delta}
+}
+
+type CountDiffResponse struct {
+	From  int64 `json:"from"`
+	To    int64 `json:"to"`
+	Delta int64 `json:"delta"`
+}
+
+func newCountDiffResponse(diff entity.ValueDiff[int64]) CountDiffResponse {
+	return CountDiffResponse{From: diff.From, To: diff.To, Delta: diff.Delta}
+}
+
+type DurationDiffResponse struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Delta string `json:"delta"`
+}
+
+func newDurationDiffResponse(diff entity.ValueDiff[time.Duration]) DurationDiffResponse {
+	return DurationDiffResponse{
+		From:  iso8601.ToString(diff.From),
+		To:    iso8601.ToString(diff.To),
+		Delta: iso8601.ToString(diff.Delta),
+	}
+}
+
+type PayrollRunResponse struct {
+	PayrollID string `json:"payroll_id"`
+	PeriodID  string `json:"period_id"`
+	Version   int64  `json:"version"`
+	Status    string `json:"status"`
+	Voided    bool   `json:"voided"`
+}
+
+type PayrollDiffThresholdsResponse struct {
+	TakeHomeChangePercent   int64  `json:"take_home_change_percent"`
+	BaseSalaryChangePercent int64  `json:"base_salary_change_percent"`
+	AttendanceDaysChange    int64  `json:"attendance_days_change"`
+	OvertimeHoursChange     string `json:"overtime_hours_change"`
+}
+
+type PayslipDiffResponse struct {
+	UserID         string               `json:"user_id"`
+	Username       string               `json:"username"`
+	Status         string               `json:"status"`
+	BaseSalary     MoneyDiffResponse    `json:"base_salary"`
+	AttendanceDays CountDiffResponse    `json:"attendance_days"`
+	OvertimeHours  DurationDiffResponse `json:"overtime_hours"`
+	OvertimePay    MoneyDiffResponse    `json:"overtime_pay"`
+	Reimbursement  MoneyDiffResponse    `json:"reimbursement"`
+	TakeHome       MoneyDiffResponse    `json:"take_home_pay"`
+	Anomalies      []string             `json:"anomalies"`
+}
+
+type PayrollDiffSummaryResponse struct {
+	TotalEmployee      CountDiffResponse `json:"total_employee"`
+	NewEmployee        int64             `json:"new_employee"`
+	MissingEmployee    int64             `json:"missing_employee"`
+	ChangedEmployee    int64             `json:"changed_employee"`
+	AnomalyEmployee    int64             `json:"anomaly_employee"`
+	TotalBaseSalary    MoneyDiffResponse `json:"total_base_salary"`
+	TotalOvertimePay   MoneyDiffResponse `json:"total_overtime_pay"`
+	TotalReimbursement MoneyDiffResponse `json:"total_reimbursement"`
+	TotalTakeHome      MoneyDiffResponse `json:"total_take_home_pay"`
+}
+
+type PayrollDiffResponse struct {
+	From       PayrollRunResponse            `json:"from"`
+	To         PayrollRunResponse            `json:"to"`
+	Thresholds PayrollDiffThresholdsResponse `json:"thresholds"`
+	Summary    PayrollDiffSummaryResponse    `json:"summary"`
+	Employees  []PayslipDiffResponse         `json:"employees"`
+}
+
+func NewPayrollDiffResponse(diff entity.PayrollDiff) PayrollDiffResponse {
+	employees := make([]PayslipDiffResponse, len(diff.Employees))
+	for i, employee := range diff.Employees {
+		anomalies := make([]string, len(employee.Anomalies))
+		for j, anomaly := range employee.Anomalies {
+			anomalies[j] = string(anomaly)
+		}
+
+		employees[i] = PayslipDiffResponse{
+			UserID:         employee.UserID,
+			Username:       employee.Username,
+			Status:         string(employee.Status),
+			BaseSalary:     newMoneyDiffResponse(employee.BaseSalary),
+			AttendanceDays: newCountDiffResponse(employee.AttendanceDays),
+			OvertimeHours:  newDurationDiffResponse(employee.OvertimeHours),
+			OvertimePay:    newMoneyDiffResponse(employee.OvertimePay),
+			Reimbursement:  newMoneyDiffResponse(employee.Reimbursement),
+			TakeHome:       newMoneyDiffResponse(employee.TakeHome),
+			Anomalies:      anomalies,
+		}
+	}
+
+	return PayrollDiffResponse{
+		From: newPayrollRunResponse(diff.From),
+		To:   newPayrollRunResponse(diff.To),
+		Thresholds: PayrollDiffThresholdsResponse{
+			TakeHomeChangePercent:   diff.Thresholds.TakeHomeChangePercent,
+			BaseSalaryChangePercent: diff.Thresholds.BaseSalaryChangePercent,
+			AttendanceDaysChange:    diff.Thresholds.AttendanceDaysChange,
+			OvertimeHoursChange:     iso8601.ToString(diff.Thresholds.OvertimeHoursChange),
+		},
+		Summary: PayrollDiffSummaryResponse{
+			TotalEmployee:      newCountDiffResponse(diff.Summary.TotalEmployee),
+			NewEmployee:        diff.Summary.NewEmployee,
+			MissingEmployee:    diff.Summary.MissingEmployee,
+			ChangedEmployee:    diff.Summary.ChangedEmployee,
+			AnomalyEmployee:    diff.Summary.AnomalyEmployee,
+			TotalBaseSalary:    newMoneyDiffResponse(diff.Summary.TotalBaseSalary),
+			TotalOvertimePay:   newMoneyDiffResponse(diff.Summary.TotalOvertimePay),
+			TotalReimbursement: newMoneyDiffResponse(diff.Summary.TotalReimbursement),
+			TotalTakeHome:      newMoneyDiffResponse(diff.Summary.TotalTakeHome),
+		},
+		Employees: employees,
+	}
+}
+
+func newPayrollRunResponse(run entity.PayrollRun) PayrollRunResponse {
+	return PayrollRunResponse{
+		PayrollID: run.PayrollID,
+		PeriodID:  run.PeriodID,
+		Version:   run.Version,
+		Status:    string(run.Status),
+		Voided:    run.Voided,
+	}
+}
+
+type MyPayslipResponse struct {
+	PayslipID        string                       `json:"payslip_id"`
+	PayrollID        string                       `json:"payroll_id"`
+	PayrollVersion   int64                        `json:"payroll_version"`
+	PayrollStatus    string                       `json:"payroll_status"`
+	AttendancePeriod AttendancePeriodDataResponse `json:"attendance_period"`
+	BaseSalary       money.Money                  `json:"base_salary"`
+	AttendanceDays   int64                        `json:"attendance_days"`
+	OvertimeHours    string                       `json:"overtime_hours"`
+	OvertimePay      money.Money                  `json:"overtime_pay"`
+	GrossPay         money.Money                  `json:"gross_pay"`
+	Reimbursement    money.Money                  `json:"reimbursement"`
+	Deductions       money.Money                  `json:"deductions"`
+	TakeHome         money.Money                  `json:"take_home_pay"`
+}
+
+func NewListMyPayslipResponse(history []entity.PayslipHistory) []MyPayslipResponse {
+	payslipResponses := make([]MyPayslipResponse, len(history))
+	for i, payslip := range history {
+		payslipResponses[i] = MyPayslipResponse{
+			PayslipID:      payslip.ID,
+			PayrollID:      payslip.PayrollID,
+			PayrollVersion: payslip.PayrollVersion,
+			PayrollStatus:  string(payslip.PayrollStatus),
+			AttendancePeriod: AttendancePeriodDataResponse{
+				StartDate: payslip.PeriodStartDate.Format(time.RFC3339),
+				EndDate:   payslip.PeriodEndDate.Format(time.RFC3339),
+			},
+			BaseSalary:     payslip.BaseSalary,
+			AttendanceDays: payslip.AttendanceDays,
+			OvertimeHours:  iso8601.ToString(payslip.OvertimeHours.GetOrDefault()),
+			OvertimePay:    payslip.OvertimePay,
+			GrossPay:       payslip.GrossPay,
+			Reimbursement:  payslip.ReimbursementTotal,
+			Deductions:     payslip.TotalDeductions,
+			TakeHome:       payslip.TotalTakeHome,
+		}
+	}
+	return payslipResponses
+}
+
+type PayslipYearToDateRequest struct {
+	Basis string `query:"basis"`
+	Year  int64  `query:"year"`
+}
+
+func (r *PayslipYearToDateRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Basis, validation.In(string(entity.YearBasisCalendar), string(entity.YearBasisFiscal))),
+		validation.Field(&r.Year, validation.Min(int64(1900)), validation.Max(int64(9999))),
+	)
+}
+
+func (r *PayslipYearToDateRequest) ToRequestEntity() (entity.YearBasis, int64) {
+	if r.Basis == "" {
+		return entity.YearBasisCalendar, r.Year
+	}
+	return entity.YearBasis(r.Basis), r.Year
+}
+
+type PayslipYearToDateResponse struct {
+	Basis         string      `json:"basis"`
+	Year          int64       `json:"year"`
+	StartDate     string      `json:"start_date"`
+	EndDate       string      `json:"end_date"`
+	TotalPayslip  int64       `json:"total_payslip"`
+	GrossPay      money.Money `json:"gross_pay"`
+	OvertimePay   money.Money `json:"overtime_pay"`
+	Reimbursement money.Money `json:"reimbursement"`
+	Deductions    money.Money `json:"deductions"`
+	TakeHome      money.Money `json:"take_home_pay"`
+}
+
+func NewPayslipYearToDateResponse(yearToDate entity.PayslipYearToDate) PayslipYearToDateResponse {
+	return PayslipYearToDateResponse{
+		Basis:         string(yearToDate.Basis),
+		Year:          yearToDate.Year,
+		StartDate:     yearToDate.StartDate.Format(time.RFC3339),
+		EndDate:       yearToDate.EndDate.Format(time.RFC3339),
+		TotalPayslip:  yearToDate.TotalPayslip,
+		GrossPay:      yearToDate.GrossPay,
+		OvertimePay:   yearToDate.OvertimePay,
+		Reimbursement: yearToDate.Reimbursement,
+		Deductions:    yearToDate.Deductions,
+		TakeHome:      yearToDate.TakeHome,
+	}
+}
@@ -5,18 +5,19 @@ import (
 
 	"github.com/invopop/validation"
 	"github.com/vnnyx/employee-management/internal/reimbursement/entity"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
 type ReimbursementRequest struct {
-	Amount      int64           `json:"amount" validate:"required"`
+	Amount      money.Money     `json:"amount" validate:"required"`
 	Date        string          `json:"date" validate:"required"`
 	Description optional.String `json:"description,omitempty"`
 }
 
 func (r *ReimbursementRequest) Validate() error {
 	return validation.ValidateStruct(r,
-		validation.Field(&r.Amount, validation.Required, validation.Min(1)),
+		validation.Field(&r.Amount, validation.By(positiveAmount)),
 		validation.Field(&r.Date, validation.Required, validation.Date("2006-01-02")),
 	)
 }
@@ -29,3 +30,19 @@ func (r *ReimbursementRequest) ToRequestEntity() entity.SubmitReimbursement {
 		Description: r.Description,
 	}
 }
+
+// positiveAmount rejects zero and negative amounts, validation.Required and
+// validation.Min only understand numbers and see a money.Money as its text.
+func positiveAmount(value any) error {
+	amount, ok := value.(money.Money)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if amount.IsZero() {
+		return validation.ErrRequired
+	}
+	if amount.IsNegative() {
+		return validation.ErrMinGreaterEqualThanRequired.SetParams(map[string]any{"threshold": "0.01"})
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type PayrollCostReportRequest struct {
+	From    string `query:"from"`
+	To      string `query:"to"`
+	GroupBy string `query:"group_by"`
+}
+
+func (r *PayrollCostReportRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.From, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.To, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.GroupBy, validation.In(string(entity.GroupByNone), string(entity.GroupByDepartment), string(entity.GroupByCostCentre))),
+	)
+}
+
+func (r *PayrollCostReportRequest) ToRequestEntity() entity.PayrollCostFilter {
+	startDate, _ := time.Parse(dateFormat, r.From)
+	endDate, _ := time.Parse(dateFormat, r.To)
+
+	groupBy := entity.GroupBy(r.GroupBy)
+	if r.GroupBy == "" {
+		groupBy = entity.GroupByNone
+	}
+
+	return entity.PayrollCostFilter{
+		StartDate: startDate,
+		EndDate:   endDate,
+		GroupBy:   groupBy,
+	}
+}
+
+type PayrollCostResponse struct {
+	Headcount     int64       `json:"headcount"`
+	BasePay       money.Money `json:"base_pay"`
+	OvertimePay   money.Money `json:"overtime_pay"`
+	Earnings      money.Money `json:"earnings"`
+	GrossPay      money.Money `json:"gross_pay"`
+	Reimbursement money.Money `json:"reimbursement"`
+	Deductions    money.Money `json:"deductions"`
+	TakeHome      money.Money `json:"take_home_pay"`
+	TotalCost     money.Money `json:"total_cost"`
+}
+
+func newPayrollCostResponse(cost entity.PayrollCost) PayrollCostResponse {
+	return PayrollCostResponse{
+		Headcount:     cost.Headcount,
+		BasePay:       cost.BasePay,
+		OvertimePay:   cost.OvertimePay,
+		Earnings:      cost.Earnings,
+		GrossPay:      cost.GrossPay,
+		Reimbursement: cost.Reimbursement,
+		Deductions:    cost.Deductions,
+		TakeHome:      cost.TakeHome,
+		TotalCost:     cost.TotalCost,
+	}
+}
+
+type PayrollCostGroupResponse struct {
+	Key             optional.String     `json:"key"`
+	Cost            PayrollCostResponse `json:"cost"`
+	TotalCostChange optional.Money      `json:"total_cost_change"`
+}
+
+func newPayrollCostGroupResponse(group entity.PayrollCostGroup) PayrollCostGroupResponse {
+	return PayrollCostGroupResponse{
+		Key:             group.Key,
+		Cost:            newPayrollCostResponse(group.Cost),
+		TotalCostChange: group.TotalCostChange,
+	}
+}
+
+type PayrollCostPeriodResponse struct {
+	PeriodID      string                     `json:"period_id"`
+	StartDate     string                     `json:"start_date"`
+	EndDate       string                     `json:"end_date"`
+	PayrollID     string                     `json:"payroll_id"`
+	PayrollStatus string                     `json:"payroll_status"`
+	Total         PayrollCostGroupResponse   `json:"total"`
+	Groups        []PayrollCostGroupResponse `json:"groups"`
+}
+
+type PayrollCostReportResponse struct {
+	StartDate string                      `json:"start_date"`
+	EndDate   string                      `json:"end_date"`
+	GroupBy   string                      `json:"group_by"`
+	Periods   []PayrollCostPeriodResponse `json:"periods"`
+	Total     PayrollCostResponse         `json:"total"`
+}
+
+func NewPayrollCostReportResponse(report entity.PayrollCostReport) PayrollCostReportResponse {
+	periods := make([]PayrollCostPeriodResponse, len(report.Periods))
+	for i, period := range report.Periods {
+		groups := make([]PayrollCostGroupResponse, len(period.Groups))
+		for j, group := range period.Groups {
+			groups[j] = newPayrollCostGroupResponse(group)
+		}
+
+		periods[i] = PayrollCostPeriodResponse{
+			PeriodID:      period.PeriodID,
+			StartDate:     period.StartDate.Format(dateFormat),
+			EndDate:       period.EndDate.Format(dateFormat),
+			PayrollID:     period.PayrollID,
+			PayrollStatus: period.PayrollStatus,
+			Total:         newPayrollCostGroupResponse(period.Total),
+			Groups:        groups,
+		}
+	}
+
+	return PayrollCostReportResponse{
+		StartDate: report.StartDate.Format(dateFormat),
+		EndDate:   report.EndDate.Format(dateFormat),
+		GroupBy:   string(report.GroupBy),
+		Periods:   periods,
+		Total:     newPayrollCostResponse(report.Total),
+	}
+}
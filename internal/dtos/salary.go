@@ -0,0 +1,62 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type SalaryChangeRequest struct {
+	Salary        money.Money     `json:"salary" validate:"required"`
+	EffectiveFrom string          `json:"effective_from" validate:"required"`
+	Reason        optional.String `json:"reason,omitempty"`
+}
+
+func (r *SalaryChangeRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Salary, validation.By(positiveAmount)),
+		validation.Field(&r.EffectiveFrom, validation.Required, validation.Date(dateFormat)),
+	)
+}
+
+func (r *SalaryChangeRequest) ToRequestEntity() entity.ScheduleSalaryChange {
+	parsedDate, _ := time.Parse(dateFormat, r.EffectiveFrom)
+	return entity.ScheduleSalaryChange{
+		Salary:        r.Salary,
+		EffectiveFrom: parsedDate,
+		Reason:        r.Reason,
+	}
+}
+
+type SalaryHistoryResponse struct {
+	ID            string          `json:"id"`
+	UserID        string          `json:"user_id"`
+	Salary        money.Money     `json:"salary"`
+	EffectiveFrom string          `json:"effective_from"`
+	Reason        optional.String `json:"reason"`
+	CreatedBy     string          `json:"created_by"`
+	CreatedAt     string          `json:"created_at"`
+}
+
+func NewSalaryHistoryResponse(salaryHistory entity.SalaryHistory) SalaryHistoryResponse {
+	return SalaryHistoryResponse{
+		ID:            salaryHistory.ID,
+		UserID:        salaryHistory.UserID,
+		Salary:        salaryHistory.Salary,
+		EffectiveFrom: salaryHistory.EffectiveFrom.Format(dateFormat),
+		Reason:        salaryHistory.Reason,
+		CreatedBy:     salaryHistory.CreatedBy,
+		CreatedAt:     salaryHistory.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewListSalaryHistoryResponse(salaryHistory []entity.SalaryHistory) []SalaryHistoryResponse {
+	responses := make([]SalaryHistoryResponse, len(salaryHistory))
+	for i, item := range salaryHistory {
+		responses[i] = NewSalaryHistoryResponse(item)
+	}
+	return responses
+}
@@ -0,0 +1,206 @@
+package dtos
+
+import (
+	"strings"
+	"time"
+
+	"github.com/invopop/validation"
+	"github.com/invopop/validation/is"
+	"github.com/vnnyx/employee-management/internal/users/entity"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/timezone"
+)
+
+type UserEmploymentRequest struct {
+	EmploymentStartDate string          `json:"employment_start_date" validate:"required"`
+	EmploymentEndDate   optional.String `json:"employment_end_date,omitempty"`
+}
+
+func (r *UserEmploymentRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.EmploymentStartDate, validation.Required, validation.Date(dateFormat)),
+		validation.Field(&r.EmploymentEndDate, validation.By(optionalDate)),
+	)
+}
+
+func (r *UserEmploymentRequest) ToRequestEntity() entity.UpdateUserEmployment {
+	startDate, _ := time.Parse(dateFormat, r.EmploymentStartDate)
+
+	endDate := optional.NewTime()
+	r.EmploymentEndDate.IfPresent(func(value string) {
+		parsedDate, _ := time.Parse(dateFormat, value)
+		endDate.Set(parsedDate)
+	})
+
+	return entity.UpdateUserEmployment{
+		EmploymentStartDate: startDate,
+		EmploymentEndDate:   endDate,
+	}
+}
+
+func optionalDate(value any) error {
+	date, ok := value.(optional.String)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if v, ok := date.Get(); ok {
+		return validation.Date(dateFormat).Validate(v)
+	}
+	return nil
+}
+
+type UserEmploymentResponse struct {
+	ID                  string          `json:"id"`
+	Username            string          `json:"username"`
+	EmploymentStartDate string          `json:"employment_start_date"`
+	EmploymentEndDate   optional.String `json:"employment_end_date"`
+}
+
+func NewUserEmploymentResponse(user entity.User) UserEmploymentResponse {
+	response := UserEmploymentResponse{
+		ID:                  user.ID,
+		Username:            user.Username,
+		EmploymentStartDate: user.EmploymentStartDate.Format(dateFormat),
+		EmploymentEndDate:   optional.NewString(),
+	}
+	if endDate, ok := user.EmploymentEndDate.Get(); ok {
+		response.EmploymentEndDate = optional.NewString(endDate.Format(dateFormat))
+	}
+	return response
+}
+
+type UserDepartmentRequest struct {
+	Department optional.String `json:"department,omitempty"`
+	CostCentre optional.String `json:"cost_centre,omitempty"`
+}
+
+func (r *UserDepartmentRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Department, validation.By(optionalName)),
+		validation.Field(&r.CostCentre, validation.By(optionalName)),
+	)
+}
+
+func (r *UserDepartmentRequest) ToRequestEntity() entity.UpdateUserDepartment {
+	return entity.UpdateUserDepartment{
+		Department: blankToEmpty(r.Department.TrimSpace()),
+		CostCentre: blankToEmpty(r.CostCentre.TrimSpace()),
+	}
+}
+
+func optionalName(value any) error {
+	name, ok := value.(optional.String)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if v, ok := name.Get(); ok {
+		return validation.Length(0, 100).Validate(v)
+	}
+	return nil
+}
+
+// blankToEmpty unassigns the user when an empty name is sent.
+func blankToEmpty(value optional.String) optional.String {
+	if v, ok := value.Get(); ok && v != "" {
+		return value
+	}
+	return optional.NewString()
+}
+
+type UserDepartmentResponse struct {
+	ID         string          `json:"id"`
+	Username   string          `json:"username"`
+	Department optional.String `json:"department"`
+	CostCentre optional.String `json:"cost_centre"`
+}
+
+func NewUserDepartmentResponse(user entity.User) UserDepartmentResponse {
+	return UserDepartmentResponse{
+		ID:         user.ID,
+		Username:   user.Username,
+		Department: user.Department,
+		CostCentre: user.CostCentre,
+	}
+}
+
+type UserTimezoneRequest struct {
+	Timezone string `json:"timezone" validate:"required"`
+}
+
+func (r *UserTimezoneRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Timezone, validation.Required, validation.By(ianaTimezone)),
+	)
+}
+
+func (r *UserTimezoneRequest) ToRequestEntity() entity.UpdateUserTimezone {
+	return entity.UpdateUserTimezone{
+		Timezone: r.Timezone,
+	}
+}
+
+func ianaTimezone(value any) error {
+	name, ok := value.(string)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if _, err := timezone.Load(name); err != nil {
+		return validation.NewError("validation_is_timezone", "must be an IANA timezone")
+	}
+	return nil
+}
+
+type UserTimezoneResponse struct {
+	ID       string `json:"id"`
+	Username string `json:"username"`
+	Timezone string `json:"timezone"`
+}
+
+func NewUserTimezoneResponse(user entity.User) UserTimezoneResponse {
+	return UserTimezoneResponse{
+		ID:       user.ID,
+		Username: user.Username,
+		Timezone: user.Location().String(),
+	}
+}
+
+type UserManagerRequest struct {
+	ManagerID optional.String `json:"manager_id,omitempty"`
+}
+
+func (r *UserManagerRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.ManagerID, validation.By(optionalUUID)),
+	)
+}
+
+func (r *UserManagerRequest) ToRequestEntity() entity.UpdateUserManager {
+	return entity.UpdateUserManager{
+		ManagerID: blankToEmpty(r.ManagerID.TrimSpace()),
+	}
+}
+
+func optionalUUID(value any) error {
+	id, ok := value.(optional.String)
+	if !ok {
+		return validation.ErrNotNilRequired
+	}
+	if v, ok := id.Get(); ok && strings.TrimSpace(v) != "" {
+		return is.UUID.Validate(v)
+	}
+	return nil
+}
+
+type UserManagerResponse struct {
+	ID        string          `json:"id"`
+	Username  string          `json:"username"`
+	ManagerID optional.String `json:"manager_id"`
+}
+
+func NewUserManagerResponse(user entity.User) UserManagerResponse {
+	return UserManagerResponse{
+		ID:        user.ID,
+		Username:  user.Username,
+		ManagerID: user.ManagerID,
+	}
+}
@@ -0,0 +1,255 @@
+package v1
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type HolidayHandler struct {
+	uc holiday.UseCase
+}
+
+func NewHolidayHandler(uc holiday.UseCase) *HolidayHandler {
+	return &HolidayHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      List Holidays
+// @Description  List the holidays between two dates, both included
+// @Tags         Holiday
+// @Produce      json
+// @Param        from query string true "Start date (YYYY-MM-DD)"
+// @Param        to query string true "End date (YYYY-MM-DD)"
+// @Success      200 {object} dtos.Response{data=[]dtos.HolidayResponse} "Holidays Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/holidays [GET]
+// @Security     BearerAuth
+func (h *HolidayHandler) ListHolidays(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"HolidayHandler.ListHolidays()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.ListHolidaysRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "HolidayHandler().ListHolidays().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "HolidayHandler().ListHolidays().req.Validate()")
+	}
+
+	from, to := req.ToRequestEntity()
+	holidays, err := h.uc.ListHolidays(ctx, authCredential, from, to)
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().ListHolidays().uc.ListHolidays()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListHolidayResponse(holidays),
+		},
+	)
+}
+
+// @Summary      Create Holiday
+// @Description  Add a holiday to the working-day calendar
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.HolidayRequest true "Holiday Request"
+// @Success      201 {object} dtos.Response{data=dtos.HolidayResponse} "Holiday Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/holidays [POST]
+// @Security     BearerAuth
+func (h *HolidayHandler) CreateHoliday(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"HolidayHandler.CreateHoliday()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.HolidayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "HolidayHandler().CreateHoliday().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "HolidayHandler().CreateHoliday().req.Validate()")
+	}
+
+	data, err := h.uc.CreateHoliday(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().CreateHoliday().uc.CreateHoliday()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewHolidayResponse(data),
+		},
+	)
+}
+
+// @Summary      Update Holiday
+// @Description  Change the date or name of a holiday
+// @Tags         Holiday
+// @Accept       json
+// @Produce      json
+// @Param        holidayId path string true "Holiday ID"
+// @Param        request body dtos.HolidayRequest true "Holiday Request"
+// @Success      200 {object} dtos.Response{data=dtos.HolidayResponse} "Holiday Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/holidays/{holidayId} [PUT]
+// @Security     BearerAuth
+func (h *HolidayHandler) UpdateHoliday(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"HolidayHandler.UpdateHoliday()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		HolidayID uuid.UUID `params:"holidayId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().UpdateHoliday().c.ParamsParser()")
+	}
+
+	var req dtos.HolidayRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "HolidayHandler().UpdateHoliday().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "HolidayHandler().UpdateHoliday().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateHoliday(ctx, authCredential, param.HolidayID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().UpdateHoliday().uc.UpdateHoliday()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewHolidayResponse(data),
+		},
+	)
+}
+
+// @Summary      Delete Holiday
+// @Description  Remove a holiday from the working-day calendar
+// @Tags         Holiday
+// @Produce      json
+// @Param        holidayId path string true "Holiday ID"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/holidays/{holidayId} [DELETE]
+// @Security     BearerAuth
+func (h *HolidayHandler) DeleteHoliday(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"HolidayHandler.DeleteHoliday()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		HolidayID uuid.UUID `params:"holidayId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().DeleteHoliday().c.ParamsParser()")
+	}
+
+	err = h.uc.DeleteHoliday(ctx, authCredential, param.HolidayID.String())
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().DeleteHoliday().uc.DeleteHoliday()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+		},
+	)
+}
+
+// @Summary      Import Holidays
+// @Description  Import holidays from a CSV (date,name) or ICS file, existing dates are renamed
+// @Tags         Holiday
+// @Accept       multipart/form-data
+// @Produce      json
+// @Param        file formData file true "Holiday file"
+// @Param        format formData string false "File format (csv or ics), defaults to the file extension"
+// @Success      200 {object} dtos.Response{data=dtos.ImportHolidaysResponse} "Import Holidays Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/holidays/import [POST]
+// @Security     BearerAuth
+func (h *HolidayHandler) ImportHolidays(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"HolidayHandler.ImportHolidays()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().ImportHolidays().c.FormFile()")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().ImportHolidays().fileHeader.Open()")
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().ImportHolidays().io.ReadAll()")
+	}
+
+	format := c.FormValue("format")
+	if format == "" {
+		format = strings.TrimPrefix(filepath.Ext(fileHeader.Filename), ".")
+	}
+
+	data, err := h.uc.ImportHolidays(ctx, authCredential, entity.ImportHolidays{
+		Format:  entity.ImportFormat(strings.ToLower(format)),
+		Content: content,
+	})
+	if err != nil {
+		return errors.Wrap(err, "HolidayHandler().ImportHolidays().uc.ImportHolidays()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewImportHolidaysResponse(data),
+		},
+	)
+}
@@ -0,0 +1,13 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapHoliday(routes fiber.Router, h *HolidayHandler) {
+	holidays := routes.Group("/holidays")
+
+	holidays.Get("/", h.ListHolidays)
+	holidays.Post("/", h.CreateHoliday)
+	holidays.Post("/import", h.ImportHolidays)
+	holidays.Put("/:holidayId", h.UpdateHoliday)
+	holidays.Delete("/:holidayId", h.DeleteHoliday)
+}
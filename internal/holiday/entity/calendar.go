@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+)
+
+const calendarDateFormat = "2006-01-02"
+
+// Calendar decides which dates are working days. Saturdays, Sundays and the
+// configured holidays are not, attendance, overtime and payroll all count
+// working days through it so they never disagree.
+type Calendar struct {
+	holidays map[string]Holiday
+}
+
+func NewCalendar(holidays []Holiday) Calendar {
+	mapped := make(map[string]Holiday, len(holidays))
+	for _, holiday := range holidays {
+		mapped[holiday.HolidayDate.Format(calendarDateFormat)] = holiday
+	}
+	return Calendar{
+		holidays: mapped,
+	}
+}
+
+func (c Calendar) IsHoliday(date time.Time) bool {
+	_, ok := c.holidays[date.Format(calendarDateFormat)]
+	return ok
+}
+
+func (c Calendar) IsWeekend(date time.Time) bool {
+	return date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+}
+
+func (c Calendar) IsWorkingDay(date time.Time) bool {
+	return !c.IsWeekend(date) && !c.IsHoliday(date)
+}
+
+// WorkingDays counts the working days between startDate and endDate, both
+// dates included.
+func (c Calendar) WorkingDays(startDate, endDate time.Time) int64 {
+	var total int64
+	start := time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, startDate.Location())
+	end := time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 0, 0, 0, 0, startDate.Location())
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(date) {
+			total++
+		}
+	}
+	return total
+}
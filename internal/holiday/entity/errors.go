@@ -0,0 +1,29 @@
+package entity
+
+const (
+	HolidayNotAuthorized     = "HOLIDAY_NOT_AUTHORIZED"
+	HolidayNotFound          = "HOLIDAY_NOT_FOUND"
+	HolidayAlreadyExists     = "HOLIDAY_ALREADY_EXISTS"
+	HolidayInvalidRange      = "HOLIDAY_INVALID_RANGE"
+	HolidayInvalidImportFile = "HOLIDAY_INVALID_IMPORT_FILE"
+	HolidayUnsupportedFormat = "HOLIDAY_UNSUPPORTED_FORMAT"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case HolidayNotAuthorized:
+		return "You are not authorized to manage holidays"
+	case HolidayNotFound:
+		return "Holiday not found"
+	case HolidayAlreadyExists:
+		return "A holiday on the same date already exists"
+	case HolidayInvalidRange:
+		return "The date range is invalid, from must be before to"
+	case HolidayInvalidImportFile:
+		return "The holiday file could not be read"
+	case HolidayUnsupportedFormat:
+		return "Unsupported holiday file format, expected csv or ics"
+	default:
+		return "An unknown error occurred"
+	}
+}
@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+)
+
+type Holiday struct {
+	ID          string    `db:"id"`
+	HolidayDate time.Time `db:"holiday_date"`
+	Name        string    `db:"name"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+	CreatedBy   string    `db:"created_by"`
+	UpdatedBy   string    `db:"updated_by"`
+	IPAddress   string    `db:"ip_address"`
+}
+
+type UpsertHoliday struct {
+	HolidayDate time.Time
+	Name        string
+}
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV ImportFormat = "csv"
+	ImportFormatICS ImportFormat = "ics"
+)
+
+type ImportHolidays struct {
+	Format  ImportFormat
+	Content []byte
+}
+
+type ImportedHolidays struct {
+	TotalImported int64
+	Holidays      []Holiday
+}
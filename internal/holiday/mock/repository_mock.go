@@ -0,0 +1,314 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/holiday/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/holiday/repository.go -destination internal/holiday/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	holiday "github.com/vnnyx/employee-management/internal/holiday"
+	entity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockRepository) DeleteHoliday(ctx context.Context, holidayID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, holidayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockRepositoryMockRecorder) DeleteHoliday(ctx, holidayID any) *MockRepositoryDeleteHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockRepository)(nil).DeleteHoliday), ctx, holidayID)
+	return &MockRepositoryDeleteHolidayCall{Call: call}
+}
+
+// MockRepositoryDeleteHolidayCall wrap *gomock.Call
+type MockRepositoryDeleteHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryDeleteHolidayCall) Return(arg0 error) *MockRepositoryDeleteHolidayCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryDeleteHolidayCall) Do(f func(context.Context, string) error) *MockRepositoryDeleteHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryDeleteHolidayCall) DoAndReturn(f func(context.Context, string) error) *MockRepositoryDeleteHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindHolidayByID mocks base method.
+func (m *MockRepository) FindHolidayByID(ctx context.Context, holidayID string) (*entity.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHolidayByID", ctx, holidayID)
+	ret0, _ := ret[0].(*entity.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHolidayByID indicates an expected call of FindHolidayByID.
+func (mr *MockRepositoryMockRecorder) FindHolidayByID(ctx, holidayID any) *MockRepositoryFindHolidayByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHolidayByID", reflect.TypeOf((*MockRepository)(nil).FindHolidayByID), ctx, holidayID)
+	return &MockRepositoryFindHolidayByIDCall{Call: call}
+}
+
+// MockRepositoryFindHolidayByIDCall wrap *gomock.Call
+type MockRepositoryFindHolidayByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindHolidayByIDCall) Return(arg0 *entity.Holiday, arg1 error) *MockRepositoryFindHolidayByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindHolidayByIDCall) Do(f func(context.Context, string) (*entity.Holiday, error)) *MockRepositoryFindHolidayByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindHolidayByIDCall) DoAndReturn(f func(context.Context, string) (*entity.Holiday, error)) *MockRepositoryFindHolidayByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindHolidaysByRange mocks base method.
+func (m *MockRepository) FindHolidaysByRange(ctx context.Context, startDate, endDate time.Time) ([]entity.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHolidaysByRange", ctx, startDate, endDate)
+	ret0, _ := ret[0].([]entity.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHolidaysByRange indicates an expected call of FindHolidaysByRange.
+func (mr *MockRepositoryMockRecorder) FindHolidaysByRange(ctx, startDate, endDate any) *MockRepositoryFindHolidaysByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHolidaysByRange", reflect.TypeOf((*MockRepository)(nil).FindHolidaysByRange), ctx, startDate, endDate)
+	return &MockRepositoryFindHolidaysByRangeCall{Call: call}
+}
+
+// MockRepositoryFindHolidaysByRangeCall wrap *gomock.Call
+type MockRepositoryFindHolidaysByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindHolidaysByRangeCall) Return(arg0 []entity.Holiday, arg1 error) *MockRepositoryFindHolidaysByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindHolidaysByRangeCall) Do(f func(context.Context, time.Time, time.Time) ([]entity.Holiday, error)) *MockRepositoryFindHolidaysByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindHolidaysByRangeCall) DoAndReturn(f func(context.Context, time.Time, time.Time) ([]entity.Holiday, error)) *MockRepositoryFindHolidaysByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewHoliday mocks base method.
+func (m *MockRepository) StoreNewHoliday(ctx context.Context, arg1 entity.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewHoliday", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewHoliday indicates an expected call of StoreNewHoliday.
+func (mr *MockRepositoryMockRecorder) StoreNewHoliday(ctx, arg1 any) *MockRepositoryStoreNewHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewHoliday", reflect.TypeOf((*MockRepository)(nil).StoreNewHoliday), ctx, arg1)
+	return &MockRepositoryStoreNewHolidayCall{Call: call}
+}
+
+// MockRepositoryStoreNewHolidayCall wrap *gomock.Call
+type MockRepositoryStoreNewHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewHolidayCall) Return(arg0 error) *MockRepositoryStoreNewHolidayCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewHolidayCall) Do(f func(context.Context, entity.Holiday) error) *MockRepositoryStoreNewHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewHolidayCall) DoAndReturn(f func(context.Context, entity.Holiday) error) *MockRepositoryStoreNewHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateHoliday mocks base method.
+func (m *MockRepository) UpdateHoliday(ctx context.Context, arg1 entity.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHoliday", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHoliday indicates an expected call of UpdateHoliday.
+func (mr *MockRepositoryMockRecorder) UpdateHoliday(ctx, arg1 any) *MockRepositoryUpdateHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHoliday", reflect.TypeOf((*MockRepository)(nil).UpdateHoliday), ctx, arg1)
+	return &MockRepositoryUpdateHolidayCall{Call: call}
+}
+
+// MockRepositoryUpdateHolidayCall wrap *gomock.Call
+type MockRepositoryUpdateHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateHolidayCall) Return(arg0 error) *MockRepositoryUpdateHolidayCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateHolidayCall) Do(f func(context.Context, entity.Holiday) error) *MockRepositoryUpdateHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateHolidayCall) DoAndReturn(f func(context.Context, entity.Holiday) error) *MockRepositoryUpdateHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpsertHolidays mocks base method.
+func (m *MockRepository) UpsertHolidays(ctx context.Context, holidays []entity.Holiday) ([]entity.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertHolidays", ctx, holidays)
+	ret0, _ := ret[0].([]entity.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertHolidays indicates an expected call of UpsertHolidays.
+func (mr *MockRepositoryMockRecorder) UpsertHolidays(ctx, holidays any) *MockRepositoryUpsertHolidaysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertHolidays", reflect.TypeOf((*MockRepository)(nil).UpsertHolidays), ctx, holidays)
+	return &MockRepositoryUpsertHolidaysCall{Call: call}
+}
+
+// MockRepositoryUpsertHolidaysCall wrap *gomock.Call
+type MockRepositoryUpsertHolidaysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpsertHolidaysCall) Return(arg0 []entity.Holiday, arg1 error) *MockRepositoryUpsertHolidaysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpsertHolidaysCall) Do(f func(context.Context, []entity.Holiday) ([]entity.Holiday, error)) *MockRepositoryUpsertHolidaysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpsertHolidaysCall) DoAndReturn(f func(context.Context, []entity.Holiday) ([]entity.Holiday, error)) *MockRepositoryUpsertHolidaysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) holiday.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(holiday.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 holiday.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) holiday.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) holiday.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/holiday/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/holiday/usecase.go -destination internal/holiday/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/holiday/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateHoliday mocks base method.
+func (m *MockUseCase) CreateHoliday(ctx context.Context, authCredential entity.Credential, payload entity0.UpsertHoliday) (entity0.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHoliday", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity0.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHoliday indicates an expected call of CreateHoliday.
+func (mr *MockUseCaseMockRecorder) CreateHoliday(ctx, authCredential, payload any) *MockUseCaseCreateHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHoliday", reflect.TypeOf((*MockUseCase)(nil).CreateHoliday), ctx, authCredential, payload)
+	return &MockUseCaseCreateHolidayCall{Call: call}
+}
+
+// MockUseCaseCreateHolidayCall wrap *gomock.Call
+type MockUseCaseCreateHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCreateHolidayCall) Return(arg0 entity0.Holiday, arg1 error) *MockUseCaseCreateHolidayCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCreateHolidayCall) Do(f func(context.Context, entity.Credential, entity0.UpsertHoliday) (entity0.Holiday, error)) *MockUseCaseCreateHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCreateHolidayCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.UpsertHoliday) (entity0.Holiday, error)) *MockUseCaseCreateHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteHoliday mocks base method.
+func (m *MockUseCase) DeleteHoliday(ctx context.Context, authCredential entity.Credential, holidayID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHoliday", ctx, authCredential, holidayID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHoliday indicates an expected call of DeleteHoliday.
+func (mr *MockUseCaseMockRecorder) DeleteHoliday(ctx, authCredential, holidayID any) *MockUseCaseDeleteHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHoliday", reflect.TypeOf((*MockUseCase)(nil).DeleteHoliday), ctx, authCredential, holidayID)
+	return &MockUseCaseDeleteHolidayCall{Call: call}
+}
+
+// MockUseCaseDeleteHolidayCall wrap *gomock.Call
+type MockUseCaseDeleteHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseDeleteHolidayCall) Return(arg0 error) *MockUseCaseDeleteHolidayCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseDeleteHolidayCall) Do(f func(context.Context, entity.Credential, string) error) *MockUseCaseDeleteHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseDeleteHolidayCall) DoAndReturn(f func(context.Context, entity.Credential, string) error) *MockUseCaseDeleteHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportHolidays mocks base method.
+func (m *MockUseCase) ImportHolidays(ctx context.Context, authCredential entity.Credential, payload entity0.ImportHolidays) (entity0.ImportedHolidays, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportHolidays", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity0.ImportedHolidays)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportHolidays indicates an expected call of ImportHolidays.
+func (mr *MockUseCaseMockRecorder) ImportHolidays(ctx, authCredential, payload any) *MockUseCaseImportHolidaysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportHolidays", reflect.TypeOf((*MockUseCase)(nil).ImportHolidays), ctx, authCredential, payload)
+	return &MockUseCaseImportHolidaysCall{Call: call}
+}
+
+// MockUseCaseImportHolidaysCall wrap *gomock.Call
+type MockUseCaseImportHolidaysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseImportHolidaysCall) Return(arg0 entity0.ImportedHolidays, arg1 error) *MockUseCaseImportHolidaysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseImportHolidaysCall) Do(f func(context.Context, entity.Credential, entity0.ImportHolidays) (entity0.ImportedHolidays, error)) *MockUseCaseImportHolidaysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseImportHolidaysCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.ImportHolidays) (entity0.ImportedHolidays, error)) *MockUseCaseImportHolidaysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListHolidays mocks base method.
+func (m *MockUseCase) ListHolidays(ctx context.Context, authCredential entity.Credential, startDate, endDate time.Time) ([]entity0.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHolidays", ctx, authCredential, startDate, endDate)
+	ret0, _ := ret[0].([]entity0.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHolidays indicates an expected call of ListHolidays.
+func (mr *MockUseCaseMockRecorder) ListHolidays(ctx, authCredential, startDate, endDate any) *MockUseCaseListHolidaysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHolidays", reflect.TypeOf((*MockUseCase)(nil).ListHolidays), ctx, authCredential, startDate, endDate)
+	return &MockUseCaseListHolidaysCall{Call: call}
+}
+
+// MockUseCaseListHolidaysCall wrap *gomock.Call
+type MockUseCaseListHolidaysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListHolidaysCall) Return(arg0 []entity0.Holiday, arg1 error) *MockUseCaseListHolidaysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListHolidaysCall) Do(f func(context.Context, entity.Credential, time.Time, time.Time) ([]entity0.Holiday, error)) *MockUseCaseListHolidaysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListHolidaysCall) DoAndReturn(f func(context.Context, entity.Credential, time.Time, time.Time) ([]entity0.Holiday, error)) *MockUseCaseListHolidaysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateHoliday mocks base method.
+func (m *MockUseCase) UpdateHoliday(ctx context.Context, authCredential entity.Credential, holidayID string, payload entity0.UpsertHoliday) (entity0.Holiday, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHoliday", ctx, authCredential, holidayID, payload)
+	ret0, _ := ret[0].(entity0.Holiday)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHoliday indicates an expected call of UpdateHoliday.
+func (mr *MockUseCaseMockRecorder) UpdateHoliday(ctx, authCredential, holidayID, payload any) *MockUseCaseUpdateHolidayCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHoliday", reflect.TypeOf((*MockUseCase)(nil).UpdateHoliday), ctx, authCredential, holidayID, payload)
+	return &MockUseCaseUpdateHolidayCall{Call: call}
+}
+
+// MockUseCaseUpdateHolidayCall wrap *gomock.Call
+type MockUseCaseUpdateHolidayCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateHolidayCall) Return(arg0 entity0.Holiday, arg1 error) *MockUseCaseUpdateHolidayCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateHolidayCall) Do(f func(context.Context, entity.Credential, string, entity0.UpsertHoliday) (entity0.Holiday, error)) *MockUseCaseUpdateHolidayCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateHolidayCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpsertHoliday) (entity0.Holiday, error)) *MockUseCaseUpdateHolidayCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,20 @@
+package holiday
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	StoreNewHoliday(ctx context.Context, holiday entity.Holiday) error
+	UpdateHoliday(ctx context.Context, holiday entity.Holiday) error
+	DeleteHoliday(ctx context.Context, holidayID string) error
+	UpsertHolidays(ctx context.Context, holidays []entity.Holiday) ([]entity.Holiday, error)
+	FindHolidayByID(ctx context.Context, holidayID string) (*entity.Holiday, error)
+	FindHolidaysByRange(ctx context.Context, startDate, endDate time.Time) ([]entity.Holiday, error)
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type holidayRepo struct {
+	db database.Queryer
+}
+
+func NewHolidayRepository(db database.Queryer) holiday.Repository {
+	return &holidayRepo{
+		db: db,
+	}
+}
+
+func (r *holidayRepo) WithTx(tx database.DBTx) holiday.Repository {
+	return &holidayRepo{
+		db: tx,
+	}
+}
+
+func (r *holidayRepo) StoreNewHoliday(ctx context.Context, holiday entity.Holiday) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.StoreNewHoliday()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertHolidayQuery, holiday)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert holiday"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *holidayRepo) UpdateHoliday(ctx context.Context, holiday entity.Holiday) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.UpdateHoliday()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateHolidayQuery, holiday)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update holiday"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *holidayRepo) DeleteHoliday(ctx context.Context, holidayID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.DeleteHoliday()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, deleteHolidayQuery, holidayID)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *holidayRepo) UpsertHolidays(ctx context.Context, holidays []entity.Holiday) ([]entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.UpsertHolidays()",
+	)
+	defer span.End()
+
+	if len(holidays) == 0 {
+		return nil, nil
+	}
+
+	query, args, err := sqlx.Named(upsertHolidaysQuery, holidays)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	// Dates that already exist keep their ID, so the stored rows are returned
+	// instead of the ones passed in.
+	var stored []entity.Holiday
+	err = pgxscan.Select(ctx, r.db, &stored, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	if len(stored) != len(holidays) {
+		return nil, errors.Wrap(errors.New("failed to upsert holidays"), constants.ErrWrapPgxscanSelect)
+	}
+
+	return stored, nil
+}
+
+func (r *holidayRepo) FindHolidayByID(ctx context.Context, holidayID string) (*entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.FindHolidayByID()",
+	)
+	defer span.End()
+
+	var holiday entity.Holiday
+	err := pgxscan.Get(ctx, r.db, &holiday, findHolidayByIDQuery, holidayID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &holiday, nil
+}
+
+func (r *holidayRepo) FindHolidaysByRange(ctx context.Context, startDate, endDate time.Time) ([]entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayRepository.FindHolidaysByRange()",
+	)
+	defer span.End()
+
+	var holidays []entity.Holiday
+	err := pgxscan.Select(ctx, r.db, &holidays, findHolidaysByRangeQuery, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return holidays, nil
+}
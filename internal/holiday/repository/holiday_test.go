@@ -0,0 +1,400 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/internal/holiday/repository"
+)
+
+func TestStoreNewHoliday(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.Holiday
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO holidays").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("holiday-1"))
+			},
+			input: entity.Holiday{
+				ID:          "holiday-1",
+				HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+				Name:        "Independence Day",
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				CreatedBy:   "admin",
+				UpdatedBy:   "admin",
+				IPAddress:   "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO holidays").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.Holiday{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO holidays").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.Holiday{ID: "holiday-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewHoliday(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdateHoliday(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.Holiday
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE holidays SET").
+					WithArgs(time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC), "Independence Day (observed)", now, "admin", "127.0.0.1", "holiday-1").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("holiday-1"))
+			},
+			input: entity.Holiday{
+				ID:          "holiday-1",
+				HolidayDate: time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC),
+				Name:        "Independence Day (observed)",
+				UpdatedAt:   now,
+				UpdatedBy:   "admin",
+				IPAddress:   "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE holidays SET").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(pgx.ErrNoRows)
+			},
+			input:     entity.Holiday{ID: "holiday-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateHoliday(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteHoliday(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		holidayID string
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM holidays").
+					WithArgs("holiday-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			holidayID: "holiday-1",
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM holidays").
+					WithArgs("holiday-2").
+					WillReturnError(errors.New("delete failed"))
+			},
+			holidayID: "holiday-2",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.DeleteHoliday(context.Background(), tt.holidayID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpsertHolidays(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     []entity.Holiday
+		expectErr bool
+	}{
+		{
+			name: "success - multiple rows",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO holidays (.+) ON CONFLICT").
+					WithArgs(
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+					).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "holiday_date", "name",
+						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+					}).
+						AddRow("holiday-1", now, "New Year", now, now, "admin", "admin", "127.0.0.1").
+						AddRow("holiday-2", now, "Labour Day", now, now, "admin", "admin", "127.0.0.1"))
+			},
+			input: []entity.Holiday{
+				{ID: "holiday-1", CreatedAt: now, UpdatedAt: now},
+				{ID: "holiday-2", CreatedAt: now, UpdatedAt: now},
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - mismatched return count",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO holidays (.+) ON CONFLICT").
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "holiday_date", "name",
+						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+					}).AddRow("holiday-1", now, "New Year", now, now, "admin", "admin", "127.0.0.1"))
+			},
+			input:     []entity.Holiday{{ID: "holiday-1"}, {ID: "holiday-2"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty input",
+			setupMock: func() {}, // No DB call expected
+			input:     []entity.Holiday{},
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.UpsertHolidays(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, len(tt.input))
+			}
+		})
+	}
+}
+
+func TestFindHolidayByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		holidayID string
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE id").
+					WithArgs("holiday-1").
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "holiday_date", "name",
+						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+					}).AddRow("holiday-1", now, "Independence Day", now, now, "admin", "admin", "127.0.0.1"))
+			},
+			holidayID: "holiday-1",
+			expectNil: false,
+			expectErr: false,
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE id").
+					WithArgs("holiday-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			holidayID: "holiday-2",
+			expectNil: true,
+			expectErr: false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE id").
+					WithArgs("holiday-3").
+					WillReturnError(errors.New("db error"))
+			},
+			holidayID: "holiday-3",
+			expectNil: true,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindHolidayByID(context.Background(), tt.holidayID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+			}
+		})
+	}
+}
+
+func TestFindHolidaysByRange(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewHolidayRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectedLen int
+		expectErr   bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE holiday_date BETWEEN").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "holiday_date", "name",
+						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+					}).
+						AddRow("holiday-1", time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC), "Independence Day", now, now, "admin", "admin", "127.0.0.1").
+						AddRow("holiday-2", time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC), "Collective Leave", now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expectedLen: 2,
+			expectErr:   false,
+		},
+		{
+			name: "empty",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE holiday_date BETWEEN").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows([]string{
+						"id", "holiday_date", "name",
+						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+					}))
+			},
+			expectedLen: 0,
+			expectErr:   false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM holidays WHERE holiday_date BETWEEN").
+					WithArgs(startDate, endDate).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindHolidaysByRange(context.Background(), startDate, endDate)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectedLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package repository
+
+const insertHolidayQuery = `
+INSERT INTO holidays (
+	id,
+	holiday_date,
+	name,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:holiday_date,
+	:name,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const updateHolidayQuery = `
+UPDATE holidays SET
+	holiday_date = :holiday_date,
+	name = :name,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :id
+RETURNING id
+`
+
+const deleteHolidayQuery = `
+DELETE FROM holidays
+WHERE id = $1
+`
+
+const upsertHolidaysQuery = `
+INSERT INTO holidays (
+	id,
+	holiday_date,
+	name,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:holiday_date,
+	:name,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+ON CONFLICT (holiday_date) DO UPDATE SET
+	name = EXCLUDED.name,
+	updated_at = EXCLUDED.updated_at,
+	updated_by = EXCLUDED.updated_by,
+	ip_address = EXCLUDED.ip_address
+RETURNING
+	id,
+	holiday_date,
+	name,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+`
+
+const findHolidayByIDQuery = `
+SELECT
+	id,
+	holiday_date,
+	name,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM holidays
+WHERE id = $1
+`
+
+const findHolidaysByRangeQuery = `
+SELECT
+	id,
+	holiday_date,
+	name,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM holidays
+WHERE holiday_date BETWEEN $1::DATE AND $2::DATE
+ORDER BY holiday_date ASC
+`
@@ -0,0 +1,17 @@
+package holiday
+
+import (
+	"context"
+	"time"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+)
+
+type UseCase interface {
+	ListHolidays(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.Holiday, error)
+	CreateHoliday(ctx context.Context, authCredential authCredential.Credential, payload entity.UpsertHoliday) (entity.Holiday, error)
+	UpdateHoliday(ctx context.Context, authCredential authCredential.Credential, holidayID string, payload entity.UpsertHoliday) (entity.Holiday, error)
+	DeleteHoliday(ctx context.Context, authCredential authCredential.Credential, holidayID string) error
+	ImportHolidays(ctx context.Context, authCredential authCredential.Credential, payload entity.ImportHolidays) (entity.ImportedHolidays, error)
+}
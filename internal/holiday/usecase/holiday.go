@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type holidayUseCase struct {
+	holidayRepo holiday.Repository
+}
+
+func NewHolidayUseCase(holidayRepo holiday.Repository) holiday.UseCase {
+	return &holidayUseCase{
+		holidayRepo: holidayRepo,
+	}
+}
+
+func (u *holidayUseCase) ListHolidays(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayUseCase.ListHolidays()",
+	)
+	defer span.End()
+
+	if startDate.After(endDate) {
+		return nil, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.HolidayInvalidRange,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayInvalidRange),
+			},
+		)
+	}
+
+	holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "HolidayUseCase.ListHolidays().FindHolidaysByRange()")
+	}
+
+	return holidays, nil
+}
+
+func (u *holidayUseCase) CreateHoliday(ctx context.Context, authCredential authCredential.Credential, payload entity.UpsertHoliday) (entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayUseCase.CreateHoliday()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.Holiday{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.HolidayNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newHoliday := entity.Holiday{
+		ID:          uuid.NewString(),
+		HolidayDate: payload.HolidayDate,
+		Name:        payload.Name,
+		CreatedAt:   timeNow,
+		UpdatedAt:   timeNow,
+		CreatedBy:   authCredential.UserID,
+		UpdatedBy:   authCredential.UserID,
+		IPAddress:   authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+
+		err := holidayRepoTx.StoreNewHoliday(ctx, newHoliday)
+		if err != nil {
+			if database.IsUniqueViolation(err, "holidays_holiday_date_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.HolidayAlreadyExists,
+						Message:   entity.GetErrorMessageByIssueCode(entity.HolidayAlreadyExists),
+						Received:  payload.HolidayDate.Format(holidayDateFormat),
+					},
+				)
+			}
+			return errors.Wrap(err, "HolidayUseCase.CreateHoliday().StoreNewHoliday()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Holiday{}, errors.Wrap(err, "HolidayUseCase.CreateHoliday().WithAuditContext()")
+	}
+
+	return newHoliday, nil
+}
+
+func (u *holidayUseCase) UpdateHoliday(ctx context.Context, authCredential authCredential.Credential, holidayID string, payload entity.UpsertHoliday) (entity.Holiday, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayUseCase.UpdateHoliday()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.Holiday{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.HolidayNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+			},
+		)
+	}
+
+	var updatedHoliday entity.Holiday
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+
+		existingHoliday, err := holidayRepoTx.FindHolidayByID(ctx, holidayID)
+		if err != nil {
+			return errors.Wrap(err, "HolidayUseCase.UpdateHoliday().FindHolidayByID()")
+		}
+		if existingHoliday == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotFound),
+					Received:  holidayID,
+				},
+			)
+		}
+
+		updatedHoliday = *existingHoliday
+		updatedHoliday.HolidayDate = payload.HolidayDate
+		updatedHoliday.Name = payload.Name
+		updatedHoliday.UpdatedAt = time.Now()
+		updatedHoliday.UpdatedBy = authCredential.UserID
+		updatedHoliday.IPAddress = authCredential.IPAddress
+
+		err = holidayRepoTx.UpdateHoliday(ctx, updatedHoliday)
+		if err != nil {
+			if database.IsUniqueViolation(err, "holidays_holiday_date_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.HolidayAlreadyExists,
+						Message:   entity.GetErrorMessageByIssueCode(entity.HolidayAlreadyExists),
+						Received:  payload.HolidayDate.Format(holidayDateFormat),
+					},
+				)
+			}
+			return errors.Wrap(err, "HolidayUseCase.UpdateHoliday().UpdateHoliday()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Holiday{}, errors.Wrap(err, "HolidayUseCase.UpdateHoliday().WithAuditContext()")
+	}
+
+	return updatedHoliday, nil
+}
+
+func (u *holidayUseCase) DeleteHoliday(ctx context.Context, authCredential authCredential.Credential, holidayID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayUseCase.DeleteHoliday()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.HolidayNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+
+		existingHoliday, err := holidayRepoTx.FindHolidayByID(ctx, holidayID)
+		if err != nil {
+			return errors.Wrap(err, "HolidayUseCase.DeleteHoliday().FindHolidayByID()")
+		}
+		if existingHoliday == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotFound),
+					Received:  holidayID,
+				},
+			)
+		}
+
+		err = holidayRepoTx.DeleteHoliday(ctx, holidayID)
+		if err != nil {
+			return errors.Wrap(err, "HolidayUseCase.DeleteHoliday().DeleteHoliday()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "HolidayUseCase.DeleteHoliday().WithAuditContext()")
+	}
+
+	return nil
+}
+
+func (u *holidayUseCase) ImportHolidays(ctx context.Context, authCredential authCredential.Credential, payload entity.ImportHolidays) (entity.ImportedHolidays, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"HolidayUseCase.ImportHolidays()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.ImportedHolidays{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.HolidayNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+			},
+		)
+	}
+
+	var (
+		parsed []entity.UpsertHoliday
+		err    error
+	)
+	switch payload.Format {
+	case entity.ImportFormatCSV:
+		parsed, err = parseHolidaysCSV(payload.Content)
+	case entity.ImportFormatICS:
+		parsed, err = parseHolidaysICS(payload.Content)
+	default:
+		return entity.ImportedHolidays{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.HolidayUnsupportedFormat,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayUnsupportedFormat),
+				Received:  string(payload.Format),
+				Path:      []string{"format"},
+			},
+		)
+	}
+	if err != nil {
+		return entity.ImportedHolidays{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.HolidayInvalidImportFile,
+				Message:   entity.GetErrorMessageByIssueCode(entity.HolidayInvalidImportFile),
+				Received:  err.Error(),
+				Path:      []string{"file"},
+			},
+		)
+	}
+
+	// A file may list the same date twice, the last entry wins as the upsert
+	// cannot touch the same row twice in one statement.
+	timeNow := time.Now()
+	holidays := make([]entity.Holiday, 0, len(parsed))
+	indexByDate := make(map[string]int, len(parsed))
+	for _, item := range parsed {
+		newHoliday := entity.Holiday{
+			ID:          uuid.NewString(),
+			HolidayDate: item.HolidayDate,
+			Name:        item.Name,
+			CreatedAt:   timeNow,
+			UpdatedAt:   timeNow,
+			CreatedBy:   authCredential.UserID,
+			UpdatedBy:   authCredential.UserID,
+			IPAddress:   authCredential.IPAddress,
+		}
+
+		key := item.HolidayDate.Format(holidayDateFormat)
+		if i, ok := indexByDate[key]; ok {
+			holidays[i] = newHoliday
+			continue
+		}
+		indexByDate[key] = len(holidays)
+		holidays = append(holidays, newHoliday)
+	}
+
+	var stored []entity.Holiday
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+
+		stored, err = holidayRepoTx.UpsertHolidays(ctx, holidays)
+		if err != nil {
+			return errors.Wrap(err, "HolidayUseCase.ImportHolidays().UpsertHolidays()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.ImportedHolidays{}, errors.Wrap(err, "HolidayUseCase.ImportHolidays().WithAuditContext()")
+	}
+
+	return entity.ImportedHolidays{
+		TotalImported: int64(len(stored)),
+		Holidays:      stored,
+	}, nil
+}
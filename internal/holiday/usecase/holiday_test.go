@@ -0,0 +1,541 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+	mockHoliday "github.com/vnnyx/employee-management/internal/holiday/mock"
+	"github.com/vnnyx/employee-management/internal/holiday/usecase"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+func TestListHolidays(t *testing.T) {
+	type testCase struct {
+		name        string
+		startDate   time.Time
+		endDate     time.Time
+		expectedLen int
+		expectedErr error
+		setupMock   func(repo *mockHoliday.MockRepository)
+	}
+
+	tests := []testCase{
+		{
+			name:        "success - holidays in range",
+			startDate:   time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			endDate:     time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+			expectedLen: 1,
+			setupMock: func(repo *mockHoliday.MockRepository) {
+				repo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC)).
+					Return([]entity.Holiday{
+						{
+							ID:          "holiday-1",
+							HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+							Name:        "Independence Day",
+						},
+					}, nil)
+			},
+		},
+		{
+			name:      "error - start after end",
+			startDate: time.Date(2025, 8, 31, 0, 0, 0, 0, time.UTC),
+			endDate:   time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.HolidayInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayInvalidRange),
+				}),
+			setupMock: func(repo *mockHoliday.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockHoliday.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewHolidayUseCase(mockRepo)
+
+			holidays, err := useCase.ListHolidays(context.Background(), userCredential, tt.startDate, tt.endDate)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, holidays, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestCreateHoliday(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpsertHoliday
+		expectedErr    error
+		setupMock      func(repo *mockHoliday.MockRepository, txRepo *mockHoliday.MockRepository)
+	}
+
+	payload := entity.UpsertHoliday{
+		HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+		Name:        "Independence Day",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - holiday created",
+			authCredential: adminCredential,
+			payload:        payload,
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().StoreNewHoliday(gomock.Any(), mock.MatchedBy(func(args entity.Holiday) bool {
+					return testutil.EqualVerbose(
+						entity.Holiday{
+							HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+							Name:        "Independence Day",
+							CreatedBy:   "admin-1",
+							UpdatedBy:   "admin-1",
+							IPAddress:   "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Holiday{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - date already taken",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.HolidayAlreadyExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayAlreadyExists),
+					Received:  "2025-08-17",
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().StoreNewHoliday(gomock.Any(), gomock.Any()).Return(&pgconn.PgError{
+					Code:           "23505",
+					ConstraintName: "holidays_holiday_date_key",
+				})
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockHoliday.NewMockRepository(ctrl)
+			mockRepoTx := mockHoliday.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewHolidayUseCase(mockRepo)
+
+			holiday, err := useCase.CreateHoliday(context.Background(), tt.authCredential, tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, holiday.ID)
+				assert.Equal(t, tt.payload.Name, holiday.Name)
+			}
+		})
+	}
+}
+
+func TestUpdateHoliday(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		holidayID      string
+		payload        entity.UpsertHoliday
+		expectedErr    error
+		setupMock      func(repo *mockHoliday.MockRepository, txRepo *mockHoliday.MockRepository)
+	}
+
+	payload := entity.UpsertHoliday{
+		HolidayDate: time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC),
+		Name:        "Independence Day (observed)",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - holiday moved",
+			authCredential: adminCredential,
+			holidayID:      "holiday-1",
+			payload:        payload,
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindHolidayByID(gomock.Any(), "holiday-1").Return(&entity.Holiday{
+					ID:          "holiday-1",
+					HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC),
+					Name:        "Independence Day",
+					CreatedBy:   "admin-2",
+				}, nil)
+				txRepo.EXPECT().UpdateHoliday(gomock.Any(), mock.MatchedBy(func(args entity.Holiday) bool {
+					return testutil.EqualVerbose(
+						entity.Holiday{
+							ID:          "holiday-1",
+							HolidayDate: time.Date(2025, 8, 18, 0, 0, 0, 0, time.UTC),
+							Name:        "Independence Day (observed)",
+							CreatedBy:   "admin-2",
+							UpdatedBy:   "admin-1",
+							IPAddress:   "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Holiday{}, "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - holiday not found",
+			authCredential: adminCredential,
+			holidayID:      "holiday-x",
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotFound),
+					Received:  "holiday-x",
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindHolidayByID(gomock.Any(), "holiday-x").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			holidayID:      "holiday-1",
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockHoliday.NewMockRepository(ctrl)
+			mockRepoTx := mockHoliday.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewHolidayUseCase(mockRepo)
+
+			holiday, err := useCase.UpdateHoliday(context.Background(), tt.authCredential, tt.holidayID, tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.payload.HolidayDate, holiday.HolidayDate)
+			}
+		})
+	}
+}
+
+func TestDeleteHoliday(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		holidayID      string
+		expectedErr    error
+		setupMock      func(repo *mockHoliday.MockRepository, txRepo *mockHoliday.MockRepository)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - holiday deleted",
+			authCredential: adminCredential,
+			holidayID:      "holiday-1",
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindHolidayByID(gomock.Any(), "holiday-1").Return(&entity.Holiday{ID: "holiday-1"}, nil)
+				txRepo.EXPECT().DeleteHoliday(gomock.Any(), "holiday-1").Return(nil)
+			},
+		},
+		{
+			name:           "error - holiday not found",
+			authCredential: adminCredential,
+			holidayID:      "holiday-x",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotFound),
+					Received:  "holiday-x",
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindHolidayByID(gomock.Any(), "holiday-x").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			holidayID:      "holiday-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockHoliday.NewMockRepository(ctrl)
+			mockRepoTx := mockHoliday.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewHolidayUseCase(mockRepo)
+
+			err := useCase.DeleteHoliday(context.Background(), tt.authCredential, tt.holidayID)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestImportHolidays(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.ImportHolidays
+		expectedTotal  int64
+		expectedErr    error
+		setupMock      func(repo *mockHoliday.MockRepository, txRepo *mockHoliday.MockRepository)
+	}
+
+	// upsertReturning expects the given date/name pairs and echoes them back
+	// as the stored rows.
+	upsertReturning := func(expected []entity.Holiday) func(repo, txRepo *mockHoliday.MockRepository) {
+		return func(repo, txRepo *mockHoliday.MockRepository) {
+			repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+			txRepo.EXPECT().UpsertHolidays(gomock.Any(), mock.MatchedBy(func(args []entity.Holiday) bool {
+				return testutil.EqualVerbose(
+					expected,
+					args,
+					cmpopts.IgnoreFields(entity.Holiday{},
+						"ID", "CreatedAt", "UpdatedAt", "CreatedBy", "UpdatedBy", "IPAddress",
+					),
+				)
+			})).DoAndReturn(func(ctx context.Context, holidays []entity.Holiday) ([]entity.Holiday, error) {
+				return holidays, nil
+			})
+		}
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - csv with header and repeated date",
+			authCredential: adminCredential,
+			payload: entity.ImportHolidays{
+				Format: entity.ImportFormatCSV,
+				Content: []byte("date,name\n" +
+					"2025-01-01,New Year\n" +
+					"2025-05-01, Labour Day\n" +
+					"2025-01-01,New Year's Day\n"),
+			},
+			expectedTotal: 2,
+			setupMock: upsertReturning([]entity.Holiday{
+				{HolidayDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Name: "New Year's Day"},
+				{HolidayDate: time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), Name: "Labour Day"},
+			}),
+		},
+		{
+			name:           "success - ics with folded summary and multi-day event",
+			authCredential: adminCredential,
+			payload: entity.ImportHolidays{
+				Format: entity.ImportFormatICS,
+				Content: []byte("BEGIN:VCALENDAR\r\n" +
+					"VERSION:2.0\r\n" +
+					"BEGIN:VEVENT\r\n" +
+					"DTSTART;VALUE=DATE:20250817\r\n" +
+					"DTEND;VALUE=DATE:20250818\r\n" +
+					"SUMMARY:Independence\r\n" +
+					"  Day\r\n" +
+					"END:VEVENT\r\n" +
+					"BEGIN:VEVENT\r\n" +
+					"DTSTART;VALUE=DATE:20251224\r\n" +
+					"DTEND;VALUE=DATE:20251227\r\n" +
+					"SUMMARY:Christmas\\, collective leave\r\n" +
+					"END:VEVENT\r\n" +
+					"END:VCALENDAR\r\n"),
+			},
+			expectedTotal: 4,
+			setupMock: upsertReturning([]entity.Holiday{
+				{HolidayDate: time.Date(2025, 8, 17, 0, 0, 0, 0, time.UTC), Name: "Independence Day"},
+				{HolidayDate: time.Date(2025, 12, 24, 0, 0, 0, 0, time.UTC), Name: "Christmas, collective leave"},
+				{HolidayDate: time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC), Name: "Christmas, collective leave"},
+				{HolidayDate: time.Date(2025, 12, 26, 0, 0, 0, 0, time.UTC), Name: "Christmas, collective leave"},
+			}),
+		},
+		{
+			name:           "error - csv with invalid date",
+			authCredential: adminCredential,
+			payload: entity.ImportHolidays{
+				Format:  entity.ImportFormatCSV,
+				Content: []byte("2025-01-01,New Year\n01/05/2025,Labour Day\n"),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.HolidayInvalidImportFile,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayInvalidImportFile),
+					Received:  `line 2: invalid date "01/05/2025"`,
+					Path:      []string{"file"},
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+		{
+			name:           "error - ics event without summary",
+			authCredential: adminCredential,
+			payload: entity.ImportHolidays{
+				Format:  entity.ImportFormatICS,
+				Content: []byte("BEGIN:VEVENT\nDTSTART;VALUE=DATE:20250817\nEND:VEVENT\n"),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.HolidayInvalidImportFile,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayInvalidImportFile),
+					Received:  "line 3: event without DTSTART or SUMMARY",
+					Path:      []string{"file"},
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+		{
+			name:           "error - unsupported format",
+			authCredential: adminCredential,
+			payload: entity.ImportHolidays{
+				Format:  "xlsx",
+				Content: []byte("irrelevant"),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.HolidayUnsupportedFormat,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayUnsupportedFormat),
+					Received:  "xlsx",
+					Path:      []string{"format"},
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload: entity.ImportHolidays{
+				Format:  entity.ImportFormatCSV,
+				Content: []byte("2025-01-01,New Year\n"),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.HolidayNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.HolidayNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockHoliday.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockHoliday.NewMockRepository(ctrl)
+			mockRepoTx := mockHoliday.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewHolidayUseCase(mockRepo)
+
+			result, err := useCase.ImportHolidays(context.Background(), tt.authCredential, tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedTotal, result.TotalImported)
+				assert.Len(t, result.Holidays, int(tt.expectedTotal))
+			}
+		})
+	}
+}
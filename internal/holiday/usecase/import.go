@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/holiday/entity"
+)
+
+const (
+	holidayDateFormat = "2006-01-02"
+	icsDateFormat     = "20060102"
+)
+
+// parseHolidaysCSV reads rows of "date,name" with dates as YYYY-MM-DD. A
+// leading header row is skipped.
+func parseHolidaysCSV(content []byte) ([]entity.UpsertHoliday, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var holidays []entity.UpsertHoliday
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue
+		}
+
+		holidayDate, err := time.Parse(holidayDateFormat, strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, record[0])
+		}
+
+		name := strings.TrimSpace(record[1])
+		if name == "" {
+			return nil, fmt.Errorf("line %d: empty holiday name", line)
+		}
+
+		holidays = append(holidays, entity.UpsertHoliday{
+			HolidayDate: holidayDate,
+			Name:        name,
+		})
+	}
+
+	return holidays, nil
+}
+
+// parseHolidaysICS reads the VEVENT entries of an iCalendar file. All-day
+// events spanning several days produce one holiday per day, DTEND being
+// exclusive as defined by RFC 5545.
+func parseHolidaysICS(content []byte) ([]entity.UpsertHoliday, error) {
+	var (
+		holidays []entity.UpsertHoliday
+		inEvent  bool
+		summary  string
+		start    time.Time
+		end      time.Time
+	)
+
+	for i, line := range unfoldICSLines(content) {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		property, _, _ := strings.Cut(name, ";")
+
+		switch strings.ToUpper(property) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent = true
+				summary, start, end = "", time.Time{}, time.Time{}
+			}
+		case "DTSTART", "DTEND":
+			if !inEvent {
+				continue
+			}
+			date, err := parseICSDate(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s %q", i+1, property, value)
+			}
+			if strings.EqualFold(property, "DTSTART") {
+				start = date
+			} else {
+				end = date
+			}
+		case "SUMMARY":
+			if inEvent {
+				summary = unescapeICSText(value)
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+
+			if start.IsZero() || strings.TrimSpace(summary) == "" {
+				return nil, fmt.Errorf("line %d: event without DTSTART or SUMMARY", i+1)
+			}
+
+			holidays = append(holidays, entity.UpsertHoliday{HolidayDate: start, Name: summary})
+			for date := start.AddDate(0, 0, 1); date.Before(end); date = date.AddDate(0, 0, 1) {
+				holidays = append(holidays, entity.UpsertHoliday{HolidayDate: date, Name: summary})
+			}
+		}
+	}
+
+	return holidays, nil
+}
+
+func unfoldICSLines(content []byte) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func parseICSDate(value string) (time.Time, error) {
+	if len(value) < len(icsDateFormat) {
+		return time.Time{}, errors.New("date too short")
+	}
+	return time.Parse(icsDateFormat, value[:len(icsDateFormat)])
+}
+
+func unescapeICSText(value string) string {
+	replacer := strings.NewReplacer(`\,`, ",", `\;`, ";", `\n`, " ", `\N`, " ", `\\`, `\`)
+	return strings.TrimSpace(replacer.Replace(value))
+}
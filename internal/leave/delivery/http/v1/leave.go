@@ -0,0 +1,501 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/leave"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type LeaveHandler struct {
+	uc leave.UseCase
+}
+
+func NewLeaveHandler(uc leave.UseCase) *LeaveHandler {
+	return &LeaveHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      List Leave Types
+// @Description  List the kinds of leave employees can request
+// @Tags         Leave
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveTypeResponse} "Leave Types Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/types [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ListLeaveTypes(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ListLeaveTypes()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListLeaveTypes(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListLeaveTypes().uc.ListLeaveTypes()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLeaveTypeResponse(data),
+		},
+	)
+}
+
+// @Summary      Create Leave Type
+// @Description  Add a kind of leave with the days accrued every year and the unused days that carry over to the next one, approved leave of a paid type counts as attended days in payroll
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.LeaveTypeRequest true "Create Leave Type Request"
+// @Success      201 {object} dtos.Response{data=dtos.LeaveTypeResponse} "Leave Type Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/types [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) CreateLeaveType(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.CreateLeaveType()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.LeaveTypeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().CreateLeaveType().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().CreateLeaveType().req.Validate()")
+	}
+
+	data, err := h.uc.CreateLeaveType(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().CreateLeaveType().uc.CreateLeaveType()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveTypeResponse(data),
+		},
+	)
+}
+
+// @Summary      Accrue Leave Balances
+// @Description  Grant every user employed in the year the allowance of each leave type, prorated to the days they are employed, and carry over the unused days of the previous year up to the limit of the type. Accruing a year again recomputes its balances
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.LeaveAccrualRequest true "Accrue Leave Balances Request"
+// @Success      200 {object} dtos.Response{data=dtos.LeaveAccrualResponse} "Leave Accrual Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/accruals [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) AccrueLeaveBalances(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.AccrueLeaveBalances()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.LeaveAccrualRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().AccrueLeaveBalances().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().AccrueLeaveBalances().req.Validate()")
+	}
+
+	data, err := h.uc.AccrueLeaveBalances(ctx, authCredential, req.Year)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().AccrueLeaveBalances().uc.AccrueLeaveBalances()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveAccrualResponse(data),
+		},
+	)
+}
+
+// @Summary      List Leave Balances
+// @Description  List the leave balances of a user for a year
+// @Tags         Leave
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        year query int false "Year, the current year when empty"
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveBalanceResponse} "Leave Balances Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/leave/balances [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ListLeaveBalances(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ListLeaveBalances()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListLeaveBalances().c.ParamsParser()")
+	}
+
+	var req dtos.LeaveBalancesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListLeaveBalances().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListLeaveBalances().req.Validate()")
+	}
+
+	data, err := h.uc.ListLeaveBalances(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListLeaveBalances().uc.ListLeaveBalances()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLeaveBalanceResponse(data),
+		},
+	)
+}
+
+// @Summary      List Pending Leave Requests
+// @Description  List the leave requests waiting for a decision of the caller, admins see every request and managers the requests of their direct reports
+// @Tags         Leave
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveRequestResponse} "Leave Requests Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/requests/pending [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ListPendingLeaveRequests(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ListPendingLeaveRequests()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListPendingLeaveRequests(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListPendingLeaveRequests().uc.ListPendingLeaveRequests()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLeaveRequestResponse(data),
+		},
+	)
+}
+
+// @Summary      Approve Leave Request
+// @Description  Approve a pending leave request of a direct report, or of anyone as an admin. The days are taken from the balance of the year
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param        requestId path string true "Leave Request ID"
+// @Param        request body dtos.LeaveDecisionRequest false "Leave Decision Request"
+// @Success      200 {object} dtos.Response{data=dtos.LeaveRequestResponse} "Leave Request Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/requests/{requestId}/approve [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) ApproveLeaveRequest(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ApproveLeaveRequest()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		RequestID uuid.UUID `params:"requestId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ApproveLeaveRequest().c.ParamsParser()")
+	}
+
+	var req dtos.LeaveDecisionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "LeaveHandler().ApproveLeaveRequest().c.BodyParser()")
+		}
+	}
+
+	data, err := h.uc.ApproveLeaveRequest(ctx, authCredential, param.RequestID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ApproveLeaveRequest().uc.ApproveLeaveRequest()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveRequestResponse(data),
+		},
+	)
+}
+
+// @Summary      Reject Leave Request
+// @Description  Reject a pending leave request of a direct report, or of anyone as an admin, giving its days back to the balance
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param        requestId path string true "Leave Request ID"
+// @Param        request body dtos.LeaveDecisionRequest false "Leave Decision Request"
+// @Success      200 {object} dtos.Response{data=dtos.LeaveRequestResponse} "Leave Request Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/requests/{requestId}/reject [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) RejectLeaveRequest(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.RejectLeaveRequest()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		RequestID uuid.UUID `params:"requestId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().RejectLeaveRequest().c.ParamsParser()")
+	}
+
+	var req dtos.LeaveDecisionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "LeaveHandler().RejectLeaveRequest().c.BodyParser()")
+		}
+	}
+
+	data, err := h.uc.RejectLeaveRequest(ctx, authCredential, param.RequestID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().RejectLeaveRequest().uc.RejectLeaveRequest()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveRequestResponse(data),
+		},
+	)
+}
+
+// @Summary      Show Leave Calendar
+// @Description  Show the approved leave on every date between two dates, both included and within one year. Admins see everyone, other users their own leave and the leave of their direct reports
+// @Tags         Leave
+// @Produce      json
+// @Param        start_date query string true "Start date (YYYY-MM-DD)"
+// @Param        end_date query string true "End date (YYYY-MM-DD)"
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveCalendarDayResponse} "Leave Calendar Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/leave/calendar [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ShowLeaveCalendar(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ShowLeaveCalendar()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.LeaveCalendarRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ShowLeaveCalendar().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ShowLeaveCalendar().req.Validate()")
+	}
+
+	startDate, endDate := req.ToRequestEntity()
+	data, err := h.uc.ShowLeaveCalendar(ctx, authCredential, startDate, endDate)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ShowLeaveCalendar().uc.ShowLeaveCalendar()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveCalendarResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Leave Balances
+// @Description  List the leave balances of the caller for a year
+// @Tags         Leave
+// @Produce      json
+// @Param        year query int false "Year, the current year when empty"
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveBalanceResponse} "Leave Balances Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/leave/balances [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ListMyLeaveBalances(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ListMyLeaveBalances()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.LeaveBalancesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListMyLeaveBalances().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListMyLeaveBalances().req.Validate()")
+	}
+
+	data, err := h.uc.ListMyLeaveBalances(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListMyLeaveBalances().uc.ListMyLeaveBalances()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLeaveBalanceResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Leave Requests
+// @Description  List the leave requests of the caller, latest first
+// @Tags         Leave
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.LeaveRequestResponse} "Leave Requests Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/leave/requests [GET]
+// @Security     BearerAuth
+func (h *LeaveHandler) ListMyLeaveRequests(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.ListMyLeaveRequests()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListMyLeaveRequests(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().ListMyLeaveRequests().uc.ListMyLeaveRequests()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLeaveRequestResponse(data),
+		},
+	)
+}
+
+// @Summary      Submit My Leave Request
+// @Description  Request leave for the working days between two dates, both included and within one year. The days are held from the balance until the request is decided or cancelled
+// @Tags         Leave
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.LeaveRequestRequest true "Submit Leave Request"
+// @Success      201 {object} dtos.Response{data=dtos.LeaveRequestResponse} "Leave Request Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/leave/requests [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) SubmitMyLeaveRequest(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.SubmitMyLeaveRequest()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.LeaveRequestRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "LeaveHandler().SubmitMyLeaveRequest().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LeaveHandler().SubmitMyLeaveRequest().req.Validate()")
+	}
+
+	data, err := h.uc.SubmitMyLeaveRequest(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().SubmitMyLeaveRequest().uc.SubmitMyLeaveRequest()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveRequestResponse(data),
+		},
+	)
+}
+
+// @Summary      Cancel My Leave Request
+// @Description  Withdraw a pending leave request or give back the days of an approved one, as long as payroll has not been generated for them
+// @Tags         Leave
+// @Produce      json
+// @Param        requestId path string true "Leave Request ID"
+// @Success      200 {object} dtos.Response{data=dtos.LeaveRequestResponse} "Leave Request Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/leave/requests/{requestId}/cancel [POST]
+// @Security     BearerAuth
+func (h *LeaveHandler) CancelMyLeaveRequest(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LeaveHandler.CancelMyLeaveRequest()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		RequestID uuid.UUID `params:"requestId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().CancelMyLeaveRequest().c.ParamsParser()")
+	}
+
+	data, err := h.uc.CancelMyLeaveRequest(ctx, authCredential, param.RequestID.String())
+	if err != nil {
+		return errors.Wrap(err, "LeaveHandler().CancelMyLeaveRequest().uc.CancelMyLeaveRequest()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLeaveRequestResponse(data),
+		},
+	)
+}
@@ -0,0 +1,24 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapLeave(routes fiber.Router, h *LeaveHandler) {
+	leave := routes.Group("/leave")
+
+	leave.Get("/types", h.ListLeaveTypes)
+	leave.Post("/types", h.CreateLeaveType)
+	leave.Post("/accruals", h.AccrueLeaveBalances)
+	leave.Get("/requests/pending", h.ListPendingLeaveRequests)
+	leave.Post("/requests/:requestId/approve", h.ApproveLeaveRequest)
+	leave.Post("/requests/:requestId/reject", h.RejectLeaveRequest)
+	leave.Get("/calendar", h.ShowLeaveCalendar)
+
+	routes.Get("/users/:userId/leave/balances", h.ListLeaveBalances)
+
+	me := routes.Group("/me")
+
+	me.Get("/leave/balances", h.ListMyLeaveBalances)
+	me.Get("/leave/requests", h.ListMyLeaveRequests)
+	me.Post("/leave/requests", h.SubmitMyLeaveRequest)
+	me.Post("/leave/requests/:requestId/cancel", h.CancelMyLeaveRequest)
+}
@@ -0,0 +1,50 @@
+package entity
+
+const (
+	LeaveNotAuthorized         = "LEAVE_NOT_AUTHORIZED"
+	LeaveUserNotFound          = "LEAVE_USER_NOT_FOUND"
+	LeaveTypeNotFound          = "LEAVE_TYPE_NOT_FOUND"
+	LeaveTypeAlreadyExists     = "LEAVE_TYPE_ALREADY_EXISTS"
+	LeaveInvalidRange          = "LEAVE_INVALID_RANGE"
+	LeaveNoWorkingDays         = "LEAVE_NO_WORKING_DAYS"
+	LeaveOverlaps              = "LEAVE_OVERLAPS"
+	LeaveInsufficientBalance   = "LEAVE_INSUFFICIENT_BALANCE"
+	LeavePeriodClosed          = "LEAVE_PERIOD_CLOSED"
+	LeaveRequestNotFound       = "LEAVE_REQUEST_NOT_FOUND"
+	LeaveRequestNotPending     = "LEAVE_REQUEST_NOT_PENDING"
+	LeaveRequestNotCancellable = "LEAVE_REQUEST_NOT_CANCELLABLE"
+	LeaveRequestSelfApproval   = "LEAVE_REQUEST_SELF_APPROVAL"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case LeaveNotAuthorized:
+		return "You are not authorized to manage this leave"
+	case LeaveUserNotFound:
+		return "User not found"
+	case LeaveTypeNotFound:
+		return "Leave type not found"
+	case LeaveTypeAlreadyExists:
+		return "A leave type with this code already exists"
+	case LeaveInvalidRange:
+		return "The leave must end on or after its start date and within the same year"
+	case LeaveNoWorkingDays:
+		return "The leave does not cover any working day"
+	case LeaveOverlaps:
+		return "The leave overlaps another pending or approved leave"
+	case LeaveInsufficientBalance:
+		return "Not enough leave days left in the balance"
+	case LeavePeriodClosed:
+		return "The leave falls in an attendance period whose payroll has been generated"
+	case LeaveRequestNotFound:
+		return "Leave request not found"
+	case LeaveRequestNotPending:
+		return "Only pending leave requests can be decided"
+	case LeaveRequestNotCancellable:
+		return "Only pending or approved leave requests can be cancelled"
+	case LeaveRequestSelfApproval:
+		return "You cannot decide your own leave request"
+	default:
+		return "An unknown error occurred"
+	}
+}
@@ -0,0 +1,201 @@
+package entity
+
+import (
+	"time"
+
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// LeaveType is a kind of leave. Every year employees accrue AnnualAllowance
+// days of it and at most MaxCarryOver unused days move to the next year.
+// Approved leave of a Paid type counts as attended days in payroll.
+type LeaveType struct {
+	ID              string    `db:"id"`
+	Code            string    `db:"code"`
+	Name            string    `db:"name"`
+	Paid            bool      `db:"paid"`
+	AnnualAllowance int64     `db:"annual_allowance"`
+	MaxCarryOver    int64     `db:"max_carry_over"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+	CreatedBy       string    `db:"created_by"`
+	UpdatedBy       string    `db:"updated_by"`
+	IPAddress       string    `db:"ip_address"`
+}
+
+type CreateLeaveType struct {
+	Code            string
+	Name            string
+	Paid            bool
+	AnnualAllowance int64
+	MaxCarryOver    int64
+}
+
+// LeaveBalance is what a user may take of a leave type in a year. UsedDays
+// and PendingDays are not stored, they total the approved and the pending
+// requests of the year.
+type LeaveBalance struct {
+	ID              string    `db:"id"`
+	UserID          string    `db:"user_id"`
+	LeaveTypeID     string    `db:"leave_type_id"`
+	LeaveTypeCode   string    `db:"leave_type_code"`
+	LeaveTypeName   string    `db:"leave_type_name"`
+	Year            int64     `db:"year"`
+	AccruedDays     int64     `db:"accrued_days"`
+	CarriedOverDays int64     `db:"carried_over_days"`
+	UsedDays        int64     `db:"used_days"`
+	PendingDays     int64     `db:"pending_days"`
+	CreatedAt       time.Time `db:"created_at"`
+	UpdatedAt       time.Time `db:"updated_at"`
+	CreatedBy       string    `db:"created_by"`
+	UpdatedBy       string    `db:"updated_by"`
+	IPAddress       string    `db:"ip_address"`
+}
+
+// Entitlement is every day the user may take in the year.
+func (b LeaveBalance) Entitlement() int64 {
+	return b.AccruedDays + b.CarriedOverDays
+}
+
+// UnusedDays is what is left once the approved requests are taken.
+func (b LeaveBalance) UnusedDays() int64 {
+	return max(b.Entitlement()-b.UsedDays, 0)
+}
+
+// AvailableDays is what a new request may still take, pending requests
+// already hold their days.
+func (b LeaveBalance) AvailableDays() int64 {
+	return max(b.UnusedDays()-b.PendingDays, 0)
+}
+
+// ProrateAllowance scales an annual allowance to the days a user is employed
+// in a year of yearDays days, rounded to the nearest day.
+func ProrateAllowance(allowance, employedDays, yearDays int64) int64 {
+	if employedDays >= yearDays || yearDays <= 0 {
+		return allowance
+	}
+	return (allowance*employedDays + yearDays/2) / yearDays
+}
+
+// LeaveAccrual is the outcome of accruing the balances of a year.
+type LeaveAccrual struct {
+	Year     int64
+	Balances []LeaveBalance
+}
+
+type LeaveRequestStatus string
+
+const (
+	LeaveRequestStatusPending   LeaveRequestStatus = "pending"
+	LeaveRequestStatusApproved  LeaveRequestStatus = "approved"
+	LeaveRequestStatusRejected  LeaveRequestStatus = "rejected"
+	LeaveRequestStatusCancelled LeaveRequestStatus = "cancelled"
+)
+
+// LeaveRequest takes Days working days between StartDate and EndDate, both
+// included, from the balance of the year they fall in.
+type LeaveRequest struct {
+	ID              string             `db:"id"`
+	UserID          string             `db:"user_id"`
+	Username        string             `db:"username"`
+	LeaveTypeID     string             `db:"leave_type_id"`
+	LeaveTypeCode   string             `db:"leave_type_code"`
+	LeaveTypeName   string             `db:"leave_type_name"`
+	Paid            bool               `db:"paid"`
+	StartDate       time.Time          `db:"start_date"`
+	EndDate         time.Time          `db:"end_date"`
+	Days            int64              `db:"days"`
+	Reason          optional.String    `db:"reason"`
+	Status          LeaveRequestStatus `db:"status"`
+	DecidedBy       optional.String    `db:"decided_by"`
+	DecidedAt       optional.Time      `db:"decided_at"`
+	DecisionComment optional.String    `db:"decision_comment"`
+	CreatedAt       time.Time          `db:"created_at"`
+	UpdatedAt       time.Time          `db:"updated_at"`
+	CreatedBy       string             `db:"created_by"`
+	UpdatedBy       string             `db:"updated_by"`
+	IPAddress       string             `db:"ip_address"`
+}
+
+// WorkingDates lists the working days of the request between from and to,
+// both included.
+func (r LeaveRequest) WorkingDates(calendar holidayEntity.Calendar, from, to time.Time) []time.Time {
+	start, end := r.StartDate, r.EndDate
+	if from.After(start) {
+		start = from
+	}
+	if to.Before(end) {
+		end = to
+	}
+
+	var dates []time.Time
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		if calendar.IsWorkingDay(date) {
+			dates = append(dates, date)
+		}
+	}
+	return dates
+}
+
+type SubmitLeaveRequest struct {
+	LeaveTypeID string
+	StartDate   time.Time
+	EndDate     time.Time
+	Reason      optional.String
+}
+
+type DecideLeaveRequest struct {
+	Comment optional.String
+}
+
+// LeaveCalendarDay lists the approved leave covering a date.
+type LeaveCalendarDay struct {
+	Date   time.Time
+	Leaves []LeaveRequest
+}
+
+// NewLeaveCalendar lays requests out over every date between startDate and
+// endDate, both included.
+func NewLeaveCalendar(requests []LeaveRequest, startDate, endDate time.Time) []LeaveCalendarDay {
+	var days []LeaveCalendarDay
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		day := LeaveCalendarDay{
+			Date:   date,
+			Leaves: []LeaveRequest{},
+		}
+		for _, request := range requests {
+			if !date.Before(request.StartDate) && !date.After(request.EndDate) {
+				day.Leaves = append(day.Leaves, request)
+			}
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+type MappedBy string
+
+const (
+	MappedByUserID MappedBy = "user_id"
+)
+
+type MappedOptions struct {
+	MappedBy MappedBy
+}
+
+type FindLeaveRequestOptions struct {
+	*MappedOptions
+	PessimisticLock bool
+}
+
+type FindLeaveRequestResult struct {
+	List     []LeaveRequest
+	Mapped   map[any][]LeaveRequest
+	IsMapped bool
+	MappedBy MappedBy
+}
+
+type FindLeaveBalanceOptions struct {
+	PessimisticLock bool
+}
@@ -0,0 +1,679 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/leave/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/leave/repository.go -destination internal/leave/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	leave "github.com/vnnyx/employee-management/internal/leave"
+	entity "github.com/vnnyx/employee-management/internal/leave/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindApprovedLeaveByRange mocks base method.
+func (m *MockRepository) FindApprovedLeaveByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindLeaveRequestOptions) (entity.FindLeaveRequestResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, startDate, endDate}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindApprovedLeaveByRange", varargs...)
+	ret0, _ := ret[0].(entity.FindLeaveRequestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApprovedLeaveByRange indicates an expected call of FindApprovedLeaveByRange.
+func (mr *MockRepositoryMockRecorder) FindApprovedLeaveByRange(ctx, startDate, endDate any, opts ...any) *MockRepositoryFindApprovedLeaveByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, startDate, endDate}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApprovedLeaveByRange", reflect.TypeOf((*MockRepository)(nil).FindApprovedLeaveByRange), varargs...)
+	return &MockRepositoryFindApprovedLeaveByRangeCall{Call: call}
+}
+
+// MockRepositoryFindApprovedLeaveByRangeCall wrap *gomock.Call
+type MockRepositoryFindApprovedLeaveByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindApprovedLeaveByRangeCall) Return(arg0 entity.FindLeaveRequestResult, arg1 error) *MockRepositoryFindApprovedLeaveByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindApprovedLeaveByRangeCall) Do(f func(context.Context, time.Time, time.Time, ...entity.FindLeaveRequestOptions) (entity.FindLeaveRequestResult, error)) *MockRepositoryFindApprovedLeaveByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindApprovedLeaveByRangeCall) DoAndReturn(f func(context.Context, time.Time, time.Time, ...entity.FindLeaveRequestOptions) (entity.FindLeaveRequestResult, error)) *MockRepositoryFindApprovedLeaveByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveBalance mocks base method.
+func (m *MockRepository) FindLeaveBalance(ctx context.Context, userID, leaveTypeID string, year int64, opts ...entity.FindLeaveBalanceOptions) (*entity.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID, leaveTypeID, year}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindLeaveBalance", varargs...)
+	ret0, _ := ret[0].(*entity.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveBalance indicates an expected call of FindLeaveBalance.
+func (mr *MockRepositoryMockRecorder) FindLeaveBalance(ctx, userID, leaveTypeID, year any, opts ...any) *MockRepositoryFindLeaveBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID, leaveTypeID, year}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveBalance", reflect.TypeOf((*MockRepository)(nil).FindLeaveBalance), varargs...)
+	return &MockRepositoryFindLeaveBalanceCall{Call: call}
+}
+
+// MockRepositoryFindLeaveBalanceCall wrap *gomock.Call
+type MockRepositoryFindLeaveBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveBalanceCall) Return(arg0 *entity.LeaveBalance, arg1 error) *MockRepositoryFindLeaveBalanceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveBalanceCall) Do(f func(context.Context, string, string, int64, ...entity.FindLeaveBalanceOptions) (*entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveBalanceCall) DoAndReturn(f func(context.Context, string, string, int64, ...entity.FindLeaveBalanceOptions) (*entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveBalancesByUserIDYear mocks base method.
+func (m *MockRepository) FindLeaveBalancesByUserIDYear(ctx context.Context, userID string, year int64) ([]entity.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveBalancesByUserIDYear", ctx, userID, year)
+	ret0, _ := ret[0].([]entity.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveBalancesByUserIDYear indicates an expected call of FindLeaveBalancesByUserIDYear.
+func (mr *MockRepositoryMockRecorder) FindLeaveBalancesByUserIDYear(ctx, userID, year any) *MockRepositoryFindLeaveBalancesByUserIDYearCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveBalancesByUserIDYear", reflect.TypeOf((*MockRepository)(nil).FindLeaveBalancesByUserIDYear), ctx, userID, year)
+	return &MockRepositoryFindLeaveBalancesByUserIDYearCall{Call: call}
+}
+
+// MockRepositoryFindLeaveBalancesByUserIDYearCall wrap *gomock.Call
+type MockRepositoryFindLeaveBalancesByUserIDYearCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveBalancesByUserIDYearCall) Return(arg0 []entity.LeaveBalance, arg1 error) *MockRepositoryFindLeaveBalancesByUserIDYearCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveBalancesByUserIDYearCall) Do(f func(context.Context, string, int64) ([]entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalancesByUserIDYearCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveBalancesByUserIDYearCall) DoAndReturn(f func(context.Context, string, int64) ([]entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalancesByUserIDYearCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveBalancesByYear mocks base method.
+func (m *MockRepository) FindLeaveBalancesByYear(ctx context.Context, year int64) ([]entity.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveBalancesByYear", ctx, year)
+	ret0, _ := ret[0].([]entity.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveBalancesByYear indicates an expected call of FindLeaveBalancesByYear.
+func (mr *MockRepositoryMockRecorder) FindLeaveBalancesByYear(ctx, year any) *MockRepositoryFindLeaveBalancesByYearCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveBalancesByYear", reflect.TypeOf((*MockRepository)(nil).FindLeaveBalancesByYear), ctx, year)
+	return &MockRepositoryFindLeaveBalancesByYearCall{Call: call}
+}
+
+// MockRepositoryFindLeaveBalancesByYearCall wrap *gomock.Call
+type MockRepositoryFindLeaveBalancesByYearCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveBalancesByYearCall) Return(arg0 []entity.LeaveBalance, arg1 error) *MockRepositoryFindLeaveBalancesByYearCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveBalancesByYearCall) Do(f func(context.Context, int64) ([]entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalancesByYearCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveBalancesByYearCall) DoAndReturn(f func(context.Context, int64) ([]entity.LeaveBalance, error)) *MockRepositoryFindLeaveBalancesByYearCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveRequestByID mocks base method.
+func (m *MockRepository) FindLeaveRequestByID(ctx context.Context, requestID string, opts ...entity.FindLeaveRequestOptions) (*entity.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, requestID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindLeaveRequestByID", varargs...)
+	ret0, _ := ret[0].(*entity.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveRequestByID indicates an expected call of FindLeaveRequestByID.
+func (mr *MockRepositoryMockRecorder) FindLeaveRequestByID(ctx, requestID any, opts ...any) *MockRepositoryFindLeaveRequestByIDCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, requestID}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveRequestByID", reflect.TypeOf((*MockRepository)(nil).FindLeaveRequestByID), varargs...)
+	return &MockRepositoryFindLeaveRequestByIDCall{Call: call}
+}
+
+// MockRepositoryFindLeaveRequestByIDCall wrap *gomock.Call
+type MockRepositoryFindLeaveRequestByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveRequestByIDCall) Return(arg0 *entity.LeaveRequest, arg1 error) *MockRepositoryFindLeaveRequestByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveRequestByIDCall) Do(f func(context.Context, string, ...entity.FindLeaveRequestOptions) (*entity.LeaveRequest, error)) *MockRepositoryFindLeaveRequestByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveRequestByIDCall) DoAndReturn(f func(context.Context, string, ...entity.FindLeaveRequestOptions) (*entity.LeaveRequest, error)) *MockRepositoryFindLeaveRequestByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveRequestsByUserID mocks base method.
+func (m *MockRepository) FindLeaveRequestsByUserID(ctx context.Context, userID string) ([]entity.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveRequestsByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveRequestsByUserID indicates an expected call of FindLeaveRequestsByUserID.
+func (mr *MockRepositoryMockRecorder) FindLeaveRequestsByUserID(ctx, userID any) *MockRepositoryFindLeaveRequestsByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveRequestsByUserID", reflect.TypeOf((*MockRepository)(nil).FindLeaveRequestsByUserID), ctx, userID)
+	return &MockRepositoryFindLeaveRequestsByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindLeaveRequestsByUserIDCall wrap *gomock.Call
+type MockRepositoryFindLeaveRequestsByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveRequestsByUserIDCall) Return(arg0 []entity.LeaveRequest, arg1 error) *MockRepositoryFindLeaveRequestsByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveRequestsByUserIDCall) Do(f func(context.Context, string) ([]entity.LeaveRequest, error)) *MockRepositoryFindLeaveRequestsByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveRequestsByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.LeaveRequest, error)) *MockRepositoryFindLeaveRequestsByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveTypeByCode mocks base method.
+func (m *MockRepository) FindLeaveTypeByCode(ctx context.Context, code string) (*entity.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveTypeByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveTypeByCode indicates an expected call of FindLeaveTypeByCode.
+func (mr *MockRepositoryMockRecorder) FindLeaveTypeByCode(ctx, code any) *MockRepositoryFindLeaveTypeByCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveTypeByCode", reflect.TypeOf((*MockRepository)(nil).FindLeaveTypeByCode), ctx, code)
+	return &MockRepositoryFindLeaveTypeByCodeCall{Call: call}
+}
+
+// MockRepositoryFindLeaveTypeByCodeCall wrap *gomock.Call
+type MockRepositoryFindLeaveTypeByCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveTypeByCodeCall) Return(arg0 *entity.LeaveType, arg1 error) *MockRepositoryFindLeaveTypeByCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveTypeByCodeCall) Do(f func(context.Context, string) (*entity.LeaveType, error)) *MockRepositoryFindLeaveTypeByCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveTypeByCodeCall) DoAndReturn(f func(context.Context, string) (*entity.LeaveType, error)) *MockRepositoryFindLeaveTypeByCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveTypeByID mocks base method.
+func (m *MockRepository) FindLeaveTypeByID(ctx context.Context, leaveTypeID string) (*entity.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveTypeByID", ctx, leaveTypeID)
+	ret0, _ := ret[0].(*entity.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveTypeByID indicates an expected call of FindLeaveTypeByID.
+func (mr *MockRepositoryMockRecorder) FindLeaveTypeByID(ctx, leaveTypeID any) *MockRepositoryFindLeaveTypeByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveTypeByID", reflect.TypeOf((*MockRepository)(nil).FindLeaveTypeByID), ctx, leaveTypeID)
+	return &MockRepositoryFindLeaveTypeByIDCall{Call: call}
+}
+
+// MockRepositoryFindLeaveTypeByIDCall wrap *gomock.Call
+type MockRepositoryFindLeaveTypeByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveTypeByIDCall) Return(arg0 *entity.LeaveType, arg1 error) *MockRepositoryFindLeaveTypeByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveTypeByIDCall) Do(f func(context.Context, string) (*entity.LeaveType, error)) *MockRepositoryFindLeaveTypeByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveTypeByIDCall) DoAndReturn(f func(context.Context, string) (*entity.LeaveType, error)) *MockRepositoryFindLeaveTypeByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLeaveTypes mocks base method.
+func (m *MockRepository) FindLeaveTypes(ctx context.Context) ([]entity.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLeaveTypes", ctx)
+	ret0, _ := ret[0].([]entity.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLeaveTypes indicates an expected call of FindLeaveTypes.
+func (mr *MockRepositoryMockRecorder) FindLeaveTypes(ctx any) *MockRepositoryFindLeaveTypesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLeaveTypes", reflect.TypeOf((*MockRepository)(nil).FindLeaveTypes), ctx)
+	return &MockRepositoryFindLeaveTypesCall{Call: call}
+}
+
+// MockRepositoryFindLeaveTypesCall wrap *gomock.Call
+type MockRepositoryFindLeaveTypesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLeaveTypesCall) Return(arg0 []entity.LeaveType, arg1 error) *MockRepositoryFindLeaveTypesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLeaveTypesCall) Do(f func(context.Context) ([]entity.LeaveType, error)) *MockRepositoryFindLeaveTypesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLeaveTypesCall) DoAndReturn(f func(context.Context) ([]entity.LeaveType, error)) *MockRepositoryFindLeaveTypesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindOverlappingLeaveRequest mocks base method.
+func (m *MockRepository) FindOverlappingLeaveRequest(ctx context.Context, userID string, startDate, endDate time.Time) (*entity.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOverlappingLeaveRequest", ctx, userID, startDate, endDate)
+	ret0, _ := ret[0].(*entity.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOverlappingLeaveRequest indicates an expected call of FindOverlappingLeaveRequest.
+func (mr *MockRepositoryMockRecorder) FindOverlappingLeaveRequest(ctx, userID, startDate, endDate any) *MockRepositoryFindOverlappingLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOverlappingLeaveRequest", reflect.TypeOf((*MockRepository)(nil).FindOverlappingLeaveRequest), ctx, userID, startDate, endDate)
+	return &MockRepositoryFindOverlappingLeaveRequestCall{Call: call}
+}
+
+// MockRepositoryFindOverlappingLeaveRequestCall wrap *gomock.Call
+type MockRepositoryFindOverlappingLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindOverlappingLeaveRequestCall) Return(arg0 *entity.LeaveRequest, arg1 error) *MockRepositoryFindOverlappingLeaveRequestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindOverlappingLeaveRequestCall) Do(f func(context.Context, string, time.Time, time.Time) (*entity.LeaveRequest, error)) *MockRepositoryFindOverlappingLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindOverlappingLeaveRequestCall) DoAndReturn(f func(context.Context, string, time.Time, time.Time) (*entity.LeaveRequest, error)) *MockRepositoryFindOverlappingLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPendingLeaveRequests mocks base method.
+func (m *MockRepository) FindPendingLeaveRequests(ctx context.Context, managerID string) ([]entity.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingLeaveRequests", ctx, managerID)
+	ret0, _ := ret[0].([]entity.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingLeaveRequests indicates an expected call of FindPendingLeaveRequests.
+func (mr *MockRepositoryMockRecorder) FindPendingLeaveRequests(ctx, managerID any) *MockRepositoryFindPendingLeaveRequestsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingLeaveRequests", reflect.TypeOf((*MockRepository)(nil).FindPendingLeaveRequests), ctx, managerID)
+	return &MockRepositoryFindPendingLeaveRequestsCall{Call: call}
+}
+
+// MockRepositoryFindPendingLeaveRequestsCall wrap *gomock.Call
+type MockRepositoryFindPendingLeaveRequestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPendingLeaveRequestsCall) Return(arg0 []entity.LeaveRequest, arg1 error) *MockRepositoryFindPendingLeaveRequestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPendingLeaveRequestsCall) Do(f func(context.Context, string) ([]entity.LeaveRequest, error)) *MockRepositoryFindPendingLeaveRequestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPendingLeaveRequestsCall) DoAndReturn(f func(context.Context, string) ([]entity.LeaveRequest, error)) *MockRepositoryFindPendingLeaveRequestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewLeaveRequest mocks base method.
+func (m *MockRepository) StoreNewLeaveRequest(ctx context.Context, request entity.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewLeaveRequest", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewLeaveRequest indicates an expected call of StoreNewLeaveRequest.
+func (mr *MockRepositoryMockRecorder) StoreNewLeaveRequest(ctx, request any) *MockRepositoryStoreNewLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewLeaveRequest", reflect.TypeOf((*MockRepository)(nil).StoreNewLeaveRequest), ctx, request)
+	return &MockRepositoryStoreNewLeaveRequestCall{Call: call}
+}
+
+// MockRepositoryStoreNewLeaveRequestCall wrap *gomock.Call
+type MockRepositoryStoreNewLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewLeaveRequestCall) Return(arg0 error) *MockRepositoryStoreNewLeaveRequestCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewLeaveRequestCall) Do(f func(context.Context, entity.LeaveRequest) error) *MockRepositoryStoreNewLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewLeaveRequestCall) DoAndReturn(f func(context.Context, entity.LeaveRequest) error) *MockRepositoryStoreNewLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewLeaveType mocks base method.
+func (m *MockRepository) StoreNewLeaveType(ctx context.Context, leaveType entity.LeaveType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewLeaveType", ctx, leaveType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewLeaveType indicates an expected call of StoreNewLeaveType.
+func (mr *MockRepositoryMockRecorder) StoreNewLeaveType(ctx, leaveType any) *MockRepositoryStoreNewLeaveTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewLeaveType", reflect.TypeOf((*MockRepository)(nil).StoreNewLeaveType), ctx, leaveType)
+	return &MockRepositoryStoreNewLeaveTypeCall{Call: call}
+}
+
+// MockRepositoryStoreNewLeaveTypeCall wrap *gomock.Call
+type MockRepositoryStoreNewLeaveTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewLeaveTypeCall) Return(arg0 error) *MockRepositoryStoreNewLeaveTypeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewLeaveTypeCall) Do(f func(context.Context, entity.LeaveType) error) *MockRepositoryStoreNewLeaveTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewLeaveTypeCall) DoAndReturn(f func(context.Context, entity.LeaveType) error) *MockRepositoryStoreNewLeaveTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateLeaveRequestStatus mocks base method.
+func (m *MockRepository) UpdateLeaveRequestStatus(ctx context.Context, request entity.LeaveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLeaveRequestStatus", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLeaveRequestStatus indicates an expected call of UpdateLeaveRequestStatus.
+func (mr *MockRepositoryMockRecorder) UpdateLeaveRequestStatus(ctx, request any) *MockRepositoryUpdateLeaveRequestStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLeaveRequestStatus", reflect.TypeOf((*MockRepository)(nil).UpdateLeaveRequestStatus), ctx, request)
+	return &MockRepositoryUpdateLeaveRequestStatusCall{Call: call}
+}
+
+// MockRepositoryUpdateLeaveRequestStatusCall wrap *gomock.Call
+type MockRepositoryUpdateLeaveRequestStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateLeaveRequestStatusCall) Return(arg0 error) *MockRepositoryUpdateLeaveRequestStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateLeaveRequestStatusCall) Do(f func(context.Context, entity.LeaveRequest) error) *MockRepositoryUpdateLeaveRequestStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateLeaveRequestStatusCall) DoAndReturn(f func(context.Context, entity.LeaveRequest) error) *MockRepositoryUpdateLeaveRequestStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpsertLeaveBalance mocks base method.
+func (m *MockRepository) UpsertLeaveBalance(ctx context.Context, balance entity.LeaveBalance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertLeaveBalance", ctx, balance)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertLeaveBalance indicates an expected call of UpsertLeaveBalance.
+func (mr *MockRepositoryMockRecorder) UpsertLeaveBalance(ctx, balance any) *MockRepositoryUpsertLeaveBalanceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertLeaveBalance", reflect.TypeOf((*MockRepository)(nil).UpsertLeaveBalance), ctx, balance)
+	return &MockRepositoryUpsertLeaveBalanceCall{Call: call}
+}
+
+// MockRepositoryUpsertLeaveBalanceCall wrap *gomock.Call
+type MockRepositoryUpsertLeaveBalanceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpsertLeaveBalanceCall) Return(arg0 error) *MockRepositoryUpsertLeaveBalanceCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpsertLeaveBalanceCall) Do(f func(context.Context, entity.LeaveBalance) error) *MockRepositoryUpsertLeaveBalanceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpsertLeaveBalanceCall) DoAndReturn(f func(context.Context, entity.LeaveBalance) error) *MockRepositoryUpsertLeaveBalanceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) leave.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(leave.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 leave.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) leave.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) leave.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
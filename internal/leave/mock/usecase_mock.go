@@ -0,0 +1,512 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/leave/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/leave/usecase.go -destination internal/leave/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/leave/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AccrueLeaveBalances mocks base method.
+func (m *MockUseCase) AccrueLeaveBalances(ctx context.Context, authCredential entity.Credential, year int64) (entity0.LeaveAccrual, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccrueLeaveBalances", ctx, authCredential, year)
+	ret0, _ := ret[0].(entity0.LeaveAccrual)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccrueLeaveBalances indicates an expected call of AccrueLeaveBalances.
+func (mr *MockUseCaseMockRecorder) AccrueLeaveBalances(ctx, authCredential, year any) *MockUseCaseAccrueLeaveBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccrueLeaveBalances", reflect.TypeOf((*MockUseCase)(nil).AccrueLeaveBalances), ctx, authCredential, year)
+	return &MockUseCaseAccrueLeaveBalancesCall{Call: call}
+}
+
+// MockUseCaseAccrueLeaveBalancesCall wrap *gomock.Call
+type MockUseCaseAccrueLeaveBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseAccrueLeaveBalancesCall) Return(arg0 entity0.LeaveAccrual, arg1 error) *MockUseCaseAccrueLeaveBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseAccrueLeaveBalancesCall) Do(f func(context.Context, entity.Credential, int64) (entity0.LeaveAccrual, error)) *MockUseCaseAccrueLeaveBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseAccrueLeaveBalancesCall) DoAndReturn(f func(context.Context, entity.Credential, int64) (entity0.LeaveAccrual, error)) *MockUseCaseAccrueLeaveBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ApproveLeaveRequest mocks base method.
+func (m *MockUseCase) ApproveLeaveRequest(ctx context.Context, authCredential entity.Credential, requestID string, payload entity0.DecideLeaveRequest) (entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveLeaveRequest", ctx, authCredential, requestID, payload)
+	ret0, _ := ret[0].(entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApproveLeaveRequest indicates an expected call of ApproveLeaveRequest.
+func (mr *MockUseCaseMockRecorder) ApproveLeaveRequest(ctx, authCredential, requestID, payload any) *MockUseCaseApproveLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveLeaveRequest", reflect.TypeOf((*MockUseCase)(nil).ApproveLeaveRequest), ctx, authCredential, requestID, payload)
+	return &MockUseCaseApproveLeaveRequestCall{Call: call}
+}
+
+// MockUseCaseApproveLeaveRequestCall wrap *gomock.Call
+type MockUseCaseApproveLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseApproveLeaveRequestCall) Return(arg0 entity0.LeaveRequest, arg1 error) *MockUseCaseApproveLeaveRequestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseApproveLeaveRequestCall) Do(f func(context.Context, entity.Credential, string, entity0.DecideLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseApproveLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseApproveLeaveRequestCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.DecideLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseApproveLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CancelMyLeaveRequest mocks base method.
+func (m *MockUseCase) CancelMyLeaveRequest(ctx context.Context, authCredential entity.Credential, requestID string) (entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMyLeaveRequest", ctx, authCredential, requestID)
+	ret0, _ := ret[0].(entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMyLeaveRequest indicates an expected call of CancelMyLeaveRequest.
+func (mr *MockUseCaseMockRecorder) CancelMyLeaveRequest(ctx, authCredential, requestID any) *MockUseCaseCancelMyLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMyLeaveRequest", reflect.TypeOf((*MockUseCase)(nil).CancelMyLeaveRequest), ctx, authCredential, requestID)
+	return &MockUseCaseCancelMyLeaveRequestCall{Call: call}
+}
+
+// MockUseCaseCancelMyLeaveRequestCall wrap *gomock.Call
+type MockUseCaseCancelMyLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCancelMyLeaveRequestCall) Return(arg0 entity0.LeaveRequest, arg1 error) *MockUseCaseCancelMyLeaveRequestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCancelMyLeaveRequestCall) Do(f func(context.Context, entity.Credential, string) (entity0.LeaveRequest, error)) *MockUseCaseCancelMyLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCancelMyLeaveRequestCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.LeaveRequest, error)) *MockUseCaseCancelMyLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateLeaveType mocks base method.
+func (m *MockUseCase) CreateLeaveType(ctx context.Context, authCredential entity.Credential, payload entity0.CreateLeaveType) (entity0.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLeaveType", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity0.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLeaveType indicates an expected call of CreateLeaveType.
+func (mr *MockUseCaseMockRecorder) CreateLeaveType(ctx, authCredential, payload any) *MockUseCaseCreateLeaveTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLeaveType", reflect.TypeOf((*MockUseCase)(nil).CreateLeaveType), ctx, authCredential, payload)
+	return &MockUseCaseCreateLeaveTypeCall{Call: call}
+}
+
+// MockUseCaseCreateLeaveTypeCall wrap *gomock.Call
+type MockUseCaseCreateLeaveTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCreateLeaveTypeCall) Return(arg0 entity0.LeaveType, arg1 error) *MockUseCaseCreateLeaveTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCreateLeaveTypeCall) Do(f func(context.Context, entity.Credential, entity0.CreateLeaveType) (entity0.LeaveType, error)) *MockUseCaseCreateLeaveTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCreateLeaveTypeCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.CreateLeaveType) (entity0.LeaveType, error)) *MockUseCaseCreateLeaveTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLeaveBalances mocks base method.
+func (m *MockUseCase) ListLeaveBalances(ctx context.Context, authCredential entity.Credential, userID string, year int64) ([]entity0.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveBalances", ctx, authCredential, userID, year)
+	ret0, _ := ret[0].([]entity0.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveBalances indicates an expected call of ListLeaveBalances.
+func (mr *MockUseCaseMockRecorder) ListLeaveBalances(ctx, authCredential, userID, year any) *MockUseCaseListLeaveBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveBalances", reflect.TypeOf((*MockUseCase)(nil).ListLeaveBalances), ctx, authCredential, userID, year)
+	return &MockUseCaseListLeaveBalancesCall{Call: call}
+}
+
+// MockUseCaseListLeaveBalancesCall wrap *gomock.Call
+type MockUseCaseListLeaveBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListLeaveBalancesCall) Return(arg0 []entity0.LeaveBalance, arg1 error) *MockUseCaseListLeaveBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListLeaveBalancesCall) Do(f func(context.Context, entity.Credential, string, int64) ([]entity0.LeaveBalance, error)) *MockUseCaseListLeaveBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListLeaveBalancesCall) DoAndReturn(f func(context.Context, entity.Credential, string, int64) ([]entity0.LeaveBalance, error)) *MockUseCaseListLeaveBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLeaveTypes mocks base method.
+func (m *MockUseCase) ListLeaveTypes(ctx context.Context, authCredential entity.Credential) ([]entity0.LeaveType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLeaveTypes", ctx, authCredential)
+	ret0, _ := ret[0].([]entity0.LeaveType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLeaveTypes indicates an expected call of ListLeaveTypes.
+func (mr *MockUseCaseMockRecorder) ListLeaveTypes(ctx, authCredential any) *MockUseCaseListLeaveTypesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLeaveTypes", reflect.TypeOf((*MockUseCase)(nil).ListLeaveTypes), ctx, authCredential)
+	return &MockUseCaseListLeaveTypesCall{Call: call}
+}
+
+// MockUseCaseListLeaveTypesCall wrap *gomock.Call
+type MockUseCaseListLeaveTypesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListLeaveTypesCall) Return(arg0 []entity0.LeaveType, arg1 error) *MockUseCaseListLeaveTypesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListLeaveTypesCall) Do(f func(context.Context, entity.Credential) ([]entity0.LeaveType, error)) *MockUseCaseListLeaveTypesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListLeaveTypesCall) DoAndReturn(f func(context.Context, entity.Credential) ([]entity0.LeaveType, error)) *MockUseCaseListLeaveTypesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyLeaveBalances mocks base method.
+func (m *MockUseCase) ListMyLeaveBalances(ctx context.Context, authCredential entity.Credential, year int64) ([]entity0.LeaveBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyLeaveBalances", ctx, authCredential, year)
+	ret0, _ := ret[0].([]entity0.LeaveBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyLeaveBalances indicates an expected call of ListMyLeaveBalances.
+func (mr *MockUseCaseMockRecorder) ListMyLeaveBalances(ctx, authCredential, year any) *MockUseCaseListMyLeaveBalancesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyLeaveBalances", reflect.TypeOf((*MockUseCase)(nil).ListMyLeaveBalances), ctx, authCredential, year)
+	return &MockUseCaseListMyLeaveBalancesCall{Call: call}
+}
+
+// MockUseCaseListMyLeaveBalancesCall wrap *gomock.Call
+type MockUseCaseListMyLeaveBalancesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyLeaveBalancesCall) Return(arg0 []entity0.LeaveBalance, arg1 error) *MockUseCaseListMyLeaveBalancesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyLeaveBalancesCall) Do(f func(context.Context, entity.Credential, int64) ([]entity0.LeaveBalance, error)) *MockUseCaseListMyLeaveBalancesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyLeaveBalancesCall) DoAndReturn(f func(context.Context, entity.Credential, int64) ([]entity0.LeaveBalance, error)) *MockUseCaseListMyLeaveBalancesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyLeaveRequests mocks base method.
+func (m *MockUseCase) ListMyLeaveRequests(ctx context.Context, authCredential entity.Credential) ([]entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyLeaveRequests", ctx, authCredential)
+	ret0, _ := ret[0].([]entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyLeaveRequests indicates an expected call of ListMyLeaveRequests.
+func (mr *MockUseCaseMockRecorder) ListMyLeaveRequests(ctx, authCredential any) *MockUseCaseListMyLeaveRequestsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyLeaveRequests", reflect.TypeOf((*MockUseCase)(nil).ListMyLeaveRequests), ctx, authCredential)
+	return &MockUseCaseListMyLeaveRequestsCall{Call: call}
+}
+
+// MockUseCaseListMyLeaveRequestsCall wrap *gomock.Call
+type MockUseCaseListMyLeaveRequestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyLeaveRequestsCall) Return(arg0 []entity0.LeaveRequest, arg1 error) *MockUseCaseListMyLeaveRequestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyLeaveRequestsCall) Do(f func(context.Context, entity.Credential) ([]entity0.LeaveRequest, error)) *MockUseCaseListMyLeaveRequestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyLeaveRequestsCall) DoAndReturn(f func(context.Context, entity.Credential) ([]entity0.LeaveRequest, error)) *MockUseCaseListMyLeaveRequestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPendingLeaveRequests mocks base method.
+func (m *MockUseCase) ListPendingLeaveRequests(ctx context.Context, authCredential entity.Credential) ([]entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPendingLeaveRequests", ctx, authCredential)
+	ret0, _ := ret[0].([]entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPendingLeaveRequests indicates an expected call of ListPendingLeaveRequests.
+func (mr *MockUseCaseMockRecorder) ListPendingLeaveRequests(ctx, authCredential any) *MockUseCaseListPendingLeaveRequestsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPendingLeaveRequests", reflect.TypeOf((*MockUseCase)(nil).ListPendingLeaveRequests), ctx, authCredential)
+	return &MockUseCaseListPendingLeaveRequestsCall{Call: call}
+}
+
+// MockUseCaseListPendingLeaveRequestsCall wrap *gomock.Call
+type MockUseCaseListPendingLeaveRequestsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListPendingLeaveRequestsCall) Return(arg0 []entity0.LeaveRequest, arg1 error) *MockUseCaseListPendingLeaveRequestsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListPendingLeaveRequestsCall) Do(f func(context.Context, entity.Credential) ([]entity0.LeaveRequest, error)) *MockUseCaseListPendingLeaveRequestsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListPendingLeaveRequestsCall) DoAndReturn(f func(context.Context, entity.Credential) ([]entity0.LeaveRequest, error)) *MockUseCaseListPendingLeaveRequestsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RejectLeaveRequest mocks base method.
+func (m *MockUseCase) RejectLeaveRequest(ctx context.Context, authCredential entity.Credential, requestID string, payload entity0.DecideLeaveRequest) (entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectLeaveRequest", ctx, authCredential, requestID, payload)
+	ret0, _ := ret[0].(entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RejectLeaveRequest indicates an expected call of RejectLeaveRequest.
+func (mr *MockUseCaseMockRecorder) RejectLeaveRequest(ctx, authCredential, requestID, payload any) *MockUseCaseRejectLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectLeaveRequest", reflect.TypeOf((*MockUseCase)(nil).RejectLeaveRequest), ctx, authCredential, requestID, payload)
+	return &MockUseCaseRejectLeaveRequestCall{Call: call}
+}
+
+// MockUseCaseRejectLeaveRequestCall wrap *gomock.Call
+type MockUseCaseRejectLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseRejectLeaveRequestCall) Return(arg0 entity0.LeaveRequest, arg1 error) *MockUseCaseRejectLeaveRequestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseRejectLeaveRequestCall) Do(f func(context.Context, entity.Credential, string, entity0.DecideLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseRejectLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseRejectLeaveRequestCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.DecideLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseRejectLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowLeaveCalendar mocks base method.
+func (m *MockUseCase) ShowLeaveCalendar(ctx context.Context, authCredential entity.Credential, startDate, endDate time.Time) ([]entity0.LeaveCalendarDay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowLeaveCalendar", ctx, authCredential, startDate, endDate)
+	ret0, _ := ret[0].([]entity0.LeaveCalendarDay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowLeaveCalendar indicates an expected call of ShowLeaveCalendar.
+func (mr *MockUseCaseMockRecorder) ShowLeaveCalendar(ctx, authCredential, startDate, endDate any) *MockUseCaseShowLeaveCalendarCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowLeaveCalendar", reflect.TypeOf((*MockUseCase)(nil).ShowLeaveCalendar), ctx, authCredential, startDate, endDate)
+	return &MockUseCaseShowLeaveCalendarCall{Call: call}
+}
+
+// MockUseCaseShowLeaveCalendarCall wrap *gomock.Call
+type MockUseCaseShowLeaveCalendarCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowLeaveCalendarCall) Return(arg0 []entity0.LeaveCalendarDay, arg1 error) *MockUseCaseShowLeaveCalendarCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowLeaveCalendarCall) Do(f func(context.Context, entity.Credential, time.Time, time.Time) ([]entity0.LeaveCalendarDay, error)) *MockUseCaseShowLeaveCalendarCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowLeaveCalendarCall) DoAndReturn(f func(context.Context, entity.Credential, time.Time, time.Time) ([]entity0.LeaveCalendarDay, error)) *MockUseCaseShowLeaveCalendarCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SubmitMyLeaveRequest mocks base method.
+func (m *MockUseCase) SubmitMyLeaveRequest(ctx context.Context, authCredential entity.Credential, payload entity0.SubmitLeaveRequest) (entity0.LeaveRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitMyLeaveRequest", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity0.LeaveRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitMyLeaveRequest indicates an expected call of SubmitMyLeaveRequest.
+func (mr *MockUseCaseMockRecorder) SubmitMyLeaveRequest(ctx, authCredential, payload any) *MockUseCaseSubmitMyLeaveRequestCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitMyLeaveRequest", reflect.TypeOf((*MockUseCase)(nil).SubmitMyLeaveRequest), ctx, authCredential, payload)
+	return &MockUseCaseSubmitMyLeaveRequestCall{Call: call}
+}
+
+// MockUseCaseSubmitMyLeaveRequestCall wrap *gomock.Call
+type MockUseCaseSubmitMyLeaveRequestCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseSubmitMyLeaveRequestCall) Return(arg0 entity0.LeaveRequest, arg1 error) *MockUseCaseSubmitMyLeaveRequestCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseSubmitMyLeaveRequestCall) Do(f func(context.Context, entity.Credential, entity0.SubmitLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseSubmitMyLeaveRequestCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseSubmitMyLeaveRequestCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.SubmitLeaveRequest) (entity0.LeaveRequest, error)) *MockUseCaseSubmitMyLeaveRequestCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,29 @@
+package leave
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	StoreNewLeaveType(ctx context.Context, leaveType entity.LeaveType) error
+	FindLeaveTypes(ctx context.Context) ([]entity.LeaveType, error)
+	FindLeaveTypeByID(ctx context.Context, leaveTypeID string) (*entity.LeaveType, error)
+	FindLeaveTypeByCode(ctx context.Context, code string) (*entity.LeaveType, error)
+	UpsertLeaveBalance(ctx context.Context, balance entity.LeaveBalance) error
+	FindLeaveBalancesByYear(ctx context.Context, year int64) ([]entity.LeaveBalance, error)
+	FindLeaveBalancesByUserIDYear(ctx context.Context, userID string, year int64) ([]entity.LeaveBalance, error)
+	FindLeaveBalance(ctx context.Context, userID, leaveTypeID string, year int64, opts ...entity.FindLeaveBalanceOptions) (*entity.LeaveBalance, error)
+	StoreNewLeaveRequest(ctx context.Context, request entity.LeaveRequest) error
+	FindLeaveRequestByID(ctx context.Context, requestID string, opts ...entity.FindLeaveRequestOptions) (*entity.LeaveRequest, error)
+	FindLeaveRequestsByUserID(ctx context.Context, userID string) ([]entity.LeaveRequest, error)
+	FindOverlappingLeaveRequest(ctx context.Context, userID string, startDate, endDate time.Time) (*entity.LeaveRequest, error)
+	FindPendingLeaveRequests(ctx context.Context, managerID string) ([]entity.LeaveRequest, error)
+	FindApprovedLeaveByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindLeaveRequestOptions) (entity.FindLeaveRequestResult, error)
+	UpdateLeaveRequestStatus(ctx context.Context, request entity.LeaveRequest) error
+}
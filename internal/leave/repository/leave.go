@@ -0,0 +1,370 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/leave"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type leaveRepo struct {
+	db database.Queryer
+}
+
+func NewLeaveRepository(db database.Queryer) leave.Repository {
+	return &leaveRepo{
+		db: db,
+	}
+}
+
+func (r *leaveRepo) WithTx(tx database.DBTx) leave.Repository {
+	return &leaveRepo{
+		db: tx,
+	}
+}
+
+func (r *leaveRepo) StoreNewLeaveType(ctx context.Context, leaveType entity.LeaveType) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.StoreNewLeaveType()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertLeaveTypeQuery, leaveType)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert leave type"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *leaveRepo) FindLeaveTypes(ctx context.Context) ([]entity.LeaveType, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveTypes()",
+	)
+	defer span.End()
+
+	var leaveTypes []entity.LeaveType
+	err := pgxscan.Select(ctx, r.db, &leaveTypes, findLeaveTypesQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return leaveTypes, nil
+}
+
+func (r *leaveRepo) FindLeaveTypeByID(ctx context.Context, leaveTypeID string) (*entity.LeaveType, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveTypeByID()",
+	)
+	defer span.End()
+
+	var leaveType entity.LeaveType
+	err := pgxscan.Get(ctx, r.db, &leaveType, findLeaveTypeByIDQuery, leaveTypeID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &leaveType, nil
+}
+
+func (r *leaveRepo) FindLeaveTypeByCode(ctx context.Context, code string) (*entity.LeaveType, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveTypeByCode()",
+	)
+	defer span.End()
+
+	var leaveType entity.LeaveType
+	err := pgxscan.Get(ctx, r.db, &leaveType, findLeaveTypeByCodeQuery, code)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &leaveType, nil
+}
+
+func (r *leaveRepo) UpsertLeaveBalance(ctx context.Context, balance entity.LeaveBalance) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.UpsertLeaveBalance()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(upsertLeaveBalanceQuery, balance)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to upsert leave balance"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *leaveRepo) FindLeaveBalancesByYear(ctx context.Context, year int64) ([]entity.LeaveBalance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveBalancesByYear()",
+	)
+	defer span.End()
+
+	var balances []entity.LeaveBalance
+	err := pgxscan.Select(ctx, r.db, &balances, findLeaveBalancesByYearQuery, year)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return balances, nil
+}
+
+func (r *leaveRepo) FindLeaveBalancesByUserIDYear(ctx context.Context, userID string, year int64) ([]entity.LeaveBalance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveBalancesByUserIDYear()",
+	)
+	defer span.End()
+
+	var balances []entity.LeaveBalance
+	err := pgxscan.Select(ctx, r.db, &balances, findLeaveBalancesByUserIDYearQuery, userID, year)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return balances, nil
+}
+
+func (r *leaveRepo) FindLeaveBalance(ctx context.Context, userID, leaveTypeID string, year int64, opts ...entity.FindLeaveBalanceOptions) (*entity.LeaveBalance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveBalance()",
+	)
+	defer span.End()
+
+	query := findLeaveBalanceQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE OF lb"
+	}
+
+	var balance entity.LeaveBalance
+	err := pgxscan.Get(ctx, r.db, &balance, query, userID, leaveTypeID, year)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &balance, nil
+}
+
+func (r *leaveRepo) StoreNewLeaveRequest(ctx context.Context, request entity.LeaveRequest) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.StoreNewLeaveRequest()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertLeaveRequestQuery, request)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert leave request"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *leaveRepo) FindLeaveRequestByID(ctx context.Context, requestID string, opts ...entity.FindLeaveRequestOptions) (*entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveRequestByID()",
+	)
+	defer span.End()
+
+	query := findLeaveRequestByIDQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE OF lr"
+	}
+
+	var request entity.LeaveRequest
+	err := pgxscan.Get(ctx, r.db, &request, query, requestID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &request, nil
+}
+
+func (r *leaveRepo) FindLeaveRequestsByUserID(ctx context.Context, userID string) ([]entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindLeaveRequestsByUserID()",
+	)
+	defer span.End()
+
+	var requests []entity.LeaveRequest
+	err := pgxscan.Select(ctx, r.db, &requests, findLeaveRequestsByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return requests, nil
+}
+
+func (r *leaveRepo) FindOverlappingLeaveRequest(ctx context.Context, userID string, startDate, endDate time.Time) (*entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindOverlappingLeaveRequest()",
+	)
+	defer span.End()
+
+	var request entity.LeaveRequest
+	err := pgxscan.Get(ctx, r.db, &request, findOverlappingLeaveRequestQuery, userID, startDate, endDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &request, nil
+}
+
+func (r *leaveRepo) FindPendingLeaveRequests(ctx context.Context, managerID string) ([]entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindPendingLeaveRequests()",
+	)
+	defer span.End()
+
+	var requests []entity.LeaveRequest
+	err := pgxscan.Select(ctx, r.db, &requests, findPendingLeaveRequestsQuery, managerID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return requests, nil
+}
+
+func (r *leaveRepo) FindApprovedLeaveByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindLeaveRequestOptions) (entity.FindLeaveRequestResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.FindApprovedLeaveByRange()",
+	)
+	defer span.End()
+
+	var result entity.FindLeaveRequestResult
+
+	query := findApprovedLeaveByRangeQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE OF lr"
+	}
+
+	var requests []entity.LeaveRequest
+	err := pgxscan.Select(ctx, r.db, &requests, query, startDate, endDate)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = requests
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedRequests := make(map[any][]entity.LeaveRequest)
+		var keyFunc func(request entity.LeaveRequest) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(request entity.LeaveRequest) any {
+				return request.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range requests {
+			key := keyFunc(item)
+			mappedRequests[key] = append(mappedRequests[key], item)
+		}
+
+		result.Mapped = mappedRequests
+	}
+
+	return result, nil
+}
+
+func (r *leaveRepo) UpdateLeaveRequestStatus(ctx context.Context, request entity.LeaveRequest) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveRepository.UpdateLeaveRequestStatus()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateLeaveRequestStatusQuery, request)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update leave request"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
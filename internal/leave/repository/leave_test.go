@@ -0,0 +1,658 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/internal/leave/repository"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var leaveTypeColumns = []string{
+	"id", "code", "name", "paid", "annual_allowance", "max_carry_over",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+var leaveBalanceColumns = []string{
+	"id", "user_id", "leave_type_id", "leave_type_code", "leave_type_name", "year", "accrued_days", "carried_over_days",
+	"used_days", "pending_days", "created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+var leaveRequestColumns = []string{
+	"id", "user_id", "username", "leave_type_id", "leave_type_code", "leave_type_name", "paid", "start_date", "end_date",
+	"days", "reason", "status", "decided_by", "decided_at", "decision_comment",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
+
+func TestStoreNewLeaveType(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.LeaveType
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_types").
+					WithArgs(anyArgs(11)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("type-1"))
+			},
+			input: entity.LeaveType{
+				ID:              "type-1",
+				Code:            "ANNUAL",
+				Name:            "Annual Leave",
+				Paid:            true,
+				AnnualAllowance: 12,
+				MaxCarryOver:    5,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+				CreatedBy:       "admin",
+				UpdatedBy:       "admin",
+				IPAddress:       "127.0.0.1",
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_types").
+					WithArgs(anyArgs(11)...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_types").
+					WithArgs(anyArgs(11)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewLeaveType(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFindLeaveTypeByCode(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  *entity.LeaveType
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_types WHERE code").
+					WithArgs("ANNUAL").
+					WillReturnRows(pgxmock.NewRows(leaveTypeColumns).
+						AddRow("type-1", "ANNUAL", "Annual Leave", true, int64(12), int64(5), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expected: &entity.LeaveType{
+				ID:              "type-1",
+				Code:            "ANNUAL",
+				Name:            "Annual Leave",
+				Paid:            true,
+				AnnualAllowance: 12,
+				MaxCarryOver:    5,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+				CreatedBy:       "admin",
+				UpdatedBy:       "admin",
+				IPAddress:       "127.0.0.1",
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_types WHERE code").
+					WithArgs("ANNUAL").
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_types WHERE code").
+					WithArgs("ANNUAL").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindLeaveTypeByCode(context.Background(), "ANNUAL")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestUpsertLeaveBalance(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+	input := entity.LeaveBalance{
+		ID:              "balance-1",
+		UserID:          "user-1",
+		LeaveTypeID:     "type-1",
+		Year:            2025,
+		AccruedDays:     12,
+		CarriedOverDays: 3,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		CreatedBy:       "admin",
+		UpdatedBy:       "admin",
+		IPAddress:       "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_balances (.+) ON CONFLICT \\(user_id, leave_type_id, year\\) DO UPDATE").
+					WithArgs(input.ID, input.UserID, input.LeaveTypeID, input.Year, input.AccruedDays, input.CarriedOverDays,
+						input.CreatedAt, input.UpdatedAt, input.CreatedBy, input.UpdatedBy, input.IPAddress).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("balance-1"))
+			},
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_balances").
+					WithArgs(anyArgs(11)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpsertLeaveBalance(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFindLeaveBalance(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		opts      []entity.FindLeaveBalanceOptions
+		expected  *entity.LeaveBalance
+		expectErr bool
+	}{
+		{
+			name: "success - locked",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_balances lb (.+) FOR UPDATE OF lb").
+					WithArgs("user-1", "type-1", int64(2025)).
+					WillReturnRows(pgxmock.NewRows(leaveBalanceColumns).
+						AddRow("balance-1", "user-1", "type-1", "ANNUAL", "Annual Leave", int64(2025), int64(12), int64(3),
+							int64(4), int64(2), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindLeaveBalanceOptions{{PessimisticLock: true}},
+			expected: &entity.LeaveBalance{
+				ID:              "balance-1",
+				UserID:          "user-1",
+				LeaveTypeID:     "type-1",
+				LeaveTypeCode:   "ANNUAL",
+				LeaveTypeName:   "Annual Leave",
+				Year:            2025,
+				AccruedDays:     12,
+				CarriedOverDays: 3,
+				UsedDays:        4,
+				PendingDays:     2,
+				CreatedAt:       now,
+				UpdatedAt:       now,
+				CreatedBy:       "admin",
+				UpdatedBy:       "admin",
+				IPAddress:       "127.0.0.1",
+			},
+		},
+		{
+			name: "not accrued",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_balances lb").
+					WithArgs("user-1", "type-1", int64(2025)).
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_balances lb").
+					WithArgs("user-1", "type-1", int64(2025)).
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindLeaveBalance(context.Background(), "user-1", "type-1", 2025, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestStoreNewLeaveRequest(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_requests").
+					WithArgs(anyArgs(13)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("request-1"))
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO leave_requests").
+					WithArgs(anyArgs(13)...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewLeaveRequest(context.Background(), entity.LeaveRequest{
+				ID:          "request-1",
+				UserID:      "user-1",
+				LeaveTypeID: "type-1",
+				StartDate:   time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC),
+				EndDate:     time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC),
+				Days:        3,
+				Reason:      optional.NewString("Family trip"),
+				Status:      entity.LeaveRequestStatusPending,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				CreatedBy:   "user-1",
+				UpdatedBy:   "user-1",
+				IPAddress:   "127.0.0.1",
+			})
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindLeaveRequestByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		opts      []entity.FindLeaveRequestOptions
+		expected  *entity.LeaveRequest
+		expectErr bool
+	}{
+		{
+			name: "success - locked",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr (.+) FOR UPDATE OF lr").
+					WithArgs("request-1").
+					WillReturnRows(pgxmock.NewRows(leaveRequestColumns).
+						AddRow("request-1", "user-1", "employee", "type-1", "ANNUAL", "Annual Leave", true, startDate, endDate,
+							int64(3), nil, entity.LeaveRequestStatusPending, nil, nil, nil,
+							now, now, "user-1", "user-1", "127.0.0.1"))
+			},
+			opts: []entity.FindLeaveRequestOptions{{PessimisticLock: true}},
+			expected: &entity.LeaveRequest{
+				ID:            "request-1",
+				UserID:        "user-1",
+				Username:      "employee",
+				LeaveTypeID:   "type-1",
+				LeaveTypeCode: "ANNUAL",
+				LeaveTypeName: "Annual Leave",
+				Paid:          true,
+				StartDate:     startDate,
+				EndDate:       endDate,
+				Days:          3,
+				Status:        entity.LeaveRequestStatusPending,
+				CreatedAt:     now,
+				UpdatedAt:     now,
+				CreatedBy:     "user-1",
+				UpdatedBy:     "user-1",
+				IPAddress:     "127.0.0.1",
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs("request-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs("request-1").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindLeaveRequestByID(context.Background(), "request-1", tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFindPendingLeaveRequests(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		managerID   string
+		setupMock   func()
+		expectedLen int
+		expectErr   bool
+	}{
+		{
+			name:      "success - direct reports of a manager",
+			managerID: "manager-1",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr (.+) WHERE lr.status = 'pending'").
+					WithArgs("manager-1").
+					WillReturnRows(pgxmock.NewRows(leaveRequestColumns).
+						AddRow("request-1", "user-1", "employee", "type-1", "ANNUAL", "Annual Leave", true, startDate, startDate,
+							int64(1), nil, entity.LeaveRequestStatusPending, nil, nil, nil,
+							now, now, "user-1", "user-1", "127.0.0.1"))
+			},
+			expectedLen: 1,
+		},
+		{
+			name:      "success - everyone",
+			managerID: "",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs("").
+					WillReturnRows(pgxmock.NewRows(leaveRequestColumns))
+			},
+			expectedLen: 0,
+		},
+		{
+			name:      "db error",
+			managerID: "manager-1",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs("manager-1").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindPendingLeaveRequests(context.Background(), tt.managerID)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, result, tt.expectedLen)
+		})
+	}
+}
+
+func TestFindApprovedLeaveByRange(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindLeaveRequestOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user and locked",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr (.+) FOR UPDATE OF lr").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(leaveRequestColumns).
+						AddRow("request-1", "user-1", "employee", "type-1", "ANNUAL", "Annual Leave", true, startDate, startDate,
+							int64(1), nil, entity.LeaveRequestStatusApproved, "manager-1", now, nil,
+							now, now, "user-1", "manager-1", "127.0.0.1").
+						AddRow("request-2", "user-1", "employee", "type-2", "SICK", "Sick Leave", true, endDate, endDate,
+							int64(1), nil, entity.LeaveRequestStatusApproved, "manager-1", now, nil,
+							now, now, "user-1", "manager-1", "127.0.0.1").
+						AddRow("request-3", "user-2", "other", "type-1", "ANNUAL", "Annual Leave", true, startDate, endDate,
+							int64(21), nil, entity.LeaveRequestStatusApproved, "admin", now, nil,
+							now, now, "user-2", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindLeaveRequestOptions{
+				{
+					PessimisticLock: true,
+					MappedOptions:   &entity.MappedOptions{MappedBy: entity.MappedByUserID},
+				},
+			},
+			expectedLen:    3,
+			expectedMapped: map[any]int{"user-1": 2, "user-2": 1},
+		},
+		{
+			name: "success - not mapped",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(leaveRequestColumns))
+			},
+			expectedLen: 0,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM leave_requests lr").
+					WithArgs(startDate, endDate).
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindApprovedLeaveByRange(context.Background(), startDate, endDate, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result.List, tt.expectedLen)
+			assert.Equal(t, tt.expectedMapped != nil, result.IsMapped)
+			for key, count := range tt.expectedMapped {
+				assert.Len(t, result.Mapped[key], count)
+			}
+		})
+	}
+}
+
+func TestUpdateLeaveRequestStatus(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLeaveRepository(mock)
+	now := time.Now()
+	input := entity.LeaveRequest{
+		ID:              "request-1",
+		Status:          entity.LeaveRequestStatusApproved,
+		DecidedBy:       optional.NewString("manager-1"),
+		DecidedAt:       optional.NewTime(now),
+		DecisionComment: optional.NewString("Enjoy"),
+		UpdatedAt:       now,
+		UpdatedBy:       "manager-1",
+		IPAddress:       "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE leave_requests").
+					WithArgs(input.Status, input.DecidedBy, input.DecidedAt, input.DecisionComment,
+						input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.ID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("request-1"))
+			},
+		},
+		{
+			name: "request not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE leave_requests").
+					WithArgs(anyArgs(8)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateLeaveRequestStatus(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
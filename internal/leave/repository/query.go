@@ -0,0 +1,260 @@
+package repository
+
+const insertLeaveTypeQuery = `
+INSERT INTO leave_types (
+	id,
+	code,
+	name,
+	paid,
+	annual_allowance,
+	max_carry_over,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:code,
+	:name,
+	:paid,
+	:annual_allowance,
+	:max_carry_over,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findLeaveTypesQuery = `
+SELECT
+	id,
+	code,
+	name,
+	paid,
+	annual_allowance,
+	max_carry_over,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM leave_types
+ORDER BY code ASC
+`
+
+const findLeaveTypeByIDQuery = `
+SELECT
+	id,
+	code,
+	name,
+	paid,
+	annual_allowance,
+	max_carry_over,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM leave_types
+WHERE id = $1
+`
+
+const findLeaveTypeByCodeQuery = `
+SELECT
+	id,
+	code,
+	name,
+	paid,
+	annual_allowance,
+	max_carry_over,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM leave_types
+WHERE code = $1
+`
+
+// Accruing a year again replaces the accrued and carried over days, the
+// requests already taken from the balance stay.
+const upsertLeaveBalanceQuery = `
+INSERT INTO leave_balances (
+	id,
+	user_id,
+	leave_type_id,
+	year,
+	accrued_days,
+	carried_over_days,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:leave_type_id,
+	:year,
+	:accrued_days,
+	:carried_over_days,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+ON CONFLICT (user_id, leave_type_id, year) DO UPDATE SET
+	accrued_days = EXCLUDED.accrued_days,
+	carried_over_days = EXCLUDED.carried_over_days,
+	updated_at = EXCLUDED.updated_at,
+	updated_by = EXCLUDED.updated_by,
+	ip_address = EXCLUDED.ip_address
+RETURNING id
+`
+
+// Used and pending days total the requests of the balance year, a request
+// never spans two years.
+const selectLeaveBalanceColumns = `
+SELECT
+	lb.id,
+	lb.user_id,
+	lb.leave_type_id,
+	lt.code AS leave_type_code,
+	lt.name AS leave_type_name,
+	lb.year,
+	lb.accrued_days,
+	lb.carried_over_days,
+	(
+		SELECT COALESCE(SUM(lr.days), 0) FROM leave_requests lr
+		WHERE lr.user_id = lb.user_id AND lr.leave_type_id = lb.leave_type_id
+		AND EXTRACT(YEAR FROM lr.start_date) = lb.year AND lr.status = 'approved'
+	) AS used_days,
+	(
+		SELECT COALESCE(SUM(lr.days), 0) FROM leave_requests lr
+		WHERE lr.user_id = lb.user_id AND lr.leave_type_id = lb.leave_type_id
+		AND EXTRACT(YEAR FROM lr.start_date) = lb.year AND lr.status = 'pending'
+	) AS pending_days,
+	lb.created_at,
+	lb.updated_at,
+	lb.created_by,
+	lb.updated_by,
+	lb.ip_address
+FROM leave_balances lb
+JOIN leave_types lt ON lt.id = lb.leave_type_id
+`
+
+const findLeaveBalancesByYearQuery = selectLeaveBalanceColumns + `WHERE lb.year = $1
+ORDER BY lb.user_id, lt.code ASC
+`
+
+const findLeaveBalancesByUserIDYearQuery = selectLeaveBalanceColumns + `WHERE lb.user_id = $1 AND lb.year = $2
+ORDER BY lt.code ASC
+`
+
+const findLeaveBalanceQuery = selectLeaveBalanceColumns + `WHERE lb.user_id = $1 AND lb.leave_type_id = $2 AND lb.year = $3`
+
+const insertLeaveRequestQuery = `
+INSERT INTO leave_requests (
+	id,
+	user_id,
+	leave_type_id,
+	start_date,
+	end_date,
+	days,
+	reason,
+	status,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:leave_type_id,
+	:start_date,
+	:end_date,
+	:days,
+	:reason,
+	:status,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const selectLeaveRequestColumns = `
+SELECT
+	lr.id,
+	lr.user_id,
+	u.username,
+	lr.leave_type_id,
+	lt.code AS leave_type_code,
+	lt.name AS leave_type_name,
+	lt.paid,
+	lr.start_date,
+	lr.end_date,
+	lr.days,
+	lr.reason,
+	lr.status,
+	lr.decided_by,
+	lr.decided_at,
+	lr.decision_comment,
+	lr.created_at,
+	lr.updated_at,
+	lr.created_by,
+	lr.updated_by,
+	lr.ip_address
+FROM leave_requests lr
+JOIN users u ON u.id = lr.user_id
+JOIN leave_types lt ON lt.id = lr.leave_type_id
+`
+
+const findLeaveRequestByIDQuery = selectLeaveRequestColumns + `WHERE lr.id = $1`
+
+const findLeaveRequestsByUserIDQuery = selectLeaveRequestColumns + `WHERE lr.user_id = $1
+ORDER BY lr.start_date DESC, lr.created_at DESC
+`
+
+const findOverlappingLeaveRequestQuery = selectLeaveRequestColumns + `WHERE lr.user_id = $1
+AND lr.status IN ('pending', 'approved')
+AND lr.start_date <= $3::DATE AND lr.end_date >= $2::DATE
+ORDER BY lr.start_date
+LIMIT 1
+`
+
+// An empty manager lists the pending requests of everyone.
+const findPendingLeaveRequestsQuery = selectLeaveRequestColumns + `WHERE lr.status = 'pending'
+AND ($1 = '' OR u.manager_id::TEXT = $1)
+ORDER BY lr.start_date ASC, lr.created_at ASC
+`
+
+const findApprovedLeaveByRangeQuery = selectLeaveRequestColumns + `WHERE lr.status = 'approved'
+AND lr.start_date <= $2::DATE AND lr.end_date >= $1::DATE
+ORDER BY lr.user_id, lr.start_date ASC
+`
+
+const updateLeaveRequestStatusQuery = `
+UPDATE leave_requests
+SET
+	status = :status,
+	decided_by = :decided_by,
+	decided_at = :decided_at,
+	decision_comment = :decision_comment,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :id
+RETURNING id
+`
@@ -0,0 +1,25 @@
+package leave
+
+import (
+	"context"
+	"time"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+)
+
+type UseCase interface {
+	CreateLeaveType(ctx context.Context, authCredential authCredential.Credential, payload entity.CreateLeaveType) (entity.LeaveType, error)
+	ListLeaveTypes(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveType, error)
+	AccrueLeaveBalances(ctx context.Context, authCredential authCredential.Credential, year int64) (entity.LeaveAccrual, error)
+	ListLeaveBalances(ctx context.Context, authCredential authCredential.Credential, userID string, year int64) ([]entity.LeaveBalance, error)
+	ListPendingLeaveRequests(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveRequest, error)
+	ApproveLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string, payload entity.DecideLeaveRequest) (entity.LeaveRequest, error)
+	RejectLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string, payload entity.DecideLeaveRequest) (entity.LeaveRequest, error)
+	ShowLeaveCalendar(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.LeaveCalendarDay, error)
+
+	ListMyLeaveBalances(ctx context.Context, authCredential authCredential.Credential, year int64) ([]entity.LeaveBalance, error)
+	SubmitMyLeaveRequest(ctx context.Context, authCredential authCredential.Credential, payload entity.SubmitLeaveRequest) (entity.LeaveRequest, error)
+	ListMyLeaveRequests(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveRequest, error)
+	CancelMyLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string) (entity.LeaveRequest, error)
+}
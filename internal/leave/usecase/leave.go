@@ -0,0 +1,707 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/attendance"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/internal/leave"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type leaveUseCase struct {
+	leaveRepo      leave.Repository
+	userRepo       users.Repository
+	holidayRepo    holiday.Repository
+	attendanceRepo attendance.Repository
+}
+
+func NewLeaveUseCase(
+	leaveRepo leave.Repository,
+	userRepo users.Repository,
+	holidayRepo holiday.Repository,
+	attendanceRepo attendance.Repository,
+) leave.UseCase {
+	return &leaveUseCase{
+		leaveRepo:      leaveRepo,
+		userRepo:       userRepo,
+		holidayRepo:    holidayRepo,
+		attendanceRepo: attendanceRepo,
+	}
+}
+
+func (u *leaveUseCase) CreateLeaveType(ctx context.Context, authCredential authCredential.Credential, payload entity.CreateLeaveType) (entity.LeaveType, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.CreateLeaveType()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.LeaveType{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LeaveNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newLeaveType := entity.LeaveType{
+		ID:              uuid.NewString(),
+		Code:            payload.Code,
+		Name:            payload.Name,
+		Paid:            payload.Paid,
+		AnnualAllowance: payload.AnnualAllowance,
+		MaxCarryOver:    payload.MaxCarryOver,
+		CreatedAt:       timeNow,
+		UpdatedAt:       timeNow,
+		CreatedBy:       authCredential.UserID,
+		UpdatedBy:       authCredential.UserID,
+		IPAddress:       authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+
+		existing, err := leaveRepoTx.FindLeaveTypeByCode(ctx, payload.Code)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.CreateLeaveType().FindLeaveTypeByCode()")
+		}
+		if existing != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveTypeAlreadyExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveTypeAlreadyExists),
+					Received:  payload.Code,
+				},
+			)
+		}
+
+		err = leaveRepoTx.StoreNewLeaveType(ctx, newLeaveType)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.CreateLeaveType().StoreNewLeaveType()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LeaveType{}, errors.Wrap(err, "LeaveUseCase.CreateLeaveType().WithAuditContext()")
+	}
+
+	return newLeaveType, nil
+}
+
+func (u *leaveUseCase) ListLeaveTypes(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveType, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ListLeaveTypes()",
+	)
+	defer span.End()
+
+	leaveTypes, err := u.leaveRepo.FindLeaveTypes(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListLeaveTypes().FindLeaveTypes()")
+	}
+
+	return leaveTypes, nil
+}
+
+// AccrueLeaveBalances grants every user employed in the year the allowance of
+// each leave type, prorated to the days they are employed, and carries over
+// what is left of the previous year up to the limit of the type. Accruing a
+// year again recomputes its balances, the requests taken from them stay.
+func (u *leaveUseCase) AccrueLeaveBalances(ctx context.Context, authCredential authCredential.Credential, year int64) (entity.LeaveAccrual, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.AccrueLeaveBalances()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.LeaveAccrual{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LeaveNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+			},
+		)
+	}
+
+	yearStart := time.Date(int(year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	yearEnd := time.Date(int(year), time.December, 31, 0, 0, 0, 0, time.UTC)
+	yearDays := int64(yearEnd.Sub(yearStart).Hours()/24) + 1
+
+	result := entity.LeaveAccrual{
+		Year: year,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		leaveTypes, err := leaveRepoTx.FindLeaveTypes(ctx)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().FindLeaveTypes()")
+		}
+
+		userResult, err := userRepoTx.FindAllUsers(ctx)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().FindAllUsers()")
+		}
+
+		previousBalances, err := leaveRepoTx.FindLeaveBalancesByYear(ctx, year-1)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().FindLeaveBalancesByYear()")
+		}
+		unusedDays := make(map[string]int64, len(previousBalances))
+		for _, balance := range previousBalances {
+			unusedDays[balance.UserID+"/"+balance.LeaveTypeID] = balance.UnusedDays()
+		}
+
+		timeNow := time.Now()
+		for _, user := range userResult.List {
+			from, to, ok := user.EmployedWithin(yearStart, yearEnd)
+			if !ok {
+				continue
+			}
+			employedDays := int64(to.Sub(from).Hours()/24) + 1
+
+			for _, leaveType := range leaveTypes {
+				err = leaveRepoTx.UpsertLeaveBalance(ctx, entity.LeaveBalance{
+					ID:              uuid.NewString(),
+					UserID:          user.ID,
+					LeaveTypeID:     leaveType.ID,
+					Year:            year,
+					AccruedDays:     entity.ProrateAllowance(leaveType.AnnualAllowance, employedDays, yearDays),
+					CarriedOverDays: min(unusedDays[user.ID+"/"+leaveType.ID], leaveType.MaxCarryOver),
+					CreatedAt:       timeNow,
+					UpdatedAt:       timeNow,
+					CreatedBy:       authCredential.UserID,
+					UpdatedBy:       authCredential.UserID,
+					IPAddress:       authCredential.IPAddress,
+				})
+				if err != nil {
+					return errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().UpsertLeaveBalance()")
+				}
+			}
+		}
+
+		result.Balances, err = leaveRepoTx.FindLeaveBalancesByYear(ctx, year)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().FindLeaveBalancesByYear()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LeaveAccrual{}, errors.Wrap(err, "LeaveUseCase.AccrueLeaveBalances().WithAuditContext()")
+	}
+
+	return result, nil
+}
+
+func (u *leaveUseCase) ListLeaveBalances(ctx context.Context, authCredential authCredential.Credential, userID string, year int64) ([]entity.LeaveBalance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ListLeaveBalances()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LeaveNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+			},
+		)
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListLeaveBalances().FindUserByID()")
+	}
+	if user == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.LeaveUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeaveUserNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	balances, err := u.leaveRepo.FindLeaveBalancesByUserIDYear(ctx, userID, year)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListLeaveBalances().FindLeaveBalancesByUserIDYear()")
+	}
+
+	return balances, nil
+}
+
+// ListPendingLeaveRequests lists the requests waiting for a decision of the
+// caller, admins decide on everyone and managers on their direct reports.
+func (u *leaveUseCase) ListPendingLeaveRequests(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ListPendingLeaveRequests()",
+	)
+	defer span.End()
+
+	managerID := authCredential.UserID
+	if *authCredential.IsAdmin {
+		managerID = ""
+	}
+
+	requests, err := u.leaveRepo.FindPendingLeaveRequests(ctx, managerID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListPendingLeaveRequests().FindPendingLeaveRequests()")
+	}
+
+	return requests, nil
+}
+
+func (u *leaveUseCase) ApproveLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string, payload entity.DecideLeaveRequest) (entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ApproveLeaveRequest()",
+	)
+	defer span.End()
+
+	request, err := u.decideLeaveRequest(ctx, authCredential, requestID, entity.LeaveRequestStatusApproved, payload)
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.ApproveLeaveRequest().decideLeaveRequest()")
+	}
+
+	return request, nil
+}
+
+func (u *leaveUseCase) RejectLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string, payload entity.DecideLeaveRequest) (entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.RejectLeaveRequest()",
+	)
+	defer span.End()
+
+	request, err := u.decideLeaveRequest(ctx, authCredential, requestID, entity.LeaveRequestStatusRejected, payload)
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.RejectLeaveRequest().decideLeaveRequest()")
+	}
+
+	return request, nil
+}
+
+// decideLeaveRequest lets an admin or the manager of the requester decide on
+// a pending request. Approving checks the balance again, the days of the
+// request are held by it since it was submitted.
+func (u *leaveUseCase) decideLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string, status entity.LeaveRequestStatus, payload entity.DecideLeaveRequest) (entity.LeaveRequest, error) {
+	var decided entity.LeaveRequest
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		request, err := leaveRepoTx.FindLeaveRequestByID(ctx, requestID, entity.FindLeaveRequestOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().FindLeaveRequestByID()")
+		}
+		if request == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotFound),
+					Received:  requestID,
+				},
+			)
+		}
+
+		if request.UserID == authCredential.UserID {
+			return apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestSelfApproval,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestSelfApproval),
+					Received:  requestID,
+				},
+			)
+		}
+
+		if !*authCredential.IsAdmin {
+			requester, err := userRepoTx.FindUserByID(ctx, request.UserID)
+			if err != nil {
+				return errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().FindUserByID()")
+			}
+			if requester == nil || requester.ManagerID.GetOrDefault() != authCredential.UserID {
+				return apperror.Forbidden(
+					apperror.AppError{
+						IssueCode: entity.LeaveNotAuthorized,
+						Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+					},
+				)
+			}
+		}
+
+		if request.Status != entity.LeaveRequestStatusPending {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotPending,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotPending),
+					Received:  string(request.Status),
+				},
+			)
+		}
+
+		if status == entity.LeaveRequestStatusApproved {
+			err = u.checkPeriodOpen(ctx, tx, request.StartDate, request.EndDate)
+			if err != nil {
+				return errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().checkPeriodOpen()")
+			}
+
+			balance, err := leaveRepoTx.FindLeaveBalance(ctx, request.UserID, request.LeaveTypeID, int64(request.StartDate.Year()), entity.FindLeaveBalanceOptions{
+				PessimisticLock: true,
+			})
+			if err != nil {
+				return errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().FindLeaveBalance()")
+			}
+			if balance == nil || balance.UnusedDays() < request.Days {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.LeaveInsufficientBalance,
+						Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInsufficientBalance),
+						Received:  strconv.FormatInt(request.Days, 10),
+					},
+				)
+			}
+		}
+
+		timeNow := time.Now()
+		decided = *request
+		decided.Status = status
+		decided.DecidedBy = optional.NewString(authCredential.UserID)
+		decided.DecidedAt = optional.NewTime(timeNow)
+		decided.DecisionComment = payload.Comment
+		decided.UpdatedAt = timeNow
+		decided.UpdatedBy = authCredential.UserID
+		decided.IPAddress = authCredential.IPAddress
+
+		err = leaveRepoTx.UpdateLeaveRequestStatus(ctx, decided)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().UpdateLeaveRequestStatus()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.decideLeaveRequest().WithAuditContext()")
+	}
+
+	return decided, nil
+}
+
+// ShowLeaveCalendar lays out the approved leave between startDate and
+// endDate. Admins see everyone, other users their own leave and the leave of
+// their direct reports.
+func (u *leaveUseCase) ShowLeaveCalendar(ctx context.Context, authCredential authCredential.Credential, startDate, endDate time.Time) ([]entity.LeaveCalendarDay, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ShowLeaveCalendar()",
+	)
+	defer span.End()
+
+	err := validateLeaveRange(startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ShowLeaveCalendar().validateLeaveRange()")
+	}
+
+	result, err := u.leaveRepo.FindApprovedLeaveByRange(ctx, startDate, endDate)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ShowLeaveCalendar().FindApprovedLeaveByRange()")
+	}
+
+	requests := result.List
+	if !*authCredential.IsAdmin {
+		userResult, err := u.userRepo.FindAllUsers(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "LeaveUseCase.ShowLeaveCalendar().FindAllUsers()")
+		}
+
+		visible := map[string]bool{
+			authCredential.UserID: true,
+		}
+		for _, user := range userResult.List {
+			if user.ManagerID.GetOrDefault() == authCredential.UserID {
+				visible[user.ID] = true
+			}
+		}
+
+		requests = nil
+		for _, request := range result.List {
+			if visible[request.UserID] {
+				requests = append(requests, request)
+			}
+		}
+	}
+
+	return entity.NewLeaveCalendar(requests, startDate, endDate), nil
+}
+
+func (u *leaveUseCase) ListMyLeaveBalances(ctx context.Context, authCredential authCredential.Credential, year int64) ([]entity.LeaveBalance, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ListMyLeaveBalances()",
+	)
+	defer span.End()
+
+	balances, err := u.leaveRepo.FindLeaveBalancesByUserIDYear(ctx, authCredential.UserID, year)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListMyLeaveBalances().FindLeaveBalancesByUserIDYear()")
+	}
+
+	return balances, nil
+}
+
+// SubmitMyLeaveRequest asks for the working days between the dates of the
+// payload. The days are held from the balance of their year until the
+// request is decided or cancelled.
+func (u *leaveUseCase) SubmitMyLeaveRequest(ctx context.Context, authCredential authCredential.Credential, payload entity.SubmitLeaveRequest) (entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.SubmitMyLeaveRequest()",
+	)
+	defer span.End()
+
+	err := validateLeaveRange(payload.StartDate, payload.EndDate)
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().validateLeaveRange()")
+	}
+
+	timeNow := time.Now()
+	newRequest := entity.LeaveRequest{
+		ID:          uuid.NewString(),
+		UserID:      authCredential.UserID,
+		Username:    authCredential.Username,
+		LeaveTypeID: payload.LeaveTypeID,
+		StartDate:   payload.StartDate,
+		EndDate:     payload.EndDate,
+		Reason:      payload.Reason,
+		Status:      entity.LeaveRequestStatusPending,
+		CreatedAt:   timeNow,
+		UpdatedAt:   timeNow,
+		CreatedBy:   authCredential.UserID,
+		UpdatedBy:   authCredential.UserID,
+		IPAddress:   authCredential.IPAddress,
+	}
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+
+		leaveType, err := leaveRepoTx.FindLeaveTypeByID(ctx, payload.LeaveTypeID)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().FindLeaveTypeByID()")
+		}
+		if leaveType == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveTypeNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveTypeNotFound),
+					Received:  payload.LeaveTypeID,
+				},
+			)
+		}
+		newRequest.LeaveTypeCode = leaveType.Code
+		newRequest.LeaveTypeName = leaveType.Name
+		newRequest.Paid = leaveType.Paid
+
+		holidays, err := holidayRepoTx.FindHolidaysByRange(ctx, payload.StartDate, payload.EndDate)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().FindHolidaysByRange()")
+		}
+		newRequest.Days = holidayEntity.NewCalendar(holidays).WorkingDays(payload.StartDate, payload.EndDate)
+		if newRequest.Days == 0 {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveNoWorkingDays,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNoWorkingDays),
+				},
+			)
+		}
+
+		err = u.checkPeriodOpen(ctx, tx, payload.StartDate, payload.EndDate)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().checkPeriodOpen()")
+		}
+
+		// Locking the balance first keeps two requests of the user from
+		// both passing the overlap and balance checks.
+		balance, err := leaveRepoTx.FindLeaveBalance(ctx, authCredential.UserID, payload.LeaveTypeID, int64(payload.StartDate.Year()), entity.FindLeaveBalanceOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().FindLeaveBalance()")
+		}
+
+		overlapping, err := leaveRepoTx.FindOverlappingLeaveRequest(ctx, authCredential.UserID, payload.StartDate, payload.EndDate)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().FindOverlappingLeaveRequest()")
+		}
+		if overlapping != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveOverlaps),
+					Received:  overlapping.ID,
+				},
+			)
+		}
+
+		if balance == nil || balance.AvailableDays() < newRequest.Days {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveInsufficientBalance,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInsufficientBalance),
+					Received:  strconv.FormatInt(newRequest.Days, 10),
+				},
+			)
+		}
+
+		err = leaveRepoTx.StoreNewLeaveRequest(ctx, newRequest)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().StoreNewLeaveRequest()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.SubmitMyLeaveRequest().WithAuditContext()")
+	}
+
+	return newRequest, nil
+}
+
+func (u *leaveUseCase) ListMyLeaveRequests(ctx context.Context, authCredential authCredential.Credential) ([]entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.ListMyLeaveRequests()",
+	)
+	defer span.End()
+
+	requests, err := u.leaveRepo.FindLeaveRequestsByUserID(ctx, authCredential.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LeaveUseCase.ListMyLeaveRequests().FindLeaveRequestsByUserID()")
+	}
+
+	return requests, nil
+}
+
+// CancelMyLeaveRequest withdraws a pending request or gives back the days of
+// an approved one, as long as payroll has not been generated for them.
+func (u *leaveUseCase) CancelMyLeaveRequest(ctx context.Context, authCredential authCredential.Credential, requestID string) (entity.LeaveRequest, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LeaveUseCase.CancelMyLeaveRequest()",
+	)
+	defer span.End()
+
+	var cancelled entity.LeaveRequest
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+
+		request, err := leaveRepoTx.FindLeaveRequestByID(ctx, requestID, entity.FindLeaveRequestOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.CancelMyLeaveRequest().FindLeaveRequestByID()")
+		}
+		if request == nil || request.UserID != authCredential.UserID {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotFound),
+					Received:  requestID,
+				},
+			)
+		}
+
+		switch request.Status {
+		case entity.LeaveRequestStatusPending:
+		case entity.LeaveRequestStatusApproved:
+			err = u.checkPeriodOpen(ctx, tx, request.StartDate, request.EndDate)
+			if err != nil {
+				return errors.Wrap(err, "LeaveUseCase.CancelMyLeaveRequest().checkPeriodOpen()")
+			}
+		default:
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotCancellable,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotCancellable),
+					Received:  string(request.Status),
+				},
+			)
+		}
+
+		cancelled = *request
+		cancelled.Status = entity.LeaveRequestStatusCancelled
+		cancelled.UpdatedAt = time.Now()
+		cancelled.UpdatedBy = authCredential.UserID
+		cancelled.IPAddress = authCredential.IPAddress
+
+		err = leaveRepoTx.UpdateLeaveRequestStatus(ctx, cancelled)
+		if err != nil {
+			return errors.Wrap(err, "LeaveUseCase.CancelMyLeaveRequest().UpdateLeaveRequestStatus()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.LeaveRequest{}, errors.Wrap(err, "LeaveUseCase.CancelMyLeaveRequest().WithAuditContext()")
+	}
+
+	return cancelled, nil
+}
+
+// checkPeriodOpen refuses leave in a period whose payroll has been
+// generated, it would change the attended days the payroll was computed
+// with.
+func (u *leaveUseCase) checkPeriodOpen(ctx context.Context, tx database.DBTx, startDate, endDate time.Time) error {
+	closedPeriod, err := u.attendanceRepo.WithTx(tx).FindClosedPeriodByRange(ctx, startDate, endDate)
+	if err != nil {
+		return errors.Wrap(err, "LeaveUseCase.checkPeriodOpen().FindClosedPeriodByRange()")
+	}
+	if closedPeriod != nil {
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.LeavePeriodClosed,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeavePeriodClosed),
+				Received:  closedPeriod.ID,
+			},
+		)
+	}
+	return nil
+}
+
+func validateLeaveRange(startDate, endDate time.Time) error {
+	if endDate.Before(startDate) || endDate.Year() != startDate.Year() {
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.LeaveInvalidRange,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInvalidRange),
+				Received:  startDate.Format(time.DateOnly) + " - " + endDate.Format(time.DateOnly),
+			},
+		)
+	}
+	return nil
+}
@@ -0,0 +1,901 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	attEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
+	mockAtt "github.com/vnnyx/employee-management/internal/attendance/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	mockHoliday "github.com/vnnyx/employee-management/internal/holiday/mock"
+	"github.com/vnnyx/employee-management/internal/leave/entity"
+	mockLeave "github.com/vnnyx/employee-management/internal/leave/mock"
+	"github.com/vnnyx/employee-management/internal/leave/usecase"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	managerCredential = authCredential.Credential{
+		UserID:    "manager-1",
+		IPAddress: "127.0.0.1",
+		Username:  "manager",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	leaveRepo     *mockLeave.MockRepository
+	leaveRepoTx   *mockLeave.MockRepository
+	userRepo      *mockUser.MockRepository
+	userRepoTx    *mockUser.MockRepository
+	holidayRepo   *mockHoliday.MockRepository
+	holidayRepoTx *mockHoliday.MockRepository
+	attRepo       *mockAtt.MockRepository
+	attRepoTx     *mockAtt.MockRepository
+}
+
+func newMockParams(ctrl *gomock.Controller) mockParams {
+	return mockParams{
+		leaveRepo:     mockLeave.NewMockRepository(ctrl),
+		leaveRepoTx:   mockLeave.NewMockRepository(ctrl),
+		userRepo:      mockUser.NewMockRepository(ctrl),
+		userRepoTx:    mockUser.NewMockRepository(ctrl),
+		holidayRepo:   mockHoliday.NewMockRepository(ctrl),
+		holidayRepoTx: mockHoliday.NewMockRepository(ctrl),
+		attRepo:       mockAtt.NewMockRepository(ctrl),
+		attRepoTx:     mockAtt.NewMockRepository(ctrl),
+	}
+}
+
+func balanceMatcher(userID, leaveTypeID string, accruedDays, carriedOverDays int64) any {
+	return mock.MatchedBy(func(args entity.LeaveBalance) bool {
+		return args.UserID == userID &&
+			args.LeaveTypeID == leaveTypeID &&
+			args.Year == 2025 &&
+			args.AccruedDays == accruedDays &&
+			args.CarriedOverDays == carriedOverDays &&
+			args.CreatedBy == "admin-1"
+	})
+}
+
+func TestCreateLeaveType(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.CreateLeaveType{
+		Code:            "ANNUAL",
+		Name:            "Annual leave",
+		Paid:            true,
+		AnnualAllowance: 12,
+		MaxCarryOver:    5,
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - leave type created",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByCode(gomock.Any(), "ANNUAL").Return(nil, nil)
+				m.leaveRepoTx.EXPECT().StoreNewLeaveType(gomock.Any(), mock.MatchedBy(func(args entity.LeaveType) bool {
+					return testutil.EqualVerbose(
+						entity.LeaveType{
+							Code:            "ANNUAL",
+							Name:            "Annual leave",
+							Paid:            true,
+							AnnualAllowance: 12,
+							MaxCarryOver:    5,
+							CreatedBy:       "admin-1",
+							UpdatedBy:       "admin-1",
+							IPAddress:       "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.LeaveType{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - code already exists",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveTypeAlreadyExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveTypeAlreadyExists),
+					Received:  "ANNUAL",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByCode(gomock.Any(), "ANNUAL").Return(&entity.LeaveType{ID: "type-annual", Code: "ANNUAL"}, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LeaveNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			leaveType, err := useCase.CreateLeaveType(context.Background(), tt.authCredential, payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "ANNUAL", leaveType.Code)
+			}
+		})
+	}
+}
+
+func TestAccrueLeaveBalances(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - balances prorated and carried over",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypes(gomock.Any()).Return([]entity.LeaveType{
+					{ID: "type-annual", Code: "ANNUAL", AnnualAllowance: 12, MaxCarryOver: 5},
+					{ID: "type-sick", Code: "SICK", AnnualAllowance: 10},
+				}, nil)
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any()).Return(userEntity.FindUserResult{
+					List: []userEntity.User{
+						{ID: "user-1", EmploymentStartDate: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC)},
+						{ID: "user-2", EmploymentStartDate: time.Date(2025, 7, 2, 0, 0, 0, 0, time.UTC)},
+						{
+							ID:                  "user-3",
+							EmploymentStartDate: time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC),
+							EmploymentEndDate:   optional.NewTime(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)),
+						},
+					},
+				}, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalancesByYear(gomock.Any(), int64(2024)).Return([]entity.LeaveBalance{
+					{UserID: "user-1", LeaveTypeID: "type-annual", Year: 2024, AccruedDays: 12, UsedDays: 4},
+					{UserID: "user-1", LeaveTypeID: "type-sick", Year: 2024, AccruedDays: 10, UsedDays: 2},
+				}, nil)
+				m.leaveRepoTx.EXPECT().UpsertLeaveBalance(gomock.Any(), balanceMatcher("user-1", "type-annual", 12, 5)).Return(nil)
+				m.leaveRepoTx.EXPECT().UpsertLeaveBalance(gomock.Any(), balanceMatcher("user-1", "type-sick", 10, 0)).Return(nil)
+				m.leaveRepoTx.EXPECT().UpsertLeaveBalance(gomock.Any(), balanceMatcher("user-2", "type-annual", 6, 0)).Return(nil)
+				m.leaveRepoTx.EXPECT().UpsertLeaveBalance(gomock.Any(), balanceMatcher("user-2", "type-sick", 5, 0)).Return(nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalancesByYear(gomock.Any(), int64(2025)).Return([]entity.LeaveBalance{
+					{UserID: "user-1", LeaveTypeID: "type-annual", Year: 2025, AccruedDays: 12, CarriedOverDays: 5},
+					{UserID: "user-1", LeaveTypeID: "type-sick", Year: 2025, AccruedDays: 10},
+					{UserID: "user-2", LeaveTypeID: "type-annual", Year: 2025, AccruedDays: 6},
+					{UserID: "user-2", LeaveTypeID: "type-sick", Year: 2025, AccruedDays: 5},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LeaveNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			accrual, err := useCase.AccrueLeaveBalances(context.Background(), tt.authCredential, 2025)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2025), accrual.Year)
+				assert.Len(t, accrual.Balances, 4)
+			}
+		})
+	}
+}
+
+func TestListPendingLeaveRequests(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - admin sees every request",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().FindPendingLeaveRequests(gomock.Any(), "").Return([]entity.LeaveRequest{{ID: "request-1"}}, nil)
+			},
+		},
+		{
+			name:           "success - manager sees direct reports",
+			authCredential: managerCredential,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().FindPendingLeaveRequests(gomock.Any(), "manager-1").Return([]entity.LeaveRequest{{ID: "request-1"}}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			requests, err := useCase.ListPendingLeaveRequests(context.Background(), tt.authCredential)
+			assert.NoError(t, err)
+			assert.Len(t, requests, 1)
+		})
+	}
+}
+
+func TestApproveLeaveRequest(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	timeNow := time.Date(2025, 10, 1, 9, 0, 0, 0, time.UTC)
+	pendingRequest := entity.LeaveRequest{
+		ID:          "request-1",
+		UserID:      "user-1",
+		LeaveTypeID: "type-annual",
+		StartDate:   time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2025, 10, 10, 0, 0, 0, 0, time.UTC),
+		Days:        5,
+		Status:      entity.LeaveRequestStatusPending,
+	}
+	lockOpts := entity.FindLeaveRequestOptions{PessimisticLock: true}
+	balanceOpts := entity.FindLeaveBalanceOptions{PessimisticLock: true}
+
+	tests := []testCase{
+		{
+			name:           "success - approved by the manager",
+			authCredential: managerCredential,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&pendingRequest, nil)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1", ManagerID: optional.NewString("manager-1")}, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), pendingRequest.StartDate, pendingRequest.EndDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalance(gomock.Any(), "user-1", "type-annual", int64(2025), balanceOpts).Return(&entity.LeaveBalance{
+					AccruedDays: 12, UsedDays: 2, PendingDays: 5,
+				}, nil)
+				m.leaveRepoTx.EXPECT().UpdateLeaveRequestStatus(gomock.Any(), mock.MatchedBy(func(args entity.LeaveRequest) bool {
+					expected := pendingRequest
+					expected.Status = entity.LeaveRequestStatusApproved
+					expected.DecidedBy = optional.NewString("manager-1")
+					expected.DecidedAt = optional.NewTime(timeNow)
+					expected.DecisionComment = optional.NewString("Enjoy")
+					expected.UpdatedAt = timeNow
+					expected.UpdatedBy = "manager-1"
+					expected.IPAddress = "127.0.0.1"
+					return testutil.EqualVerbose(expected, args)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - own request",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestSelfApproval,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestSelfApproval),
+					Received:  "request-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&pendingRequest, nil)
+			},
+		},
+		{
+			name:           "error - not the manager of the requester",
+			authCredential: managerCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LeaveNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&pendingRequest, nil)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1", ManagerID: optional.NewString("manager-2")}, nil)
+			},
+		},
+		{
+			name:           "error - request not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotFound),
+					Received:  "request-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - request already decided",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotPending,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotPending),
+					Received:  "rejected",
+				}),
+			setupMock: func(m mockParams) {
+				rejected := pendingRequest
+				rejected.Status = entity.LeaveRequestStatusRejected
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&rejected, nil)
+			},
+		},
+		{
+			name:           "error - period closed by payroll",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeavePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeavePeriodClosed),
+					Received:  "period-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&pendingRequest, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), pendingRequest.StartDate, pendingRequest.EndDate).Return(&attEntity.ClosedAttendancePeriod{
+					AttendancePeriod: attEntity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        "payroll-1",
+				}, nil)
+			},
+		},
+		{
+			name:           "error - insufficient balance",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveInsufficientBalance,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInsufficientBalance),
+					Received:  "5",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(&pendingRequest, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), pendingRequest.StartDate, pendingRequest.EndDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalance(gomock.Any(), "user-1", "type-annual", int64(2025), balanceOpts).Return(&entity.LeaveBalance{
+					AccruedDays: 12, UsedDays: 10, PendingDays: 5,
+				}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return timeNow })
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			request, err := useCase.ApproveLeaveRequest(context.Background(), tt.authCredential, "request-1", entity.DecideLeaveRequest{
+				Comment: optional.NewString("Enjoy"),
+			})
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.LeaveRequestStatusApproved, request.Status)
+			}
+		})
+	}
+}
+
+func TestRejectLeaveRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	patches := patchAuditContext()
+	defer patches.Reset()
+
+	m := newMockParams(ctrl)
+	m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+	m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+	m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", entity.FindLeaveRequestOptions{PessimisticLock: true}).Return(&entity.LeaveRequest{
+		ID:     "request-1",
+		UserID: "user-1",
+		Days:   5,
+		Status: entity.LeaveRequestStatusPending,
+	}, nil)
+	m.leaveRepoTx.EXPECT().UpdateLeaveRequestStatus(gomock.Any(), mock.MatchedBy(func(args entity.LeaveRequest) bool {
+		return args.Status == entity.LeaveRequestStatusRejected && args.DecidedBy.GetOrDefault() == "admin-1"
+	})).Return(nil)
+
+	useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+	request, err := useCase.RejectLeaveRequest(context.Background(), adminCredential, "request-1", entity.DecideLeaveRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, entity.LeaveRequestStatusRejected, request.Status)
+}
+
+func TestShowLeaveCalendar(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		startDate      time.Time
+		endDate        time.Time
+		expectedUsers  []string
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	startDate := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 10, 8, 0, 0, 0, 0, time.UTC)
+	approved := entity.FindLeaveRequestResult{
+		List: []entity.LeaveRequest{
+			{ID: "request-1", UserID: "user-1", StartDate: startDate, EndDate: startDate},
+			{ID: "request-2", UserID: "user-2", StartDate: startDate, EndDate: startDate},
+			{ID: "request-3", UserID: "manager-1", StartDate: startDate, EndDate: startDate},
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - admin sees everyone",
+			authCredential: adminCredential,
+			startDate:      startDate,
+			endDate:        endDate,
+			expectedUsers:  []string{"user-1", "user-2", "manager-1"},
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().FindApprovedLeaveByRange(gomock.Any(), startDate, endDate).Return(approved, nil)
+			},
+		},
+		{
+			name:           "success - manager sees own and direct reports",
+			authCredential: managerCredential,
+			startDate:      startDate,
+			endDate:        endDate,
+			expectedUsers:  []string{"user-1", "manager-1"},
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().FindApprovedLeaveByRange(gomock.Any(), startDate, endDate).Return(approved, nil)
+				m.userRepo.EXPECT().FindAllUsers(gomock.Any()).Return(userEntity.FindUserResult{
+					List: []userEntity.User{
+						{ID: "user-1", ManagerID: optional.NewString("manager-1")},
+						{ID: "user-2", ManagerID: optional.NewString("manager-2")},
+						{ID: "manager-1"},
+					},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - range across years",
+			authCredential: adminCredential,
+			startDate:      time.Date(2025, 12, 29, 0, 0, 0, 0, time.UTC),
+			endDate:        time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInvalidRange),
+					Received:  "2025-12-29 - 2026-01-02",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			days, err := useCase.ShowLeaveCalendar(context.Background(), tt.authCredential, tt.startDate, tt.endDate)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, days, 3)
+
+				var users []string
+				for _, request := range days[0].Leaves {
+					users = append(users, request.UserID)
+				}
+				assert.Equal(t, tt.expectedUsers, users)
+				assert.Empty(t, days[1].Leaves)
+			}
+		})
+	}
+}
+
+func TestSubmitMyLeaveRequest(t *testing.T) {
+	type testCase struct {
+		name        string
+		payload     entity.SubmitLeaveRequest
+		expectedErr error
+		setupMock   func(m mockParams)
+	}
+
+	startDate := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 10, 10, 0, 0, 0, 0, time.UTC)
+	payload := entity.SubmitLeaveRequest{
+		LeaveTypeID: "type-annual",
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Reason:      optional.NewString("Family trip"),
+	}
+	annual := &entity.LeaveType{ID: "type-annual", Code: "ANNUAL", Name: "Annual leave", Paid: true}
+	holidays := []holidayEntity.Holiday{
+		{ID: "holiday-1", HolidayDate: time.Date(2025, 10, 8, 0, 0, 0, 0, time.UTC)},
+	}
+	balanceOpts := entity.FindLeaveBalanceOptions{PessimisticLock: true}
+
+	tests := []testCase{
+		{
+			name:    "success - working days requested",
+			payload: payload,
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByID(gomock.Any(), "type-annual").Return(annual, nil)
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(holidays, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalance(gomock.Any(), "user-1", "type-annual", int64(2025), balanceOpts).Return(&entity.LeaveBalance{
+					AccruedDays: 12, CarriedOverDays: 2, UsedDays: 5, PendingDays: 5,
+				}, nil)
+				m.leaveRepoTx.EXPECT().FindOverlappingLeaveRequest(gomock.Any(), "user-1", startDate, endDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().StoreNewLeaveRequest(gomock.Any(), mock.MatchedBy(func(args entity.LeaveRequest) bool {
+					return testutil.EqualVerbose(
+						entity.LeaveRequest{
+							UserID:        "user-1",
+							Username:      "testuser",
+							LeaveTypeID:   "type-annual",
+							LeaveTypeCode: "ANNUAL",
+							LeaveTypeName: "Annual leave",
+							Paid:          true,
+							StartDate:     startDate,
+							EndDate:       endDate,
+							Days:          4,
+							Reason:        optional.NewString("Family trip"),
+							Status:        entity.LeaveRequestStatusPending,
+							CreatedBy:     "user-1",
+							UpdatedBy:     "user-1",
+							IPAddress:     "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.LeaveRequest{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name: "error - end before start",
+			payload: entity.SubmitLeaveRequest{
+				LeaveTypeID: "type-annual",
+				StartDate:   endDate,
+				EndDate:     startDate,
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInvalidRange),
+					Received:  "2025-10-10 - 2025-10-06",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:    "error - leave type not found",
+			payload: payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveTypeNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveTypeNotFound),
+					Received:  "type-annual",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByID(gomock.Any(), "type-annual").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - only weekend days",
+			payload: entity.SubmitLeaveRequest{
+				LeaveTypeID: "type-annual",
+				StartDate:   time.Date(2025, 10, 11, 0, 0, 0, 0, time.UTC),
+				EndDate:     time.Date(2025, 10, 12, 0, 0, 0, 0, time.UTC),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveNoWorkingDays,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveNoWorkingDays),
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByID(gomock.Any(), "type-annual").Return(annual, nil)
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:    "error - overlaps another request",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveOverlaps,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveOverlaps),
+					Received:  "request-2",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByID(gomock.Any(), "type-annual").Return(annual, nil)
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(holidays, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalance(gomock.Any(), "user-1", "type-annual", int64(2025), balanceOpts).Return(&entity.LeaveBalance{AccruedDays: 12}, nil)
+				m.leaveRepoTx.EXPECT().FindOverlappingLeaveRequest(gomock.Any(), "user-1", startDate, endDate).Return(&entity.LeaveRequest{ID: "request-2"}, nil)
+			},
+		},
+		{
+			name:    "error - pending requests hold the balance",
+			payload: payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveInsufficientBalance,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveInsufficientBalance),
+					Received:  "4",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveTypeByID(gomock.Any(), "type-annual").Return(annual, nil)
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(holidays, nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().FindLeaveBalance(gomock.Any(), "user-1", "type-annual", int64(2025), balanceOpts).Return(&entity.LeaveBalance{
+					AccruedDays: 12, UsedDays: 5, PendingDays: 4,
+				}, nil)
+				m.leaveRepoTx.EXPECT().FindOverlappingLeaveRequest(gomock.Any(), "user-1", startDate, endDate).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			request, err := useCase.SubmitMyLeaveRequest(context.Background(), userCredential, tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(4), request.Days)
+			}
+		})
+	}
+}
+
+func TestCancelMyLeaveRequest(t *testing.T) {
+	type testCase struct {
+		name        string
+		expectedErr error
+		setupMock   func(m mockParams)
+	}
+
+	startDate := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 10, 10, 0, 0, 0, 0, time.UTC)
+	lockOpts := entity.FindLeaveRequestOptions{PessimisticLock: true}
+	requestWithStatus := func(userID string, status entity.LeaveRequestStatus) *entity.LeaveRequest {
+		return &entity.LeaveRequest{
+			ID:        "request-1",
+			UserID:    userID,
+			StartDate: startDate,
+			EndDate:   endDate,
+			Days:      5,
+			Status:    status,
+		}
+	}
+
+	tests := []testCase{
+		{
+			name: "success - pending request cancelled",
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(requestWithStatus("user-1", entity.LeaveRequestStatusPending), nil)
+				m.leaveRepoTx.EXPECT().UpdateLeaveRequestStatus(gomock.Any(), mock.MatchedBy(func(args entity.LeaveRequest) bool {
+					return args.Status == entity.LeaveRequestStatusCancelled && args.UpdatedBy == "user-1"
+				})).Return(nil)
+			},
+		},
+		{
+			name: "success - approved request in an open period cancelled",
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(requestWithStatus("user-1", entity.LeaveRequestStatusApproved), nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.leaveRepoTx.EXPECT().UpdateLeaveRequestStatus(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "error - approved request in a closed period",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeavePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeavePeriodClosed),
+					Received:  "period-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(requestWithStatus("user-1", entity.LeaveRequestStatusApproved), nil)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.attRepoTx.EXPECT().FindClosedPeriodByRange(gomock.Any(), startDate, endDate).Return(&attEntity.ClosedAttendancePeriod{
+					AttendancePeriod: attEntity.AttendancePeriod{ID: "period-1"},
+					PayrollID:        "payroll-1",
+				}, nil)
+			},
+		},
+		{
+			name: "error - request of another user",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotFound),
+					Received:  "request-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(requestWithStatus("user-2", entity.LeaveRequestStatusPending), nil)
+			},
+		},
+		{
+			name: "error - rejected request",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LeaveRequestNotCancellable,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LeaveRequestNotCancellable),
+					Received:  "rejected",
+				}),
+			setupMock: func(m mockParams) {
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+				m.leaveRepoTx.EXPECT().FindLeaveRequestByID(gomock.Any(), "request-1", lockOpts).Return(requestWithStatus("user-1", entity.LeaveRequestStatusRejected), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLeaveUseCase(m.leaveRepo, m.userRepo, m.holidayRepo, m.attRepo)
+
+			request, err := useCase.CancelMyLeaveRequest(context.Background(), userCredential, "request-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.LeaveRequestStatusCancelled, request.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/loan"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type LoanHandler struct {
+	uc loan.UseCase
+}
+
+func NewLoanHandler(uc loan.UseCase) *LoanHandler {
+	return &LoanHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      List Loans
+// @Description  List the loans of a user with their outstanding balance, including repaid ones
+// @Tags         Loan
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} dtos.Response{data=[]dtos.LoanResponse} "Loans Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/loans [GET]
+// @Security     BearerAuth
+func (h *LoanHandler) ListLoans(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LoanHandler.ListLoans()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ListLoans().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ListLoans(ctx, authCredential, param.UserID.String())
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ListLoans().uc.ListLoans()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLoanResponse(data),
+		},
+	)
+}
+
+// @Summary      Create Loan
+// @Description  Grant a salary advance repaid through payroll, every payroll from the period containing the start date deducts the installment as long as the net pay allows it
+// @Tags         Loan
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.LoanRequest true "Create Loan Request"
+// @Success      201 {object} dtos.Response{data=dtos.LoanResponse} "Loan Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/loans [POST]
+// @Security     BearerAuth
+func (h *LoanHandler) CreateLoan(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LoanHandler.CreateLoan()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().CreateLoan().c.ParamsParser()")
+	}
+
+	var req dtos.LoanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "LoanHandler().CreateLoan().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "LoanHandler().CreateLoan().req.Validate()")
+	}
+
+	data, err := h.uc.CreateLoan(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().CreateLoan().uc.CreateLoan()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLoanResponse(data),
+		},
+	)
+}
+
+// @Summary      Show Loan Statement
+// @Description  Show a loan of a user with its installment schedule and the repayments deducted by payrolls that were not voided
+// @Tags         Loan
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        loanId path string true "Loan ID"
+// @Success      200 {object} dtos.Response{data=dtos.LoanStatementResponse} "Loan Statement Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/loans/{loanId} [GET]
+// @Security     BearerAuth
+func (h *LoanHandler) ShowLoanStatement(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LoanHandler.ShowLoanStatement()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+		LoanID uuid.UUID `params:"loanId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ShowLoanStatement().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowLoanStatement(ctx, authCredential, param.UserID.String(), param.LoanID.String())
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ShowLoanStatement().uc.ShowLoanStatement()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLoanStatementResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Loans
+// @Description  List the loans of the logged in employee with their outstanding balance
+// @Tags         Loan
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.LoanResponse} "Loans Response"
+// @Router       /v1/me/loans [GET]
+// @Security     BearerAuth
+func (h *LoanHandler) ListMyLoans(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LoanHandler.ListMyLoans()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListMyLoans(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ListMyLoans().uc.ListMyLoans()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListLoanResponse(data),
+		},
+	)
+}
+
+// @Summary      Show My Loan Statement
+// @Description  Show a loan of the logged in employee with its installment schedule and repayments
+// @Tags         Loan
+// @Produce      json
+// @Param        loanId path string true "Loan ID"
+// @Success      200 {object} dtos.Response{data=dtos.LoanStatementResponse} "Loan Statement Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/loans/{loanId} [GET]
+// @Security     BearerAuth
+func (h *LoanHandler) ShowMyLoanStatement(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"LoanHandler.ShowMyLoanStatement()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		LoanID uuid.UUID `params:"loanId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ShowMyLoanStatement().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowMyLoanStatement(ctx, authCredential, param.LoanID.String())
+	if err != nil {
+		return errors.Wrap(err, "LoanHandler().ShowMyLoanStatement().uc.ShowMyLoanStatement()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewLoanStatementResponse(data),
+		},
+	)
+}
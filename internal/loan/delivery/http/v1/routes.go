@@ -0,0 +1,16 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapLoan(routes fiber.Router, h *LoanHandler) {
+	loans := routes.Group("/users/:userId/loans")
+
+	loans.Get("/", h.ListLoans)
+	loans.Post("/", h.CreateLoan)
+	loans.Get("/:loanId", h.ShowLoanStatement)
+
+	me := routes.Group("/me")
+
+	me.Get("/loans", h.ListMyLoans)
+	me.Get("/loans/:loanId", h.ShowMyLoanStatement)
+}
@@ -0,0 +1,23 @@
+package entity
+
+const (
+	LoanNotAuthorized      = "LOAN_NOT_AUTHORIZED"
+	LoanUserNotFound       = "LOAN_USER_NOT_FOUND"
+	LoanNotFound           = "LOAN_NOT_FOUND"
+	LoanInvalidInstallment = "LOAN_INVALID_INSTALLMENT"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case LoanNotAuthorized:
+		return "You are not authorized to manage loans"
+	case LoanUserNotFound:
+		return "User not found"
+	case LoanNotFound:
+		return "Loan not found"
+	case LoanInvalidInstallment:
+		return "The installment amount cannot be more than the principal"
+	default:
+		return "An unknown error occurred"
+	}
+}
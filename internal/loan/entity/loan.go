@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// Loan is a salary advance repaid through payroll. Every payroll from the
+// period containing StartDate deducts InstallmentAmount until the principal
+// is repaid. RepaidAmount is not stored, it totals the repayments on payslips
+// that were not voided.
+type Loan struct {
+	ID                string          `db:"id"`
+	UserID            string          `db:"user_id"`
+	Principal         money.Money     `db:"principal"`
+	InstallmentAmount money.Money     `db:"installment_amount"`
+	StartDate         time.Time       `db:"start_date"`
+	Reason            optional.String `db:"reason"`
+	RepaidAmount      money.Money     `db:"repaid_amount"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+	CreatedBy         string          `db:"created_by"`
+	UpdatedBy         string          `db:"updated_by"`
+	IPAddress         string          `db:"ip_address"`
+}
+
+// OutstandingBalance is the part of the principal still to be repaid.
+func (l Loan) OutstandingBalance() money.Money {
+	return money.Max(l.Principal.Sub(l.RepaidAmount), money.Money{})
+}
+
+// DueInstallment is the amount a payroll deducts, the last installment only
+// covers what is left of the principal.
+func (l Loan) DueInstallment() money.Money {
+	return money.Min(l.InstallmentAmount, l.OutstandingBalance())
+}
+
+// Schedule splits the principal into the installments the loan is repaid
+// with when every payroll deducts the full installment.
+func (l Loan) Schedule() []LoanInstallment {
+	if !l.InstallmentAmount.IsPositive() {
+		return nil
+	}
+
+	var installments []LoanInstallment
+	balance := l.Principal
+	for number := int64(1); balance.IsPositive(); number++ {
+		amount := money.Min(l.InstallmentAmount, balance)
+		balance = balance.Sub(amount)
+		installments = append(installments, LoanInstallment{
+			Number:       number,
+			Amount:       amount,
+			BalanceAfter: balance,
+		})
+	}
+	return installments
+}
+
+type LoanInstallment struct {
+	Number       int64
+	Amount       money.Money
+	BalanceAfter money.Money
+}
+
+type CreateLoan struct {
+	Principal         money.Money
+	InstallmentAmount money.Money
+	StartDate         time.Time
+	Reason            optional.String
+}
+
+// LoanRepayment is an installment deducted by the payroll of a period.
+type LoanRepayment struct {
+	ID              string      `db:"id"`
+	LoanID          string      `db:"loan_id"`
+	PayslipID       string      `db:"payslip_id"`
+	PayrollID       string      `db:"payroll_id"`
+	PayrollStatus   string      `db:"payroll_status"`
+	PeriodID        string      `db:"period_id"`
+	PeriodStartDate time.Time   `db:"period_start_date"`
+	PeriodEndDate   time.Time   `db:"period_end_date"`
+	Amount          money.Money `db:"amount"`
+	CreatedAt       time.Time   `db:"created_at"`
+}
+
+// LoanStatement is a loan with its planned installments and the repayments
+// deducted so far.
+type LoanStatement struct {
+	Loan       Loan
+	Schedule   []LoanInstallment
+	Repayments []LoanRepayment
+}
+
+type MappedBy string
+
+const (
+	MappedByUserID MappedBy = "user_id"
+)
+
+type MappedOptions struct {
+	MappedBy MappedBy
+}
+
+type FindLoanOptions struct {
+	PessimisticLock bool
+	*MappedOptions
+}
+
+type FindLoanResult struct {
+	List     []Loan
+	Mapped   map[any][]Loan
+	IsMapped bool
+	MappedBy MappedBy
+}
@@ -0,0 +1,282 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/loan/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/loan/repository.go -destination internal/loan/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	loan "github.com/vnnyx/employee-management/internal/loan"
+	entity "github.com/vnnyx/employee-management/internal/loan/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindLoanByID mocks base method.
+func (m *MockRepository) FindLoanByID(ctx context.Context, userID, loanID string) (*entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLoanByID", ctx, userID, loanID)
+	ret0, _ := ret[0].(*entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLoanByID indicates an expected call of FindLoanByID.
+func (mr *MockRepositoryMockRecorder) FindLoanByID(ctx, userID, loanID any) *MockRepositoryFindLoanByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLoanByID", reflect.TypeOf((*MockRepository)(nil).FindLoanByID), ctx, userID, loanID)
+	return &MockRepositoryFindLoanByIDCall{Call: call}
+}
+
+// MockRepositoryFindLoanByIDCall wrap *gomock.Call
+type MockRepositoryFindLoanByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLoanByIDCall) Return(arg0 *entity.Loan, arg1 error) *MockRepositoryFindLoanByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLoanByIDCall) Do(f func(context.Context, string, string) (*entity.Loan, error)) *MockRepositoryFindLoanByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLoanByIDCall) DoAndReturn(f func(context.Context, string, string) (*entity.Loan, error)) *MockRepositoryFindLoanByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLoanRepaymentsByLoanID mocks base method.
+func (m *MockRepository) FindLoanRepaymentsByLoanID(ctx context.Context, loanID string) ([]entity.LoanRepayment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLoanRepaymentsByLoanID", ctx, loanID)
+	ret0, _ := ret[0].([]entity.LoanRepayment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLoanRepaymentsByLoanID indicates an expected call of FindLoanRepaymentsByLoanID.
+func (mr *MockRepositoryMockRecorder) FindLoanRepaymentsByLoanID(ctx, loanID any) *MockRepositoryFindLoanRepaymentsByLoanIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLoanRepaymentsByLoanID", reflect.TypeOf((*MockRepository)(nil).FindLoanRepaymentsByLoanID), ctx, loanID)
+	return &MockRepositoryFindLoanRepaymentsByLoanIDCall{Call: call}
+}
+
+// MockRepositoryFindLoanRepaymentsByLoanIDCall wrap *gomock.Call
+type MockRepositoryFindLoanRepaymentsByLoanIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLoanRepaymentsByLoanIDCall) Return(arg0 []entity.LoanRepayment, arg1 error) *MockRepositoryFindLoanRepaymentsByLoanIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLoanRepaymentsByLoanIDCall) Do(f func(context.Context, string) ([]entity.LoanRepayment, error)) *MockRepositoryFindLoanRepaymentsByLoanIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLoanRepaymentsByLoanIDCall) DoAndReturn(f func(context.Context, string) ([]entity.LoanRepayment, error)) *MockRepositoryFindLoanRepaymentsByLoanIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindLoansByUserID mocks base method.
+func (m *MockRepository) FindLoansByUserID(ctx context.Context, userID string) ([]entity.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLoansByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLoansByUserID indicates an expected call of FindLoansByUserID.
+func (mr *MockRepositoryMockRecorder) FindLoansByUserID(ctx, userID any) *MockRepositoryFindLoansByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLoansByUserID", reflect.TypeOf((*MockRepository)(nil).FindLoansByUserID), ctx, userID)
+	return &MockRepositoryFindLoansByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindLoansByUserIDCall wrap *gomock.Call
+type MockRepositoryFindLoansByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindLoansByUserIDCall) Return(arg0 []entity.Loan, arg1 error) *MockRepositoryFindLoansByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindLoansByUserIDCall) Do(f func(context.Context, string) ([]entity.Loan, error)) *MockRepositoryFindLoansByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindLoansByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.Loan, error)) *MockRepositoryFindLoansByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindOutstandingLoans mocks base method.
+func (m *MockRepository) FindOutstandingLoans(ctx context.Context, periodID string, endDate time.Time, opts ...entity.FindLoanOptions) (entity.FindLoanResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, periodID, endDate}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindOutstandingLoans", varargs...)
+	ret0, _ := ret[0].(entity.FindLoanResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOutstandingLoans indicates an expected call of FindOutstandingLoans.
+func (mr *MockRepositoryMockRecorder) FindOutstandingLoans(ctx, periodID, endDate any, opts ...any) *MockRepositoryFindOutstandingLoansCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, periodID, endDate}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOutstandingLoans", reflect.TypeOf((*MockRepository)(nil).FindOutstandingLoans), varargs...)
+	return &MockRepositoryFindOutstandingLoansCall{Call: call}
+}
+
+// MockRepositoryFindOutstandingLoansCall wrap *gomock.Call
+type MockRepositoryFindOutstandingLoansCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindOutstandingLoansCall) Return(arg0 entity.FindLoanResult, arg1 error) *MockRepositoryFindOutstandingLoansCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindOutstandingLoansCall) Do(f func(context.Context, string, time.Time, ...entity.FindLoanOptions) (entity.FindLoanResult, error)) *MockRepositoryFindOutstandingLoansCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindOutstandingLoansCall) DoAndReturn(f func(context.Context, string, time.Time, ...entity.FindLoanOptions) (entity.FindLoanResult, error)) *MockRepositoryFindOutstandingLoansCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewLoan mocks base method.
+func (m *MockRepository) StoreNewLoan(ctx context.Context, arg1 entity.Loan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewLoan", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewLoan indicates an expected call of StoreNewLoan.
+func (mr *MockRepositoryMockRecorder) StoreNewLoan(ctx, arg1 any) *MockRepositoryStoreNewLoanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewLoan", reflect.TypeOf((*MockRepository)(nil).StoreNewLoan), ctx, arg1)
+	return &MockRepositoryStoreNewLoanCall{Call: call}
+}
+
+// MockRepositoryStoreNewLoanCall wrap *gomock.Call
+type MockRepositoryStoreNewLoanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewLoanCall) Return(arg0 error) *MockRepositoryStoreNewLoanCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewLoanCall) Do(f func(context.Context, entity.Loan) error) *MockRepositoryStoreNewLoanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewLoanCall) DoAndReturn(f func(context.Context, entity.Loan) error) *MockRepositoryStoreNewLoanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) loan.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(loan.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 loan.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) loan.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) loan.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/loan/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/loan/usecase.go -destination internal/loan/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/loan/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateLoan mocks base method.
+func (m *MockUseCase) CreateLoan(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.CreateLoan) (entity0.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoan", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoan indicates an expected call of CreateLoan.
+func (mr *MockUseCaseMockRecorder) CreateLoan(ctx, authCredential, userID, payload any) *MockUseCaseCreateLoanCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoan", reflect.TypeOf((*MockUseCase)(nil).CreateLoan), ctx, authCredential, userID, payload)
+	return &MockUseCaseCreateLoanCall{Call: call}
+}
+
+// MockUseCaseCreateLoanCall wrap *gomock.Call
+type MockUseCaseCreateLoanCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCreateLoanCall) Return(arg0 entity0.Loan, arg1 error) *MockUseCaseCreateLoanCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCreateLoanCall) Do(f func(context.Context, entity.Credential, string, entity0.CreateLoan) (entity0.Loan, error)) *MockUseCaseCreateLoanCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCreateLoanCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.CreateLoan) (entity0.Loan, error)) *MockUseCaseCreateLoanCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLoans mocks base method.
+func (m *MockUseCase) ListLoans(ctx context.Context, authCredential entity.Credential, userID string) ([]entity0.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoans", ctx, authCredential, userID)
+	ret0, _ := ret[0].([]entity0.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoans indicates an expected call of ListLoans.
+func (mr *MockUseCaseMockRecorder) ListLoans(ctx, authCredential, userID any) *MockUseCaseListLoansCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoans", reflect.TypeOf((*MockUseCase)(nil).ListLoans), ctx, authCredential, userID)
+	return &MockUseCaseListLoansCall{Call: call}
+}
+
+// MockUseCaseListLoansCall wrap *gomock.Call
+type MockUseCaseListLoansCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListLoansCall) Return(arg0 []entity0.Loan, arg1 error) *MockUseCaseListLoansCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListLoansCall) Do(f func(context.Context, entity.Credential, string) ([]entity0.Loan, error)) *MockUseCaseListLoansCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListLoansCall) DoAndReturn(f func(context.Context, entity.Credential, string) ([]entity0.Loan, error)) *MockUseCaseListLoansCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyLoans mocks base method.
+func (m *MockUseCase) ListMyLoans(ctx context.Context, authCredential entity.Credential) ([]entity0.Loan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyLoans", ctx, authCredential)
+	ret0, _ := ret[0].([]entity0.Loan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyLoans indicates an expected call of ListMyLoans.
+func (mr *MockUseCaseMockRecorder) ListMyLoans(ctx, authCredential any) *MockUseCaseListMyLoansCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyLoans", reflect.TypeOf((*MockUseCase)(nil).ListMyLoans), ctx, authCredential)
+	return &MockUseCaseListMyLoansCall{Call: call}
+}
+
+// MockUseCaseListMyLoansCall wrap *gomock.Call
+type MockUseCaseListMyLoansCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyLoansCall) Return(arg0 []entity0.Loan, arg1 error) *MockUseCaseListMyLoansCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyLoansCall) Do(f func(context.Context, entity.Credential) ([]entity0.Loan, error)) *MockUseCaseListMyLoansCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyLoansCall) DoAndReturn(f func(context.Context, entity.Credential) ([]entity0.Loan, error)) *MockUseCaseListMyLoansCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowLoanStatement mocks base method.
+func (m *MockUseCase) ShowLoanStatement(ctx context.Context, authCredential entity.Credential, userID, loanID string) (entity0.LoanStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowLoanStatement", ctx, authCredential, userID, loanID)
+	ret0, _ := ret[0].(entity0.LoanStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowLoanStatement indicates an expected call of ShowLoanStatement.
+func (mr *MockUseCaseMockRecorder) ShowLoanStatement(ctx, authCredential, userID, loanID any) *MockUseCaseShowLoanStatementCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowLoanStatement", reflect.TypeOf((*MockUseCase)(nil).ShowLoanStatement), ctx, authCredential, userID, loanID)
+	return &MockUseCaseShowLoanStatementCall{Call: call}
+}
+
+// MockUseCaseShowLoanStatementCall wrap *gomock.Call
+type MockUseCaseShowLoanStatementCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowLoanStatementCall) Return(arg0 entity0.LoanStatement, arg1 error) *MockUseCaseShowLoanStatementCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowLoanStatementCall) Do(f func(context.Context, entity.Credential, string, string) (entity0.LoanStatement, error)) *MockUseCaseShowLoanStatementCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowLoanStatementCall) DoAndReturn(f func(context.Context, entity.Credential, string, string) (entity0.LoanStatement, error)) *MockUseCaseShowLoanStatementCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowMyLoanStatement mocks base method.
+func (m *MockUseCase) ShowMyLoanStatement(ctx context.Context, authCredential entity.Credential, loanID string) (entity0.LoanStatement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowMyLoanStatement", ctx, authCredential, loanID)
+	ret0, _ := ret[0].(entity0.LoanStatement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowMyLoanStatement indicates an expected call of ShowMyLoanStatement.
+func (mr *MockUseCaseMockRecorder) ShowMyLoanStatement(ctx, authCredential, loanID any) *MockUseCaseShowMyLoanStatementCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowMyLoanStatement", reflect.TypeOf((*MockUseCase)(nil).ShowMyLoanStatement), ctx, authCredential, loanID)
+	return &MockUseCaseShowMyLoanStatementCall{Call: call}
+}
+
+// MockUseCaseShowMyLoanStatementCall wrap *gomock.Call
+type MockUseCaseShowMyLoanStatementCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowMyLoanStatementCall) Return(arg0 entity0.LoanStatement, arg1 error) *MockUseCaseShowMyLoanStatementCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowMyLoanStatementCall) Do(f func(context.Context, entity.Credential, string) (entity0.LoanStatement, error)) *MockUseCaseShowMyLoanStatementCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowMyLoanStatementCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.LoanStatement, error)) *MockUseCaseShowMyLoanStatementCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
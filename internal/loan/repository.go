@@ -0,0 +1,19 @@
+package loan
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	StoreNewLoan(ctx context.Context, loan entity.Loan) error
+	FindLoanByID(ctx context.Context, userID, loanID string) (*entity.Loan, error)
+	FindLoansByUserID(ctx context.Context, userID string) ([]entity.Loan, error)
+	FindOutstandingLoans(ctx context.Context, periodID string, endDate time.Time, opts ...entity.FindLoanOptions) (entity.FindLoanResult, error)
+	FindLoanRepaymentsByLoanID(ctx context.Context, loanID string) ([]entity.LoanRepayment, error)
+}
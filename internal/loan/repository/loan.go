@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/loan"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type loanRepo struct {
+	db database.Queryer
+}
+
+func NewLoanRepository(db database.Queryer) loan.Repository {
+	return &loanRepo{
+		db: db,
+	}
+}
+
+func (r *loanRepo) WithTx(tx database.DBTx) loan.Repository {
+	return &loanRepo{
+		db: tx,
+	}
+}
+
+func (r *loanRepo) StoreNewLoan(ctx context.Context, loan entity.Loan) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanRepository.StoreNewLoan()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertLoanQuery, loan)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert loan"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *loanRepo) FindLoanByID(ctx context.Context, userID, loanID string) (*entity.Loan, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanRepository.FindLoanByID()",
+	)
+	defer span.End()
+
+	var loan entity.Loan
+	err := pgxscan.Get(ctx, r.db, &loan, findLoanByIDQuery, userID, loanID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &loan, nil
+}
+
+func (r *loanRepo) FindLoansByUserID(ctx context.Context, userID string) ([]entity.Loan, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanRepository.FindLoansByUserID()",
+	)
+	defer span.End()
+
+	var loans []entity.Loan
+	err := pgxscan.Select(ctx, r.db, &loans, findLoansByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return loans, nil
+}
+
+func (r *loanRepo) FindOutstandingLoans(ctx context.Context, periodID string, endDate time.Time, opts ...entity.FindLoanOptions) (entity.FindLoanResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanRepository.FindOutstandingLoans()",
+	)
+	defer span.End()
+
+	var result entity.FindLoanResult
+
+	query := findOutstandingLoansQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE OF l"
+	}
+
+	var loans []entity.Loan
+	err := pgxscan.Select(ctx, r.db, &loans, query, periodID, endDate)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = loans
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedLoans := make(map[any][]entity.Loan)
+		var keyFunc func(loan entity.Loan) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(loan entity.Loan) any {
+				return loan.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range loans {
+			key := keyFunc(item)
+			mappedLoans[key] = append(mappedLoans[key], item)
+		}
+
+		result.Mapped = mappedLoans
+	}
+
+	return result, nil
+}
+
+func (r *loanRepo) FindLoanRepaymentsByLoanID(ctx context.Context, loanID string) ([]entity.LoanRepayment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanRepository.FindLoanRepaymentsByLoanID()",
+	)
+	defer span.End()
+
+	var repayments []entity.LoanRepayment
+	err := pgxscan.Select(ctx, r.db, &repayments, findLoanRepaymentsByLoanIDQuery, loanID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return repayments, nil
+}
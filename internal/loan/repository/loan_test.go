@@ -0,0 +1,391 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	"github.com/vnnyx/employee-management/internal/loan/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var loanColumns = []string{
+	"id", "user_id", "principal", "installment_amount", "start_date", "reason", "repaid_amount",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func anyArgs(n int) []any {
+	args := make([]any, n)
+	for i := range args {
+		args[i] = pgxmock.AnyArg()
+	}
+	return args
+}
+
+func TestStoreNewLoan(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLoanRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.Loan
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO loans").
+					WithArgs(anyArgs(11)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("loan-1"))
+			},
+			input: entity.Loan{
+				ID:                "loan-1",
+				UserID:            "user-1",
+				Principal:         money.New(1000),
+				InstallmentAmount: money.New(250),
+				StartDate:         time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Reason:            optional.NewString("Medical expenses"),
+				CreatedAt:         now,
+				UpdatedAt:         now,
+				CreatedBy:         "admin",
+				UpdatedBy:         "admin",
+				IPAddress:         "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO loans").
+					WithArgs(anyArgs(11)...).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.Loan{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO loans").
+					WithArgs(anyArgs(11)...).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.Loan{ID: "loan-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewLoan(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindLoanByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLoanRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		setupMock  func()
+		expected   *entity.Loan
+		expectErr  bool
+		expectsNil bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("user-1", "loan-1").
+					WillReturnRows(pgxmock.NewRows(loanColumns).
+						AddRow("loan-1", "user-1", money.New(1000), money.New(250), startDate, nil, money.New(500),
+							now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expected: &entity.Loan{
+				ID:                "loan-1",
+				UserID:            "user-1",
+				Principal:         money.New(1000),
+				InstallmentAmount: money.New(250),
+				StartDate:         startDate,
+				RepaidAmount:      money.New(500),
+				CreatedAt:         now,
+				UpdatedAt:         now,
+				CreatedBy:         "admin",
+				UpdatedBy:         "admin",
+				IPAddress:         "127.0.0.1",
+			},
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("user-1", "loan-1").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expectsNil: true,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("user-1", "loan-1").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindLoanByID(context.Background(), "user-1", "loan-1")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.expectsNil {
+				assert.Nil(t, result)
+				return
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFindLoansByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLoanRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectedLen int
+		expectErr   bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("user-1").
+					WillReturnRows(pgxmock.NewRows(loanColumns).
+						AddRow("loan-1", "user-1", money.New(1000), money.New(250), startDate, nil, money.New(1000),
+							now, now, "admin", "admin", "127.0.0.1").
+						AddRow("loan-2", "user-1", money.New(600), money.New(200), startDate, nil, money.New(0),
+							now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("user-1").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			loans, err := repo.FindLoansByUserID(context.Background(), "user-1")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, loans, tt.expectedLen)
+		})
+	}
+}
+
+func TestFindOutstandingLoans(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLoanRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindLoanOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("period-1", endDate).
+					WillReturnRows(pgxmock.NewRows(loanColumns).
+						AddRow("loan-1", "user-1", money.New(1000), money.New(250), startDate, nil, money.New(250),
+							now, now, "admin", "admin", "127.0.0.1").
+						AddRow("loan-2", "user-1", money.New(600), money.New(200), startDate, nil, money.New(0),
+							now, now, "admin", "admin", "127.0.0.1").
+						AddRow("loan-3", "user-2", money.New(300), money.New(100), startDate, nil, money.New(0),
+							now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindLoanOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID}},
+			},
+			expectedLen:    3,
+			expectedMapped: map[any]int{"user-1": 2, "user-2": 1},
+		},
+		{
+			name: "success - not mapped",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("period-1", endDate).
+					WillReturnRows(pgxmock.NewRows(loanColumns))
+			},
+			expectedLen: 0,
+		},
+		{
+			name: "success - pessimistic lock",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans (.+) FOR UPDATE OF l").
+					WithArgs("period-1", endDate).
+					WillReturnRows(pgxmock.NewRows(loanColumns).
+						AddRow("loan-1", "user-1", money.New(1000), money.New(250), startDate, nil, money.New(250),
+							now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindLoanOptions{
+				{PessimisticLock: true},
+			},
+			expectedLen: 1,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM loans").
+					WithArgs("period-1", endDate).
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindOutstandingLoans(context.Background(), "period-1", endDate, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result.List, tt.expectedLen)
+			assert.Equal(t, tt.expectedMapped != nil, result.IsMapped)
+			for key, count := range tt.expectedMapped {
+				assert.Len(t, result.Mapped[key], count)
+			}
+		})
+	}
+}
+
+func TestFindLoanRepaymentsByLoanID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewLoanRepository(mock)
+	now := time.Now()
+	periodStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "loan_id", "payslip_id", "payroll_id", "payroll_status", "period_id",
+		"period_start_date", "period_end_date", "amount", "created_at",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  []entity.LoanRepayment
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_items").
+					WithArgs("loan-1").
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("item-1", "loan-1", "ps-1", "payroll-1", "paid", "period-1", periodStart, periodEnd, money.New(250), now))
+			},
+			expected: []entity.LoanRepayment{
+				{
+					ID:              "item-1",
+					LoanID:          "loan-1",
+					PayslipID:       "ps-1",
+					PayrollID:       "payroll-1",
+					PayrollStatus:   "paid",
+					PeriodID:        "period-1",
+					PeriodStartDate: periodStart,
+					PeriodEndDate:   periodEnd,
+					Amount:          money.New(250),
+					CreatedAt:       now,
+				},
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_items").
+					WithArgs("loan-1").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			repayments, err := repo.FindLoanRepaymentsByLoanID(context.Background(), "loan-1")
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, repayments)
+		})
+	}
+}
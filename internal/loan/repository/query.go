@@ -0,0 +1,133 @@
+package repository
+
+const insertLoanQuery = `
+INSERT INTO loans (
+	id,
+	user_id,
+	principal,
+	installment_amount,
+	start_date,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:principal,
+	:installment_amount,
+	:start_date,
+	:reason,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+// Repayments are the loan items of payslips that were not voided.
+const findLoanByIDQuery = `
+SELECT
+	l.id,
+	l.user_id,
+	l.principal,
+	l.installment_amount,
+	l.start_date,
+	l.reason,
+	COALESCE(r.repaid_amount, 0) AS repaid_amount,
+	l.created_at,
+	l.updated_at,
+	l.created_by,
+	l.updated_by,
+	l.ip_address
+FROM loans l
+LEFT JOIN (
+	SELECT pi.loan_id, SUM(pi.amount) AS repaid_amount
+	FROM payslip_items pi
+	JOIN payslips ps ON ps.id = pi.payslip_id
+	WHERE pi.loan_id = $2 AND ps.voided_at IS NULL
+	GROUP BY pi.loan_id
+) r ON r.loan_id = l.id
+WHERE l.user_id = $1 AND l.id = $2
+`
+
+const findLoansByUserIDQuery = `
+SELECT
+	l.id,
+	l.user_id,
+	l.principal,
+	l.installment_amount,
+	l.start_date,
+	l.reason,
+	COALESCE(r.repaid_amount, 0) AS repaid_amount,
+	l.created_at,
+	l.updated_at,
+	l.created_by,
+	l.updated_by,
+	l.ip_address
+FROM loans l
+LEFT JOIN (
+	SELECT pi.loan_id, SUM(pi.amount) AS repaid_amount
+	FROM payslip_items pi
+	JOIN payslips ps ON ps.id = pi.payslip_id
+	WHERE pi.loan_id IS NOT NULL AND ps.voided_at IS NULL
+	GROUP BY pi.loan_id
+) r ON r.loan_id = l.id
+WHERE l.user_id = $1
+ORDER BY l.start_date ASC, l.created_at ASC
+`
+
+// The repayments of the period itself are left out, so a preview or a
+// regenerated payroll deducts the installment again instead of counting the
+// one it replaces.
+const findOutstandingLoansQuery = `
+SELECT
+	l.id,
+	l.user_id,
+	l.principal,
+	l.installment_amount,
+	l.start_date,
+	l.reason,
+	COALESCE(r.repaid_amount, 0) AS repaid_amount,
+	l.created_at,
+	l.updated_at,
+	l.created_by,
+	l.updated_by,
+	l.ip_address
+FROM loans l
+LEFT JOIN (
+	SELECT pi.loan_id, SUM(pi.amount) AS repaid_amount
+	FROM payslip_items pi
+	JOIN payslips ps ON ps.id = pi.payslip_id
+	JOIN payrolls p ON p.id = ps.payroll_id
+	WHERE pi.loan_id IS NOT NULL AND ps.voided_at IS NULL AND p.period_id <> $1
+	GROUP BY pi.loan_id
+) r ON r.loan_id = l.id
+WHERE l.start_date <= $2::DATE AND l.principal > COALESCE(r.repaid_amount, 0)
+ORDER BY l.user_id, l.start_date ASC, l.created_at ASC
+`
+
+const findLoanRepaymentsByLoanIDQuery = `
+SELECT
+	pi.id,
+	pi.loan_id,
+	pi.payslip_id,
+	ps.payroll_id,
+	p.status AS payroll_status,
+	p.period_id,
+	ap.start_date AS period_start_date,
+	ap.end_date AS period_end_date,
+	pi.amount,
+	pi.created_at
+FROM payslip_items pi
+JOIN payslips ps ON ps.id = pi.payslip_id
+JOIN payrolls p ON p.id = ps.payroll_id
+JOIN attendance_periods ap ON ap.id = p.period_id
+WHERE pi.loan_id = $1 AND ps.voided_at IS NULL
+ORDER BY ap.start_date ASC
+`
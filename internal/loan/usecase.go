@@ -0,0 +1,17 @@
+package loan
+
+import (
+	"context"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+)
+
+type UseCase interface {
+	ListLoans(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Loan, error)
+	CreateLoan(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateLoan) (entity.Loan, error)
+	ShowLoanStatement(ctx context.Context, authCredential authCredential.Credential, userID, loanID string) (entity.LoanStatement, error)
+
+	ListMyLoans(ctx context.Context, authCredential authCredential.Credential) ([]entity.Loan, error)
+	ShowMyLoanStatement(ctx context.Context, authCredential authCredential.Credential, loanID string) (entity.LoanStatement, error)
+}
@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/loan"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type loanUseCase struct {
+	loanRepo loan.Repository
+	userRepo users.Repository
+}
+
+func NewLoanUseCase(loanRepo loan.Repository, userRepo users.Repository) loan.UseCase {
+	return &loanUseCase{
+		loanRepo: loanRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (u *loanUseCase) ListLoans(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.Loan, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanUseCase.ListLoans()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LoanNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+			},
+		)
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LoanUseCase.ListLoans().FindUserByID()")
+	}
+	if user == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.LoanUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanUserNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	loans, err := u.loanRepo.FindLoansByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LoanUseCase.ListLoans().FindLoansByUserID()")
+	}
+
+	return loans, nil
+}
+
+// CreateLoan grants a salary advance. The first installment is deducted by
+// the payroll of the period containing the start date, a payroll already
+// generated for it only picks the loan up when it is regenerated.
+func (u *loanUseCase) CreateLoan(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.CreateLoan) (entity.Loan, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanUseCase.CreateLoan()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.Loan{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LoanNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+			},
+		)
+	}
+
+	if payload.InstallmentAmount.Cmp(payload.Principal) > 0 {
+		return entity.Loan{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.LoanInvalidInstallment,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanInvalidInstallment),
+				Received:  payload.InstallmentAmount.String(),
+				Expected:  payload.Principal.String(),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newLoan := entity.Loan{
+		ID:                uuid.NewString(),
+		UserID:            userID,
+		Principal:         payload.Principal,
+		InstallmentAmount: payload.InstallmentAmount,
+		StartDate:         payload.StartDate,
+		Reason:            payload.Reason,
+		CreatedAt:         timeNow,
+		UpdatedAt:         timeNow,
+		CreatedBy:         authCredential.UserID,
+		UpdatedBy:         authCredential.UserID,
+		IPAddress:         authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		loanRepoTx := u.loanRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		user, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "LoanUseCase.CreateLoan().FindUserByID()")
+		}
+		if user == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LoanUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanUserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		err = loanRepoTx.StoreNewLoan(ctx, newLoan)
+		if err != nil {
+			return errors.Wrap(err, "LoanUseCase.CreateLoan().StoreNewLoan()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Loan{}, errors.Wrap(err, "LoanUseCase.CreateLoan().WithAuditContext()")
+	}
+
+	return newLoan, nil
+}
+
+func (u *loanUseCase) ShowLoanStatement(ctx context.Context, authCredential authCredential.Credential, userID, loanID string) (entity.LoanStatement, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanUseCase.ShowLoanStatement()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.LoanStatement{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.LoanNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+			},
+		)
+	}
+
+	statement, err := u.findLoanStatement(ctx, userID, loanID)
+	if err != nil {
+		return entity.LoanStatement{}, errors.Wrap(err, "LoanUseCase.ShowLoanStatement().findLoanStatement()")
+	}
+
+	return statement, nil
+}
+
+func (u *loanUseCase) ListMyLoans(ctx context.Context, authCredential authCredential.Credential) ([]entity.Loan, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanUseCase.ListMyLoans()",
+	)
+	defer span.End()
+
+	loans, err := u.loanRepo.FindLoansByUserID(ctx, authCredential.UserID)
+	if err != nil {
+		return nil, errors.Wrap(err, "LoanUseCase.ListMyLoans().FindLoansByUserID()")
+	}
+
+	return loans, nil
+}
+
+// ShowMyLoanStatement only finds loans of the logged in employee, the loan of
+// someone else is reported as not found.
+func (u *loanUseCase) ShowMyLoanStatement(ctx context.Context, authCredential authCredential.Credential, loanID string) (entity.LoanStatement, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"LoanUseCase.ShowMyLoanStatement()",
+	)
+	defer span.End()
+
+	statement, err := u.findLoanStatement(ctx, authCredential.UserID, loanID)
+	if err != nil {
+		return entity.LoanStatement{}, errors.Wrap(err, "LoanUseCase.ShowMyLoanStatement().findLoanStatement()")
+	}
+
+	return statement, nil
+}
+
+func (u *loanUseCase) findLoanStatement(ctx context.Context, userID, loanID string) (entity.LoanStatement, error) {
+	existingLoan, err := u.loanRepo.FindLoanByID(ctx, userID, loanID)
+	if err != nil {
+		return entity.LoanStatement{}, errors.Wrap(err, "LoanUseCase.findLoanStatement().FindLoanByID()")
+	}
+	if existingLoan == nil {
+		return entity.LoanStatement{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.LoanNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotFound),
+				Received:  loanID,
+			},
+		)
+	}
+
+	repayments, err := u.loanRepo.FindLoanRepaymentsByLoanID(ctx, loanID)
+	if err != nil {
+		return entity.LoanStatement{}, errors.Wrap(err, "LoanUseCase.findLoanStatement().FindLoanRepaymentsByLoanID()")
+	}
+
+	return entity.LoanStatement{
+		Loan:       *existingLoan,
+		Schedule:   existingLoan.Schedule(),
+		Repayments: repayments,
+	}, nil
+}
@@ -0,0 +1,387 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/loan/entity"
+	mockLoan "github.com/vnnyx/employee-management/internal/loan/mock"
+	"github.com/vnnyx/employee-management/internal/loan/usecase"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	loanRepo   *mockLoan.MockRepository
+	loanRepoTx *mockLoan.MockRepository
+	userRepo   *mockUser.MockRepository
+	userRepoTx *mockUser.MockRepository
+}
+
+func newMockParams(ctrl *gomock.Controller) mockParams {
+	return mockParams{
+		loanRepo:   mockLoan.NewMockRepository(ctrl),
+		loanRepoTx: mockLoan.NewMockRepository(ctrl),
+		userRepo:   mockUser.NewMockRepository(ctrl),
+		userRepoTx: mockUser.NewMockRepository(ctrl),
+	}
+}
+
+func TestListLoans(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedLen    int
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - loans listed",
+			authCredential: adminCredential,
+			expectedLen:    2,
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.loanRepo.EXPECT().FindLoansByUserID(gomock.Any(), "user-1").Return([]entity.Loan{
+					{ID: "loan-1", UserID: "user-1", Principal: money.New(1000), InstallmentAmount: money.New(250), RepaidAmount: money.New(1000)},
+					{ID: "loan-2", UserID: "user-1", Principal: money.New(600), InstallmentAmount: money.New(200)},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LoanUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LoanNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLoanUseCase(m.loanRepo, m.userRepo)
+
+			loans, err := useCase.ListLoans(context.Background(), tt.authCredential, "user-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, loans, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestCreateLoan(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.CreateLoan
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.CreateLoan{
+		Principal:         money.New(1000),
+		InstallmentAmount: money.New(250),
+		StartDate:         time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		Reason:            optional.NewString("Medical expenses"),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - loan created",
+			authCredential: adminCredential,
+			payload:        payload,
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.loanRepoTx.EXPECT().StoreNewLoan(gomock.Any(), mock.MatchedBy(func(args entity.Loan) bool {
+					return testutil.EqualVerbose(
+						entity.Loan{
+							UserID:            "user-1",
+							Principal:         money.New(1000),
+							InstallmentAmount: money.New(250),
+							StartDate:         time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+							Reason:            optional.NewString("Medical expenses"),
+							CreatedBy:         "admin-1",
+							UpdatedBy:         "admin-1",
+							IPAddress:         "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Loan{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - installment above principal",
+			authCredential: adminCredential,
+			payload: entity.CreateLoan{
+				Principal:         money.New(1000),
+				InstallmentAmount: money.New(1500),
+				StartDate:         time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.LoanInvalidInstallment,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanInvalidInstallment),
+					Received:  "1500.00",
+					Expected:  "1000.00",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LoanUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LoanNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLoanUseCase(m.loanRepo, m.userRepo)
+
+			loan, err := useCase.CreateLoan(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, loan.ID)
+				assert.True(t, tt.payload.Principal.Equal(loan.OutstandingBalance()))
+			}
+		})
+	}
+}
+
+func TestShowLoanStatement(t *testing.T) {
+	type testCase struct {
+		name              string
+		authCredential    authCredential.Credential
+		expectedSchedule  []entity.LoanInstallment
+		expectedRepayment int
+		expectedErr       error
+		setupMock         func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - schedule with a shorter last installment",
+			authCredential: adminCredential,
+			expectedSchedule: []entity.LoanInstallment{
+				{Number: 1, Amount: money.New(400), BalanceAfter: money.New(600)},
+				{Number: 2, Amount: money.New(400), BalanceAfter: money.New(200)},
+				{Number: 3, Amount: money.New(200), BalanceAfter: money.Money{}},
+			},
+			expectedRepayment: 1,
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().FindLoanByID(gomock.Any(), "user-1", "loan-1").Return(&entity.Loan{
+					ID:                "loan-1",
+					UserID:            "user-1",
+					Principal:         money.New(1000),
+					InstallmentAmount: money.New(400),
+					RepaidAmount:      money.New(400),
+				}, nil)
+				m.loanRepo.EXPECT().FindLoanRepaymentsByLoanID(gomock.Any(), "loan-1").Return([]entity.LoanRepayment{
+					{ID: "item-1", LoanID: "loan-1", PayslipID: "ps-1", Amount: money.New(400)},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - loan not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LoanNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotFound),
+					Received:  "loan-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().FindLoanByID(gomock.Any(), "user-1", "loan-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.LoanNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLoanUseCase(m.loanRepo, m.userRepo)
+
+			statement, err := useCase.ShowLoanStatement(context.Background(), tt.authCredential, "user-1", "loan-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedSchedule, statement.Schedule)
+				assert.Len(t, statement.Repayments, tt.expectedRepayment)
+				assert.Equal(t, money.New(600), statement.Loan.OutstandingBalance())
+			}
+		})
+	}
+}
+
+func TestShowMyLoanStatement(t *testing.T) {
+	type testCase struct {
+		name        string
+		expectedErr error
+		setupMock   func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name: "success - own loan",
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().FindLoanByID(gomock.Any(), "user-1", "loan-1").Return(&entity.Loan{
+					ID:                "loan-1",
+					UserID:            "user-1",
+					Principal:         money.New(1000),
+					InstallmentAmount: money.New(500),
+				}, nil)
+				m.loanRepo.EXPECT().FindLoanRepaymentsByLoanID(gomock.Any(), "loan-1").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - loan of someone else",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.LoanNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.LoanNotFound),
+					Received:  "loan-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.loanRepo.EXPECT().FindLoanByID(gomock.Any(), "user-1", "loan-1").Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := newMockParams(ctrl)
+			tt.setupMock(m)
+
+			useCase := usecase.NewLoanUseCase(m.loanRepo, m.userRepo)
+
+			statement, err := useCase.ShowMyLoanStatement(context.Background(), userCredential, "loan-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, statement.Schedule, 2)
+			}
+		})
+	}
+}
@@ -58,3 +58,73 @@ func (h *OvertimeHandler) SubmitOvertime(c *fiber.Ctx) error {
 		},
 	)
 }
+
+// @Summary      Get Overtime Policy
+// @Description  Get the active overtime policy
+// @Tags         Overtime
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=dtos.OvertimePolicyResponse} "Overtime Policy Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/overtime/policy [GET]
+// @Security     BearerAuth
+func (h *OvertimeHandler) GetOvertimePolicy(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"OvertimeHandler.GetOvertimePolicy()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	policy, err := h.overtimeUC.GetOvertimePolicy(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "OvertimeHandler().GetOvertimePolicy().uc.GetOvertimePolicy()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewOvertimePolicyResponse(policy),
+		},
+	)
+}
+
+// @Summary      Update Overtime Policy
+// @Description  Store a new version of the overtime policy, used by payrolls generated afterwards
+// @Tags         Overtime
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.OvertimePolicyRequest true "Overtime Policy Request"
+// @Success      201 {object} dtos.Response{data=dtos.OvertimePolicyResponse} "Overtime Policy Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/overtime/policy [PUT]
+// @Security     BearerAuth
+func (h *OvertimeHandler) UpdateOvertimePolicy(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"OvertimeHandler.UpdateOvertimePolicy()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+	var policyRequest dtos.OvertimePolicyRequest
+	if err := c.BodyParser(&policyRequest); err != nil {
+		return errors.Wrap(err, "OvertimeHandler().UpdateOvertimePolicy().c.BodyParser()")
+	}
+
+	if err := policyRequest.Validate(); err != nil {
+		return errors.Wrap(err, "OvertimeHandler().UpdateOvertimePolicy().policyRequest.Validate()")
+	}
+
+	policy, err := h.overtimeUC.UpdateOvertimePolicy(ctx, authCredential, policyRequest.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "OvertimeHandler().UpdateOvertimePolicy().uc.UpdateOvertimePolicy()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewOvertimePolicyResponse(policy),
+		},
+	)
+}
@@ -6,4 +6,6 @@ func MapOvertime(routes fiber.Router, h *OvertimeHandler) {
 	overtime := routes.Group("/overtime")
 
 	overtime.Post("/", h.SubmitOvertime)
+	overtime.Get("/policy", h.GetOvertimePolicy)
+	overtime.Put("/policy", h.UpdateOvertimePolicy)
 }
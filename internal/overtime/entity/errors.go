@@ -3,6 +3,11 @@ package entity
 const (
 	OvertimeInvalidTimeRequest = "OVERTIME_INVALID_TIME_REQUEST"
 	OvertimeExceedsLimit       = "OVERTIME_EXCEEDS_LIMIT"
+	OvertimeNotAuthorized      = "OVERTIME_NOT_AUTHORIZED"
+	OvertimePolicyNotFound     = "OVERTIME_POLICY_NOT_FOUND"
+	OvertimePolicyInvalidTiers = "OVERTIME_POLICY_INVALID_TIERS"
+	OvertimePeriodClosed       = "OVERTIME_PERIOD_CLOSED"
+	OvertimeUserNotFound       = "OVERTIME_USER_NOT_FOUND"
 )
 
 func GetErrorMessageByIssueCode(issueCode string) string {
@@ -11,6 +16,16 @@ func GetErrorMessageByIssueCode(issueCode string) string {
 		return "Overtime cannot be submitted on working hours"
 	case OvertimeExceedsLimit:
 		return "Overtime exceeds the allowed limit for the day"
+	case OvertimeNotAuthorized:
+		return "You are not authorized to manage the overtime policy"
+	case OvertimePolicyNotFound:
+		return "Overtime policy has not been configured"
+	case OvertimePolicyInvalidTiers:
+		return "Overtime tiers must be ordered by strictly increasing hours"
+	case OvertimePeriodClosed:
+		return "Overtime cannot be submitted, the payroll of the period has already been generated"
+	case OvertimeUserNotFound:
+		return "User not found"
 	default:
 		return "An unknown error occurred"
 	}
@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+// OvertimePolicy describes how overtime hours are paid. Policies are never
+// updated in place, every change is stored as a new version so payslips can
+// keep referring to the exact rules they were calculated with.
+type OvertimePolicy struct {
+	ID                  string         `db:"id"`
+	Version             int64          `db:"version"`
+	WeekdayMultiplier   float64        `db:"weekday_multiplier"`
+	WeekendMultiplier   float64        `db:"weekend_multiplier"`
+	StandardHoursPerDay int64          `db:"standard_hours_per_day"`
+	Tiers               []OvertimeTier `db:"tiers"`
+	CreatedAt           time.Time      `db:"created_at"`
+	UpdatedAt           time.Time      `db:"updated_at"`
+	CreatedBy           string         `db:"created_by"`
+	UpdatedBy           string         `db:"updated_by"`
+	IPAddress           string         `db:"ip_address"`
+}
+
+// OvertimeTier applies Multiplier to the hours worked in a day beyond AfterHours.
+type OvertimeTier struct {
+	AfterHours int64   `json:"after_hours"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+type UpdateOvertimePolicy struct {
+	WeekdayMultiplier   float64
+	WeekendMultiplier   float64
+	StandardHoursPerDay int64
+	Tiers               []OvertimeTier
+}
+
+type FindOvertimePolicyOptions struct {
+	PessimisticLock bool
+}
+
+// RatePerHour derives the hourly rate from the salary of a period with the
+// given number of working days. The rate is rounded half up to the cent, it
+// is stored on the payslip and has to reproduce the overtime pay exactly.
+func (p OvertimePolicy) RatePerHour(salary money.Money, workingDays int64) money.Money {
+	hours := workingDays * p.StandardHoursPerDay
+	if hours <= 0 {
+		return money.Zero
+	}
+	return salary.Div(hours, money.RoundHalfUp)
+}
+
+// BaseMultiplier returns the multiplier for the first hours of overtime,
+// weekends and holidays use the weekend multiplier.
+func (p OvertimePolicy) BaseMultiplier(workingDay bool) float64 {
+	if !workingDay {
+		return p.WeekendMultiplier
+	}
+	return p.WeekdayMultiplier
+}
+
+// CalculatePay splits the overtime of a single day into the base segment and
+// the tier segments. A tier never pays less than the base multiplier of the day.
+// The pay is returned exact, the caller rounds the total of the period once.
+func (p OvertimePolicy) CalculatePay(overtime Overtime, ratePerHour money.Money, workingDay bool) *big.Rat {
+	base := p.BaseMultiplier(workingDay)
+	hours := new(big.Rat).SetFrac64(int64(overtime.OvertimeHours), int64(time.Hour))
+	rate := ratePerHour.Rat()
+
+	var (
+		pay        = new(big.Rat)
+		from       = new(big.Rat)
+		multiplier = base
+	)
+	segment := func(until *big.Rat) {
+		hoursInSegment := new(big.Rat).Sub(until, from)
+		pay.Add(pay, hoursInSegment.Mul(hoursInSegment, rate).Mul(hoursInSegment, money.Rate(multiplier)))
+	}
+	for _, tier := range p.Tiers {
+		until := new(big.Rat).SetInt64(tier.AfterHours)
+		if hours.Cmp(until) <= 0 {
+			break
+		}
+		segment(until)
+		from = until
+		multiplier = max(base, tier.Multiplier)
+	}
+	segment(hours)
+
+	return pay
+}
@@ -44,6 +44,50 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// FindActivePolicy mocks base method.
+func (m *MockRepository) FindActivePolicy(ctx context.Context, opts ...entity.FindOvertimePolicyOptions) (*entity.OvertimePolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindActivePolicy", varargs...)
+	ret0, _ := ret[0].(*entity.OvertimePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActivePolicy indicates an expected call of FindActivePolicy.
+func (mr *MockRepositoryMockRecorder) FindActivePolicy(ctx any, opts ...any) *MockRepositoryFindActivePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActivePolicy", reflect.TypeOf((*MockRepository)(nil).FindActivePolicy), varargs...)
+	return &MockRepositoryFindActivePolicyCall{Call: call}
+}
+
+// MockRepositoryFindActivePolicyCall wrap *gomock.Call
+type MockRepositoryFindActivePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindActivePolicyCall) Return(arg0 *entity.OvertimePolicy, arg1 error) *MockRepositoryFindActivePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindActivePolicyCall) Do(f func(context.Context, ...entity.FindOvertimePolicyOptions) (*entity.OvertimePolicy, error)) *MockRepositoryFindActivePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindActivePolicyCall) DoAndReturn(f func(context.Context, ...entity.FindOvertimePolicyOptions) (*entity.OvertimePolicy, error)) *MockRepositoryFindActivePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindOvertimeByPeriod mocks base method.
 func (m *MockRepository) FindOvertimeByPeriod(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindOvertimeOptions) (entity.FindOvertimeResult, error) {
 	m.ctrl.T.Helper()
@@ -165,6 +209,44 @@ func (c *MockRepositoryStoreNewOvertimeCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// StoreNewPolicy mocks base method.
+func (m *MockRepository) StoreNewPolicy(ctx context.Context, policy entity.OvertimePolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewPolicy", ctx, policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewPolicy indicates an expected call of StoreNewPolicy.
+func (mr *MockRepositoryMockRecorder) StoreNewPolicy(ctx, policy any) *MockRepositoryStoreNewPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewPolicy", reflect.TypeOf((*MockRepository)(nil).StoreNewPolicy), ctx, policy)
+	return &MockRepositoryStoreNewPolicyCall{Call: call}
+}
+
+// MockRepositoryStoreNewPolicyCall wrap *gomock.Call
+type MockRepositoryStoreNewPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewPolicyCall) Return(arg0 error) *MockRepositoryStoreNewPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewPolicyCall) Do(f func(context.Context, entity.OvertimePolicy) error) *MockRepositoryStoreNewPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewPolicyCall) DoAndReturn(f func(context.Context, entity.OvertimePolicy) error) *MockRepositoryStoreNewPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpsertOvertime mocks base method.
 func (m *MockRepository) UpsertOvertime(ctx context.Context, arg1 entity.Overtime) error {
 	m.ctrl.T.Helper()
@@ -42,6 +42,45 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// GetOvertimePolicy mocks base method.
+func (m *MockUseCase) GetOvertimePolicy(ctx context.Context, authCredential entity.Credential) (entity0.OvertimePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOvertimePolicy", ctx, authCredential)
+	ret0, _ := ret[0].(entity0.OvertimePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOvertimePolicy indicates an expected call of GetOvertimePolicy.
+func (mr *MockUseCaseMockRecorder) GetOvertimePolicy(ctx, authCredential any) *MockUseCaseGetOvertimePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvertimePolicy", reflect.TypeOf((*MockUseCase)(nil).GetOvertimePolicy), ctx, authCredential)
+	return &MockUseCaseGetOvertimePolicyCall{Call: call}
+}
+
+// MockUseCaseGetOvertimePolicyCall wrap *gomock.Call
+type MockUseCaseGetOvertimePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseGetOvertimePolicyCall) Return(arg0 entity0.OvertimePolicy, arg1 error) *MockUseCaseGetOvertimePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseGetOvertimePolicyCall) Do(f func(context.Context, entity.Credential) (entity0.OvertimePolicy, error)) *MockUseCaseGetOvertimePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseGetOvertimePolicyCall) DoAndReturn(f func(context.Context, entity.Credential) (entity0.OvertimePolicy, error)) *MockUseCaseGetOvertimePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SubmitOvertime mocks base method.
 func (m *MockUseCase) SubmitOvertime(ctx context.Context, authCredential entity.Credential, payload entity0.SubmitOvertime) error {
 	m.ctrl.T.Helper()
@@ -79,3 +118,42 @@ func (c *MockUseCaseSubmitOvertimeCall) DoAndReturn(f func(context.Context, enti
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateOvertimePolicy mocks base method.
+func (m *MockUseCase) UpdateOvertimePolicy(ctx context.Context, authCredential entity.Credential, payload entity0.UpdateOvertimePolicy) (entity0.OvertimePolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOvertimePolicy", ctx, authCredential, payload)
+	ret0, _ := ret[0].(entity0.OvertimePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOvertimePolicy indicates an expected call of UpdateOvertimePolicy.
+func (mr *MockUseCaseMockRecorder) UpdateOvertimePolicy(ctx, authCredential, payload any) *MockUseCaseUpdateOvertimePolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOvertimePolicy", reflect.TypeOf((*MockUseCase)(nil).UpdateOvertimePolicy), ctx, authCredential, payload)
+	return &MockUseCaseUpdateOvertimePolicyCall{Call: call}
+}
+
+// MockUseCaseUpdateOvertimePolicyCall wrap *gomock.Call
+type MockUseCaseUpdateOvertimePolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateOvertimePolicyCall) Return(arg0 entity0.OvertimePolicy, arg1 error) *MockUseCaseUpdateOvertimePolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateOvertimePolicyCall) Do(f func(context.Context, entity.Credential, entity0.UpdateOvertimePolicy) (entity0.OvertimePolicy, error)) *MockUseCaseUpdateOvertimePolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateOvertimePolicyCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.UpdateOvertimePolicy) (entity0.OvertimePolicy, error)) *MockUseCaseUpdateOvertimePolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -15,4 +15,6 @@ type Repository interface {
 	FindOvertimeByUserIDDate(ctx context.Context, userID string, date time.Time) (*entity.Overtime, error)
 	UpsertOvertime(ctx context.Context, overtime entity.Overtime) error
 	FindOvertimeByPeriod(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindOvertimeOptions) (entity.FindOvertimeResult, error)
+	FindActivePolicy(ctx context.Context, opts ...entity.FindOvertimePolicyOptions) (*entity.OvertimePolicy, error)
+	StoreNewPolicy(ctx context.Context, policy entity.OvertimePolicy) error
 }
@@ -175,3 +175,53 @@ func (r *overtimeRepo) FindOvertimeByPeriod(ctx context.Context, startDate, endD
 
 	return result, nil
 }
+
+func (r *overtimeRepo) FindActivePolicy(ctx context.Context, opts ...entity.FindOvertimePolicyOptions) (*entity.OvertimePolicy, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"OvertimeRepository.FindActivePolicy()",
+	)
+	defer span.End()
+
+	query := findActiveOvertimePolicyQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var policy entity.OvertimePolicy
+	err := pgxscan.Get(ctx, r.db, &policy, query)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &policy, nil
+}
+
+func (r *overtimeRepo) StoreNewPolicy(ctx context.Context, policy entity.OvertimePolicy) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"OvertimeRepository.StoreNewPolicy()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertOvertimePolicyQuery, policy)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert overtime policy"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
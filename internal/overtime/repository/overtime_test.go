@@ -363,3 +363,169 @@ func TestFindOvertimeByPeriod(t *testing.T) {
 		})
 	}
 }
+
+func TestFindActivePolicy(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewOvertimeRepository(mock)
+	now := time.Now()
+
+	columns := []string{
+		"id", "version", "weekday_multiplier", "weekend_multiplier", "standard_hours_per_day", "tiers",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		opts      []entity.FindOvertimePolicyOptions
+		expected  *entity.OvertimePolicy
+		expectErr bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM overtime_policies ORDER BY version DESC LIMIT 1$").
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(
+						"policy-2", int64(2), 1.5, 2.0, int64(8), []entity.OvertimeTier{{AfterHours: 1, Multiplier: 2}},
+						now, now, "admin", "admin", "127.0.0.1",
+					))
+			},
+			expected: &entity.OvertimePolicy{
+				ID:                  "policy-2",
+				Version:             2,
+				WeekdayMultiplier:   1.5,
+				WeekendMultiplier:   2,
+				StandardHoursPerDay: 8,
+				Tiers:               []entity.OvertimeTier{{AfterHours: 1, Multiplier: 2}},
+				CreatedAt:           now,
+				UpdatedAt:           now,
+				CreatedBy:           "admin",
+				UpdatedBy:           "admin",
+				IPAddress:           "127.0.0.1",
+			},
+		},
+		{
+			name: "found with lock",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM overtime_policies (.+) FOR UPDATE").
+					WillReturnRows(pgxmock.NewRows(columns).AddRow(
+						"policy-1", int64(1), 1.0, 1.0, int64(8), []entity.OvertimeTier{},
+						now, now, "admin", "admin", "127.0.0.1",
+					))
+			},
+			opts: []entity.FindOvertimePolicyOptions{{PessimisticLock: true}},
+			expected: &entity.OvertimePolicy{
+				ID:                  "policy-1",
+				Version:             1,
+				WeekdayMultiplier:   1,
+				WeekendMultiplier:   1,
+				StandardHoursPerDay: 8,
+				Tiers:               []entity.OvertimeTier{},
+				CreatedAt:           now,
+				UpdatedAt:           now,
+				CreatedBy:           "admin",
+				UpdatedBy:           "admin",
+				IPAddress:           "127.0.0.1",
+			},
+		},
+		{
+			name: "not configured",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM overtime_policies").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			expected: nil,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM overtime_policies").
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindActivePolicy(context.Background(), tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestStoreNewPolicy(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewOvertimeRepository(mock)
+	now := time.Now()
+
+	input := entity.OvertimePolicy{
+		ID:                  "policy-2",
+		Version:             2,
+		WeekdayMultiplier:   1.5,
+		WeekendMultiplier:   2,
+		StandardHoursPerDay: 8,
+		Tiers:               []entity.OvertimeTier{{AfterHours: 1, Multiplier: 2}},
+		CreatedAt:           now,
+		UpdatedAt:           now,
+		CreatedBy:           "admin",
+		UpdatedBy:           "admin",
+		IPAddress:           "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO overtime_policies").
+					WithArgs("policy-2", int64(2), 1.5, 2.0, int64(8), input.Tiers,
+						now, now, "admin", "admin", "127.0.0.1").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("policy-2"))
+			},
+		},
+		{
+			name: "duplicate version",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO overtime_policies").
+					WillReturnError(errors.New("duplicate key value violates unique constraint"))
+			},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO overtime_policies").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewPolicy(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -86,4 +86,52 @@ SELECT
 FROM overtimes
 WHERE overtime_date BETWEEN $1::DATE AND $2::DATE
 ORDER BY overtime_date ASC
-`
\ No newline at end of file
+`
+
+const findActiveOvertimePolicyQuery = `
+SELECT
+	id,
+	version,
+	weekday_multiplier,
+	weekend_multiplier,
+	standard_hours_per_day,
+	tiers,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM overtime_policies
+ORDER BY version DESC
+LIMIT 1
+`
+
+const insertOvertimePolicyQuery = `
+INSERT INTO overtime_policies (
+	id,
+	version,
+	weekday_multiplier,
+	weekend_multiplier,
+	standard_hours_per_day,
+	tiers,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:version,
+	:weekday_multiplier,
+	:weekend_multiplier,
+	:standard_hours_per_day,
+	:tiers,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
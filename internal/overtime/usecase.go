@@ -9,4 +9,6 @@ import (
 
 type UseCase interface {
 	SubmitOvertime(ctx context.Context, authCredential authCredential.Credential, payload entity.SubmitOvertime) error
+	GetOvertimePolicy(ctx context.Context, authCredential authCredential.Credential) (entity.OvertimePolicy, error)
+	UpdateOvertimePolicy(ctx context.Context, authCredential authCredential.Credential, payload entity.UpdateOvertimePolicy) (entity.OvertimePolicy, error)
 }
@@ -7,22 +7,33 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/attendance"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
 	"github.com/vnnyx/employee-management/internal/overtime"
 	"github.com/vnnyx/employee-management/internal/overtime/entity"
+	"github.com/vnnyx/employee-management/internal/users"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/iso8601"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/timezone"
 )
 
 type overtimeUseCase struct {
-	overtimeRepo overtime.Repository
+	overtimeRepo   overtime.Repository
+	holidayRepo    holiday.Repository
+	attendanceRepo attendance.Repository
+	userRepo       users.Repository
 }
 
-func NewOvertimeUseCase(overtimeRepo overtime.Repository) overtime.UseCase {
+func NewOvertimeUseCase(overtimeRepo overtime.Repository, holidayRepo holiday.Repository, attendanceRepo attendance.Repository, userRepo users.Repository) overtime.UseCase {
 	return &overtimeUseCase{
-		overtimeRepo: overtimeRepo,
+		overtimeRepo:   overtimeRepo,
+		holidayRepo:    holidayRepo,
+		attendanceRepo: attendanceRepo,
+		userRepo:       userRepo,
 	}
 }
 
@@ -33,19 +44,57 @@ func (u *overtimeUseCase) SubmitOvertime(ctx context.Context, authCredential aut
 	)
 	defer span.End()
 
-	timeNow := time.Now()
-	// Check if request overtime outside working hours
-	if timeNow.Hour() >= 9 && timeNow.Hour() <= 17 && timeNow.Weekday() != time.Saturday && timeNow.Weekday() != time.Sunday {
-		return apperror.BadRequest(
+	user, err := u.userRepo.FindUserByID(ctx, authCredential.UserID)
+	if err != nil {
+		return errors.Wrap(err, "OvertimeUseCase.SubmitOvertime().FindUserByID()")
+	}
+	if user == nil {
+		return apperror.NotFound(
 			apperror.AppError{
-				IssueCode: entity.OvertimeInvalidTimeRequest,
-				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeInvalidTimeRequest),
+				IssueCode: entity.OvertimeUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeUserNotFound),
+				Received:  authCredential.UserID,
 			},
 		)
 	}
 
-	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+	// Working hours are those of the timezone the user works in
+	timeNow := time.Now().In(user.Location())
+	// Check if request overtime outside working hours
+	if timeNow.Hour() >= 9 && timeNow.Hour() <= 17 {
+		today := timezone.Date(timeNow)
+		holidays, err := u.holidayRepo.FindHolidaysByRange(ctx, today, today)
+		if err != nil {
+			return errors.Wrap(err, "OvertimeUseCase.SubmitOvertime().FindHolidaysByRange()")
+		}
+
+		if holidayEntity.NewCalendar(holidays).IsWorkingDay(today) {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimeInvalidTimeRequest,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeInvalidTimeRequest),
+				},
+			)
+		}
+	}
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
 		overtimeRepoTx := u.overtimeRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByDate(ctx, payload.OvertimeDate)
+		if err != nil {
+			return errors.Wrap(err, "OvertimeUseCase.SubmitOvertime().FindClosedPeriodByDate()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
 
 		overtime, err := overtimeRepoTx.FindOvertimeByUserIDDate(ctx, authCredential.UserID, payload.OvertimeDate)
 		if err != nil {
@@ -102,3 +151,113 @@ func (u *overtimeUseCase) SubmitOvertime(ctx context.Context, authCredential aut
 
 	return nil
 }
+
+func (u *overtimeUseCase) GetOvertimePolicy(ctx context.Context, authCredential authCredential.Credential) (entity.OvertimePolicy, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"OvertimeUseCase.GetOvertimePolicy()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.OvertimePolicy{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.OvertimeNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeNotAuthorized),
+			},
+		)
+	}
+
+	policy, err := u.overtimeRepo.FindActivePolicy(ctx)
+	if err != nil {
+		return entity.OvertimePolicy{}, errors.Wrap(err, "OvertimeUseCase.GetOvertimePolicy().FindActivePolicy()")
+	}
+	if policy == nil {
+		return entity.OvertimePolicy{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.OvertimePolicyNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePolicyNotFound),
+			},
+		)
+	}
+
+	return *policy, nil
+}
+
+func (u *overtimeUseCase) UpdateOvertimePolicy(ctx context.Context, authCredential authCredential.Credential, payload entity.UpdateOvertimePolicy) (entity.OvertimePolicy, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"OvertimeUseCase.UpdateOvertimePolicy()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.OvertimePolicy{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.OvertimeNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeNotAuthorized),
+			},
+		)
+	}
+
+	tiers := make([]entity.OvertimeTier, 0, len(payload.Tiers))
+	for i, tier := range payload.Tiers {
+		if i > 0 && tier.AfterHours <= payload.Tiers[i-1].AfterHours {
+			return entity.OvertimePolicy{}, apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimePolicyInvalidTiers,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePolicyInvalidTiers),
+					Path:      []string{"tiers"},
+				},
+			)
+		}
+		tiers = append(tiers, tier)
+	}
+
+	var policy entity.OvertimePolicy
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		overtimeRepoTx := u.overtimeRepo.WithTx(tx)
+
+		activePolicy, err := overtimeRepoTx.FindActivePolicy(ctx, entity.FindOvertimePolicyOptions{
+			PessimisticLock: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "OvertimeUseCase.UpdateOvertimePolicy().FindActivePolicy()")
+		}
+
+		// Policies are versioned instead of updated so stored payslips keep
+		// pointing to the rules they were calculated with.
+		var version int64 = 1
+		if activePolicy != nil {
+			version = activePolicy.Version + 1
+		}
+
+		timeNow := time.Now()
+		policy = entity.OvertimePolicy{
+			ID:                  uuid.NewString(),
+			Version:             version,
+			WeekdayMultiplier:   payload.WeekdayMultiplier,
+			WeekendMultiplier:   payload.WeekendMultiplier,
+			StandardHoursPerDay: payload.StandardHoursPerDay,
+			Tiers:               tiers,
+			CreatedAt:           timeNow,
+			UpdatedAt:           timeNow,
+			CreatedBy:           authCredential.UserID,
+			UpdatedBy:           authCredential.UserID,
+			IPAddress:           authCredential.IPAddress,
+		}
+
+		err = overtimeRepoTx.StoreNewPolicy(ctx, policy)
+		if err != nil {
+			return errors.Wrap(err, "OvertimeUseCase.UpdateOvertimePolicy().StoreNewPolicy()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.OvertimePolicy{}, errors.Wrap(err, "OvertimeUseCase.UpdateOvertimePolicy().WithAuditContext()")
+	}
+
+	return policy, nil
+}
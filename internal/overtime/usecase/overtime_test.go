@@ -10,10 +10,16 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	attendanceEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
+	mockAttendance "github.com/vnnyx/employee-management/internal/attendance/mock"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	mockHoliday "github.com/vnnyx/employee-management/internal/holiday/mock"
 	"github.com/vnnyx/employee-management/internal/overtime/entity"
 	mockOvertime "github.com/vnnyx/employee-management/internal/overtime/mock"
 	"github.com/vnnyx/employee-management/internal/overtime/usecase"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/testutil"
@@ -21,14 +27,25 @@ import (
 )
 
 func TestSubmitOvertime(t *testing.T) {
+	utcUser := &userEntity.User{ID: "user-1", Timezone: "UTC"}
+	newYorkUser := &userEntity.User{ID: "user-1", Timezone: "America/New_York"}
+	credential := authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+
 	type testCase struct {
 		name           string
 		authCredential authCredential.Credential
+		user           *userEntity.User
 		payload        entity.SubmitOvertime
 		overtime       entity.Overtime
 		expectedErr    error
 		mockNow        *time.Time
-		setupMock      func(repo *mockOvertime.MockRepository, txRepo *mockOvertime.MockRepository)
+		setupMock      func(repo *mockOvertime.MockRepository, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo *mockAttendance.MockRepository, attendanceTxRepo *mockAttendance.MockRepository)
 	}
 
 	tests := []testCase{
@@ -41,6 +58,7 @@ func TestSubmitOvertime(t *testing.T) {
 				IsAdmin:   func(b bool) *bool { return &b }(false),
 				RequestID: "req-123",
 			},
+			user: utcUser,
 			payload: entity.SubmitOvertime{
 				OvertimeDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 				Overtime:     "PT3H",
@@ -51,8 +69,12 @@ func TestSubmitOvertime(t *testing.T) {
 				OvertimeHours: 3 * time.Hour,
 			},
 			expectedErr: nil,
-			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
 				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				attendanceRepo.EXPECT().WithTx(gomock.Any()).Return(attendanceTxRepo)
+				attendanceTxRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
 				txRepo.EXPECT().
 					FindOvertimeByUserIDDate(gomock.Any(), "user-1", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)).
 					Return(nil, nil)
@@ -81,6 +103,7 @@ func TestSubmitOvertime(t *testing.T) {
 				IsAdmin:   func(b bool) *bool { return &b }(false),
 				RequestID: "req-123",
 			},
+			user: utcUser,
 			payload: entity.SubmitOvertime{
 				OvertimeDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 				Overtime:     "PT4H",
@@ -90,8 +113,12 @@ func TestSubmitOvertime(t *testing.T) {
 					IssueCode: entity.OvertimeExceedsLimit,
 					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeExceedsLimit),
 				}),
-			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
 				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				attendanceRepo.EXPECT().WithTx(gomock.Any()).Return(attendanceTxRepo)
+				attendanceTxRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
 				txRepo.EXPECT().
 					FindOvertimeByUserIDDate(gomock.Any(), "user-1", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)).
 					Return(nil, nil)
@@ -107,6 +134,7 @@ func TestSubmitOvertime(t *testing.T) {
 				IsAdmin:   func(b bool) *bool { return &b }(false),
 				RequestID: "req-123",
 			},
+			user: utcUser,
 			payload: entity.SubmitOvertime{
 				OvertimeDate: time.Date(2023, 10, 1, 9, 0, 0, 0, time.UTC),
 				Overtime:     "PT2H",
@@ -117,9 +145,136 @@ func TestSubmitOvertime(t *testing.T) {
 					IssueCode: entity.OvertimeInvalidTimeRequest,
 					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeInvalidTimeRequest),
 				}),
-			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
+				holidayRepo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
 			},
 		},
+		{
+			name: "success - overtime submitted during working hours on a holiday",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			user: utcUser,
+			payload: entity.SubmitOvertime{
+				OvertimeDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+				Overtime:     "PT2H",
+			},
+			mockNow:     ptrTime(time.Date(2023, 10, 2, 10, 0, 0, 0, time.UTC)), // Monday 10 AM
+			expectedErr: nil,
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
+				holidayRepo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]holidayEntity.Holiday{
+						{
+							ID:          "holiday-1",
+							HolidayDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+							Name:        "Company Day",
+						},
+					}, nil)
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				attendanceRepo.EXPECT().WithTx(gomock.Any()).Return(attendanceTxRepo)
+				attendanceTxRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+				txRepo.EXPECT().
+					FindOvertimeByUserIDDate(gomock.Any(), "user-1", time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+				txRepo.EXPECT().
+					UpsertOvertime(gomock.Any(), gomock.Any()).
+					Return(nil)
+			},
+		},
+		{
+			name: "error - period closed by its payroll",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			user: utcUser,
+			payload: entity.SubmitOvertime{
+				OvertimeDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				Overtime:     "PT2H",
+			},
+			mockNow: ptrTime(time.Date(2023, 10, 7, 20, 0, 0, 0, time.UTC)),
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimePeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePeriodClosed),
+				}),
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				attendanceRepo.EXPECT().WithTx(gomock.Any()).Return(attendanceTxRepo)
+				attendanceTxRepo.EXPECT().
+					FindClosedPeriodByDate(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)).
+					Return(&attendanceEntity.ClosedAttendancePeriod{
+						AttendancePeriod: attendanceEntity.AttendancePeriod{ID: "period-1"},
+						PayrollID:        "payroll-1",
+					}, nil)
+				// No FindOvertimeByUserIDDate or Upsert expected
+			},
+		},
+		{
+			name:           "success - after local working hours once clocks spring forward",
+			authCredential: credential,
+			user:           newYorkUser,
+			payload: entity.SubmitOvertime{
+				OvertimeDate: time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+				Overtime:     "PT2H",
+			},
+			mockNow:     ptrTime(time.Date(2025, 3, 10, 22, 30, 0, 0, time.UTC)), // Monday 18:30 EDT
+			expectedErr: nil,
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				attendanceRepo.EXPECT().WithTx(gomock.Any()).Return(attendanceTxRepo)
+				attendanceTxRepo.EXPECT().FindClosedPeriodByDate(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().FindOvertimeByUserIDDate(gomock.Any(), "user-1", gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().UpsertOvertime(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "error - within local working hours before clocks spring forward",
+			authCredential: credential,
+			user:           newYorkUser,
+			payload: entity.SubmitOvertime{
+				OvertimeDate: time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC),
+				Overtime:     "PT2H",
+			},
+			mockNow: ptrTime(time.Date(2025, 3, 7, 22, 30, 0, 0, time.UTC)), // Friday 17:30 EST
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimeInvalidTimeRequest,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeInvalidTimeRequest),
+				}),
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository, holidayRepo *mockHoliday.MockRepository, attendanceRepo, attendanceTxRepo *mockAttendance.MockRepository) {
+				holidayRepo.EXPECT().
+					FindHolidaysByRange(gomock.Any(), time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 7, 0, 0, 0, 0, time.UTC)).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: credential,
+			user:           nil,
+			payload: entity.SubmitOvertime{
+				OvertimeDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				Overtime:     "PT2H",
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.OvertimeUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeUserNotFound),
+					Received:  "user-1",
+				}),
+		},
 	}
 
 	for _, tt := range tests {
@@ -145,12 +300,17 @@ func TestSubmitOvertime(t *testing.T) {
 
 			mockRepo := mockOvertime.NewMockRepository(ctrl)
 			mockRepoTx := mockOvertime.NewMockRepository(ctrl)
+			mockHolidayRepo := mockHoliday.NewMockRepository(ctrl)
+			mockAttendanceRepo := mockAttendance.NewMockRepository(ctrl)
+			mockAttendanceRepoTx := mockAttendance.NewMockRepository(ctrl)
+			mockUserRepo := mockUser.NewMockRepository(ctrl)
 
+			mockUserRepo.EXPECT().FindUserByID(gomock.Any(), tt.authCredential.UserID).Return(tt.user, nil)
 			if tt.setupMock != nil {
-				tt.setupMock(mockRepo, mockRepoTx)
+				tt.setupMock(mockRepo, mockRepoTx, mockHolidayRepo, mockAttendanceRepo, mockAttendanceRepoTx)
 			}
 
-			useCase := usecase.NewOvertimeUseCase(mockRepo)
+			useCase := usecase.NewOvertimeUseCase(mockRepo, mockHolidayRepo, mockAttendanceRepo, mockUserRepo)
 
 			err := useCase.SubmitOvertime(context.Background(), tt.authCredential, tt.payload)
 
@@ -163,6 +323,257 @@ func TestSubmitOvertime(t *testing.T) {
 	}
 }
 
+func TestGetOvertimePolicy(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expected       entity.OvertimePolicy
+		expectedErr    error
+		setupMock      func(repo *mockOvertime.MockRepository)
+	}
+
+	policy := entity.OvertimePolicy{
+		ID:                  "policy-1",
+		Version:             1,
+		WeekdayMultiplier:   1.5,
+		WeekendMultiplier:   2,
+		StandardHoursPerDay: 8,
+		Tiers:               []entity.OvertimeTier{{AfterHours: 1, Multiplier: 2}},
+	}
+
+	tests := []testCase{
+		{
+			name: "success - active policy returned",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			expected: policy,
+			setupMock: func(repo *mockOvertime.MockRepository) {
+				repo.EXPECT().FindActivePolicy(gomock.Any()).Return(&policy, nil)
+			},
+		},
+		{
+			name: "error - policy not configured",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.OvertimePolicyNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePolicyNotFound),
+				}),
+			setupMock: func(repo *mockOvertime.MockRepository) {
+				repo.EXPECT().FindActivePolicy(gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.OvertimeNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeNotAuthorized),
+				}),
+			setupMock: func(repo *mockOvertime.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockOvertime.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			useCase := usecase.NewOvertimeUseCase(mockRepo, nil, nil, nil)
+
+			result, err := useCase.GetOvertimePolicy(context.Background(), tt.authCredential)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestUpdateOvertimePolicy(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateOvertimePolicy
+		expected       entity.OvertimePolicy
+		expectedErr    error
+		setupMock      func(repo *mockOvertime.MockRepository, txRepo *mockOvertime.MockRepository)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	payload := entity.UpdateOvertimePolicy{
+		WeekdayMultiplier:   1.5,
+		WeekendMultiplier:   2,
+		StandardHoursPerDay: 8,
+		Tiers: []entity.OvertimeTier{
+			{AfterHours: 1, Multiplier: 2},
+			{AfterHours: 3, Multiplier: 3},
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - new version stored",
+			authCredential: adminCredential,
+			payload:        payload,
+			expected: entity.OvertimePolicy{
+				Version:             3,
+				WeekdayMultiplier:   1.5,
+				WeekendMultiplier:   2,
+				StandardHoursPerDay: 8,
+				Tiers:               payload.Tiers,
+				CreatedBy:           "admin-1",
+				UpdatedBy:           "admin-1",
+				IPAddress:           "127.0.0.1",
+			},
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().
+					FindActivePolicy(gomock.Any(), entity.FindOvertimePolicyOptions{PessimisticLock: true}).
+					Return(&entity.OvertimePolicy{ID: "policy-2", Version: 2}, nil)
+				txRepo.EXPECT().
+					StoreNewPolicy(gomock.Any(), mock.MatchedBy(func(args entity.OvertimePolicy) bool {
+						return testutil.EqualVerbose(
+							entity.OvertimePolicy{
+								Version:             3,
+								WeekdayMultiplier:   1.5,
+								WeekendMultiplier:   2,
+								StandardHoursPerDay: 8,
+								Tiers:               payload.Tiers,
+								CreatedBy:           "admin-1",
+								UpdatedBy:           "admin-1",
+								IPAddress:           "127.0.0.1",
+							},
+							args,
+							cmpopts.IgnoreFields(entity.OvertimePolicy{}, "ID", "CreatedAt", "UpdatedAt"),
+						)
+					})).
+					Return(nil)
+			},
+		},
+		{
+			name:           "success - first policy",
+			authCredential: adminCredential,
+			payload:        entity.UpdateOvertimePolicy{WeekdayMultiplier: 1, WeekendMultiplier: 1, StandardHoursPerDay: 8},
+			expected: entity.OvertimePolicy{
+				Version:             1,
+				WeekdayMultiplier:   1,
+				WeekendMultiplier:   1,
+				StandardHoursPerDay: 8,
+				Tiers:               []entity.OvertimeTier{},
+				CreatedBy:           "admin-1",
+				UpdatedBy:           "admin-1",
+				IPAddress:           "127.0.0.1",
+			},
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {
+				repo.EXPECT().WithTx(gomock.Any()).Return(txRepo)
+				txRepo.EXPECT().FindActivePolicy(gomock.Any(), gomock.Any()).Return(nil, nil)
+				txRepo.EXPECT().StoreNewPolicy(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "error - tiers not increasing",
+			authCredential: adminCredential,
+			payload: entity.UpdateOvertimePolicy{
+				WeekdayMultiplier:   1.5,
+				WeekendMultiplier:   2,
+				StandardHoursPerDay: 8,
+				Tiers: []entity.OvertimeTier{
+					{AfterHours: 3, Multiplier: 3},
+					{AfterHours: 1, Multiplier: 2},
+				},
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.OvertimePolicyInvalidTiers,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePolicyInvalidTiers),
+				}),
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payload: payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.OvertimeNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.OvertimeNotAuthorized),
+				}),
+			setupMock: func(repo, txRepo *mockOvertime.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mockOvertime.NewMockRepository(ctrl)
+			mockRepoTx := mockOvertime.NewMockRepository(ctrl)
+			tt.setupMock(mockRepo, mockRepoTx)
+
+			useCase := usecase.NewOvertimeUseCase(mockRepo, nil, nil, nil)
+
+			result, err := useCase.UpdateOvertimePolicy(context.Background(), tt.authCredential, tt.payload)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, testutil.EqualVerbose(tt.expected, result,
+					cmpopts.IgnoreFields(entity.OvertimePolicy{}, "ID", "CreatedAt", "UpdatedAt"),
+				))
+			}
+		})
+	}
+}
+
 func ptrTime(t time.Time) *time.Time {
 	return &t
 }
@@ -1,6 +1,11 @@
 package v1
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -9,27 +14,34 @@ import (
 	"github.com/vnnyx/employee-management/internal/constants"
 	"github.com/vnnyx/employee-management/internal/dtos"
 	"github.com/vnnyx/employee-management/internal/payroll"
+	"github.com/vnnyx/employee-management/internal/payroll/document"
+	"github.com/vnnyx/employee-management/internal/payroll/entity"
+	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
 	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
 type PayrollHandler struct {
-	uc payroll.UseCase
+	uc              payroll.UseCase
+	payslipRenderer *document.PayslipRenderer
+	bankFileWriter  *document.BankFileWriter
 }
 
-func NewPayrollHandler(uc payroll.UseCase) *PayrollHandler {
+func NewPayrollHandler(uc payroll.UseCase, payslipRenderer *document.PayslipRenderer, bankFileWriter *document.BankFileWriter) *PayrollHandler {
 	return &PayrollHandler{
-		uc: uc,
+		uc:              uc,
+		payslipRenderer: payslipRenderer,
+		bankFileWriter:  bankFileWriter,
 	}
 }
 
 // @Summary      Generate Payroll
-// @Description  Generate payroll for a specific period
+// @Description  Queue the payroll generation of a specific period, its progress is tracked with the returned job
 // @Tags         Payroll
 // @Accept       json
 // @Produce      json
 // @Param        request body dtos.GeneratePayrollRequest true "Generate Payroll Request"
-// @Success      201 {object} dtos.Response{request,data=dtos.GeneratedPayrollResponse} "Generated Payroll Response"
+// @Success      202 {object} dtos.Response{data=dtos.PayrollJobResponse} "Payroll Job Response"
 // @Failure      400 {object} apperror.Error "Bad Request"
 // @Router        /v1/payroll [POST]
 // @Security     BearerAuth
@@ -52,6 +64,95 @@ func (h *PayrollHandler) GeneratePayroll(c *fiber.Ctx) error {
 		return errors.Wrap(err, "PayrollHandler().GeneratePayroll().uc.GeneratePayroll()")
 	}
 
+	return c.Status(fiber.StatusAccepted).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollJobResponse(data),
+		},
+	)
+}
+
+// @Summary      Show Payroll Job
+// @Description  Show the status, progress and outcome of a payroll generation job
+// @Tags         Payroll
+// @Produce      json
+// @Param        jobId path string true "Payroll Job ID"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollJobResponse} "Payroll Job Response"
+// @Failure      404 {object} apperror.Error "Not Found"
+// @Router       /v1/payroll/jobs/{jobId} [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) ShowPayrollJob(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.ShowPayrollJob()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		JobID uuid.UUID `params:"jobId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ShowPayrollJob().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowPayrollJob(ctx, authCredential, param.JobID.String())
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ShowPayrollJob().uc.ShowPayrollJob()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollJobResponse(data),
+		},
+	)
+}
+
+// @Summary      Regenerate Payroll
+// @Description  Void the active payroll of a period and generate a new version of it
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Param        request body dtos.RegeneratePayrollRequest true "Regenerate Payroll Request"
+// @Success      201 {object} dtos.Response{data=dtos.GeneratedPayrollResponse} "Generated Payroll Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/regenerate [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) RegeneratePayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.RegeneratePayroll()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().RegeneratePayroll().c.ParamsParser()")
+	}
+
+	var req dtos.RegeneratePayrollRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().RegeneratePayroll().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().RegeneratePayroll().req.Validate()")
+	}
+
+	data, err := h.uc.RegeneratePayroll(ctx, authCredential, param.PayrollID.String(), req.Reason)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().RegeneratePayroll().uc.RegeneratePayroll()")
+	}
+
 	return c.Status(fiber.StatusCreated).JSON(
 		dtos.Response{
 			RequestID: authCredential.RequestID,
@@ -60,6 +161,259 @@ func (h *PayrollHandler) GeneratePayroll(c *fiber.Ctx) error {
 	)
 }
 
+// @Summary      Submit Payroll
+// @Description  Submit a draft payroll for review by another admin
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Param        request body dtos.PayrollTransitionRequest false "Payroll Transition Request"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollApprovalResponse} "Payroll Approval Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/submit [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) SubmitPayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.SubmitPayroll()",
+	)
+	defer span.End()
+
+	var req dtos.PayrollTransitionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "PayrollHandler().SubmitPayroll().c.BodyParser()")
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().SubmitPayroll().req.Validate()")
+	}
+
+	err := h.transitionPayroll(ctx, c, entity.PayrollActionSubmit, req.Comment)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().SubmitPayroll().transitionPayroll()")
+	}
+
+	return nil
+}
+
+// @Summary      Approve Payroll
+// @Description  Approve a submitted payroll, its payslips become visible to the employees. The admin who submitted it cannot approve it
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Param        request body dtos.PayrollTransitionRequest false "Payroll Transition Request"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollApprovalResponse} "Payroll Approval Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Failure      403 {object} apperror.Error "Forbidden"
+// @Router       /v1/payroll/{payrollId}/approve [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) ApprovePayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.ApprovePayroll()",
+	)
+	defer span.End()
+
+	var req dtos.PayrollTransitionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "PayrollHandler().ApprovePayroll().c.BodyParser()")
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().ApprovePayroll().req.Validate()")
+	}
+
+	err := h.transitionPayroll(ctx, c, entity.PayrollActionApprove, req.Comment)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ApprovePayroll().transitionPayroll()")
+	}
+
+	return nil
+}
+
+// @Summary      Reject Payroll
+// @Description  Send a submitted payroll back to draft with a comment on what to fix. The admin who submitted it cannot reject it
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Param        request body dtos.RejectPayrollRequest true "Reject Payroll Request"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollApprovalResponse} "Payroll Approval Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Failure      403 {object} apperror.Error "Forbidden"
+// @Router       /v1/payroll/{payrollId}/reject [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) RejectPayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.RejectPayroll()",
+	)
+	defer span.End()
+
+	var req dtos.RejectPayrollRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().RejectPayroll().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().RejectPayroll().req.Validate()")
+	}
+
+	err := h.transitionPayroll(ctx, c, entity.PayrollActionReject, req.Comment)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().RejectPayroll().transitionPayroll()")
+	}
+
+	return nil
+}
+
+// @Summary      Pay Payroll
+// @Description  Mark an approved payroll as paid once the bank transfers are done
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Param        request body dtos.PayrollTransitionRequest false "Payroll Transition Request"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollApprovalResponse} "Payroll Approval Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/pay [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) PayPayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.PayPayroll()",
+	)
+	defer span.End()
+
+	var req dtos.PayrollTransitionRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return errors.Wrap(err, "PayrollHandler().PayPayroll().c.BodyParser()")
+		}
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().PayPayroll().req.Validate()")
+	}
+
+	err := h.transitionPayroll(ctx, c, entity.PayrollActionPay, req.Comment)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().PayPayroll().transitionPayroll()")
+	}
+
+	return nil
+}
+
+func (h *PayrollHandler) transitionPayroll(ctx context.Context, c *fiber.Ctx, action entity.PayrollAction, comment string) error {
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().transitionPayroll().c.ParamsParser()")
+	}
+
+	data, err := h.uc.TransitionPayroll(ctx, authCredential, param.PayrollID.String(), action, comment)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().transitionPayroll().uc.TransitionPayroll()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollApprovalResponse(data),
+		},
+	)
+}
+
+// @Summary      List Payroll Approvals
+// @Description  List the approval trail of a payroll with the comment of every action, oldest first
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID"
+// @Success      200 {object} dtos.Response{data=[]dtos.PayrollApprovalResponse} "Payroll Approvals Response"
+// @Failure      404 {object} apperror.Error "Not Found"
+// @Router       /v1/payroll/{payrollId}/approvals [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) ListPayrollApprovals(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.ListPayrollApprovals()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ListPayrollApprovals().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ListPayrollApprovals(ctx, authCredential, param.PayrollID.String())
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ListPayrollApprovals().uc.ListPayrollApprovals()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListPayrollApprovalResponse(data),
+		},
+	)
+}
+
+// @Summary      Preview Payroll
+// @Description  Calculate payroll for a specific period without storing anything
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        request body dtos.GeneratePayrollRequest true "Preview Payroll Request"
+// @Success      200 {object} dtos.Response{data=dtos.PayrollPreviewResponse} "Payroll Preview Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/preview [POST]
+// @Security     BearerAuth
+func (h *PayrollHandler) PreviewPayroll(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.PreviewPayroll()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.GeneratePayrollRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().PreviewPayroll().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().PreviewPayroll().req.Validate()")
+	}
+
+	data, err := h.uc.PreviewPayroll(ctx, authCredential, req.PeriodID)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().PreviewPayroll().uc.PreviewPayroll()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollPreviewResponse(data),
+		},
+	)
+}
+
 // @Summary      Show Payslip
 // @Description  Show payslip for a specific payroll
 // @Tags         Payroll
@@ -154,3 +508,346 @@ func (h *PayrollHandler) ListPayslips(c *fiber.Ctx) error {
 
 	return c.Status(fiber.StatusOK).JSON(data.Response(authCredential.RequestID))
 }
+
+// @Summary      Download Payslip
+// @Description  Download the payslip of the current user for a specific payroll as a PDF
+// @Tags         Payroll
+// @Produce      application/pdf
+// @Param        payrollId path string true "Payroll ID"
+// @Success      200 {file} file "Payslip PDF"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/payslip.pdf [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) DownloadPayslip(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.DownloadPayslip()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadPayslip().c.ParamsParser()")
+	}
+
+	data, err := h.uc.ShowPayslip(ctx, authCredential, param.PayrollID.String())
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadPayslip().uc.ShowPayslip()")
+	}
+
+	var buf bytes.Buffer
+	err = h.payslipRenderer.RenderPayslip(&buf, *data)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadPayslip().payslipRenderer.RenderPayslip()")
+	}
+
+	c.Set(fiber.HeaderContentType, "application/pdf")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, document.PayslipFileName(*data)))
+	return c.Status(fiber.StatusOK).Send(buf.Bytes())
+}
+
+// @Summary      Download Payslips
+// @Description  Download the payslips of every employee for a specific payroll as a ZIP of PDFs
+// @Tags         Payroll
+// @Produce      application/zip
+// @Param        payrollId path string true "Payroll ID"
+// @Success      200 {file} file "Payslips ZIP"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/payslips.zip [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) DownloadPayslips(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.DownloadPayslips()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadPayslips().c.ParamsParser()")
+	}
+
+	// Everything that can fail with an error response is loaded up front, the
+	// archive itself is written after the headers have been sent.
+	export, err := h.uc.ExportPayslips(ctx, authCredential, param.PayrollID.String())
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadPayslips().uc.ExportPayslips()")
+	}
+
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, document.PayslipArchiveFileName(export)))
+	c.Status(fiber.StatusOK).Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		_, span := instrumentation.NewTraceSpan(
+			ctx,
+			"PayrollHandler.DownloadPayslips().WritePayslipArchive()",
+		)
+		defer span.End()
+
+		err := h.payslipRenderer.WritePayslipArchive(w, export)
+		if err == nil {
+			err = w.Flush()
+		}
+		if err != nil {
+			// The status is already sent, a truncated archive is all the
+			// client can be given.
+			span.RecordError(err)
+		}
+	})
+
+	return nil
+}
+
+// @Summary      Download Bank Transfers
+// @Description  Download the take-home transfers of a payroll as a bank disbursement file. Employees without a take-home pay are left out, every other employee needs a bank account.
+// @Tags         Payroll
+// @Produce      text/csv
+// @Produce      text/plain
+// @Produce      application/xml
+// @Param        payrollId path string true "Payroll ID"
+// @Param        format query string true "File format" Enums(csv, fixed_width, pain001)
+// @Param        execution_date query string false "Requested execution date (YYYY-MM-DD), defaults to today"
+// @Success      200 {file} file "Bank disbursement file"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/payroll/{payrollId}/bank-transfers [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) DownloadBankTransfers(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.DownloadBankTransfers()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID uuid.UUID `params:"payrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadBankTransfers().c.ParamsParser()")
+	}
+
+	var req dtos.ExportBankTransfersRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadBankTransfers().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadBankTransfers().req.Validate()")
+	}
+
+	if !h.bankFileWriter.Configured() {
+		return apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.BankNotConfigured,
+				Message:   entity.GetErrorMessageByIssueCode(entity.BankNotConfigured),
+			},
+		)
+	}
+
+	format, executionDate := req.ToRequestEntity()
+	batch, err := h.uc.ExportBankTransfers(ctx, authCredential, param.PayrollID.String(), executionDate)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadBankTransfers().uc.ExportBankTransfers()")
+	}
+
+	var buf bytes.Buffer
+	err = h.bankFileWriter.WriteBankFile(&buf, format, batch)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DownloadBankTransfers().bankFileWriter.WriteBankFile()")
+	}
+
+	c.Set(fiber.HeaderContentType, format.ContentType())
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, document.BankFileName(batch, format)))
+	return c.Status(fiber.StatusOK).Send(buf.Bytes())
+}
+
+// @Summary      Diff Payrolls
+// @Description  Compare the payslips of two payroll runs per employee, like two periods or a regenerated payroll and the version it voided. Changes reaching a threshold are flagged as anomalies, a zero threshold turns its flag off
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payrollId path string true "Payroll ID to compare from"
+// @Param        otherPayrollId path string true "Payroll ID to compare to"
+// @Param        take_home_change_percent query int false "Flag a take-home pay change of at least this percent" default(20)
+// @Param        base_salary_change_percent query int false "Flag a base salary change of at least this percent" default(10)
+// @Param        attendance_days_change query int false "Flag an attendance change of at least this many days" default(5)
+// @Param        overtime_hours_change query int false "Flag an overtime change of at least this many hours" default(10)
+// @Success      200 {object} dtos.Response{data=dtos.PayrollDiffResponse} "Payroll Diff Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Failure      404 {object} apperror.Error "Not Found"
+// @Router       /v1/payroll/{payrollId}/diff/{otherPayrollId} [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) DiffPayrolls(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.DiffPayrolls()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayrollID      uuid.UUID `params:"payrollId"`
+		OtherPayrollID uuid.UUID `params:"otherPayrollId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DiffPayrolls().c.ParamsParser()")
+	}
+
+	req := dtos.NewDiffPayrollsRequest()
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().DiffPayrolls().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().DiffPayrolls().req.Validate()")
+	}
+
+	data, err := h.uc.DiffPayrolls(ctx, authCredential, param.PayrollID.String(), param.OtherPayrollID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().DiffPayrolls().uc.DiffPayrolls()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollDiffResponse(data),
+		},
+	)
+}
+
+// @Summary      List My Payslips
+// @Description  List the payslips of the logged in employee across payrolls, latest period first. Only approved and paid payrolls are listed
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Success      200 {object} dtos.Response{data=[]dtos.MyPayslipResponse} "My Payslips Response"
+// @Router       /v1/me/payslips [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) ListMyPayslips(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.ListMyPayslips()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	data, err := h.uc.ListMyPayslips(ctx, authCredential)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ListMyPayslips().uc.ListMyPayslips()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListMyPayslipResponse(data),
+		},
+	)
+}
+
+// @Summary      Show My Year-to-Date Pay
+// @Description  Total the payslips of the logged in employee whose period ends within a calendar or fiscal year. A fiscal year is named after the year it starts in
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        basis query string false "Year basis" Enums(calendar, fiscal) default(calendar)
+// @Param        year query int false "Year, the current one when omitted"
+// @Success      200 {object} dtos.Response{data=dtos.PayslipYearToDateResponse} "Year-to-Date Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/me/payslips/ytd [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) ShowPayslipYearToDate(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.ShowPayslipYearToDate()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.PayslipYearToDateRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().ShowPayslipYearToDate().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().ShowPayslipYearToDate().req.Validate()")
+	}
+
+	basis, year := req.ToRequestEntity()
+	data, err := h.uc.ShowPayslipYearToDate(ctx, authCredential, basis, year)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().ShowPayslipYearToDate().uc.ShowPayslipYearToDate()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayslipYearToDateResponse(data),
+		},
+	)
+}
+
+// @Summary      Verify Payslip
+// @Description  Check the verification ID printed on a payslip document against the payslip as stored. A superseded payslip was issued for a payroll that has since been regenerated.
+// @Tags         Payroll
+// @Accept       json
+// @Produce      json
+// @Param        payslipId path string true "Payslip ID"
+// @Param        verification_id query string true "Verification ID printed on the payslip"
+// @Success      200 {object} dtos.Response{data=dtos.PayslipVerificationResponse} "Payslip Verification Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Failure      404 {object} apperror.Error "Not Found"
+// @Router       /v1/payroll/payslips/{payslipId}/verify [GET]
+// @Security     BearerAuth
+func (h *PayrollHandler) VerifyPayslip(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"PayrollHandler.VerifyPayslip()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		PayslipID uuid.UUID `params:"payslipId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().VerifyPayslip().c.ParamsParser()")
+	}
+
+	var req dtos.VerifyPayslipRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "PayrollHandler().VerifyPayslip().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "PayrollHandler().VerifyPayslip().req.Validate()")
+	}
+
+	data, err := h.uc.VerifyPayslip(ctx, authCredential, param.PayslipID.String(), req.VerificationID)
+	if err != nil {
+		return errors.Wrap(err, "PayrollHandler().VerifyPayslip().uc.VerifyPayslip()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayslipVerificationResponse(data),
+		},
+	)
+}
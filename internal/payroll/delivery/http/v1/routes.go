@@ -6,6 +6,24 @@ func MapPayroll(routes fiber.Router, h *PayrollHandler) {
 	payroll := routes.Group("/payroll")
 
 	payroll.Post("/", h.GeneratePayroll)
+	payroll.Post("/preview", h.PreviewPayroll)
+	payroll.Get("/jobs/:jobId", h.ShowPayrollJob)
+	payroll.Get("/payslips/:payslipId/verify", h.VerifyPayslip)
+	payroll.Post("/:payrollId/regenerate", h.RegeneratePayroll)
+	payroll.Post("/:payrollId/submit", h.SubmitPayroll)
+	payroll.Post("/:payrollId/approve", h.ApprovePayroll)
+	payroll.Post("/:payrollId/reject", h.RejectPayroll)
+	payroll.Post("/:payrollId/pay", h.PayPayroll)
+	payroll.Get("/:payrollId/approvals", h.ListPayrollApprovals)
+	payroll.Get("/:payrollId/diff/:otherPayrollId", h.DiffPayrolls)
 	payroll.Get("/:payrollId/payslip", h.ShowPayslip)
+	payroll.Get("/:payrollId/payslip.pdf", h.DownloadPayslip)
 	payroll.Get("/:payrollId/payslips", h.ListPayslips)
+	payroll.Get("/:payrollId/payslips.zip", h.DownloadPayslips)
+	payroll.Get("/:payrollId/bank-transfers", h.DownloadBankTransfers)
+
+	me := routes.Group("/me")
+
+	me.Get("/payslips", h.ListMyPayslips)
+	me.Get("/payslips/ytd", h.ShowPayslipYearToDate)
 }
@@ -0,0 +1,38 @@
+package document
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/payroll/entity"
+)
+
+// PayslipArchiveFileName is the name the bulk download is saved as.
+func PayslipArchiveFileName(export entity.PayslipExport) string {
+	return fmt.Sprintf("payslips-%s-%s.zip", formatFileDate(export.AttendancePeriod.StartDate), formatFileDate(export.AttendancePeriod.EndDate))
+}
+
+// WritePayslipArchive streams a ZIP with the PDF of every payslip. Each
+// document is rendered straight into the archive, so memory use does not grow
+// with the number of employees.
+func (r *PayslipRenderer) WritePayslipArchive(w io.Writer, export entity.PayslipExport) error {
+	zw := zip.NewWriter(w)
+
+	for _, data := range export.Payslips {
+		file, err := zw.Create(PayslipFileName(data))
+		if err != nil {
+			return errors.Wrap(err, "PayslipRenderer.WritePayslipArchive().Create()")
+		}
+
+		if err := r.RenderPayslip(file, data); err != nil {
+			return errors.Wrap(err, "PayslipRenderer.WritePayslipArchive().RenderPayslip()")
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "PayslipRenderer.WritePayslipArchive().Close()")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package document
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/payroll/entity"
+	"github.com/vnnyx/employee-management/pkg/bankfile"
+)
+
+type BankFileConfig struct {
+	// Debtor is the company account salaries are paid from.
+	Debtor   bankfile.Account
+	Currency string
+}
+
+type BankFileWriter struct {
+	debtor   bankfile.Account
+	currency string
+}
+
+func NewBankFileWriter(config BankFileConfig) *BankFileWriter {
+	return &BankFileWriter{
+		debtor:   config.Debtor,
+		currency: config.Currency,
+	}
+}
+
+// Configured reports whether the company account to pay from is set.
+func (b *BankFileWriter) Configured() bool {
+	return b.debtor.AccountNumber != ""
+}
+
+// BankFileName is the name a bank disbursement file is downloaded as.
+func BankFileName(batch entity.BankTransferBatch, format bankfile.Format) string {
+	return fmt.Sprintf("bank-transfers-%s-%s.%s", formatFileDate(batch.AttendancePeriod.StartDate), formatFileDate(batch.AttendancePeriod.EndDate), format.Extension())
+}
+
+// WriteBankFile writes the transfers of a payroll in the layout of the given
+// format. The payroll and payslip IDs identify the batch and its transfers,
+// without dashes to stay within the 35 characters banks accept.
+func (b *BankFileWriter) WriteBankFile(w io.Writer, format bankfile.Format, batch entity.BankTransferBatch) error {
+	// Banks often accept only the basic Latin characters in remittance
+	// information, so the period is written without the en dash.
+	remittance := fmt.Sprintf("Salary %s - %s", formatDate(batch.AttendancePeriod.StartDate), formatDate(batch.AttendancePeriod.EndDate))
+
+	transfers := make([]bankfile.Transfer, 0, len(batch.Transfers))
+	for _, transfer := range batch.Transfers {
+		transfers = append(transfers, bankfile.Transfer{
+			EndToEndID: compactID(transfer.PayslipID),
+			Creditor: bankfile.Account{
+				Name:          transfer.AccountHolderName,
+				AccountNumber: transfer.AccountNumber,
+				BankCode:      transfer.BankCode,
+				BIC:           transfer.BIC.GetOrDefault(""),
+			},
+			Amount:                transfer.Amount,
+			RemittanceInformation: remittance,
+		})
+	}
+
+	err := bankfile.Write(w, format, bankfile.Batch{
+		MessageID:     compactID(batch.PayrollID),
+		CreatedAt:     batch.CreatedAt,
+		ExecutionDate: batch.ExecutionDate,
+		Currency:      b.currency,
+		Debtor:        b.debtor,
+		Transfers:     transfers,
+	})
+	if err != nil {
+		return errors.Wrap(err, "BankFileWriter.WriteBankFile().Write()")
+	}
+	return nil
+}
+
+func compactID(id string) string {
+	return strings.ReplaceAll(id, "-", "")
+}
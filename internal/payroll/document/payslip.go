@@ -0,0 +1,326 @@
+// Package document renders payslips as downloadable files. Rendering happens
+// in process with pkg/pdf, no external service is involved.
+package document
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/payroll/entity"
+	"github.com/vnnyx/employee-management/pkg/iso8601"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/pdf"
+)
+
+const (
+	margin       = 48.0
+	headerHeight = 92.0
+	footerHeight = 48.0
+	rowHeight    = 18.0
+)
+
+var (
+	brandColor = pdf.Color{R: 31, G: 58, B: 96}
+	mutedColor = pdf.Color{R: 110, G: 110, B: 110}
+	ruleColor  = pdf.Color{R: 210, G: 210, B: 210}
+	bandColor  = pdf.Color{R: 236, G: 241, B: 247}
+)
+
+type PayslipRendererConfig struct {
+	// CompanyName is printed in the header of every payslip.
+	CompanyName string
+}
+
+type PayslipRenderer struct {
+	companyName string
+}
+
+func NewPayslipRenderer(config PayslipRendererConfig) *PayslipRenderer {
+	return &PayslipRenderer{
+		companyName: config.CompanyName,
+	}
+}
+
+// PayslipFileName is the name a payslip document is downloaded as.
+func PayslipFileName(data entity.PayslipData) string {
+	return fmt.Sprintf("payslip-%s-%s.pdf", sanitizeFileName(data.User.Username), formatFileDate(data.AttendancePeriod.StartDate))
+}
+
+// RenderPayslip writes the payslip as a PDF document.
+func (r *PayslipRenderer) RenderPayslip(w io.Writer, data entity.PayslipData) error {
+	doc := pdf.New("Payslip " + data.User.Username + " " + formatPeriod(data.AttendancePeriod))
+	l := &layout{
+		doc:    doc,
+		footer: footerText(data),
+	}
+	l.newPage()
+
+	r.writeHeader(l, data)
+	writeDetails(l, data)
+	writeEarnings(l, data)
+	writeNonTaxableEarnings(l, data)
+	writeReimbursements(l, data)
+	writeDeductions(l, data)
+	writeTakeHome(l, data)
+
+	if _, err := doc.WriteTo(w); err != nil {
+		return errors.Wrap(err, "PayslipRenderer.RenderPayslip().WriteTo()")
+	}
+	return nil
+}
+
+func (r *PayslipRenderer) writeHeader(l *layout, data entity.PayslipData) {
+	l.page.Rect(0, 0, pdf.PageWidth, headerHeight, brandColor)
+	l.page.Text(margin, 50, pdf.FontBold, 20, pdf.White, r.companyName)
+	l.page.TextRight(pdf.PageWidth-margin, 44, pdf.FontBold, 14, pdf.White, "PAYSLIP")
+	l.page.TextRight(pdf.PageWidth-margin, 62, pdf.FontRegular, 10, pdf.White, formatPeriod(data.AttendancePeriod))
+	l.y = headerHeight + 36
+}
+
+func writeDetails(l *layout, data entity.PayslipData) {
+	details := [][2][2]string{
+		{{"Employee", data.User.Username}, {"Payslip ID", data.ID}},
+		{{"Employee ID", data.User.ID}, {"Verification ID", data.VerificationID}},
+		{{"Base salary", formatAmount(data.BaseSalary)}, {"Working days", strconv.FormatInt(data.WorkingDays, 10)}},
+		{{"Eligible days", strconv.FormatInt(data.EligibleWorkingDays, 10)}, {"Proration", strconv.FormatFloat(data.ProrationFactor, 'f', 4, 64)}},
+	}
+
+	column := (pdf.PageWidth - 2*margin) / 2
+	for _, row := range details {
+		for i, detail := range row {
+			x := margin + float64(i)*column
+			l.page.Text(x, l.y, pdf.FontRegular, 9, mutedColor, detail[0])
+			l.page.Text(x+80, l.y, pdf.FontRegular, 9, pdf.Black, detail[1])
+		}
+		l.y += 16
+	}
+	l.y += 14
+}
+
+func writeEarnings(l *layout, data entity.PayslipData) {
+	l.section("Earnings")
+
+	l.row("Attendance", fmt.Sprintf("%d of %d working days", data.AttendanceDays, data.WorkingDays), formatAmount(data.AttendancePay))
+	// A single segment repeats the attendance line, only a salary change
+	// within the period needs the breakdown.
+	if len(data.SalarySegments) > 1 {
+		for _, segment := range data.SalarySegments {
+			l.subRow(
+				fmt.Sprintf("%s to %s at %s", formatDate(segment.StartDate), formatDate(segment.EndDate), formatAmount(segment.Salary)),
+				fmt.Sprintf("%d of %d days", segment.AttendanceDays, segment.WorkingDays),
+				formatAmount(segment.AttendancePay),
+			)
+		}
+	}
+
+	overtimeDetail := fmt.Sprintf("%s x %s x %s",
+		formatHours(data.Overtime.OvertimeHours),
+		formatAmount(data.Overtime.RatePerHour),
+		strconv.FormatFloat(data.Overtime.Multiplier, 'f', -1, 64),
+	)
+	if version, ok := data.Overtime.PolicyVersion.Get(); ok {
+		overtimeDetail += fmt.Sprintf(" (policy v%d)", version)
+	}
+	l.row("Overtime", overtimeDetail, formatAmount(data.Overtime.OvertimePay))
+
+	for _, earning := range data.Earnings {
+		if earning.Taxable {
+			l.row(earning.Name, earning.Code, formatAmount(earning.Amount))
+		}
+	}
+
+	l.total("Gross pay", formatAmount(data.GrossPay))
+}
+
+// writeNonTaxableEarnings lists the allowances and adjustments paid on top of
+// the gross pay. Most payslips have none, so the section is left out then.
+func writeNonTaxableEarnings(l *layout, data entity.PayslipData) {
+	var earnings []entity.PayslipItemData
+	for _, earning := range data.Earnings {
+		if !earning.Taxable {
+			earnings = append(earnings, earning)
+		}
+	}
+	if len(earnings) == 0 {
+		return
+	}
+
+	l.section("Non-taxable earnings")
+	for _, earning := range earnings {
+		l.row(earning.Name, earning.Code, formatAmount(earning.Amount))
+	}
+}
+
+func writeReimbursements(l *layout, data entity.PayslipData) {
+	l.section("Reimbursements")
+
+	if len(data.Reimbursements) == 0 {
+		l.empty("No reimbursements in this period")
+	}
+	for _, reimbursement := range data.Reimbursements {
+		l.row(formatDate(reimbursement.ReimbursementDate), reimbursement.Description.GetOrDefault("-"), formatAmount(reimbursement.Amount))
+	}
+
+	l.total("Total reimbursements", formatAmount(data.ReimbursementTotal))
+}
+
+func writeDeductions(l *layout, data entity.PayslipData) {
+	l.section("Deductions")
+
+	if len(data.Deductions) == 0 {
+		l.empty("No deductions in this period")
+	}
+	for _, deduction := range data.Deductions {
+		l.row(deduction.Name, deduction.Code, "-"+formatAmount(deduction.Amount))
+	}
+
+	l.total("Total deductions", "-"+formatAmount(data.TotalDeductions))
+}
+
+func writeTakeHome(l *layout, data entity.PayslipData) {
+	l.ensure(44)
+	l.page.Rect(margin, l.y, pdf.PageWidth-2*margin, 36, bandColor)
+	l.page.Text(margin+12, l.y+23, pdf.FontBold, 12, brandColor, "Take-home pay")
+	l.page.TextRight(pdf.PageWidth-margin-12, l.y+24, pdf.FontBold, 14, brandColor, formatAmount(data.TotalTakeHome))
+	l.y += 44
+}
+
+func footerText(data entity.PayslipData) string {
+	return fmt.Sprintf("Verification ID %s | Payslip %s", data.VerificationID, data.ID)
+}
+
+// layout tracks the write position and starts a new page when a block does
+// not fit on the current one.
+type layout struct {
+	doc    *pdf.Document
+	page   *pdf.Page
+	y      float64
+	footer string
+}
+
+func (l *layout) newPage() {
+	l.page = l.doc.AddPage()
+	l.y = margin
+
+	footerY := pdf.PageHeight - footerHeight
+	l.page.Line(margin, footerY, pdf.PageWidth-margin, footerY, 0.5, ruleColor)
+	l.page.Text(margin, footerY+16, pdf.FontRegular, 8, mutedColor, l.footer)
+	l.page.Text(margin, footerY+28, pdf.FontRegular, 8, mutedColor, "This payslip was generated electronically and is valid without a signature.")
+}
+
+func (l *layout) ensure(height float64) {
+	if l.y+height > pdf.PageHeight-footerHeight-12 {
+		l.newPage()
+	}
+}
+
+func (l *layout) section(title string) {
+	// Keep the heading together with at least its first row
+	l.ensure(28 + rowHeight)
+	l.page.Text(margin, l.y, pdf.FontBold, 11, brandColor, title)
+	l.y += 6
+	l.page.Line(margin, l.y, pdf.PageWidth-margin, l.y, 0.75, brandColor)
+	l.y += 16
+}
+
+func (l *layout) row(label, detail, amount string) {
+	l.ensure(rowHeight)
+	l.page.Text(margin, l.y, pdf.FontRegular, 10, pdf.Black, label)
+	l.page.Text(margin+150, l.y, pdf.FontRegular, 9, mutedColor, detail)
+	l.page.TextRight(pdf.PageWidth-margin, l.y, pdf.FontRegular, 10, pdf.Black, amount)
+	l.y += rowHeight
+}
+
+func (l *layout) subRow(label, detail, amount string) {
+	l.ensure(rowHeight)
+	l.page.Text(margin+12, l.y, pdf.FontRegular, 9, mutedColor, label)
+	l.page.Text(margin+250, l.y, pdf.FontRegular, 9, mutedColor, detail)
+	l.page.TextRight(pdf.PageWidth-margin-70, l.y, pdf.FontRegular, 9, mutedColor, amount)
+	l.y += rowHeight - 3
+}
+
+func (l *layout) empty(text string) {
+	l.ensure(rowHeight)
+	l.page.Text(margin, l.y, pdf.FontRegular, 9, mutedColor, text)
+	l.y += rowHeight
+}
+
+func (l *layout) total(label, amount string) {
+	l.ensure(rowHeight + 6)
+	l.page.Line(margin, l.y-10, pdf.PageWidth-margin, l.y-10, 0.5, ruleColor)
+	l.y += 2
+	l.page.Text(margin, l.y, pdf.FontBold, 10, pdf.Black, label)
+	l.page.TextRight(pdf.PageWidth-margin, l.y, pdf.FontBold, 10, pdf.Black, amount)
+	l.y += rowHeight + 14
+}
+
+// formatAmount formats an amount with thousands separators, 1234567.5 is
+// written as 1,234,567.50.
+func formatAmount(amount money.Money) string {
+	plain := amount.String()
+
+	sign := ""
+	if strings.HasPrefix(plain, "-") {
+		sign, plain = "-", plain[1:]
+	}
+
+	units, cents, _ := strings.Cut(plain, ".")
+	var sb strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(digit)
+	}
+	return sign + sb.String() + "." + cents
+}
+
+// formatDate formats the RFC3339 dates of the payslip data for people,
+// anything else is printed as is.
+func formatDate(value string) string {
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return value
+	}
+	return date.Format("02 Jan 2006")
+}
+
+func formatFileDate(value string) string {
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return sanitizeFileName(value)
+	}
+	return date.Format("2006-01-02")
+}
+
+func formatPeriod(period entity.AttendancePeriodData) string {
+	return formatDate(period.StartDate) + " – " + formatDate(period.EndDate)
+}
+
+// formatHours formats an ISO 8601 duration as hours and minutes.
+func formatHours(value string) string {
+	duration, err := iso8601.Parse(value)
+	if err != nil {
+		return value
+	}
+	hours := int64(duration.Hours())
+	minutes := int64(duration.Minutes()) % 60
+	if minutes == 0 {
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dh %02dm", hours, minutes)
+}
+
+func sanitizeFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// PayrollStatus is the approval state of a payroll. A payroll is generated as
+// a draft and is only final, and visible to employees, once approved.
+type PayrollStatus string
+
+const (
+	PayrollStatusDraft     PayrollStatus = "draft"
+	PayrollStatusSubmitted PayrollStatus = "submitted"
+	PayrollStatusApproved  PayrollStatus = "approved"
+	PayrollStatusPaid      PayrollStatus = "paid"
+)
+
+// IsApproved reports whether the payroll went through approval. A paid
+// payroll stays approved.
+func (s PayrollStatus) IsApproved() bool {
+	return s == PayrollStatusApproved || s == PayrollStatusPaid
+}
+
+type PayrollAction string
+
+const (
+	PayrollActionSubmit  PayrollAction = "submit"
+	PayrollActionApprove PayrollAction = "approve"
+	PayrollActionReject  PayrollAction = "reject"
+	PayrollActionPay     PayrollAction = "pay"
+)
+
+type payrollTransition struct {
+	From PayrollStatus
+	To   PayrollStatus
+}
+
+var payrollTransitions = map[PayrollAction]payrollTransition{
+	PayrollActionSubmit:  {From: PayrollStatusDraft, To: PayrollStatusSubmitted},
+	PayrollActionApprove: {From: PayrollStatusSubmitted, To: PayrollStatusApproved},
+	PayrollActionReject:  {From: PayrollStatusSubmitted, To: PayrollStatusDraft},
+	PayrollActionPay:     {From: PayrollStatusApproved, To: PayrollStatusPaid},
+}
+
+// Transition returns the status the action moves a payroll from, and to. It
+// reports false when the action is not allowed from s.
+func (s PayrollStatus) Transition(action PayrollAction) (from, to PayrollStatus, ok bool) {
+	transition, exists := payrollTransitions[action]
+	if !exists {
+		return "", "", false
+	}
+
+	return transition.From, transition.To, s == transition.From
+}
+
+// IsReview reports whether the action decides on a submitted payroll. A
+// review must come from another admin than the one who submitted it.
+func (a PayrollAction) IsReview() bool {
+	return a == PayrollActionApprove || a == PayrollActionReject
+}
+
+// PayrollApproval is an entry of the comment trail of a payroll, one per
+// status change.
+type PayrollApproval struct {
+	ID         string          `db:"id"`
+	PayrollID  string          `db:"payroll_id"`
+	Action     PayrollAction   `db:"action"`
+	FromStatus PayrollStatus   `db:"from_status"`
+	ToStatus   PayrollStatus   `db:"to_status"`
+	Comment    optional.String `db:"comment"`
+	CreatedAt  time.Time       `db:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+	CreatedBy  string          `db:"created_by"`
+	UpdatedBy  string          `db:"updated_by"`
+	IPAddress  string          `db:"ip_address"`
+}
+
+type UpdatePayrollStatus struct {
+	PayrollID  string        `db:"payroll_id"`
+	FromStatus PayrollStatus `db:"from_status"`
+	ToStatus   PayrollStatus `db:"to_status"`
+	UpdatedAt  time.Time     `db:"updated_at"`
+	UpdatedBy  string        `db:"updated_by"`
+	IPAddress  string        `db:"ip_address"`
+}
@@ -0,0 +1,185 @@
+package entity
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+type DeductionKind string
+
+const (
+	DeductionKindProgressiveTax DeductionKind = "progressive_tax"
+	DeductionKindPercentage     DeductionKind = "percentage"
+	DeductionKindFixed          DeductionKind = "fixed"
+)
+
+// DeductionRule is the stored configuration of one deduction. Only the fields
+// relevant to its kind are used: Brackets for progressive taxes, Rate and
+// CapAmount for percentage contributions and Amount for fixed deductions.
+type DeductionRule struct {
+	ID        string        `db:"id"`
+	Code      string        `db:"code"`
+	Name      string        `db:"name"`
+	Kind      DeductionKind `db:"kind"`
+	SortOrder int64         `db:"sort_order"`
+	Rate      float64       `db:"rate"`
+	CapAmount money.Money   `db:"cap_amount"`
+	Amount    money.Money   `db:"amount"`
+	Brackets  []TaxBracket  `db:"brackets"`
+	IsActive  bool          `db:"is_active"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+	CreatedBy string        `db:"created_by"`
+	UpdatedBy string        `db:"updated_by"`
+	IPAddress string        `db:"ip_address"`
+}
+
+// TaxBracket taxes the income up to UpTo at Rate. The last bracket leaves
+// UpTo at zero to tax everything above the previous one.
+type TaxBracket struct {
+	UpTo money.Money `json:"up_to"`
+	Rate float64     `json:"rate"`
+}
+
+// Deduction calculates a single deduction line from the gross pay of a payslip.
+type Deduction interface {
+	Rule() DeductionRule
+	Calculate(grossPay money.Money) money.Money
+}
+
+type progressiveTax struct {
+	rule DeductionRule
+}
+
+func (d progressiveTax) Rule() DeductionRule {
+	return d.rule
+}
+
+// Calculate taxes every bracket exactly and rounds the total once with
+// TaxRounding.
+func (d progressiveTax) Calculate(grossPay money.Money) money.Money {
+	var (
+		tax   = new(big.Rat)
+		lower money.Money
+	)
+	for _, bracket := range d.rule.Brackets {
+		upper := bracket.UpTo
+		if upper.IsZero() || upper.Cmp(grossPay) > 0 {
+			upper = grossPay
+		}
+		if upper.Cmp(lower) > 0 {
+			taxable := upper.Sub(lower).Rat()
+			tax.Add(tax, taxable.Mul(taxable, money.Rate(bracket.Rate)))
+		}
+		if bracket.UpTo.IsZero() || bracket.UpTo.Cmp(grossPay) >= 0 {
+			break
+		}
+		lower = bracket.UpTo
+	}
+	return money.FromRat(tax, TaxRounding)
+}
+
+// percentageContribution applies Rate to the gross pay, a positive CapAmount
+// caps the pay the rate is applied to as social security schemes usually do.
+type percentageContribution struct {
+	rule DeductionRule
+}
+
+func (d percentageContribution) Rule() DeductionRule {
+	return d.rule
+}
+
+func (d percentageContribution) Calculate(grossPay money.Money) money.Money {
+	base := grossPay
+	if d.rule.CapAmount.IsPositive() {
+		base = money.Min(base, d.rule.CapAmount)
+	}
+	return base.Mul(money.Rate(d.rule.Rate), ContributionRounding)
+}
+
+type fixedDeduction struct {
+	rule DeductionRule
+}
+
+func (d fixedDeduction) Rule() DeductionRule {
+	return d.rule
+}
+
+func (d fixedDeduction) Calculate(grossPay money.Money) money.Money {
+	return d.rule.Amount
+}
+
+// deductionFactories maps every supported kind to its implementation, a new
+// kind only needs an entry here.
+var deductionFactories = map[DeductionKind]func(rule DeductionRule) (Deduction, error){
+	DeductionKindProgressiveTax: func(rule DeductionRule) (Deduction, error) {
+		for i, bracket := range rule.Brackets {
+			last := i == len(rule.Brackets)-1
+			if bracket.UpTo.IsZero() && !last {
+				return nil, errors.Errorf("deduction %s: only the last bracket can be unbounded", rule.Code)
+			}
+			if i > 0 && !bracket.UpTo.IsZero() && bracket.UpTo.Cmp(rule.Brackets[i-1].UpTo) <= 0 {
+				return nil, errors.Errorf("deduction %s: brackets must be increasing", rule.Code)
+			}
+		}
+		return progressiveTax{rule: rule}, nil
+	},
+	DeductionKindPercentage: func(rule DeductionRule) (Deduction, error) {
+		return percentageContribution{rule: rule}, nil
+	},
+	DeductionKindFixed: func(rule DeductionRule) (Deduction, error) {
+		return fixedDeduction{rule: rule}, nil
+	},
+}
+
+func NewDeduction(rule DeductionRule) (Deduction, error) {
+	factory, ok := deductionFactories[rule.Kind]
+	if !ok {
+		return nil, errors.Errorf("deduction %s: unsupported kind %q", rule.Code, rule.Kind)
+	}
+	return factory(rule)
+}
+
+// DeductionPipeline runs the deductions in order against the gross pay of a
+// payslip. A deduction never takes more than what is left of the pay, so the
+// net pay cannot become negative.
+type DeductionPipeline struct {
+	deductions []Deduction
+}
+
+func NewDeductionPipeline(rules []DeductionRule) (DeductionPipeline, error) {
+	deductions := make([]Deduction, 0, len(rules))
+	for _, rule := range rules {
+		deduction, err := NewDeduction(rule)
+		if err != nil {
+			return DeductionPipeline{}, err
+		}
+		deductions = append(deductions, deduction)
+	}
+	return DeductionPipeline{deductions: deductions}, nil
+}
+
+func (p DeductionPipeline) Apply(grossPay money.Money) []PayslipItem {
+	items := make([]PayslipItem, 0, len(p.deductions))
+	remaining := grossPay
+	for i, deduction := range p.deductions {
+		amount := money.Min(deduction.Calculate(grossPay), remaining)
+		if !amount.IsPositive() {
+			continue
+		}
+		remaining = remaining.Sub(amount)
+
+		rule := deduction.Rule()
+		items = append(items, PayslipItem{
+			ItemType:  PayslipItemTypeDeduction,
+			Code:      rule.Code,
+			Name:      rule.Name,
+			Amount:    amount,
+			SortOrder: int64(i + 1),
+		})
+	}
+	return items
+}
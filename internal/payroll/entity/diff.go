@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+// PayrollDiffThresholds decides which changes between two payroll runs are
+// flagged as anomalies. A zero threshold turns its flag off.
+type PayrollDiffThresholds struct {
+	// TakeHomeChangePercent flags a take-home pay that moved by at least this
+	// percentage of the first run.
+	TakeHomeChangePercent int64
+	// BaseSalaryChangePercent flags a base salary that moved by at least this
+	// percentage of the first run.
+	BaseSalaryChangePercent int64
+	// AttendanceDaysChange flags an attendance that moved by at least this
+	// many days.
+	AttendanceDaysChange int64
+	// OvertimeHoursChange flags an overtime that moved by at least this long.
+	OvertimeHoursChange time.Duration
+}
+
+type PayslipDiffStatus string
+
+const (
+	PayslipDiffChanged   PayslipDiffStatus = "changed"
+	PayslipDiffUnchanged PayslipDiffStatus = "unchanged"
+	// PayslipDiffNew is an employee paid in the second run only.
+	PayslipDiffNew PayslipDiffStatus = "new"
+	// PayslipDiffMissing is an employee paid in the first run only.
+	PayslipDiffMissing PayslipDiffStatus = "missing"
+)
+
+type PayrollAnomaly string
+
+const (
+	PayrollAnomalyTakeHomeChange       PayrollAnomaly = "take_home_change"
+	PayrollAnomalyBaseSalaryChange     PayrollAnomaly = "base_salary_change"
+	PayrollAnomalyAttendanceDaysChange PayrollAnomaly = "attendance_days_change"
+	PayrollAnomalyOvertimeHoursChange  PayrollAnomaly = "overtime_hours_change"
+	PayrollAnomalyZeroTakeHome         PayrollAnomaly = "zero_take_home"
+)
+
+// ValueDiff holds a value in both runs. The side an employee is not paid in
+// is zero.
+type ValueDiff[T any] struct {
+	From  T
+	To    T
+	Delta T
+}
+
+func NewMoneyDiff(from, to money.Money) ValueDiff[money.Money] {
+	return ValueDiff[money.Money]{From: from, To: to, Delta: to.Sub(from)}
+}
+
+func NewInt64Diff(from, to int64) ValueDiff[int64] {
+	return ValueDiff[int64]{From: from, To: to, Delta: to - from}
+}
+
+func NewDurationDiff(from, to time.Duration) ValueDiff[time.Duration] {
+	return ValueDiff[time.Duration]{From: from, To: to, Delta: to - from}
+}
+
+type PayrollRun struct {
+	PayrollID string
+	PeriodID  string
+	Version   int64
+	Status    PayrollStatus
+	Voided    bool
+}
+
+type PayslipDiff struct {
+	UserID         string
+	Username       string
+	Status         PayslipDiffStatus
+	BaseSalary     ValueDiff[money.Money]
+	AttendanceDays ValueDiff[int64]
+	OvertimeHours  ValueDiff[time.Duration]
+	OvertimePay    ValueDiff[money.Money]
+	Reimbursement  ValueDiff[money.Money]
+	TakeHome       ValueDiff[money.Money]
+	Anomalies      []PayrollAnomaly
+}
+
+type PayrollDiffSummary struct {
+	TotalEmployee      ValueDiff[int64]
+	NewEmployee        int64
+	MissingEmployee    int64
+	ChangedEmployee    int64
+	AnomalyEmployee    int64
+	TotalBaseSalary    ValueDiff[money.Money]
+	TotalOvertimePay   ValueDiff[money.Money]
+	TotalReimbursement ValueDiff[money.Money]
+	TotalTakeHome      ValueDiff[money.Money]
+}
+
+// PayrollDiff compares the payslips of two payroll runs, per employee.
+type PayrollDiff struct {
+	From       PayrollRun
+	To         PayrollRun
+	Thresholds PayrollDiffThresholds
+	Summary    PayrollDiffSummary
+	Employees  []PayslipDiff
+}
@@ -6,6 +6,20 @@ const (
 	AttendancePeriodNotFound = "ATTENDANCE_PERIOD_NOT_FOUND"
 	UserNotFound             = "USER_NOT_FOUND"
 	PayslipNotFound          = "PAYSLIP_NOT_FOUND"
+	PayrollNotFound          = "PAYROLL_NOT_FOUND"
+	PayrollAlreadyVoided     = "PAYROLL_ALREADY_VOIDED"
+	OvertimePolicyNotFound   = "OVERTIME_POLICY_NOT_FOUND"
+	BankAccountMissing       = "BANK_ACCOUNT_MISSING"
+	PayrollJobNotFound       = "PAYROLL_JOB_NOT_FOUND"
+	PayrollJobAlreadyQueued  = "PAYROLL_JOB_ALREADY_QUEUED"
+	PayrollJobUnexpected     = "PAYROLL_JOB_UNEXPECTED_ERROR"
+	PayrollJobAbandoned      = "PAYROLL_JOB_ABANDONED"
+	PayrollInvalidTransition = "PAYROLL_INVALID_TRANSITION"
+	PayrollSelfReview        = "PAYROLL_SELF_REVIEW"
+	PayrollNotApproved       = "PAYROLL_NOT_APPROVED"
+	PayrollAlreadyPaid       = "PAYROLL_ALREADY_PAID"
+	PayslipNotAvailable      = "PAYSLIP_NOT_AVAILABLE"
+	BankNotConfigured        = "BANK_NOT_CONFIGURED"
 )
 
 func GetErrorMessageByIssueCode(issueCode string) string {
@@ -20,6 +34,34 @@ func GetErrorMessageByIssueCode(issueCode string) string {
 		return "User not found"
 	case PayslipNotFound:
 		return "Payslip not found"
+	case PayrollNotFound:
+		return "Payroll not found"
+	case PayrollAlreadyVoided:
+		return "Payroll has already been voided and superseded by a newer version"
+	case OvertimePolicyNotFound:
+		return "Overtime policy has not been configured"
+	case BankAccountMissing:
+		return "Some employees with a take-home pay have no bank account"
+	case PayrollJobNotFound:
+		return "Payroll job not found"
+	case PayrollJobAlreadyQueued:
+		return "Payroll for this period is already being generated"
+	case PayrollJobUnexpected:
+		return "Payroll generation failed unexpectedly"
+	case PayrollJobAbandoned:
+		return "Payroll generation was interrupted too many times and has been abandoned"
+	case PayrollInvalidTransition:
+		return "This action is not allowed in the current status of the payroll"
+	case PayrollSelfReview:
+		return "A payroll must be approved or rejected by another admin than the one who submitted it"
+	case PayrollNotApproved:
+		return "Payroll has not been approved yet"
+	case PayrollAlreadyPaid:
+		return "Payroll has already been paid and can no longer be regenerated"
+	case PayslipNotAvailable:
+		return "Payslip is not available until its payroll is approved"
+	case BankNotConfigured:
+		return "Bank transfers cannot be exported, the company bank account is not configured"
 	default:
 		return "An unknown error occurred"
 	}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// PayslipHistory is a payslip with the payroll and attendance period it was
+// paid in.
+type PayslipHistory struct {
+	Payslip
+	PayrollVersion  int64         `db:"payroll_version"`
+	PayrollStatus   PayrollStatus `db:"payroll_status"`
+	PeriodID        string        `db:"period_id"`
+	PeriodStartDate time.Time     `db:"period_start_date"`
+	PeriodEndDate   time.Time     `db:"period_end_date"`
+}
+
+// FindPayslipHistoryOptions narrows the history to the active payrolls in one
+// of PayrollStatuses, and to the periods ending within
+// [PeriodEndFrom, PeriodEndBefore) when they are set.
+type FindPayslipHistoryOptions struct {
+	PayrollStatuses []PayrollStatus
+	PeriodEndFrom   optional.Time
+	PeriodEndBefore optional.Time
+}
+
+type YearBasis string
+
+const (
+	YearBasisCalendar YearBasis = "calendar"
+	// YearBasisFiscal years are named after the calendar year they start in.
+	YearBasisFiscal YearBasis = "fiscal"
+)
+
+// PayslipYearToDate totals the payslips of an employee whose period ends
+// within a calendar or fiscal year.
+type PayslipYearToDate struct {
+	Basis         YearBasis
+	Year          int64
+	StartDate     time.Time
+	EndDate       time.Time
+	TotalPayslip  int64
+	GrossPay      money.Money
+	OvertimePay   money.Money
+	Reimbursement money.Money
+	Deductions    money.Money
+	TakeHome      money.Money
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type PayrollJobStatus string
+
+const (
+	PayrollJobQueued    PayrollJobStatus = "queued"
+	PayrollJobRunning   PayrollJobStatus = "running"
+	PayrollJobSucceeded PayrollJobStatus = "succeeded"
+	PayrollJobFailed    PayrollJobStatus = "failed"
+)
+
+// PayrollJobStage is the step a running job is at. Progress counts employees
+// and only advances while calculating, loading and storing are single bulk
+// queries.
+type PayrollJobStage string
+
+const (
+	PayrollJobLoading     PayrollJobStage = "loading"
+	PayrollJobCalculating PayrollJobStage = "calculating"
+	PayrollJobStoring     PayrollJobStage = "storing"
+)
+
+// PayrollJob is a payroll generation running in the background. The
+// requesting admin is kept in CreatedBy, IPAddress and RequestID, the
+// generated payroll is audited on their behalf.
+type PayrollJob struct {
+	ID            string            `db:"id"`
+	PeriodID      string            `db:"period_id"`
+	Status        PayrollJobStatus  `db:"status"`
+	Stage         optional.String   `db:"stage"`
+	ProgressDone  int64             `db:"progress_done"`
+	ProgressTotal int64             `db:"progress_total"`
+	Attempts      int64             `db:"attempts"`
+	FailureCode   optional.String   `db:"failure_code"`
+	FailureReason optional.String   `db:"failure_reason"`
+	PayrollID     optional.String   `db:"payroll_id"`
+	Result        *GeneratedPayroll `db:"result"`
+	RequestID     string            `db:"request_id"`
+	HeartbeatAt   optional.Time     `db:"heartbeat_at"`
+	StartedAt     optional.Time     `db:"started_at"`
+	FinishedAt    optional.Time     `db:"finished_at"`
+	CreatedAt     time.Time         `db:"created_at"`
+	UpdatedAt     time.Time         `db:"updated_at"`
+	CreatedBy     string            `db:"created_by"`
+	UpdatedBy     string            `db:"updated_by"`
+	IPAddress     string            `db:"ip_address"`
+}
+
+// ClaimPayrollJob picks the oldest queued job, or a running job whose worker
+// stopped sending heartbeats before StaleBefore.
+type ClaimPayrollJob struct {
+	ClaimedAt   time.Time `db:"claimed_at"`
+	StaleBefore time.Time `db:"stale_before"`
+}
+
+type PayrollJobProgress struct {
+	JobID         string          `db:"job_id"`
+	Stage         PayrollJobStage `db:"stage"`
+	ProgressDone  int64           `db:"progress_done"`
+	ProgressTotal int64           `db:"progress_total"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+}
+
+type FinishPayrollJob struct {
+	JobID         string            `db:"job_id"`
+	Status        PayrollJobStatus  `db:"status"`
+	FailureCode   optional.String   `db:"failure_code"`
+	FailureReason optional.String   `db:"failure_reason"`
+	PayrollID     optional.String   `db:"payroll_id"`
+	Result        *GeneratedPayroll `db:"result"`
+	FinishedAt    time.Time         `db:"finished_at"`
+}
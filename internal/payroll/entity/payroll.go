@@ -3,60 +3,193 @@ package entity
 import (
 	"time"
 
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 	"github.com/vnnyx/employee-management/pkg/resourceful"
 )
 
 type Payroll struct {
-	ID        string    `db:"id"`
-	PeriodID  string    `db:"period_id"`
-	RunBy     string    `db:"run_by"`
-	RunAt     time.Time `db:"run_at"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
-	CreatedBy string    `db:"created_by"`
-	UpdatedBy string    `db:"updated_by"`
-	IPAddress string    `db:"ip_address"`
+	ID         string          `db:"id"`
+	PeriodID   string          `db:"period_id"`
+	Version    int64           `db:"version"`
+	Status     PayrollStatus   `db:"status"`
+	RunBy      string          `db:"run_by"`
+	RunAt      time.Time       `db:"run_at"`
+	VoidedAt   optional.Time   `db:"voided_at"`
+	VoidedBy   optional.String `db:"voided_by"`
+	VoidReason optional.String `db:"void_reason"`
+	CreatedAt  time.Time       `db:"created_at"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+	CreatedBy  string          `db:"created_by"`
+	UpdatedBy  string          `db:"updated_by"`
+	IPAddress  string          `db:"ip_address"`
 }
 
+type VoidPayroll struct {
+	PayrollID  string    `db:"payroll_id"`
+	VoidedAt   time.Time `db:"voided_at"`
+	VoidedBy   string    `db:"voided_by"`
+	VoidReason string    `db:"void_reason"`
+	IPAddress  string    `db:"ip_address"`
+}
+
+// Payslip keeps the gross and net pay apart. GrossPay is the taxable
+// attendance, overtime and earning items pay the deductions are applied to,
+// reimbursements and non-taxable earnings are only added back into
+// TotalTakeHome, the net pay.
+// EligibleWorkingDays are the working days of the period the employee was
+// employed for, their share of WorkingDays is the proration of the base salary.
+// WorkedDuration is the clocked time of the period, payslips generated before
+// clock events existed have none.
 type Payslip struct {
-	ID                 string            `db:"id"`
-	UserID             string            `db:"user_id"`
-	PayrollID          string            `db:"payroll_id"`
-	BaseSalary         int64             `db:"base_salary"`
-	AttendanceDays     int64             `db:"attendance_days"`
-	OvertimeHours      optional.Duration `db:"overtime_hours"`
-	OvertimePay        int64             `db:"overtime_pay"`
-	ReimbursementTotal int64             `db:"reimbursement_total"`
-	TotalTakeHome      int64             `db:"total_take_home"`
-	CreatedAt          time.Time         `db:"created_at"`
-	UpdatedAt          time.Time         `db:"updated_at"`
-	CreatedBy          string            `db:"created_by"`
-	UpdatedBy          string            `db:"updated_by"`
-	IPAddress          string            `db:"ip_address"`
+	ID                    string                 `db:"id"`
+	UserID                string                 `db:"user_id"`
+	PayrollID             string                 `db:"payroll_id"`
+	BaseSalary            money.Money            `db:"base_salary"`
+	WorkingDays           optional.Int64         `db:"working_days"`
+	EligibleWorkingDays   optional.Int64         `db:"eligible_working_days"`
+	AttendanceDays        int64                  `db:"attendance_days"`
+	WorkedDuration        optional.Duration      `db:"worked_duration"`
+	OvertimeHours         optional.Duration      `db:"overtime_hours"`
+	OvertimePay           money.Money            `db:"overtime_pay"`
+	OvertimePolicyID      optional.String        `db:"overtime_policy_id"`
+	OvertimePolicyVersion optional.Int64         `db:"overtime_policy_version"`
+	OvertimeRatePerHour   optional.Money         `db:"overtime_rate_per_hour"`
+	GrossPay              money.Money            `db:"gross_pay"`
+	ReimbursementTotal    money.Money            `db:"reimbursement_total"`
+	TotalDeductions       money.Money            `db:"total_deductions"`
+	TotalTakeHome         money.Money            `db:"total_take_home"`
+	Department            optional.String        `db:"department"`
+	CostCentre            optional.String        `db:"cost_centre"`
+	CreatedAt             time.Time              `db:"created_at"`
+	UpdatedAt             time.Time              `db:"updated_at"`
+	CreatedBy             string                 `db:"created_by"`
+	UpdatedBy             string                 `db:"updated_by"`
+	IPAddress             string                 `db:"ip_address"`
+	Items                 []PayslipItem          `db:"-"`
+	SalarySegments        []PayslipSalarySegment `db:"-"`
+}
+
+// AttendanceBasis is what the salary of a segment is paid for.
+type AttendanceBasis string
+
+const (
+	// AttendanceBasisDays pays the share of working days attended.
+	AttendanceBasisDays AttendanceBasis = "days"
+	// AttendanceBasisHours pays the share of scheduled hours worked, a day
+	// counts for at most its scheduled length.
+	AttendanceBasisHours AttendanceBasis = "hours"
+)
+
+type PayslipItemType string
+
+const (
+	PayslipItemTypeAllowance     PayslipItemType = "allowance"
+	PayslipItemTypeAdjustment    PayslipItemType = "adjustment"
+	PayslipItemTypeDeduction     PayslipItemType = "deduction"
+	PayslipItemTypeLoanRepayment PayslipItemType = "loan_repayment"
+)
+
+// IsEarning reports whether the item is paid to the employee rather than
+// deducted.
+func (t PayslipItemType) IsEarning() bool {
+	return t == PayslipItemTypeAllowance || t == PayslipItemTypeAdjustment
+}
+
+// IsDeduction reports whether the item is taken off the pay, a loan
+// repayment counts towards the total deductions of the payslip.
+func (t PayslipItemType) IsDeduction() bool {
+	return t == PayslipItemTypeDeduction || t == PayslipItemTypeLoanRepayment
+}
+
+// PayslipItem is a line of a payslip that is not part of the base
+// attendance and overtime calculation. Taxable earnings are part of the gross
+// pay, the others are paid on top of the net pay. A loan repayment carries
+// the loan it is deducted for.
+type PayslipItem struct {
+	ID        string          `db:"id"`
+	PayslipID string          `db:"payslip_id"`
+	ItemType  PayslipItemType `db:"item_type"`
+	Code      string          `db:"code"`
+	Name      string          `db:"name"`
+	Amount    money.Money     `db:"amount"`
+	Taxable   bool            `db:"taxable"`
+	LoanID    optional.String `db:"loan_id"`
+	SortOrder int64           `db:"sort_order"`
+	CreatedAt time.Time       `db:"created_at"`
+	UpdatedAt time.Time       `db:"updated_at"`
+	CreatedBy string          `db:"created_by"`
+	UpdatedBy string          `db:"updated_by"`
+	IPAddress string          `db:"ip_address"`
+}
+
+// PayslipSalarySegment is the attendance pay of the days of a period paid
+// with the same salary. A salary change within the period splits the payslip
+// into several segments, each prorated over the working days of the whole
+// period.
+type PayslipSalarySegment struct {
+	ID                  string      `db:"id"`
+	PayslipID           string      `db:"payslip_id"`
+	StartDate           time.Time   `db:"start_date"`
+	EndDate             time.Time   `db:"end_date"`
+	Salary              money.Money `db:"salary"`
+	WorkingDays         int64       `db:"working_days"`
+	AttendanceDays      int64       `db:"attendance_days"`
+	AttendancePay       money.Money `db:"attendance_pay"`
+	OvertimeRatePerHour money.Money `db:"overtime_rate_per_hour"`
+	SortOrder           int64       `db:"sort_order"`
+	CreatedAt           time.Time   `db:"created_at"`
+	UpdatedAt           time.Time   `db:"updated_at"`
+	CreatedBy           string      `db:"created_by"`
+	UpdatedBy           string      `db:"updated_by"`
+	IPAddress           string      `db:"ip_address"`
 }
 
+// PayrollSummary totals the payslips of a payroll, TotalTakeHome is the net
+// amount paid out: gross pay less deductions plus reimbursements and
+// non-taxable earnings.
 type PayrollSummary struct {
-	ID            string    `db:"id"`
-	PayrollID     string    `db:"payroll_id"`
-	TotalTakeHome int64     `db:"total_take_home"`
-	GeneratedBy   string    `db:"generated_by"`
-	GeneratedAt   time.Time `db:"generated_at"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
-	CreatedBy     string    `db:"created_by"`
-	UpdatedBy     string    `db:"updated_by"`
-	IPAddress     string    `db:"ip_address"`
+	ID                 string      `db:"id"`
+	PayrollID          string      `db:"payroll_id"`
+	TotalGrossPay      money.Money `db:"total_gross_pay"`
+	TotalReimbursement money.Money `db:"total_reimbursement"`
+	TotalDeductions    money.Money `db:"total_deductions"`
+	TotalTakeHome      money.Money `db:"total_take_home"`
+	GeneratedBy        string      `db:"generated_by"`
+	GeneratedAt        time.Time   `db:"generated_at"`
+	CreatedAt          time.Time   `db:"created_at"`
+	UpdatedAt          time.Time   `db:"updated_at"`
+	CreatedBy          string      `db:"created_by"`
+	UpdatedBy          string      `db:"updated_by"`
+	IPAddress          string      `db:"ip_address"`
 }
 
+// GeneratedPayroll is also stored as the result of a payroll job, hence the
+// JSON tags.
 type GeneratedPayroll struct {
-	PeriodID      string
-	PayrollID     string
-	TotalTakeHome int64
-	TotalEmployee int64
-	TotalPayslip  int64
-	GeneratedBy   string
-	GeneratedAt   string
+	PeriodID           string        `json:"period_id"`
+	PayrollID          string        `json:"payroll_id"`
+	Version            int64         `json:"version"`
+	Status             PayrollStatus `json:"status"`
+	TotalGrossPay      money.Money   `json:"total_gross_pay"`
+	TotalReimbursement money.Money   `json:"total_reimbursement"`
+	TotalDeductions    money.Money   `json:"total_deductions"`
+	TotalTakeHome      money.Money   `json:"total_take_home_pay"`
+	TotalEmployee      int64         `json:"total_employee"`
+	TotalPayslip       int64         `json:"total_payslip"`
+	GeneratedBy        string        `json:"generated_by"`
+	GeneratedAt        string        `json:"generated_at"`
+}
+
+type PayrollPreview struct {
+	PeriodID           string
+	TotalGrossPay      money.Money
+	TotalReimbursement money.Money
+	TotalDeductions    money.Money
+	TotalTakeHome      money.Money
+	TotalEmployee      int64
+	TotalPayslip       int64
+	PayslipsData       []PayslipData
 }
 
 type FindPayrollOptions struct {
@@ -65,15 +198,33 @@ type FindPayrollOptions struct {
 
 type ReimbursementData struct {
 	Description       optional.String
-	Amount            int64
+	Amount            money.Money
 	ReimbursementDate string
 }
 
 type OvertimeData struct {
 	OvertimeHours string
-	RatePerHour   int64
+	RatePerHour   money.Money
 	Multiplier    float64
-	OvertimePay   int64
+	OvertimePay   money.Money
+	PolicyVersion optional.Int64
+}
+
+type PayslipItemData struct {
+	Code    string
+	Name    string
+	Amount  money.Money
+	Taxable bool
+}
+
+type SalarySegmentData struct {
+	StartDate           string
+	EndDate             string
+	Salary              money.Money
+	WorkingDays         int64
+	AttendanceDays      int64
+	AttendancePay       money.Money
+	OvertimeRatePerHour money.Money
 }
 
 type UserData struct {
@@ -87,21 +238,60 @@ type AttendancePeriodData struct {
 }
 
 type PayslipData struct {
-	ID                 string
-	User               UserData
-	AttendancePeriod   AttendancePeriodData
-	BaseSalary         int64
-	WorkingDays        int64
-	AttendanceDays     int64
-	AttendancePay      int64
-	Overtime           OvertimeData
-	Reimbursements     []ReimbursementData
-	ReimbursementTotal int64
-	TotalTakeHome      int64
+	ID                  string
+	VerificationID      string
+	User                UserData
+	AttendancePeriod    AttendancePeriodData
+	BaseSalary          money.Money
+	WorkingDays         int64
+	EligibleWorkingDays int64
+	ProrationFactor     float64
+	AttendanceDays      int64
+	WorkedDuration      optional.String
+	AttendancePay       money.Money
+	SalarySegments      []SalarySegmentData
+	Overtime            OvertimeData
+	Earnings            []PayslipItemData
+	GrossPay            money.Money
+	Reimbursements      []ReimbursementData
+	ReimbursementTotal  money.Money
+	Deductions          []PayslipItemData
+	TotalDeductions     money.Money
+	TotalTakeHome       money.Money
+}
+
+// PayslipExport holds every payslip of a payroll for the bulk download.
+type PayslipExport struct {
+	PayrollID        string
+	AttendancePeriod AttendancePeriodData
+	Payslips         []PayslipData
+}
+
+// BankTransferBatch holds the take-home transfers of a payroll for the bank
+// disbursement file.
+type BankTransferBatch struct {
+	PayrollID        string
+	AttendancePeriod AttendancePeriodData
+	CreatedAt        time.Time
+	ExecutionDate    time.Time
+	Transfers        []BankTransfer
+	TotalAmount      money.Money
+}
+
+type BankTransfer struct {
+	PayslipID         string
+	UserID            string
+	Username          string
+	AccountHolderName string
+	AccountNumber     string
+	BankName          string
+	BankCode          string
+	BIC               optional.String
+	Amount            money.Money
 }
 
 type ListPayslips struct {
-	TotalTakeHome int64
+	TotalTakeHome money.Money
 	PayslipsData  []PayslipData
 }
 
@@ -132,10 +322,10 @@ type FindPayslipResult struct {
 }
 
 type ListPayslipMetadata struct {
-	Count         int64    `json:"count"`
-	Page          int64    `json:"page"`
-	TotalCount    int64    `json:"total_count"`
-	TotalPage     int64    `json:"total_page"`
-	TotalTakeHome int64    `json:"total_take_home_pay"`
-	IDs           []string `json:"-"`
+	Count         int64       `json:"count"`
+	Page          int64       `json:"page"`
+	TotalCount    int64       `json:"total_count"`
+	TotalPage     int64       `json:"total_page"`
+	TotalTakeHome money.Money `json:"total_take_home_pay"`
+	IDs           []string    `json:"-"`
 }
@@ -0,0 +1,23 @@
+package entity
+
+import "github.com/vnnyx/employee-management/pkg/money"
+
+// Rounding policy of the payslip line items. Amounts entered by people, the
+// base salary, reimbursements and fixed deductions, are stored exactly and
+// never rounded. Calculated lines are computed exactly and rounded to the cent
+// once, with the mode below. Gross pay, total deductions, take-home pay and
+// the payroll summary are exact sums of the rounded lines and are never
+// rounded again, so a payslip always adds up.
+const (
+	// AttendancePayRounding rounds salary * attended days / working days. When
+	// the salary changes within the period it rounds each salary segment.
+	AttendancePayRounding = money.RoundHalfUp
+	// OvertimePayRounding rounds the overtime of the whole period, the hourly
+	// rate itself is rounded half up by the overtime policy and stored.
+	OvertimePayRounding = money.RoundHalfUp
+	// TaxRounding rounds progressive taxes down, in favour of the employee.
+	TaxRounding = money.RoundDown
+	// ContributionRounding rounds percentage contributions to the nearest
+	// cent, ties to even so the rounding does not drift across employees.
+	ContributionRounding = money.RoundHalfEven
+)
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"strings"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+// verificationIDBytes is the part of the HMAC kept in a verification ID,
+// 80 bits encode to 16 base32 characters without padding.
+const verificationIDBytes = 10
+
+// NewVerificationID derives the ID printed on a payslip document. It is an
+// HMAC of the payslip's identity and take-home pay with the verification key,
+// so HR can check through VerifyVerificationID that a document was issued by
+// this system and that the amount on it was not edited. Regenerating a
+// payroll creates new payslips and therefore new IDs.
+func NewVerificationID(key string, payslip Payslip) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(strings.Join([]string{
+		payslip.ID,
+		payslip.PayrollID,
+		payslip.UserID,
+		payslip.TotalTakeHome.String(),
+	}, "|")))
+
+	encoded := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(mac.Sum(nil)[:verificationIDBytes])
+
+	groups := make([]string, 0, len(encoded)/4)
+	for i := 0; i < len(encoded); i += 4 {
+		groups = append(groups, encoded[i:i+4])
+	}
+	return strings.Join(groups, "-")
+}
+
+// VerifyVerificationID reports whether a verification ID was derived from
+// the stored payslip. Case and the dashes between groups are ignored, they
+// are easily lost when the ID is typed over from a printed document.
+func VerifyVerificationID(key string, payslip Payslip, verificationID string) bool {
+	normalize := func(id string) []byte {
+		return []byte(strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(id), "-", "")))
+	}
+	return hmac.Equal(normalize(NewVerificationID(key, payslip)), normalize(verificationID))
+}
+
+type PayslipVerification struct {
+	PayslipID     string
+	PayrollID     string
+	UserID        string
+	TotalTakeHome money.Money
+	Valid         bool
+	// Superseded is set when the payroll of the payslip was voided by a
+	// regeneration, the document was issued but is no longer current.
+	Superseded bool
+}
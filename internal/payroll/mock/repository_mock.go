@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	payroll "github.com/vnnyx/employee-management/internal/payroll"
 	entity "github.com/vnnyx/employee-management/internal/payroll/entity"
@@ -43,19 +44,141 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ClaimPayrollJob mocks base method.
+func (m *MockRepository) ClaimPayrollJob(ctx context.Context, claim entity.ClaimPayrollJob) (*entity.PayrollJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimPayrollJob", ctx, claim)
+	ret0, _ := ret[0].(*entity.PayrollJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimPayrollJob indicates an expected call of ClaimPayrollJob.
+func (mr *MockRepositoryMockRecorder) ClaimPayrollJob(ctx, claim any) *MockRepositoryClaimPayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimPayrollJob", reflect.TypeOf((*MockRepository)(nil).ClaimPayrollJob), ctx, claim)
+	return &MockRepositoryClaimPayrollJobCall{Call: call}
+}
+
+// MockRepositoryClaimPayrollJobCall wrap *gomock.Call
+type MockRepositoryClaimPayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryClaimPayrollJobCall) Return(arg0 *entity.PayrollJob, arg1 error) *MockRepositoryClaimPayrollJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryClaimPayrollJobCall) Do(f func(context.Context, entity.ClaimPayrollJob) (*entity.PayrollJob, error)) *MockRepositoryClaimPayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryClaimPayrollJobCall) DoAndReturn(f func(context.Context, entity.ClaimPayrollJob) (*entity.PayrollJob, error)) *MockRepositoryClaimPayrollJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindActiveDeductionRules mocks base method.
+func (m *MockRepository) FindActiveDeductionRules(ctx context.Context) ([]entity.DeductionRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveDeductionRules", ctx)
+	ret0, _ := ret[0].([]entity.DeductionRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveDeductionRules indicates an expected call of FindActiveDeductionRules.
+func (mr *MockRepositoryMockRecorder) FindActiveDeductionRules(ctx any) *MockRepositoryFindActiveDeductionRulesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveDeductionRules", reflect.TypeOf((*MockRepository)(nil).FindActiveDeductionRules), ctx)
+	return &MockRepositoryFindActiveDeductionRulesCall{Call: call}
+}
+
+// MockRepositoryFindActiveDeductionRulesCall wrap *gomock.Call
+type MockRepositoryFindActiveDeductionRulesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindActiveDeductionRulesCall) Return(arg0 []entity.DeductionRule, arg1 error) *MockRepositoryFindActiveDeductionRulesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindActiveDeductionRulesCall) Do(f func(context.Context) ([]entity.DeductionRule, error)) *MockRepositoryFindActiveDeductionRulesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindActiveDeductionRulesCall) DoAndReturn(f func(context.Context) ([]entity.DeductionRule, error)) *MockRepositoryFindActiveDeductionRulesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPayrollApprovalsByPayrollID mocks base method.
+func (m *MockRepository) FindPayrollApprovalsByPayrollID(ctx context.Context, payrollID string) ([]entity.PayrollApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayrollApprovalsByPayrollID", ctx, payrollID)
+	ret0, _ := ret[0].([]entity.PayrollApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayrollApprovalsByPayrollID indicates an expected call of FindPayrollApprovalsByPayrollID.
+func (mr *MockRepositoryMockRecorder) FindPayrollApprovalsByPayrollID(ctx, payrollID any) *MockRepositoryFindPayrollApprovalsByPayrollIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayrollApprovalsByPayrollID", reflect.TypeOf((*MockRepository)(nil).FindPayrollApprovalsByPayrollID), ctx, payrollID)
+	return &MockRepositoryFindPayrollApprovalsByPayrollIDCall{Call: call}
+}
+
+// MockRepositoryFindPayrollApprovalsByPayrollIDCall wrap *gomock.Call
+type MockRepositoryFindPayrollApprovalsByPayrollIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayrollApprovalsByPayrollIDCall) Return(arg0 []entity.PayrollApproval, arg1 error) *MockRepositoryFindPayrollApprovalsByPayrollIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayrollApprovalsByPayrollIDCall) Do(f func(context.Context, string) ([]entity.PayrollApproval, error)) *MockRepositoryFindPayrollApprovalsByPayrollIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayrollApprovalsByPayrollIDCall) DoAndReturn(f func(context.Context, string) ([]entity.PayrollApproval, error)) *MockRepositoryFindPayrollApprovalsByPayrollIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindPayrollByID mocks base method.
-func (m *MockRepository) FindPayrollByID(ctx context.Context, payrollID string) (*entity.Payroll, error) {
+func (m *MockRepository) FindPayrollByID(ctx context.Context, payrollID string, opts ...entity.FindPayrollOptions) (*entity.Payroll, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindPayrollByID", ctx, payrollID)
+	varargs := []any{ctx, payrollID}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindPayrollByID", varargs...)
 	ret0, _ := ret[0].(*entity.Payroll)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindPayrollByID indicates an expected call of FindPayrollByID.
-func (mr *MockRepositoryMockRecorder) FindPayrollByID(ctx, payrollID any) *MockRepositoryFindPayrollByIDCall {
+func (mr *MockRepositoryMockRecorder) FindPayrollByID(ctx, payrollID any, opts ...any) *MockRepositoryFindPayrollByIDCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayrollByID", reflect.TypeOf((*MockRepository)(nil).FindPayrollByID), ctx, payrollID)
+	varargs := append([]any{ctx, payrollID}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayrollByID", reflect.TypeOf((*MockRepository)(nil).FindPayrollByID), varargs...)
 	return &MockRepositoryFindPayrollByIDCall{Call: call}
 }
 
@@ -71,13 +194,13 @@ func (c *MockRepositoryFindPayrollByIDCall) Return(arg0 *entity.Payroll, arg1 er
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockRepositoryFindPayrollByIDCall) Do(f func(context.Context, string) (*entity.Payroll, error)) *MockRepositoryFindPayrollByIDCall {
+func (c *MockRepositoryFindPayrollByIDCall) Do(f func(context.Context, string, ...entity.FindPayrollOptions) (*entity.Payroll, error)) *MockRepositoryFindPayrollByIDCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockRepositoryFindPayrollByIDCall) DoAndReturn(f func(context.Context, string) (*entity.Payroll, error)) *MockRepositoryFindPayrollByIDCall {
+func (c *MockRepositoryFindPayrollByIDCall) DoAndReturn(f func(context.Context, string, ...entity.FindPayrollOptions) (*entity.Payroll, error)) *MockRepositoryFindPayrollByIDCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -126,6 +249,84 @@ func (c *MockRepositoryFindPayrollByPeriodIDCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// FindPayrollJobByID mocks base method.
+func (m *MockRepository) FindPayrollJobByID(ctx context.Context, jobID string) (*entity.PayrollJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayrollJobByID", ctx, jobID)
+	ret0, _ := ret[0].(*entity.PayrollJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayrollJobByID indicates an expected call of FindPayrollJobByID.
+func (mr *MockRepositoryMockRecorder) FindPayrollJobByID(ctx, jobID any) *MockRepositoryFindPayrollJobByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayrollJobByID", reflect.TypeOf((*MockRepository)(nil).FindPayrollJobByID), ctx, jobID)
+	return &MockRepositoryFindPayrollJobByIDCall{Call: call}
+}
+
+// MockRepositoryFindPayrollJobByIDCall wrap *gomock.Call
+type MockRepositoryFindPayrollJobByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayrollJobByIDCall) Return(arg0 *entity.PayrollJob, arg1 error) *MockRepositoryFindPayrollJobByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayrollJobByIDCall) Do(f func(context.Context, string) (*entity.PayrollJob, error)) *MockRepositoryFindPayrollJobByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayrollJobByIDCall) DoAndReturn(f func(context.Context, string) (*entity.PayrollJob, error)) *MockRepositoryFindPayrollJobByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPayslipByID mocks base method.
+func (m *MockRepository) FindPayslipByID(ctx context.Context, payslipID string) (*entity.Payslip, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayslipByID", ctx, payslipID)
+	ret0, _ := ret[0].(*entity.Payslip)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayslipByID indicates an expected call of FindPayslipByID.
+func (mr *MockRepositoryMockRecorder) FindPayslipByID(ctx, payslipID any) *MockRepositoryFindPayslipByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayslipByID", reflect.TypeOf((*MockRepository)(nil).FindPayslipByID), ctx, payslipID)
+	return &MockRepositoryFindPayslipByIDCall{Call: call}
+}
+
+// MockRepositoryFindPayslipByIDCall wrap *gomock.Call
+type MockRepositoryFindPayslipByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayslipByIDCall) Return(arg0 *entity.Payslip, arg1 error) *MockRepositoryFindPayslipByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayslipByIDCall) Do(f func(context.Context, string) (*entity.Payslip, error)) *MockRepositoryFindPayslipByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayslipByIDCall) DoAndReturn(f func(context.Context, string) (*entity.Payslip, error)) *MockRepositoryFindPayslipByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // FindPayslipByPayrollID mocks base method.
 func (m *MockRepository) FindPayslipByPayrollID(ctx context.Context, payrollID string, opts ...entity.FindPayslipOptions) (entity.FindPayslipResult, error) {
 	m.ctrl.T.Helper()
@@ -209,6 +410,199 @@ func (c *MockRepositoryFindPayslipByUserIDPeriodCall) DoAndReturn(f func(context
 	return c
 }
 
+// FindPayslipHistoryByUserID mocks base method.
+func (m *MockRepository) FindPayslipHistoryByUserID(ctx context.Context, userID string, opts entity.FindPayslipHistoryOptions) ([]entity.PayslipHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayslipHistoryByUserID", ctx, userID, opts)
+	ret0, _ := ret[0].([]entity.PayslipHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayslipHistoryByUserID indicates an expected call of FindPayslipHistoryByUserID.
+func (mr *MockRepositoryMockRecorder) FindPayslipHistoryByUserID(ctx, userID, opts any) *MockRepositoryFindPayslipHistoryByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayslipHistoryByUserID", reflect.TypeOf((*MockRepository)(nil).FindPayslipHistoryByUserID), ctx, userID, opts)
+	return &MockRepositoryFindPayslipHistoryByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindPayslipHistoryByUserIDCall wrap *gomock.Call
+type MockRepositoryFindPayslipHistoryByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayslipHistoryByUserIDCall) Return(arg0 []entity.PayslipHistory, arg1 error) *MockRepositoryFindPayslipHistoryByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayslipHistoryByUserIDCall) Do(f func(context.Context, string, entity.FindPayslipHistoryOptions) ([]entity.PayslipHistory, error)) *MockRepositoryFindPayslipHistoryByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayslipHistoryByUserIDCall) DoAndReturn(f func(context.Context, string, entity.FindPayslipHistoryOptions) ([]entity.PayslipHistory, error)) *MockRepositoryFindPayslipHistoryByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPayslipItemsByPayslipIDs mocks base method.
+func (m *MockRepository) FindPayslipItemsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayslipItemsByPayslipIDs", ctx, payslipIDs)
+	ret0, _ := ret[0].([]entity.PayslipItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayslipItemsByPayslipIDs indicates an expected call of FindPayslipItemsByPayslipIDs.
+func (mr *MockRepositoryMockRecorder) FindPayslipItemsByPayslipIDs(ctx, payslipIDs any) *MockRepositoryFindPayslipItemsByPayslipIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayslipItemsByPayslipIDs", reflect.TypeOf((*MockRepository)(nil).FindPayslipItemsByPayslipIDs), ctx, payslipIDs)
+	return &MockRepositoryFindPayslipItemsByPayslipIDsCall{Call: call}
+}
+
+// MockRepositoryFindPayslipItemsByPayslipIDsCall wrap *gomock.Call
+type MockRepositoryFindPayslipItemsByPayslipIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayslipItemsByPayslipIDsCall) Return(arg0 []entity.PayslipItem, arg1 error) *MockRepositoryFindPayslipItemsByPayslipIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayslipItemsByPayslipIDsCall) Do(f func(context.Context, []string) ([]entity.PayslipItem, error)) *MockRepositoryFindPayslipItemsByPayslipIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayslipItemsByPayslipIDsCall) DoAndReturn(f func(context.Context, []string) ([]entity.PayslipItem, error)) *MockRepositoryFindPayslipItemsByPayslipIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindPayslipSalarySegmentsByPayslipIDs mocks base method.
+func (m *MockRepository) FindPayslipSalarySegmentsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipSalarySegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayslipSalarySegmentsByPayslipIDs", ctx, payslipIDs)
+	ret0, _ := ret[0].([]entity.PayslipSalarySegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayslipSalarySegmentsByPayslipIDs indicates an expected call of FindPayslipSalarySegmentsByPayslipIDs.
+func (mr *MockRepositoryMockRecorder) FindPayslipSalarySegmentsByPayslipIDs(ctx, payslipIDs any) *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayslipSalarySegmentsByPayslipIDs", reflect.TypeOf((*MockRepository)(nil).FindPayslipSalarySegmentsByPayslipIDs), ctx, payslipIDs)
+	return &MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall{Call: call}
+}
+
+// MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall wrap *gomock.Call
+type MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall) Return(arg0 []entity.PayslipSalarySegment, arg1 error) *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall) Do(f func(context.Context, []string) ([]entity.PayslipSalarySegment, error)) *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall) DoAndReturn(f func(context.Context, []string) ([]entity.PayslipSalarySegment, error)) *MockRepositoryFindPayslipSalarySegmentsByPayslipIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FinishPayrollJob mocks base method.
+func (m *MockRepository) FinishPayrollJob(ctx context.Context, finish entity.FinishPayrollJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishPayrollJob", ctx, finish)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishPayrollJob indicates an expected call of FinishPayrollJob.
+func (mr *MockRepositoryMockRecorder) FinishPayrollJob(ctx, finish any) *MockRepositoryFinishPayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishPayrollJob", reflect.TypeOf((*MockRepository)(nil).FinishPayrollJob), ctx, finish)
+	return &MockRepositoryFinishPayrollJobCall{Call: call}
+}
+
+// MockRepositoryFinishPayrollJobCall wrap *gomock.Call
+type MockRepositoryFinishPayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFinishPayrollJobCall) Return(arg0 error) *MockRepositoryFinishPayrollJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFinishPayrollJobCall) Do(f func(context.Context, entity.FinishPayrollJob) error) *MockRepositoryFinishPayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFinishPayrollJobCall) DoAndReturn(f func(context.Context, entity.FinishPayrollJob) error) *MockRepositoryFinishPayrollJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReleasePayrollJob mocks base method.
+func (m *MockRepository) ReleasePayrollJob(ctx context.Context, jobID string, updatedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePayrollJob", ctx, jobID, updatedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleasePayrollJob indicates an expected call of ReleasePayrollJob.
+func (mr *MockRepositoryMockRecorder) ReleasePayrollJob(ctx, jobID, updatedAt any) *MockRepositoryReleasePayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePayrollJob", reflect.TypeOf((*MockRepository)(nil).ReleasePayrollJob), ctx, jobID, updatedAt)
+	return &MockRepositoryReleasePayrollJobCall{Call: call}
+}
+
+// MockRepositoryReleasePayrollJobCall wrap *gomock.Call
+type MockRepositoryReleasePayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryReleasePayrollJobCall) Return(arg0 error) *MockRepositoryReleasePayrollJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryReleasePayrollJobCall) Do(f func(context.Context, string, time.Time) error) *MockRepositoryReleasePayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryReleasePayrollJobCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockRepositoryReleasePayrollJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StoreNewPayroll mocks base method.
 func (m *MockRepository) StoreNewPayroll(ctx context.Context, arg1 entity.Payroll) error {
 	m.ctrl.T.Helper()
@@ -247,6 +641,82 @@ func (c *MockRepositoryStoreNewPayrollCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// StoreNewPayrollApproval mocks base method.
+func (m *MockRepository) StoreNewPayrollApproval(ctx context.Context, approval entity.PayrollApproval) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewPayrollApproval", ctx, approval)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewPayrollApproval indicates an expected call of StoreNewPayrollApproval.
+func (mr *MockRepositoryMockRecorder) StoreNewPayrollApproval(ctx, approval any) *MockRepositoryStoreNewPayrollApprovalCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewPayrollApproval", reflect.TypeOf((*MockRepository)(nil).StoreNewPayrollApproval), ctx, approval)
+	return &MockRepositoryStoreNewPayrollApprovalCall{Call: call}
+}
+
+// MockRepositoryStoreNewPayrollApprovalCall wrap *gomock.Call
+type MockRepositoryStoreNewPayrollApprovalCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewPayrollApprovalCall) Return(arg0 error) *MockRepositoryStoreNewPayrollApprovalCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewPayrollApprovalCall) Do(f func(context.Context, entity.PayrollApproval) error) *MockRepositoryStoreNewPayrollApprovalCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewPayrollApprovalCall) DoAndReturn(f func(context.Context, entity.PayrollApproval) error) *MockRepositoryStoreNewPayrollApprovalCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewPayrollJob mocks base method.
+func (m *MockRepository) StoreNewPayrollJob(ctx context.Context, job entity.PayrollJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewPayrollJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewPayrollJob indicates an expected call of StoreNewPayrollJob.
+func (mr *MockRepositoryMockRecorder) StoreNewPayrollJob(ctx, job any) *MockRepositoryStoreNewPayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewPayrollJob", reflect.TypeOf((*MockRepository)(nil).StoreNewPayrollJob), ctx, job)
+	return &MockRepositoryStoreNewPayrollJobCall{Call: call}
+}
+
+// MockRepositoryStoreNewPayrollJobCall wrap *gomock.Call
+type MockRepositoryStoreNewPayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewPayrollJobCall) Return(arg0 error) *MockRepositoryStoreNewPayrollJobCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewPayrollJobCall) Do(f func(context.Context, entity.PayrollJob) error) *MockRepositoryStoreNewPayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewPayrollJobCall) DoAndReturn(f func(context.Context, entity.PayrollJob) error) *MockRepositoryStoreNewPayrollJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StoreNewPayrollSummary mocks base method.
 func (m *MockRepository) StoreNewPayrollSummary(ctx context.Context, summary entity.PayrollSummary) error {
 	m.ctrl.T.Helper()
@@ -285,6 +755,82 @@ func (c *MockRepositoryStoreNewPayrollSummaryCall) DoAndReturn(f func(context.Co
 	return c
 }
 
+// StoreNewPayslipItems mocks base method.
+func (m *MockRepository) StoreNewPayslipItems(ctx context.Context, items []entity.PayslipItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewPayslipItems", ctx, items)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewPayslipItems indicates an expected call of StoreNewPayslipItems.
+func (mr *MockRepositoryMockRecorder) StoreNewPayslipItems(ctx, items any) *MockRepositoryStoreNewPayslipItemsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewPayslipItems", reflect.TypeOf((*MockRepository)(nil).StoreNewPayslipItems), ctx, items)
+	return &MockRepositoryStoreNewPayslipItemsCall{Call: call}
+}
+
+// MockRepositoryStoreNewPayslipItemsCall wrap *gomock.Call
+type MockRepositoryStoreNewPayslipItemsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewPayslipItemsCall) Return(arg0 error) *MockRepositoryStoreNewPayslipItemsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewPayslipItemsCall) Do(f func(context.Context, []entity.PayslipItem) error) *MockRepositoryStoreNewPayslipItemsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewPayslipItemsCall) DoAndReturn(f func(context.Context, []entity.PayslipItem) error) *MockRepositoryStoreNewPayslipItemsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewPayslipSalarySegments mocks base method.
+func (m *MockRepository) StoreNewPayslipSalarySegments(ctx context.Context, segments []entity.PayslipSalarySegment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewPayslipSalarySegments", ctx, segments)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewPayslipSalarySegments indicates an expected call of StoreNewPayslipSalarySegments.
+func (mr *MockRepositoryMockRecorder) StoreNewPayslipSalarySegments(ctx, segments any) *MockRepositoryStoreNewPayslipSalarySegmentsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewPayslipSalarySegments", reflect.TypeOf((*MockRepository)(nil).StoreNewPayslipSalarySegments), ctx, segments)
+	return &MockRepositoryStoreNewPayslipSalarySegmentsCall{Call: call}
+}
+
+// MockRepositoryStoreNewPayslipSalarySegmentsCall wrap *gomock.Call
+type MockRepositoryStoreNewPayslipSalarySegmentsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewPayslipSalarySegmentsCall) Return(arg0 error) *MockRepositoryStoreNewPayslipSalarySegmentsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewPayslipSalarySegmentsCall) Do(f func(context.Context, []entity.PayslipSalarySegment) error) *MockRepositoryStoreNewPayslipSalarySegmentsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewPayslipSalarySegmentsCall) DoAndReturn(f func(context.Context, []entity.PayslipSalarySegment) error) *MockRepositoryStoreNewPayslipSalarySegmentsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StoreNewPayslips mocks base method.
 func (m *MockRepository) StoreNewPayslips(ctx context.Context, payslips []entity.Payslip) error {
 	m.ctrl.T.Helper()
@@ -323,6 +869,120 @@ func (c *MockRepositoryStoreNewPayslipsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// UpdatePayrollJobProgress mocks base method.
+func (m *MockRepository) UpdatePayrollJobProgress(ctx context.Context, progress entity.PayrollJobProgress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayrollJobProgress", ctx, progress)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayrollJobProgress indicates an expected call of UpdatePayrollJobProgress.
+func (mr *MockRepositoryMockRecorder) UpdatePayrollJobProgress(ctx, progress any) *MockRepositoryUpdatePayrollJobProgressCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayrollJobProgress", reflect.TypeOf((*MockRepository)(nil).UpdatePayrollJobProgress), ctx, progress)
+	return &MockRepositoryUpdatePayrollJobProgressCall{Call: call}
+}
+
+// MockRepositoryUpdatePayrollJobProgressCall wrap *gomock.Call
+type MockRepositoryUpdatePayrollJobProgressCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdatePayrollJobProgressCall) Return(arg0 error) *MockRepositoryUpdatePayrollJobProgressCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdatePayrollJobProgressCall) Do(f func(context.Context, entity.PayrollJobProgress) error) *MockRepositoryUpdatePayrollJobProgressCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdatePayrollJobProgressCall) DoAndReturn(f func(context.Context, entity.PayrollJobProgress) error) *MockRepositoryUpdatePayrollJobProgressCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdatePayrollStatus mocks base method.
+func (m *MockRepository) UpdatePayrollStatus(ctx context.Context, update entity.UpdatePayrollStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayrollStatus", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayrollStatus indicates an expected call of UpdatePayrollStatus.
+func (mr *MockRepositoryMockRecorder) UpdatePayrollStatus(ctx, update any) *MockRepositoryUpdatePayrollStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayrollStatus", reflect.TypeOf((*MockRepository)(nil).UpdatePayrollStatus), ctx, update)
+	return &MockRepositoryUpdatePayrollStatusCall{Call: call}
+}
+
+// MockRepositoryUpdatePayrollStatusCall wrap *gomock.Call
+type MockRepositoryUpdatePayrollStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdatePayrollStatusCall) Return(arg0 error) *MockRepositoryUpdatePayrollStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdatePayrollStatusCall) Do(f func(context.Context, entity.UpdatePayrollStatus) error) *MockRepositoryUpdatePayrollStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdatePayrollStatusCall) DoAndReturn(f func(context.Context, entity.UpdatePayrollStatus) error) *MockRepositoryUpdatePayrollStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VoidPayroll mocks base method.
+func (m *MockRepository) VoidPayroll(ctx context.Context, voidPayroll entity.VoidPayroll) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidPayroll", ctx, voidPayroll)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidPayroll indicates an expected call of VoidPayroll.
+func (mr *MockRepositoryMockRecorder) VoidPayroll(ctx, voidPayroll any) *MockRepositoryVoidPayrollCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidPayroll", reflect.TypeOf((*MockRepository)(nil).VoidPayroll), ctx, voidPayroll)
+	return &MockRepositoryVoidPayrollCall{Call: call}
+}
+
+// MockRepositoryVoidPayrollCall wrap *gomock.Call
+type MockRepositoryVoidPayrollCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryVoidPayrollCall) Return(arg0 error) *MockRepositoryVoidPayrollCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryVoidPayrollCall) Do(f func(context.Context, entity.VoidPayroll) error) *MockRepositoryVoidPayrollCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryVoidPayrollCall) DoAndReturn(f func(context.Context, entity.VoidPayroll) error) *MockRepositoryVoidPayrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx database.DBTx) payroll.Repository {
 	m.ctrl.T.Helper()
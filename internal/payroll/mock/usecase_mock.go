@@ -12,9 +12,12 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	dtos "github.com/vnnyx/employee-management/internal/dtos"
 	entity0 "github.com/vnnyx/employee-management/internal/payroll/entity"
+	resourceful "github.com/vnnyx/employee-management/pkg/resourceful"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,11 +45,128 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 	return m.recorder
 }
 
+// DiffPayrolls mocks base method.
+func (m *MockUseCase) DiffPayrolls(ctx context.Context, authCredential entity.Credential, fromPayrollID, toPayrollID string, thresholds entity0.PayrollDiffThresholds) (entity0.PayrollDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffPayrolls", ctx, authCredential, fromPayrollID, toPayrollID, thresholds)
+	ret0, _ := ret[0].(entity0.PayrollDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffPayrolls indicates an expected call of DiffPayrolls.
+func (mr *MockUseCaseMockRecorder) DiffPayrolls(ctx, authCredential, fromPayrollID, toPayrollID, thresholds any) *MockUseCaseDiffPayrollsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffPayrolls", reflect.TypeOf((*MockUseCase)(nil).DiffPayrolls), ctx, authCredential, fromPayrollID, toPayrollID, thresholds)
+	return &MockUseCaseDiffPayrollsCall{Call: call}
+}
+
+// MockUseCaseDiffPayrollsCall wrap *gomock.Call
+type MockUseCaseDiffPayrollsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseDiffPayrollsCall) Return(arg0 entity0.PayrollDiff, arg1 error) *MockUseCaseDiffPayrollsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseDiffPayrollsCall) Do(f func(context.Context, entity.Credential, string, string, entity0.PayrollDiffThresholds) (entity0.PayrollDiff, error)) *MockUseCaseDiffPayrollsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseDiffPayrollsCall) DoAndReturn(f func(context.Context, entity.Credential, string, string, entity0.PayrollDiffThresholds) (entity0.PayrollDiff, error)) *MockUseCaseDiffPayrollsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportBankTransfers mocks base method.
+func (m *MockUseCase) ExportBankTransfers(ctx context.Context, authCredential entity.Credential, payrollID string, executionDate time.Time) (entity0.BankTransferBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportBankTransfers", ctx, authCredential, payrollID, executionDate)
+	ret0, _ := ret[0].(entity0.BankTransferBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportBankTransfers indicates an expected call of ExportBankTransfers.
+func (mr *MockUseCaseMockRecorder) ExportBankTransfers(ctx, authCredential, payrollID, executionDate any) *MockUseCaseExportBankTransfersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportBankTransfers", reflect.TypeOf((*MockUseCase)(nil).ExportBankTransfers), ctx, authCredential, payrollID, executionDate)
+	return &MockUseCaseExportBankTransfersCall{Call: call}
+}
+
+// MockUseCaseExportBankTransfersCall wrap *gomock.Call
+type MockUseCaseExportBankTransfersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseExportBankTransfersCall) Return(arg0 entity0.BankTransferBatch, arg1 error) *MockUseCaseExportBankTransfersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseExportBankTransfersCall) Do(f func(context.Context, entity.Credential, string, time.Time) (entity0.BankTransferBatch, error)) *MockUseCaseExportBankTransfersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseExportBankTransfersCall) DoAndReturn(f func(context.Context, entity.Credential, string, time.Time) (entity0.BankTransferBatch, error)) *MockUseCaseExportBankTransfersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ExportPayslips mocks base method.
+func (m *MockUseCase) ExportPayslips(ctx context.Context, authCredential entity.Credential, payrollID string) (entity0.PayslipExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportPayslips", ctx, authCredential, payrollID)
+	ret0, _ := ret[0].(entity0.PayslipExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportPayslips indicates an expected call of ExportPayslips.
+func (mr *MockUseCaseMockRecorder) ExportPayslips(ctx, authCredential, payrollID any) *MockUseCaseExportPayslipsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportPayslips", reflect.TypeOf((*MockUseCase)(nil).ExportPayslips), ctx, authCredential, payrollID)
+	return &MockUseCaseExportPayslipsCall{Call: call}
+}
+
+// MockUseCaseExportPayslipsCall wrap *gomock.Call
+type MockUseCaseExportPayslipsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseExportPayslipsCall) Return(arg0 entity0.PayslipExport, arg1 error) *MockUseCaseExportPayslipsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseExportPayslipsCall) Do(f func(context.Context, entity.Credential, string) (entity0.PayslipExport, error)) *MockUseCaseExportPayslipsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseExportPayslipsCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.PayslipExport, error)) *MockUseCaseExportPayslipsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GeneratePayroll mocks base method.
-func (m *MockUseCase) GeneratePayroll(ctx context.Context, authCredential entity.Credential, periodID string) (entity0.GeneratedPayroll, error) {
+func (m *MockUseCase) GeneratePayroll(ctx context.Context, authCredential entity.Credential, periodID string) (entity0.PayrollJob, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GeneratePayroll", ctx, authCredential, periodID)
-	ret0, _ := ret[0].(entity0.GeneratedPayroll)
+	ret0, _ := ret[0].(entity0.PayrollJob)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -64,36 +184,114 @@ type MockUseCaseGeneratePayrollCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUseCaseGeneratePayrollCall) Return(arg0 entity0.GeneratedPayroll, arg1 error) *MockUseCaseGeneratePayrollCall {
+func (c *MockUseCaseGeneratePayrollCall) Return(arg0 entity0.PayrollJob, arg1 error) *MockUseCaseGeneratePayrollCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUseCaseGeneratePayrollCall) Do(f func(context.Context, entity.Credential, string) (entity0.GeneratedPayroll, error)) *MockUseCaseGeneratePayrollCall {
+func (c *MockUseCaseGeneratePayrollCall) Do(f func(context.Context, entity.Credential, string) (entity0.PayrollJob, error)) *MockUseCaseGeneratePayrollCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUseCaseGeneratePayrollCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.GeneratedPayroll, error)) *MockUseCaseGeneratePayrollCall {
+func (c *MockUseCaseGeneratePayrollCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.PayrollJob, error)) *MockUseCaseGeneratePayrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListMyPayslips mocks base method.
+func (m *MockUseCase) ListMyPayslips(ctx context.Context, authCredential entity.Credential) ([]entity0.PayslipHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMyPayslips", ctx, authCredential)
+	ret0, _ := ret[0].([]entity0.PayslipHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMyPayslips indicates an expected call of ListMyPayslips.
+func (mr *MockUseCaseMockRecorder) ListMyPayslips(ctx, authCredential any) *MockUseCaseListMyPayslipsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMyPayslips", reflect.TypeOf((*MockUseCase)(nil).ListMyPayslips), ctx, authCredential)
+	return &MockUseCaseListMyPayslipsCall{Call: call}
+}
+
+// MockUseCaseListMyPayslipsCall wrap *gomock.Call
+type MockUseCaseListMyPayslipsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListMyPayslipsCall) Return(arg0 []entity0.PayslipHistory, arg1 error) *MockUseCaseListMyPayslipsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListMyPayslipsCall) Do(f func(context.Context, entity.Credential) ([]entity0.PayslipHistory, error)) *MockUseCaseListMyPayslipsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListMyPayslipsCall) DoAndReturn(f func(context.Context, entity.Credential) ([]entity0.PayslipHistory, error)) *MockUseCaseListMyPayslipsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListPayrollApprovals mocks base method.
+func (m *MockUseCase) ListPayrollApprovals(ctx context.Context, authCredential entity.Credential, payrollID string) ([]entity0.PayrollApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPayrollApprovals", ctx, authCredential, payrollID)
+	ret0, _ := ret[0].([]entity0.PayrollApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPayrollApprovals indicates an expected call of ListPayrollApprovals.
+func (mr *MockUseCaseMockRecorder) ListPayrollApprovals(ctx, authCredential, payrollID any) *MockUseCaseListPayrollApprovalsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayrollApprovals", reflect.TypeOf((*MockUseCase)(nil).ListPayrollApprovals), ctx, authCredential, payrollID)
+	return &MockUseCaseListPayrollApprovalsCall{Call: call}
+}
+
+// MockUseCaseListPayrollApprovalsCall wrap *gomock.Call
+type MockUseCaseListPayrollApprovalsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListPayrollApprovalsCall) Return(arg0 []entity0.PayrollApproval, arg1 error) *MockUseCaseListPayrollApprovalsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListPayrollApprovalsCall) Do(f func(context.Context, entity.Credential, string) ([]entity0.PayrollApproval, error)) *MockUseCaseListPayrollApprovalsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListPayrollApprovalsCall) DoAndReturn(f func(context.Context, entity.Credential, string) ([]entity0.PayrollApproval, error)) *MockUseCaseListPayrollApprovalsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
 // ListPayslips mocks base method.
-func (m *MockUseCase) ListPayslips(ctx context.Context, authCredential entity.Credential, payrollID string) (entity0.ListPayslips, error) {
+func (m *MockUseCase) ListPayslips(ctx context.Context, authCredential entity.Credential, payrollID string, resource *resourceful.Resource[string, dtos.PayslipDataResponse]) (*resourceful.Resource[string, dtos.PayslipDataResponse], error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPayslips", ctx, authCredential, payrollID)
-	ret0, _ := ret[0].(entity0.ListPayslips)
+	ret := m.ctrl.Call(m, "ListPayslips", ctx, authCredential, payrollID, resource)
+	ret0, _ := ret[0].(*resourceful.Resource[string, dtos.PayslipDataResponse])
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListPayslips indicates an expected call of ListPayslips.
-func (mr *MockUseCaseMockRecorder) ListPayslips(ctx, authCredential, payrollID any) *MockUseCaseListPayslipsCall {
+func (mr *MockUseCaseMockRecorder) ListPayslips(ctx, authCredential, payrollID, resource any) *MockUseCaseListPayslipsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayslips", reflect.TypeOf((*MockUseCase)(nil).ListPayslips), ctx, authCredential, payrollID)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPayslips", reflect.TypeOf((*MockUseCase)(nil).ListPayslips), ctx, authCredential, payrollID, resource)
 	return &MockUseCaseListPayslipsCall{Call: call}
 }
 
@@ -103,19 +301,175 @@ type MockUseCaseListPayslipsCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockUseCaseListPayslipsCall) Return(arg0 entity0.ListPayslips, arg1 error) *MockUseCaseListPayslipsCall {
+func (c *MockUseCaseListPayslipsCall) Return(arg0 *resourceful.Resource[string, dtos.PayslipDataResponse], arg1 error) *MockUseCaseListPayslipsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListPayslipsCall) Do(f func(context.Context, entity.Credential, string, *resourceful.Resource[string, dtos.PayslipDataResponse]) (*resourceful.Resource[string, dtos.PayslipDataResponse], error)) *MockUseCaseListPayslipsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListPayslipsCall) DoAndReturn(f func(context.Context, entity.Credential, string, *resourceful.Resource[string, dtos.PayslipDataResponse]) (*resourceful.Resource[string, dtos.PayslipDataResponse], error)) *MockUseCaseListPayslipsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PreviewPayroll mocks base method.
+func (m *MockUseCase) PreviewPayroll(ctx context.Context, authCredential entity.Credential, periodID string) (entity0.PayrollPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewPayroll", ctx, authCredential, periodID)
+	ret0, _ := ret[0].(entity0.PayrollPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewPayroll indicates an expected call of PreviewPayroll.
+func (mr *MockUseCaseMockRecorder) PreviewPayroll(ctx, authCredential, periodID any) *MockUseCasePreviewPayrollCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewPayroll", reflect.TypeOf((*MockUseCase)(nil).PreviewPayroll), ctx, authCredential, periodID)
+	return &MockUseCasePreviewPayrollCall{Call: call}
+}
+
+// MockUseCasePreviewPayrollCall wrap *gomock.Call
+type MockUseCasePreviewPayrollCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCasePreviewPayrollCall) Return(arg0 entity0.PayrollPreview, arg1 error) *MockUseCasePreviewPayrollCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockUseCaseListPayslipsCall) Do(f func(context.Context, entity.Credential, string) (entity0.ListPayslips, error)) *MockUseCaseListPayslipsCall {
+func (c *MockUseCasePreviewPayrollCall) Do(f func(context.Context, entity.Credential, string) (entity0.PayrollPreview, error)) *MockUseCasePreviewPayrollCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockUseCaseListPayslipsCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.ListPayslips, error)) *MockUseCaseListPayslipsCall {
+func (c *MockUseCasePreviewPayrollCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.PayrollPreview, error)) *MockUseCasePreviewPayrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ProcessNextPayrollJob mocks base method.
+func (m *MockUseCase) ProcessNextPayrollJob(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessNextPayrollJob", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessNextPayrollJob indicates an expected call of ProcessNextPayrollJob.
+func (mr *MockUseCaseMockRecorder) ProcessNextPayrollJob(ctx any) *MockUseCaseProcessNextPayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessNextPayrollJob", reflect.TypeOf((*MockUseCase)(nil).ProcessNextPayrollJob), ctx)
+	return &MockUseCaseProcessNextPayrollJobCall{Call: call}
+}
+
+// MockUseCaseProcessNextPayrollJobCall wrap *gomock.Call
+type MockUseCaseProcessNextPayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseProcessNextPayrollJobCall) Return(arg0 bool, arg1 error) *MockUseCaseProcessNextPayrollJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseProcessNextPayrollJobCall) Do(f func(context.Context) (bool, error)) *MockUseCaseProcessNextPayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseProcessNextPayrollJobCall) DoAndReturn(f func(context.Context) (bool, error)) *MockUseCaseProcessNextPayrollJobCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegeneratePayroll mocks base method.
+func (m *MockUseCase) RegeneratePayroll(ctx context.Context, authCredential entity.Credential, payrollID, reason string) (entity0.GeneratedPayroll, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegeneratePayroll", ctx, authCredential, payrollID, reason)
+	ret0, _ := ret[0].(entity0.GeneratedPayroll)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegeneratePayroll indicates an expected call of RegeneratePayroll.
+func (mr *MockUseCaseMockRecorder) RegeneratePayroll(ctx, authCredential, payrollID, reason any) *MockUseCaseRegeneratePayrollCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegeneratePayroll", reflect.TypeOf((*MockUseCase)(nil).RegeneratePayroll), ctx, authCredential, payrollID, reason)
+	return &MockUseCaseRegeneratePayrollCall{Call: call}
+}
+
+// MockUseCaseRegeneratePayrollCall wrap *gomock.Call
+type MockUseCaseRegeneratePayrollCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseRegeneratePayrollCall) Return(arg0 entity0.GeneratedPayroll, arg1 error) *MockUseCaseRegeneratePayrollCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseRegeneratePayrollCall) Do(f func(context.Context, entity.Credential, string, string) (entity0.GeneratedPayroll, error)) *MockUseCaseRegeneratePayrollCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseRegeneratePayrollCall) DoAndReturn(f func(context.Context, entity.Credential, string, string) (entity0.GeneratedPayroll, error)) *MockUseCaseRegeneratePayrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ShowPayrollJob mocks base method.
+func (m *MockUseCase) ShowPayrollJob(ctx context.Context, authCredential entity.Credential, jobID string) (entity0.PayrollJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowPayrollJob", ctx, authCredential, jobID)
+	ret0, _ := ret[0].(entity0.PayrollJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowPayrollJob indicates an expected call of ShowPayrollJob.
+func (mr *MockUseCaseMockRecorder) ShowPayrollJob(ctx, authCredential, jobID any) *MockUseCaseShowPayrollJobCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowPayrollJob", reflect.TypeOf((*MockUseCase)(nil).ShowPayrollJob), ctx, authCredential, jobID)
+	return &MockUseCaseShowPayrollJobCall{Call: call}
+}
+
+// MockUseCaseShowPayrollJobCall wrap *gomock.Call
+type MockUseCaseShowPayrollJobCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowPayrollJobCall) Return(arg0 entity0.PayrollJob, arg1 error) *MockUseCaseShowPayrollJobCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowPayrollJobCall) Do(f func(context.Context, entity.Credential, string) (entity0.PayrollJob, error)) *MockUseCaseShowPayrollJobCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowPayrollJobCall) DoAndReturn(f func(context.Context, entity.Credential, string) (entity0.PayrollJob, error)) *MockUseCaseShowPayrollJobCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -158,3 +512,120 @@ func (c *MockUseCaseShowPayslipCall) DoAndReturn(f func(context.Context, entity.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ShowPayslipYearToDate mocks base method.
+func (m *MockUseCase) ShowPayslipYearToDate(ctx context.Context, authCredential entity.Credential, basis entity0.YearBasis, year int64) (entity0.PayslipYearToDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowPayslipYearToDate", ctx, authCredential, basis, year)
+	ret0, _ := ret[0].(entity0.PayslipYearToDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowPayslipYearToDate indicates an expected call of ShowPayslipYearToDate.
+func (mr *MockUseCaseMockRecorder) ShowPayslipYearToDate(ctx, authCredential, basis, year any) *MockUseCaseShowPayslipYearToDateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowPayslipYearToDate", reflect.TypeOf((*MockUseCase)(nil).ShowPayslipYearToDate), ctx, authCredential, basis, year)
+	return &MockUseCaseShowPayslipYearToDateCall{Call: call}
+}
+
+// MockUseCaseShowPayslipYearToDateCall wrap *gomock.Call
+type MockUseCaseShowPayslipYearToDateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowPayslipYearToDateCall) Return(arg0 entity0.PayslipYearToDate, arg1 error) *MockUseCaseShowPayslipYearToDateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowPayslipYearToDateCall) Do(f func(context.Context, entity.Credential, entity0.YearBasis, int64) (entity0.PayslipYearToDate, error)) *MockUseCaseShowPayslipYearToDateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowPayslipYearToDateCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.YearBasis, int64) (entity0.PayslipYearToDate, error)) *MockUseCaseShowPayslipYearToDateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TransitionPayroll mocks base method.
+func (m *MockUseCase) TransitionPayroll(ctx context.Context, authCredential entity.Credential, payrollID string, action entity0.PayrollAction, comment string) (entity0.PayrollApproval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionPayroll", ctx, authCredential, payrollID, action, comment)
+	ret0, _ := ret[0].(entity0.PayrollApproval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionPayroll indicates an expected call of TransitionPayroll.
+func (mr *MockUseCaseMockRecorder) TransitionPayroll(ctx, authCredential, payrollID, action, comment any) *MockUseCaseTransitionPayrollCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionPayroll", reflect.TypeOf((*MockUseCase)(nil).TransitionPayroll), ctx, authCredential, payrollID, action, comment)
+	return &MockUseCaseTransitionPayrollCall{Call: call}
+}
+
+// MockUseCaseTransitionPayrollCall wrap *gomock.Call
+type MockUseCaseTransitionPayrollCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseTransitionPayrollCall) Return(arg0 entity0.PayrollApproval, arg1 error) *MockUseCaseTransitionPayrollCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseTransitionPayrollCall) Do(f func(context.Context, entity.Credential, string, entity0.PayrollAction, string) (entity0.PayrollApproval, error)) *MockUseCaseTransitionPayrollCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseTransitionPayrollCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.PayrollAction, string) (entity0.PayrollApproval, error)) *MockUseCaseTransitionPayrollCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VerifyPayslip mocks base method.
+func (m *MockUseCase) VerifyPayslip(ctx context.Context, authCredential entity.Credential, payslipID, verificationID string) (entity0.PayslipVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyPayslip", ctx, authCredential, payslipID, verificationID)
+	ret0, _ := ret[0].(entity0.PayslipVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyPayslip indicates an expected call of VerifyPayslip.
+func (mr *MockUseCaseMockRecorder) VerifyPayslip(ctx, authCredential, payslipID, verificationID any) *MockUseCaseVerifyPayslipCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyPayslip", reflect.TypeOf((*MockUseCase)(nil).VerifyPayslip), ctx, authCredential, payslipID, verificationID)
+	return &MockUseCaseVerifyPayslipCall{Call: call}
+}
+
+// MockUseCaseVerifyPayslipCall wrap *gomock.Call
+type MockUseCaseVerifyPayslipCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseVerifyPayslipCall) Return(arg0 entity0.PayslipVerification, arg1 error) *MockUseCaseVerifyPayslipCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseVerifyPayslipCall) Do(f func(context.Context, entity.Credential, string, string) (entity0.PayslipVerification, error)) *MockUseCaseVerifyPayslipCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseVerifyPayslipCall) DoAndReturn(f func(context.Context, entity.Credential, string, string) (entity0.PayslipVerification, error)) *MockUseCaseVerifyPayslipCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
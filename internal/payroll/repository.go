@@ -2,6 +2,7 @@ package payroll
 
 import (
 	"context"
+	"time"
 
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
 	"github.com/vnnyx/employee-management/pkg/database"
@@ -15,6 +16,23 @@ type Repository interface {
 	StoreNewPayrollSummary(ctx context.Context, summary entity.PayrollSummary) error
 	FindPayrollByPeriodID(ctx context.Context, periodID string, opts ...entity.FindPayrollOptions) (*entity.Payroll, error)
 	FindPayslipByUserIDPeriod(ctx context.Context, userID, periodID string) (*entity.Payslip, error)
-	FindPayrollByID(ctx context.Context, payrollID string) (*entity.Payroll, error)
+	FindPayslipByID(ctx context.Context, payslipID string) (*entity.Payslip, error)
+	FindPayrollByID(ctx context.Context, payrollID string, opts ...entity.FindPayrollOptions) (*entity.Payroll, error)
 	FindPayslipByPayrollID(ctx context.Context, payrollID string, opts ...entity.FindPayslipOptions) (entity.FindPayslipResult, error)
+	FindPayslipHistoryByUserID(ctx context.Context, userID string, opts entity.FindPayslipHistoryOptions) ([]entity.PayslipHistory, error)
+	VoidPayroll(ctx context.Context, voidPayroll entity.VoidPayroll) error
+	UpdatePayrollStatus(ctx context.Context, update entity.UpdatePayrollStatus) error
+	StoreNewPayrollApproval(ctx context.Context, approval entity.PayrollApproval) error
+	FindPayrollApprovalsByPayrollID(ctx context.Context, payrollID string) ([]entity.PayrollApproval, error)
+	FindActiveDeductionRules(ctx context.Context) ([]entity.DeductionRule, error)
+	StoreNewPayslipItems(ctx context.Context, items []entity.PayslipItem) error
+	FindPayslipItemsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipItem, error)
+	StoreNewPayslipSalarySegments(ctx context.Context, segments []entity.PayslipSalarySegment) error
+	FindPayslipSalarySegmentsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipSalarySegment, error)
+	StoreNewPayrollJob(ctx context.Context, job entity.PayrollJob) error
+	FindPayrollJobByID(ctx context.Context, jobID string) (*entity.PayrollJob, error)
+	ClaimPayrollJob(ctx context.Context, claim entity.ClaimPayrollJob) (*entity.PayrollJob, error)
+	UpdatePayrollJobProgress(ctx context.Context, progress entity.PayrollJobProgress) error
+	FinishPayrollJob(ctx context.Context, finish entity.FinishPayrollJob) error
+	ReleasePayrollJob(ctx context.Context, jobID string, updatedAt time.Time) error
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -11,6 +12,7 @@ import (
 	"github.com/vnnyx/employee-management/internal/payroll"
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
 	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
 )
 
@@ -155,15 +157,39 @@ func (r *payrollRepository) FindPayslipByUserIDPeriod(ctx context.Context, userI
 	return &payslip, nil
 }
 
-func (r *payrollRepository) FindPayrollByID(ctx context.Context, payrollID string) (*entity.Payroll, error) {
+func (r *payrollRepository) FindPayslipByID(ctx context.Context, payslipID string) (*entity.Payslip, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayslipByID()",
+	)
+	defer span.End()
+
+	var payslip entity.Payslip
+	err := pgxscan.Get(ctx, r.db, &payslip, findPayslipByIDQuery, payslipID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &payslip, nil
+}
+
+func (r *payrollRepository) FindPayrollByID(ctx context.Context, payrollID string, opts ...entity.FindPayrollOptions) (*entity.Payroll, error) {
 	ctx, span := instrumentation.NewTraceSpan(
 		ctx,
 		"PayrollRepository.FindPayrollByID()",
 	)
 	defer span.End()
 
+	query := findPayrollByIDQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
 	var payroll entity.Payroll
-	err := pgxscan.Get(ctx, r.db, &payroll, findPayrollByIDQuery, payrollID)
+	err := pgxscan.Get(ctx, r.db, &payroll, query, payrollID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, nil
@@ -204,8 +230,8 @@ func (r *payrollRepository) FindPayslipByPayrollID(ctx context.Context, payrollI
 		}
 
 		// Total take home pay
-		totalQuery := "SELECT SUM(total_take_home) FROM payslips WHERE payroll_id = $1"
-		var totalTakeHome int64
+		totalQuery := "SELECT COALESCE(SUM(total_take_home), 0) FROM payslips WHERE payroll_id = $1"
+		var totalTakeHome money.Money
 		err = pgxscan.Get(ctx, r.db, &totalTakeHome, totalQuery, payrollID)
 		if err != nil {
 			return result, errors.Wrap(err, constants.ErrWrapPgxscanGet)
@@ -237,10 +263,17 @@ func (r *payrollRepository) FindPayslipByPayrollID(ctx context.Context, payrollI
 			&payslip.UserID,
 			&payslip.PayrollID,
 			&payslip.BaseSalary,
+			&payslip.WorkingDays,
+			&payslip.EligibleWorkingDays,
 			&payslip.AttendanceDays,
 			&payslip.OvertimeHours,
 			&payslip.OvertimePay,
+			&payslip.OvertimePolicyID,
+			&payslip.OvertimePolicyVersion,
+			&payslip.OvertimeRatePerHour,
+			&payslip.GrossPay,
 			&payslip.ReimbursementTotal,
+			&payslip.TotalDeductions,
 			&payslip.TotalTakeHome,
 			&payslip.CreatedAt,
 			&payslip.UpdatedAt,
@@ -291,3 +324,392 @@ func (r *payrollRepository) FindPayslipByPayrollID(ctx context.Context, payrollI
 
 	return result, nil
 }
+
+func (r *payrollRepository) VoidPayroll(ctx context.Context, voidPayroll entity.VoidPayroll) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.VoidPayroll()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(voidPayrollQuery, voidPayroll)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to void payroll"), constants.ErrWrapPgxscanGet)
+	}
+
+	// Payslips and the summary are kept for audit, they are only flagged as voided
+	for _, voidQuery := range []string{voidPayslipsQuery, voidPayrollSummaryQuery} {
+		query, args, err := sqlx.Named(voidQuery, voidPayroll)
+		if err != nil {
+			return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+		}
+		query = database.Rebind(query)
+
+		_, err = r.db.Exec(ctx, query, args...)
+		if err != nil {
+			return errors.Wrap(err, constants.ErrWrapDbExec)
+		}
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) UpdatePayrollStatus(ctx context.Context, update entity.UpdatePayrollStatus) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.UpdatePayrollStatus()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updatePayrollStatusQuery, update)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update payroll status"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) StoreNewPayrollApproval(ctx context.Context, approval entity.PayrollApproval) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.StoreNewPayrollApproval()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertPayrollApprovalQuery, approval)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert payroll approval"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FindPayrollApprovalsByPayrollID(ctx context.Context, payrollID string) ([]entity.PayrollApproval, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayrollApprovalsByPayrollID()",
+	)
+	defer span.End()
+
+	var approvals []entity.PayrollApproval
+	err := pgxscan.Select(ctx, r.db, &approvals, findPayrollApprovalsByPayrollIDQuery, payrollID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return approvals, nil
+}
+
+func (r *payrollRepository) FindActiveDeductionRules(ctx context.Context) ([]entity.DeductionRule, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindActiveDeductionRules()",
+	)
+	defer span.End()
+
+	var rules []entity.DeductionRule
+	err := pgxscan.Select(ctx, r.db, &rules, findActiveDeductionRulesQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return rules, nil
+}
+
+func (r *payrollRepository) StoreNewPayslipItems(ctx context.Context, items []entity.PayslipItem) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.StoreNewPayslipItems()",
+	)
+	defer span.End()
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.Named(insertPayslipItemQuery, items)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedIDs []string
+	err = pgxscan.Select(ctx, r.db, &returnedIDs, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	if len(returnedIDs) != len(items) {
+		return errors.Wrap(errors.New("failed to insert payslip items"), constants.ErrWrapPgxscanSelect)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FindPayslipItemsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipItem, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayslipItemsByPayslipIDs()",
+	)
+	defer span.End()
+
+	if len(payslipIDs) == 0 {
+		return nil, nil
+	}
+
+	var items []entity.PayslipItem
+	err := pgxscan.Select(ctx, r.db, &items, findPayslipItemsByPayslipIDsQuery, payslipIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return items, nil
+}
+
+func (r *payrollRepository) StoreNewPayslipSalarySegments(ctx context.Context, segments []entity.PayslipSalarySegment) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.StoreNewPayslipSalarySegments()",
+	)
+	defer span.End()
+
+	if len(segments) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.Named(insertPayslipSalarySegmentQuery, segments)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedIDs []string
+	err = pgxscan.Select(ctx, r.db, &returnedIDs, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	if len(returnedIDs) != len(segments) {
+		return errors.Wrap(errors.New("failed to insert payslip salary segments"), constants.ErrWrapPgxscanSelect)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FindPayslipSalarySegmentsByPayslipIDs(ctx context.Context, payslipIDs []string) ([]entity.PayslipSalarySegment, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayslipSalarySegmentsByPayslipIDs()",
+	)
+	defer span.End()
+
+	if len(payslipIDs) == 0 {
+		return nil, nil
+	}
+
+	var segments []entity.PayslipSalarySegment
+	err := pgxscan.Select(ctx, r.db, &segments, findPayslipSalarySegmentsByPayslipIDsQuery, payslipIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return segments, nil
+}
+
+func (r *payrollRepository) StoreNewPayrollJob(ctx context.Context, job entity.PayrollJob) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.StoreNewPayrollJob()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertPayrollJobQuery, job)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert payroll job"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FindPayrollJobByID(ctx context.Context, jobID string) (*entity.PayrollJob, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayrollJobByID()",
+	)
+	defer span.End()
+
+	var job entity.PayrollJob
+	err := pgxscan.Get(ctx, r.db, &job, findPayrollJobByIDQuery, jobID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &job, nil
+}
+
+func (r *payrollRepository) ClaimPayrollJob(ctx context.Context, claim entity.ClaimPayrollJob) (*entity.PayrollJob, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.ClaimPayrollJob()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(claimPayrollJobQuery, claim)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var job entity.PayrollJob
+	err = pgxscan.Get(ctx, r.db, &job, query, args...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &job, nil
+}
+
+func (r *payrollRepository) UpdatePayrollJobProgress(ctx context.Context, progress entity.PayrollJobProgress) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.UpdatePayrollJobProgress()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updatePayrollJobProgressQuery, progress)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FinishPayrollJob(ctx context.Context, finish entity.FinishPayrollJob) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FinishPayrollJob()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(finishPayrollJobQuery, finish)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to finish payroll job"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) ReleasePayrollJob(ctx context.Context, jobID string, updatedAt time.Time) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.ReleasePayrollJob()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, releasePayrollJobQuery, jobID, updatedAt)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *payrollRepository) FindPayslipHistoryByUserID(ctx context.Context, userID string, opts entity.FindPayslipHistoryOptions) ([]entity.PayslipHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollRepository.FindPayslipHistoryByUserID()",
+	)
+	defer span.End()
+
+	statuses := make([]string, len(opts.PayrollStatuses))
+	for i, status := range opts.PayrollStatuses {
+		statuses[i] = string(status)
+	}
+
+	query := findPayslipHistoryByUserIDQuery
+	args := []any{userID, statuses}
+	if from, ok := opts.PeriodEndFrom.Get(); ok {
+		query += " AND ap.end_date >= ?"
+		args = append(args, from)
+	}
+	if before, ok := opts.PeriodEndBefore.Get(); ok {
+		query += " AND ap.end_date < ?"
+		args = append(args, before)
+	}
+	query += " ORDER BY ap.end_date DESC, p.id"
+
+	var history []entity.PayslipHistory
+	err := pgxscan.Select(ctx, r.db, &history, database.Rebind(query), args...)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return history, nil
+}
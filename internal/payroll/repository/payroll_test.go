@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
 	"github.com/vnnyx/employee-management/internal/payroll/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
 )
 
 func TestStoreNewPayroll(t *testing.T) {
@@ -30,8 +33,8 @@ func TestStoreNewPayroll(t *testing.T) {
 			name: "success",
 			setupMock: func() {
 				mock.ExpectQuery("INSERT INTO payrolls").
-					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
 					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("payroll-1"))
 			},
 			input:     entity.Payroll{ID: "payroll-1", CreatedAt: now, UpdatedAt: now},
@@ -92,11 +95,13 @@ func TestStoreNewPayslips(t *testing.T) {
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 					).
 					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("ps-1").AddRow("ps-2"))
 			},
@@ -166,7 +171,7 @@ func TestStoreNewPayrollSummary(t *testing.T) {
 					WithArgs(
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 					).
 					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("summary-1"))
 			},
@@ -180,7 +185,7 @@ func TestStoreNewPayrollSummary(t *testing.T) {
 					WithArgs(
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 					).
 					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
 			},
@@ -194,7 +199,7 @@ func TestStoreNewPayrollSummary(t *testing.T) {
 					WithArgs(
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
-						pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
 					).
 					WillReturnError(assert.AnError)
 			},
@@ -215,3 +220,943 @@ func TestStoreNewPayrollSummary(t *testing.T) {
 		})
 	}
 }
+
+func TestVoidPayroll(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	input := entity.VoidPayroll{
+		PayrollID:  "payroll-1",
+		VoidedAt:   time.Now(),
+		VoidedBy:   "admin-1",
+		VoidReason: "late overtime approval",
+		IPAddress:  "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payrolls").
+					WithArgs(input.VoidedAt, input.VoidedBy, input.VoidReason, input.VoidedAt, input.VoidedBy,
+						input.IPAddress, input.PayrollID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("payroll-1"))
+				mock.ExpectExec("UPDATE payslips").
+					WithArgs(input.VoidedAt, input.VoidedAt, input.VoidedBy, input.IPAddress, input.PayrollID).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 2))
+				mock.ExpectExec("UPDATE payroll_summaries").
+					WithArgs(input.VoidedAt, input.VoidedAt, input.VoidedBy, input.IPAddress, input.PayrollID).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - payroll already voided",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payrolls").
+					WithArgs(input.VoidedAt, input.VoidedBy, input.VoidReason, input.VoidedAt, input.VoidedBy,
+						input.IPAddress, input.PayrollID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+		{
+			name: "error - voiding payslips fails",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payrolls").
+					WithArgs(input.VoidedAt, input.VoidedBy, input.VoidReason, input.VoidedAt, input.VoidedBy,
+						input.IPAddress, input.PayrollID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("payroll-1"))
+				mock.ExpectExec("UPDATE payslips").
+					WithArgs(input.VoidedAt, input.VoidedAt, input.VoidedBy, input.IPAddress, input.PayrollID).
+					WillReturnError(errors.New("exec error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.VoidPayroll(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindActiveDeductionRules(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	columns := []string{
+		"id", "code", "name", "kind", "sort_order", "rate", "cap_amount", "amount", "brackets", "is_active",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  []entity.DeductionRule
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM deduction_rules").
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("rule-1", "income_tax", "Income Tax", entity.DeductionKindProgressiveTax, int64(1), float64(0), int64(0), int64(0),
+							[]entity.TaxBracket{{UpTo: money.New(50), Rate: 0.05}, {Rate: 0.1}}, true, now, now, "admin-1", "admin-1", "127.0.0.1").
+						AddRow("rule-2", "union_fee", "Union Fee", entity.DeductionKindFixed, int64(2), float64(0), int64(0), int64(5),
+							[]entity.TaxBracket(nil), true, now, now, "admin-1", "admin-1", "127.0.0.1"))
+			},
+			expected: []entity.DeductionRule{
+				{
+					ID: "rule-1", Code: "income_tax", Name: "Income Tax", Kind: entity.DeductionKindProgressiveTax, SortOrder: 1,
+					Brackets: []entity.TaxBracket{{UpTo: money.New(50), Rate: 0.05}, {Rate: 0.1}}, IsActive: true,
+					CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+				{
+					ID: "rule-2", Code: "union_fee", Name: "Union Fee", Kind: entity.DeductionKindFixed, SortOrder: 2,
+					Amount: money.New(5), IsActive: true,
+					CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - query fails",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM deduction_rules").
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			rules, err := repo.FindActiveDeductionRules(context.Background())
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, rules)
+			}
+		})
+	}
+}
+
+func TestStoreNewPayslipItems(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     []entity.PayslipItem
+		expectErr bool
+	}{
+		{
+			name: "success - multiple rows",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payslip_items").
+					WithArgs(
+						"item-1", "ps-1", entity.PayslipItemTypeDeduction, "income_tax", "Income Tax", money.New(8), false, optional.String{}, int64(1),
+						now, now, "admin-1", "admin-1", "127.0.0.1",
+						"item-2", "ps-1", entity.PayslipItemTypeAllowance, "transport", "Transport", money.New(5), true, optional.String{}, int64(2),
+						now, now, "admin-1", "admin-1", "127.0.0.1",
+						"item-3", "ps-1", entity.PayslipItemTypeLoanRepayment, "loan_repayment", "Loan Repayment", money.New(3), false, optional.NewString("loan-1"), int64(3),
+						now, now, "admin-1", "admin-1", "127.0.0.1",
+					).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("item-1").AddRow("item-2").AddRow("item-3"))
+			},
+			input: []entity.PayslipItem{
+				{
+					ID: "item-1", PayslipID: "ps-1", ItemType: entity.PayslipItemTypeDeduction, Code: "income_tax", Name: "Income Tax",
+					Amount: money.New(8), SortOrder: 1, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+				{
+					ID: "item-2", PayslipID: "ps-1", ItemType: entity.PayslipItemTypeAllowance, Code: "transport", Name: "Transport",
+					Amount: money.New(5), Taxable: true, SortOrder: 2, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+				{
+					ID: "item-3", PayslipID: "ps-1", ItemType: entity.PayslipItemTypeLoanRepayment, Code: "loan_repayment", Name: "Loan Repayment",
+					Amount: money.New(3), LoanID: optional.NewString("loan-1"), SortOrder: 3, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - mismatched return count",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payslip_items").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("item-1"))
+			},
+			input:     []entity.PayslipItem{{ID: "item-1"}, {ID: "item-2"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty input",
+			setupMock: func() {}, // No DB call expected
+			input:     []entity.PayslipItem{},
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewPayslipItems(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindPayslipItemsByPayslipIDs(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	columns := []string{
+		"id", "payslip_id", "item_type", "code", "name", "amount", "taxable", "loan_id", "sort_order",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name       string
+		payslipIDs []string
+		setupMock  func()
+		expected   []entity.PayslipItem
+		expectErr  bool
+	}{
+		{
+			name:       "success",
+			payslipIDs: []string{"ps-1"},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_items").
+					WithArgs([]string{"ps-1"}).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("item-1", "ps-1", entity.PayslipItemTypeDeduction, "income_tax", "Income Tax", money.New(8), false, nil, int64(1),
+							now, now, "admin-1", "admin-1", "127.0.0.1"))
+			},
+			expected: []entity.PayslipItem{
+				{
+					ID: "item-1", PayslipID: "ps-1", ItemType: entity.PayslipItemTypeDeduction, Code: "income_tax", Name: "Income Tax",
+					Amount: money.New(8), SortOrder: 1, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:       "error - query fails",
+			payslipIDs: []string{"ps-1"},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_items").
+					WithArgs([]string{"ps-1"}).
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+		{
+			name:       "empty input",
+			payslipIDs: nil,
+			setupMock:  func() {}, // No DB call expected
+			expected:   nil,
+			expectErr:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			items, err := repo.FindPayslipItemsByPayslipIDs(context.Background(), tt.payslipIDs)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, items)
+			}
+		})
+	}
+}
+
+func TestStoreNewPayslipSalarySegments(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	firstHalf := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	secondHalf := time.Date(2023, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     []entity.PayslipSalarySegment
+		expectErr bool
+	}{
+		{
+			name: "success - multiple rows",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payslip_salary_segments").
+					WithArgs(
+						"segment-1", "ps-1", firstHalf, secondHalf.AddDate(0, 0, -1), money.New(1000), int64(10), int64(1), money.MustParse("45.45"), money.MustParse("5.68"), int64(1),
+						now, now, "admin-1", "admin-1", "127.0.0.1",
+						"segment-2", "ps-1", secondHalf, secondHalf.AddDate(0, 0, 15), money.New(2100), int64(12), int64(1), money.MustParse("95.45"), money.MustParse("11.93"), int64(2),
+						now, now, "admin-1", "admin-1", "127.0.0.1",
+					).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("segment-1").AddRow("segment-2"))
+			},
+			input: []entity.PayslipSalarySegment{
+				{
+					ID: "segment-1", PayslipID: "ps-1", StartDate: firstHalf, EndDate: secondHalf.AddDate(0, 0, -1), Salary: money.New(1000), WorkingDays: 10, AttendanceDays: 1,
+					AttendancePay: money.MustParse("45.45"), OvertimeRatePerHour: money.MustParse("5.68"), SortOrder: 1, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+				{
+					ID: "segment-2", PayslipID: "ps-1", StartDate: secondHalf, EndDate: secondHalf.AddDate(0, 0, 15), Salary: money.New(2100), WorkingDays: 12, AttendanceDays: 1,
+					AttendancePay: money.MustParse("95.45"), OvertimeRatePerHour: money.MustParse("11.93"), SortOrder: 2, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - mismatched return count",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payslip_salary_segments").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("segment-1"))
+			},
+			input:     []entity.PayslipSalarySegment{{ID: "segment-1"}, {ID: "segment-2"}},
+			expectErr: true,
+		},
+		{
+			name:      "empty input",
+			setupMock: func() {}, // No DB call expected
+			input:     []entity.PayslipSalarySegment{},
+			expectErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewPayslipSalarySegments(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindPayslipSalarySegmentsByPayslipIDs(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)
+	columns := []string{
+		"id", "payslip_id", "start_date", "end_date", "salary", "working_days", "attendance_days", "attendance_pay", "overtime_rate_per_hour", "sort_order",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name       string
+		payslipIDs []string
+		setupMock  func()
+		expected   []entity.PayslipSalarySegment
+		expectErr  bool
+	}{
+		{
+			name:       "success",
+			payslipIDs: []string{"ps-1"},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_salary_segments").
+					WithArgs([]string{"ps-1"}).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("segment-1", "ps-1", startDate, endDate, money.New(1000), int64(22), int64(20), money.MustParse("909.09"), money.MustParse("5.68"), int64(1),
+							now, now, "admin-1", "admin-1", "127.0.0.1"))
+			},
+			expected: []entity.PayslipSalarySegment{
+				{
+					ID: "segment-1", PayslipID: "ps-1", StartDate: startDate, EndDate: endDate, Salary: money.New(1000), WorkingDays: 22, AttendanceDays: 20,
+					AttendancePay: money.MustParse("909.09"), OvertimeRatePerHour: money.MustParse("5.68"), SortOrder: 1, CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:       "error - query fails",
+			payslipIDs: []string{"ps-1"},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslip_salary_segments").
+					WithArgs([]string{"ps-1"}).
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+		{
+			name:       "empty input",
+			payslipIDs: nil,
+			setupMock:  func() {}, // No DB call expected
+			expected:   nil,
+			expectErr:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			segments, err := repo.FindPayslipSalarySegmentsByPayslipIDs(context.Background(), tt.payslipIDs)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, segments)
+			}
+		})
+	}
+}
+
+func TestStoreNewPayrollJob(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	input := entity.PayrollJob{
+		ID:        "job-1",
+		PeriodID:  "period-1",
+		Status:    entity.PayrollJobQueued,
+		RequestID: "req-123",
+		CreatedAt: now,
+		UpdatedAt: now,
+		CreatedBy: "admin-1",
+		UpdatedBy: "admin-1",
+		IPAddress: "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_jobs").
+					WithArgs(input.ID, input.PeriodID, input.Status, input.RequestID, input.CreatedAt, input.UpdatedAt,
+						input.CreatedBy, input.UpdatedBy, input.IPAddress).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("job-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - query fails",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_jobs").
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewPayrollJob(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClaimPayrollJob(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	input := entity.ClaimPayrollJob{
+		ClaimedAt:   now,
+		StaleBefore: now.Add(-time.Minute),
+	}
+	columns := []string{
+		"id", "period_id", "status", "stage", "progress_done", "progress_total", "attempts", "failure_code",
+		"failure_reason", "payroll_id", "result", "request_id", "heartbeat_at", "started_at", "finished_at",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  *entity.PayrollJob
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payroll_jobs SET (.+) FOR UPDATE SKIP LOCKED").
+					WithArgs(now, now, now, now.Add(-time.Minute)).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("job-1", "period-1", entity.PayrollJobRunning, nil, int64(0), int64(0), int64(1), nil,
+							nil, nil, (*entity.GeneratedPayroll)(nil), "req-123", now, now, nil,
+							now, now, "admin-1", "admin-1", "127.0.0.1"))
+			},
+			expected: &entity.PayrollJob{
+				ID:          "job-1",
+				PeriodID:    "period-1",
+				Status:      entity.PayrollJobRunning,
+				Attempts:    1,
+				RequestID:   "req-123",
+				HeartbeatAt: optional.NewTime(now),
+				StartedAt:   optional.NewTime(now),
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				CreatedBy:   "admin-1",
+				UpdatedBy:   "admin-1",
+				IPAddress:   "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "success - no job queued",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payroll_jobs SET (.+) FOR UPDATE SKIP LOCKED").
+					WithArgs(now, now, now, now.Add(-time.Minute)).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expected:  nil,
+			expectErr: false,
+		},
+		{
+			name: "error - query fails",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payroll_jobs SET (.+) FOR UPDATE SKIP LOCKED").
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			job, err := repo.ClaimPayrollJob(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, testutil.EqualVerbose(tt.expected, job))
+			}
+		})
+	}
+}
+
+func TestFinishPayrollJob(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	input := entity.FinishPayrollJob{
+		JobID:         "job-1",
+		Status:        entity.PayrollJobFailed,
+		FailureCode:   optional.NewString(entity.PayrollAlreadyGenerated),
+		FailureReason: optional.NewString(entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyGenerated)),
+		PayrollID:     optional.NewString(),
+		FinishedAt:    time.Now(),
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payroll_jobs").
+					WithArgs(input.Status, input.FailureCode, input.FailureReason, input.PayrollID, input.Result,
+						input.FinishedAt, input.FinishedAt, input.JobID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("job-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - job no longer running",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payroll_jobs").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.FinishPayrollJob(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestReleasePayrollJob(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectExec("UPDATE payroll_jobs").
+					WithArgs("job-1", now).
+					WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - exec fails",
+			setupMock: func() {
+				mock.ExpectExec("UPDATE payroll_jobs").
+					WithArgs("job-1", now).
+					WillReturnError(errors.New("exec error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.ReleasePayrollJob(context.Background(), "job-1", now)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestUpdatePayrollStatus(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	input := entity.UpdatePayrollStatus{
+		PayrollID:  "payroll-1",
+		FromStatus: entity.PayrollStatusDraft,
+		ToStatus:   entity.PayrollStatusSubmitted,
+		UpdatedAt:  time.Now(),
+		UpdatedBy:  "admin-1",
+		IPAddress:  "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payrolls").
+					WithArgs(input.ToStatus, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.PayrollID, input.FromStatus).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("payroll-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - status changed concurrently",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE payrolls").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdatePayrollStatus(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStoreNewPayrollApproval(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	input := entity.PayrollApproval{
+		ID:         "approval-1",
+		PayrollID:  "payroll-1",
+		Action:     entity.PayrollActionReject,
+		FromStatus: entity.PayrollStatusSubmitted,
+		ToStatus:   entity.PayrollStatusDraft,
+		Comment:    optional.NewString("overtime of october is missing"),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		CreatedBy:  "admin-2",
+		UpdatedBy:  "admin-2",
+		IPAddress:  "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_approvals").
+					WithArgs(input.ID, input.PayrollID, input.Action, input.FromStatus, input.ToStatus, input.Comment,
+						input.CreatedAt, input.UpdatedAt, input.CreatedBy, input.UpdatedBy, input.IPAddress).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("approval-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_approvals").
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			expectErr: true,
+		},
+		{
+			name: "error - query fails",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO payroll_approvals").
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewPayrollApproval(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindPayrollApprovalsByPayrollID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	columns := []string{
+		"id", "payroll_id", "action", "from_status", "to_status", "comment",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  []entity.PayrollApproval
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_approvals").
+					WithArgs("payroll-1").
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("approval-1", "payroll-1", entity.PayrollActionSubmit, entity.PayrollStatusDraft, entity.PayrollStatusSubmitted, optional.NewString(),
+							now, now, "admin-1", "admin-1", "127.0.0.1"))
+			},
+			expected: []entity.PayrollApproval{
+				{
+					ID: "approval-1", PayrollID: "payroll-1", Action: entity.PayrollActionSubmit, FromStatus: entity.PayrollStatusDraft, ToStatus: entity.PayrollStatusSubmitted,
+					Comment: optional.NewString(), CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - query fails",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payroll_approvals").
+					WithArgs("payroll-1").
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			approvals, err := repo.FindPayrollApprovalsByPayrollID(context.Background(), "payroll-1")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, approvals)
+			}
+		})
+	}
+}
+
+func TestFindPayslipHistoryByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewPayrollRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	yearStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextYearStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	statuses := []entity.PayrollStatus{entity.PayrollStatusApproved, entity.PayrollStatusPaid}
+	columns := []string{
+		"id", "user_id", "payroll_id", "base_salary", "working_days", "eligible_working_days", "attendance_days", "overtime_hours", "overtime_pay",
+		"overtime_policy_id", "overtime_policy_version", "overtime_rate_per_hour", "gross_pay", "reimbursement_total", "total_deductions", "total_take_home",
+		"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+		"payroll_version", "payroll_status", "period_id", "period_start_date", "period_end_date",
+	}
+
+	tests := []struct {
+		name      string
+		opts      entity.FindPayslipHistoryOptions
+		setupMock func()
+		expected  []entity.PayslipHistory
+		expectErr bool
+	}{
+		{
+			name: "success - all periods",
+			opts: entity.FindPayslipHistoryOptions{PayrollStatuses: statuses},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslips p JOIN payrolls pr (.+) ORDER BY ap.end_date DESC").
+					WithArgs("user-1", []string{"approved", "paid"}).
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("ps-1", "user-1", "payroll-1", money.New(1000), optional.NewInt64(21), optional.NewInt64(21), int64(21), optional.NewDuration(), money.New(0),
+							optional.NewString(), optional.NewInt64(), optional.NewMoney(), money.New(1000), money.New(0), money.New(100), money.New(900),
+							now, now, "admin-1", "admin-1", "127.0.0.1",
+							int64(1), entity.PayrollStatusPaid, "period-1", startDate, endDate))
+			},
+			expected: []entity.PayslipHistory{
+				{
+					Payslip: entity.Payslip{
+						ID: "ps-1", UserID: "user-1", PayrollID: "payroll-1", BaseSalary: money.New(1000), WorkingDays: optional.NewInt64(21), EligibleWorkingDays: optional.NewInt64(21), AttendanceDays: 21,
+						OvertimeHours: optional.NewDuration(), OvertimePay: money.New(0), OvertimePolicyID: optional.NewString(), OvertimePolicyVersion: optional.NewInt64(),
+						OvertimeRatePerHour: optional.NewMoney(), GrossPay: money.New(1000), ReimbursementTotal: money.New(0), TotalDeductions: money.New(100), TotalTakeHome: money.New(900),
+						CreatedAt: now, UpdatedAt: now, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1",
+					},
+					PayrollVersion:  1,
+					PayrollStatus:   entity.PayrollStatusPaid,
+					PeriodID:        "period-1",
+					PeriodStartDate: startDate,
+					PeriodEndDate:   endDate,
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "success - periods ending within a year",
+			opts: entity.FindPayslipHistoryOptions{
+				PayrollStatuses: statuses,
+				PeriodEndFrom:   optional.NewTime(yearStart),
+				PeriodEndBefore: optional.NewTime(nextYearStart),
+			},
+			setupMock: func() {
+				mock.ExpectQuery(`ap.end_date >= \$3 AND ap.end_date < \$4 ORDER BY`).
+					WithArgs("user-1", []string{"approved", "paid"}, yearStart, nextYearStart).
+					WillReturnRows(pgxmock.NewRows(columns))
+			},
+			expected:  nil,
+			expectErr: false,
+		},
+		{
+			name: "error - query fails",
+			opts: entity.FindPayslipHistoryOptions{PayrollStatuses: statuses},
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslips p").
+					WithArgs("user-1", []string{"approved", "paid"}).
+					WillReturnError(errors.New("query error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			history, err := repo.FindPayslipHistoryByUserID(context.Background(), "user-1", tt.opts)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, history)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
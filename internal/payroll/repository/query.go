@@ -4,6 +4,8 @@ const insertPayrollQuery = `
 INSERT INTO payrolls (
 	id,
 	period_id,
+	version,
+	status,
 	run_by,
 	run_at,
 	created_at,
@@ -15,6 +17,8 @@ INSERT INTO payrolls (
 VALUES (
 	:id,
 	:period_id,
+	:version,
+	:status,
 	:run_by,
 	:run_at,
 	:created_at,
@@ -32,11 +36,21 @@ INSERT INTO payslips (
 	user_id,
 	payroll_id,
 	base_salary,
+	working_days,
+	eligible_working_days,
 	attendance_days,
+	worked_duration,
 	overtime_hours,
 	overtime_pay,
+	overtime_policy_id,
+	overtime_policy_version,
+	overtime_rate_per_hour,
+	gross_pay,
 	reimbursement_total,
+	total_deductions,
 	total_take_home,
+	department,
+	cost_centre,
 	created_at,
 	updated_at,
 	created_by,
@@ -48,11 +62,21 @@ VALUES (
 	:user_id,
 	:payroll_id,
 	:base_salary,
+	:working_days,
+	:eligible_working_days,
 	:attendance_days,
+	:worked_duration,
 	:overtime_hours,
 	:overtime_pay,
+	:overtime_policy_id,
+	:overtime_policy_version,
+	:overtime_rate_per_hour,
+	:gross_pay,
 	:reimbursement_total,
+	:total_deductions,
 	:total_take_home,
+	:department,
+	:cost_centre,
 	:created_at,
 	:updated_at,
 	:created_by,
@@ -66,6 +90,9 @@ const insertPayrollSummaryQuery = `
 INSERT INTO payroll_summaries (
 	id,
 	payroll_id,
+	total_gross_pay,
+	total_reimbursement,
+	total_deductions,
 	total_take_home,
 	generated_by,
 	generated_at,
@@ -78,6 +105,9 @@ INSERT INTO payroll_summaries (
 VALUES (
 	:id,
 	:payroll_id,
+	:total_gross_pay,
+	:total_reimbursement,
+	:total_deductions,
 	:total_take_home,
 	:generated_by,
 	:generated_at,
@@ -94,15 +124,20 @@ const findPayrollByPeriodIDQuery = `
 SELECT
 	id,
 	period_id,
+	version,
+	status,
 	run_by,
 	run_at,
+	voided_at,
+	voided_by,
+	void_reason,
 	created_at,
 	updated_at,
 	created_by,
 	updated_by,
 	ip_address
 FROM payrolls
-WHERE period_id = $1
+WHERE period_id = $1 AND voided_at IS NULL
 `
 
 const findPayslipByUserIDPeriodQuery = `
@@ -111,10 +146,18 @@ SELECT
 	user_id,
 	payroll_id,
 	base_salary,
+	working_days,
+	eligible_working_days,
 	attendance_days,
+	worked_duration,
 	overtime_hours,
 	overtime_pay,
+	overtime_policy_id,
+	overtime_policy_version,
+	overtime_rate_per_hour,
+	gross_pay,
 	reimbursement_total,
+	total_deductions,
 	total_take_home,
 	created_at,
 	updated_at,
@@ -125,16 +168,52 @@ FROM payslips
 WHERE user_id = $1 AND payroll_id = $2
 `
 
+const findPayslipByIDQuery = `
+SELECT
+	id,
+	user_id,
+	payroll_id,
+	base_salary,
+	working_days,
+	eligible_working_days,
+	attendance_days,
+	worked_duration,
+	overtime_hours,
+	overtime_pay,
+	overtime_policy_id,
+	overtime_policy_version,
+	overtime_rate_per_hour,
+	gross_pay,
+	reimbursement_total,
+	total_deductions,
+	total_take_home,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payslips
+WHERE id = $1
+`
+
 const findPayslipByPayrollIDQuery = `
 SELECT
 	id,
 	user_id,
 	payroll_id,
 	base_salary,
+	working_days,
+	eligible_working_days,
 	attendance_days,
+	worked_duration,
 	overtime_hours,
 	overtime_pay,
+	overtime_policy_id,
+	overtime_policy_version,
+	overtime_rate_per_hour,
+	gross_pay,
 	reimbursement_total,
+	total_deductions,
 	total_take_home,
 	created_at,
 	updated_at,
@@ -149,8 +228,13 @@ const findPayrollByIDQuery = `
 SELECT
 	id,
 	period_id,
+	version,
+	status,
 	run_by,
 	run_at,
+	voided_at,
+	voided_by,
+	void_reason,
 	created_at,
 	updated_at,
 	created_by,
@@ -159,3 +243,396 @@ SELECT
 FROM payrolls
 WHERE id = $1
 `
+
+const updatePayrollStatusQuery = `
+UPDATE payrolls SET
+	status = :to_status,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :payroll_id AND status = :from_status AND voided_at IS NULL
+RETURNING id
+`
+
+const insertPayrollApprovalQuery = `
+INSERT INTO payroll_approvals (
+	id,
+	payroll_id,
+	action,
+	from_status,
+	to_status,
+	comment,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:payroll_id,
+	:action,
+	:from_status,
+	:to_status,
+	:comment,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findPayrollApprovalsByPayrollIDQuery = `
+SELECT
+	id,
+	payroll_id,
+	action,
+	from_status,
+	to_status,
+	comment,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payroll_approvals
+WHERE payroll_id = $1
+ORDER BY created_at, id
+`
+
+const voidPayrollQuery = `
+UPDATE payrolls SET
+	voided_at = :voided_at,
+	voided_by = :voided_by,
+	void_reason = :void_reason,
+	updated_at = :voided_at,
+	updated_by = :voided_by,
+	ip_address = :ip_address
+WHERE id = :payroll_id AND voided_at IS NULL
+RETURNING id
+`
+
+const voidPayslipsQuery = `
+UPDATE payslips SET
+	voided_at = :voided_at,
+	updated_at = :voided_at,
+	updated_by = :voided_by,
+	ip_address = :ip_address
+WHERE payroll_id = :payroll_id AND voided_at IS NULL
+`
+
+const voidPayrollSummaryQuery = `
+UPDATE payroll_summaries SET
+	voided_at = :voided_at,
+	updated_at = :voided_at,
+	updated_by = :voided_by,
+	ip_address = :ip_address
+WHERE payroll_id = :payroll_id AND voided_at IS NULL
+`
+
+const findActiveDeductionRulesQuery = `
+SELECT
+	id,
+	code,
+	name,
+	kind,
+	sort_order,
+	rate,
+	cap_amount,
+	amount,
+	brackets,
+	is_active,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM deduction_rules
+WHERE is_active
+ORDER BY sort_order ASC, code ASC
+`
+
+const insertPayslipItemQuery = `
+INSERT INTO payslip_items (
+	id,
+	payslip_id,
+	item_type,
+	code,
+	name,
+	amount,
+	taxable,
+	loan_id,
+	sort_order,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:payslip_id,
+	:item_type,
+	:code,
+	:name,
+	:amount,
+	:taxable,
+	:loan_id,
+	:sort_order,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findPayslipItemsByPayslipIDsQuery = `
+SELECT
+	id,
+	payslip_id,
+	item_type,
+	code,
+	name,
+	amount,
+	taxable,
+	loan_id,
+	sort_order,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payslip_items
+WHERE payslip_id = ANY($1)
+ORDER BY payslip_id ASC, sort_order ASC
+`
+
+const insertPayslipSalarySegmentQuery = `
+INSERT INTO payslip_salary_segments (
+	id,
+	payslip_id,
+	start_date,
+	end_date,
+	salary,
+	working_days,
+	attendance_days,
+	attendance_pay,
+	overtime_rate_per_hour,
+	sort_order,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:payslip_id,
+	:start_date,
+	:end_date,
+	:salary,
+	:working_days,
+	:attendance_days,
+	:attendance_pay,
+	:overtime_rate_per_hour,
+	:sort_order,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findPayslipSalarySegmentsByPayslipIDsQuery = `
+SELECT
+	id,
+	payslip_id,
+	start_date,
+	end_date,
+	salary,
+	working_days,
+	attendance_days,
+	attendance_pay,
+	overtime_rate_per_hour,
+	sort_order,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payslip_salary_segments
+WHERE payslip_id = ANY($1)
+ORDER BY payslip_id ASC, sort_order ASC
+`
+
+const insertPayrollJobQuery = `
+INSERT INTO payroll_jobs (
+	id,
+	period_id,
+	status,
+	request_id,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:period_id,
+	:status,
+	:request_id,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const findPayrollJobByIDQuery = `
+SELECT
+	id,
+	period_id,
+	status,
+	stage,
+	progress_done,
+	progress_total,
+	attempts,
+	failure_code,
+	failure_reason,
+	payroll_id,
+	result,
+	request_id,
+	heartbeat_at,
+	started_at,
+	finished_at,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM payroll_jobs
+WHERE id = $1
+`
+
+// SKIP LOCKED lets several workers claim jobs concurrently without waiting on
+// each other. A running job without a recent heartbeat belongs to a worker
+// that stopped, it is claimed again.
+const claimPayrollJobQuery = `
+UPDATE payroll_jobs SET
+	status = 'running',
+	stage = NULL,
+	progress_done = 0,
+	progress_total = 0,
+	attempts = attempts + 1,
+	heartbeat_at = :claimed_at,
+	started_at = :claimed_at,
+	updated_at = :claimed_at
+WHERE id = (
+	SELECT id
+	FROM payroll_jobs
+	WHERE status = 'queued'
+		OR (status = 'running' AND heartbeat_at < :stale_before)
+	ORDER BY created_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	id,
+	period_id,
+	status,
+	stage,
+	progress_done,
+	progress_total,
+	attempts,
+	failure_code,
+	failure_reason,
+	payroll_id,
+	result,
+	request_id,
+	heartbeat_at,
+	started_at,
+	finished_at,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+`
+
+const updatePayrollJobProgressQuery = `
+UPDATE payroll_jobs SET
+	stage = :stage,
+	progress_done = :progress_done,
+	progress_total = :progress_total,
+	heartbeat_at = :updated_at,
+	updated_at = :updated_at
+WHERE id = :job_id AND status = 'running'
+`
+
+const finishPayrollJobQuery = `
+UPDATE payroll_jobs SET
+	status = :status,
+	failure_code = :failure_code,
+	failure_reason = :failure_reason,
+	payroll_id = :payroll_id,
+	result = :result,
+	finished_at = :finished_at,
+	updated_at = :finished_at
+WHERE id = :job_id AND status = 'running'
+RETURNING id
+`
+
+// releasePayrollJobQuery puts a job back in the queue when its worker shuts
+// down, so it does not have to wait for the heartbeat to go stale.
+const releasePayrollJobQuery = `
+UPDATE payroll_jobs SET
+	status = 'queued',
+	stage = NULL,
+	heartbeat_at = NULL,
+	updated_at = $2
+WHERE id = $1 AND status = 'running'
+`
+
+const findPayslipHistoryByUserIDQuery = `
+SELECT
+	p.id,
+	p.user_id,
+	p.payroll_id,
+	p.base_salary,
+	p.working_days,
+	p.eligible_working_days,
+	p.attendance_days,
+	p.worked_duration,
+	p.overtime_hours,
+	p.overtime_pay,
+	p.overtime_policy_id,
+	p.overtime_policy_version,
+	p.overtime_rate_per_hour,
+	p.gross_pay,
+	p.reimbursement_total,
+	p.total_deductions,
+	p.total_take_home,
+	p.created_at,
+	p.updated_at,
+	p.created_by,
+	p.updated_by,
+	p.ip_address,
+	pr.version AS payroll_version,
+	pr.status AS payroll_status,
+	ap.id AS period_id,
+	ap.start_date AS period_start_date,
+	ap.end_date AS period_end_date
+FROM payslips p
+JOIN payrolls pr ON pr.id = p.payroll_id
+JOIN attendance_periods ap ON ap.id = pr.period_id
+WHERE p.user_id = ? AND pr.voided_at IS NULL AND pr.status = ANY(?)
+`
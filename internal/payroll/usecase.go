@@ -2,6 +2,7 @@ package payroll
 
 import (
 	"context"
+	"time"
 
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
 	"github.com/vnnyx/employee-management/internal/dtos"
@@ -10,7 +11,19 @@ import (
 )
 
 type UseCase interface {
-	GeneratePayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.GeneratedPayroll, error)
+	GeneratePayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.PayrollJob, error)
+	ShowPayrollJob(ctx context.Context, authCredential authCredential.Credential, jobID string) (entity.PayrollJob, error)
+	ProcessNextPayrollJob(ctx context.Context) (bool, error)
+	RegeneratePayroll(ctx context.Context, authCredential authCredential.Credential, payrollID, reason string) (entity.GeneratedPayroll, error)
+	TransitionPayroll(ctx context.Context, authCredential authCredential.Credential, payrollID string, action entity.PayrollAction, comment string) (entity.PayrollApproval, error)
+	ListPayrollApprovals(ctx context.Context, authCredential authCredential.Credential, payrollID string) ([]entity.PayrollApproval, error)
+	ListMyPayslips(ctx context.Context, authCredential authCredential.Credential) ([]entity.PayslipHistory, error)
+	ShowPayslipYearToDate(ctx context.Context, authCredential authCredential.Credential, basis entity.YearBasis, year int64) (entity.PayslipYearToDate, error)
+	DiffPayrolls(ctx context.Context, authCredential authCredential.Credential, fromPayrollID, toPayrollID string, thresholds entity.PayrollDiffThresholds) (entity.PayrollDiff, error)
+	PreviewPayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.PayrollPreview, error)
 	ShowPayslip(ctx context.Context, authCredential authCredential.Credential, payrollID string) (*entity.PayslipData, error)
 	ListPayslips(ctx context.Context, authCredential authCredential.Credential, payrollID string, resource *resourceful.Resource[string, dtos.PayslipDataResponse]) (*resourceful.Resource[string, dtos.PayslipDataResponse], error)
+	ExportPayslips(ctx context.Context, authCredential authCredential.Credential, payrollID string) (entity.PayslipExport, error)
+	ExportBankTransfers(ctx context.Context, authCredential authCredential.Credential, payrollID string, executionDate time.Time) (entity.BankTransferBatch, error)
+	VerifyPayslip(ctx context.Context, authCredential authCredential.Credential, payslipID, verificationID string) (entity.PayslipVerification, error)
 }
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/payroll"
+	"github.com/vnnyx/employee-management/internal/payroll/entity"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+const (
+	// payrollJobStaleAfter is how long a running job may go without a
+	// heartbeat before another worker takes it over.
+	payrollJobStaleAfter = time.Minute
+	// payrollJobHeartbeatInterval keeps a job alive while a single step, like
+	// storing the payslips, takes longer than the progress updates.
+	payrollJobHeartbeatInterval = 10 * time.Second
+	// payrollJobProgressInterval throttles progress writes, a payroll of
+	// thousands of employees would otherwise write once per employee.
+	payrollJobProgressInterval = time.Second
+	payrollJobMaxAttempts      = 3
+)
+
+// jobProgress records how far a payroll job got. Updates are written outside
+// the generation transaction so they are visible while the job runs. A nil
+// jobProgress ignores every report, for calculations that are not jobs.
+type jobProgress struct {
+	payrollRepo payroll.Repository
+	jobID       string
+
+	mu        sync.Mutex
+	last      entity.PayrollJobProgress
+	writtenAt time.Time
+}
+
+func newJobProgress(payrollRepo payroll.Repository, jobID string) *jobProgress {
+	return &jobProgress{
+		payrollRepo: payrollRepo,
+		jobID:       jobID,
+		last: entity.PayrollJobProgress{
+			JobID: jobID,
+		},
+	}
+}
+
+func (p *jobProgress) report(ctx context.Context, stage entity.PayrollJobStage, done, total int64) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	timeNow := time.Now()
+	changedStage := stage != p.last.Stage
+	p.last = entity.PayrollJobProgress{
+		JobID:         p.jobID,
+		Stage:         stage,
+		ProgressDone:  done,
+		ProgressTotal: total,
+		UpdatedAt:     timeNow,
+	}
+
+	if !changedStage && done != total && timeNow.Sub(p.writtenAt) < payrollJobProgressInterval {
+		return
+	}
+
+	p.write(ctx)
+}
+
+// heartbeat writes the last reported progress again every
+// payrollJobHeartbeatInterval until the returned stop is called.
+func (p *jobProgress) heartbeat(ctx context.Context) (stop func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(payrollJobHeartbeatInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.mu.Lock()
+				if p.last.Stage != "" {
+					p.last.UpdatedAt = time.Now()
+					p.write(ctx)
+				}
+				p.mu.Unlock()
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
+// write must be called with mu held. A lost progress update only delays the
+// next one, it does not fail the job.
+func (p *jobProgress) write(ctx context.Context) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.jobProgress.write()",
+	)
+	defer span.End()
+
+	err := p.payrollRepo.UpdatePayrollJobProgress(ctx, p.last)
+	if err != nil {
+		instrumentation.RecordSpanError(span, err)
+		return
+	}
+
+	p.writtenAt = p.last.UpdatedAt
+}
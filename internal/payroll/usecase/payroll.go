@@ -3,27 +3,41 @@ package usecase
 import (
 	"context"
 	"math"
+	"math/big"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
+	"github.com/vnnyx/employee-management/internal/adjustment"
+	adjustmentEntity "github.com/vnnyx/employee-management/internal/adjustment/entity"
 	"github.com/vnnyx/employee-management/internal/attendance"
 	attendanceEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/bankaccount"
+	bankAccountEntity "github.com/vnnyx/employee-management/internal/bankaccount/entity"
 	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/holiday"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	"github.com/vnnyx/employee-management/internal/leave"
+	leaveEntity "github.com/vnnyx/employee-management/internal/leave/entity"
+	"github.com/vnnyx/employee-management/internal/loan"
+	loanEntity "github.com/vnnyx/employee-management/internal/loan/entity"
 	"github.com/vnnyx/employee-management/internal/overtime"
 	overtimeEntity "github.com/vnnyx/employee-management/internal/overtime/entity"
 	"github.com/vnnyx/employee-management/internal/payroll"
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
 	"github.com/vnnyx/employee-management/internal/reimbursement"
 	reimbursementEntity "github.com/vnnyx/employee-management/internal/reimbursement/entity"
+	"github.com/vnnyx/employee-management/internal/salary"
+	salaryEntity "github.com/vnnyx/employee-management/internal/salary/entity"
 	"github.com/vnnyx/employee-management/internal/users"
 	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/iso8601"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
 	"github.com/vnnyx/employee-management/pkg/optional"
 	redisc "github.com/vnnyx/employee-management/pkg/redis"
@@ -36,29 +50,143 @@ type payrollUseCase struct {
 	attendanceRepo    attendance.Repository
 	overtimeRepo      overtime.Repository
 	reimbursementRepo reimbursement.Repository
+	holidayRepo       holiday.Repository
+	salaryRepo        salary.Repository
+	bankAccountRepo   bankaccount.Repository
+	adjustmentRepo    adjustment.Repository
+	loanRepo          loan.Repository
+	leaveRepo         leave.Repository
+	verificationKey   string
+	fiscalStartMonth  time.Month
+	attendanceBasis   entity.AttendanceBasis
+	workSchedule      attendanceEntity.WorkSchedule
 }
 
-func NewPayrollUseCase(payrollRepo payroll.Repository, userRepo users.Repository, attendanceRepo attendance.Repository, overtimeRepo overtime.Repository, reimbursementRepo reimbursement.Repository) payroll.UseCase {
+type PayrollConfig struct {
+	// VerificationKey signs the verification IDs of payslip documents.
+	VerificationKey string
+	// FiscalYearStartMonth is the first month of the fiscal year, the
+	// calendar year is used when it is not set.
+	FiscalYearStartMonth int64
+	// AttendanceBasis is what the salary is paid for, attended days when it
+	// is not set.
+	AttendanceBasis entity.AttendanceBasis
+	// WorkSchedule is the length of a working day when the salary is paid
+	// for the hours worked.
+	WorkSchedule attendanceEntity.WorkSchedule
+}
+
+func NewPayrollUseCase(payrollRepo payroll.Repository, userRepo users.Repository, attendanceRepo attendance.Repository, overtimeRepo overtime.Repository, reimbursementRepo reimbursement.Repository, holidayRepo holiday.Repository, salaryRepo salary.Repository, bankAccountRepo bankaccount.Repository, adjustmentRepo adjustment.Repository, loanRepo loan.Repository, leaveRepo leave.Repository, payrollConfig PayrollConfig) payroll.UseCase {
 	return &payrollUseCase{
 		payrollRepo:       payrollRepo,
 		userRepo:          userRepo,
 		attendanceRepo:    attendanceRepo,
 		overtimeRepo:      overtimeRepo,
 		reimbursementRepo: reimbursementRepo,
+		holidayRepo:       holidayRepo,
+		salaryRepo:        salaryRepo,
+		bankAccountRepo:   bankAccountRepo,
+		adjustmentRepo:    adjustmentRepo,
+		loanRepo:          loanRepo,
+		leaveRepo:         leaveRepo,
+		verificationKey:   payrollConfig.VerificationKey,
+		fiscalStartMonth:  max(time.Month(payrollConfig.FiscalYearStartMonth), time.January),
+		attendanceBasis:   payrollConfig.AttendanceBasis,
+		workSchedule:      payrollConfig.WorkSchedule,
 	}
 }
 
-func (u *payrollUseCase) GeneratePayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.GeneratedPayroll, error) {
+func (u *payrollUseCase) GeneratePayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.PayrollJob, error) {
 	ctx, span := instrumentation.NewTraceSpan(
 		ctx,
 		"PayrollUseCase.GeneratePayroll()",
 	)
 	defer span.End()
 
-	var generatedPayroll entity.GeneratedPayroll
+	if !*authCredential.IsAdmin {
+		return entity.PayrollJob{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
+	}
+
+	period, err := u.attendanceRepo.FindPeriodByID(ctx, periodID)
+	if err != nil {
+		return entity.PayrollJob{}, errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindPeriodByID()")
+	}
+	if period == nil {
+		return entity.PayrollJob{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				Received:  periodID,
+			},
+		)
+	}
+
+	// The job checks again under lock, this only saves queueing a job that is
+	// bound to fail
+	payroll, err := u.payrollRepo.FindPayrollByPeriodID(ctx, periodID)
+	if err != nil {
+		return entity.PayrollJob{}, errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindPayrollByPeriodID()")
+	}
+	if payroll != nil {
+		return entity.PayrollJob{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.PayrollAlreadyGenerated,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyGenerated),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	job := entity.PayrollJob{
+		ID:        uuid.NewString(),
+		PeriodID:  periodID,
+		Status:    entity.PayrollJobQueued,
+		RequestID: authCredential.RequestID,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+		CreatedBy: authCredential.UserID,
+		UpdatedBy: authCredential.UserID,
+		IPAddress: authCredential.IPAddress,
+	}
+
+	err = database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		err := u.payrollRepo.WithTx(tx).StoreNewPayrollJob(ctx, job)
+		if err != nil {
+			if database.IsUniqueViolation(err, "payroll_jobs_period_id_active_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.PayrollJobAlreadyQueued,
+						Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobAlreadyQueued),
+						Received:  periodID,
+					},
+				)
+			}
+			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().StoreNewPayrollJob()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.PayrollJob{}, errors.Wrap(err, "PayrollUseCase.GeneratePayroll().WithAuditContext()")
+	}
+
+	return job, nil
+}
+
+func (u *payrollUseCase) ShowPayrollJob(ctx context.Context, authCredential authCredential.Credential, jobID string) (entity.PayrollJob, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ShowPayrollJob()",
+	)
+	defer span.End()
 
 	if !*authCredential.IsAdmin {
-		return entity.GeneratedPayroll{}, apperror.Forbidden(
+		return entity.PayrollJob{}, apperror.Forbidden(
 			apperror.AppError{
 				IssueCode: entity.PayrollNotAuthorized,
 				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
@@ -66,19 +194,129 @@ func (u *payrollUseCase) GeneratePayroll(ctx context.Context, authCredential aut
 		)
 	}
 
+	job, err := u.payrollRepo.FindPayrollJobByID(ctx, jobID)
+	if err != nil {
+		return entity.PayrollJob{}, errors.Wrap(err, "PayrollUseCase.ShowPayrollJob().FindPayrollJobByID()")
+	}
+	if job == nil {
+		return entity.PayrollJob{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.PayrollJobNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobNotFound),
+				Received:  jobID,
+			},
+		)
+	}
+
+	return *job, nil
+}
+
+// ProcessNextPayrollJob claims the oldest queued payroll job and generates its
+// payroll. It reports false when there was no job to run. The outcome of the
+// job is stored on the job itself, the returned error is only about the
+// bookkeeping of the job.
+func (u *payrollUseCase) ProcessNextPayrollJob(ctx context.Context) (bool, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ProcessNextPayrollJob()",
+	)
+	defer span.End()
+
+	timeNow := time.Now()
+	job, err := u.payrollRepo.ClaimPayrollJob(ctx, entity.ClaimPayrollJob{
+		ClaimedAt:   timeNow,
+		StaleBefore: timeNow.Add(-payrollJobStaleAfter),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "PayrollUseCase.ProcessNextPayrollJob().ClaimPayrollJob()")
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	// A job that keeps losing its worker most likely brings the worker down
+	// with it, it is not tried forever
+	if job.Attempts > payrollJobMaxAttempts {
+		err := u.finishFailedPayrollJob(ctx, job.ID, apperror.AppError{
+			IssueCode: entity.PayrollJobAbandoned,
+			Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobAbandoned),
+		})
+		if err != nil {
+			return true, errors.Wrap(err, "PayrollUseCase.ProcessNextPayrollJob().finishFailedPayrollJob()")
+		}
+		return true, nil
+	}
+
+	progress := newJobProgress(u.payrollRepo, job.ID)
+	stopHeartbeat := progress.heartbeat(ctx)
+	err = u.runPayrollJob(ctx, *job, progress)
+	stopHeartbeat()
+	if err == nil {
+		return true, nil
+	}
+
+	// The worker is shutting down, the job goes back to the queue for the
+	// next worker instead of failing
+	if ctx.Err() != nil {
+		err := u.payrollRepo.ReleasePayrollJob(context.WithoutCancel(ctx), job.ID, time.Now())
+		if err != nil {
+			return true, errors.Wrap(err, "PayrollUseCase.ProcessNextPayrollJob().ReleasePayrollJob()")
+		}
+		return true, nil
+	}
+
+	instrumentation.RecordSpanError(span, err)
+
+	// Only admins can see jobs, the cause of an unexpected failure is kept
+	// for them to act on
+	appErr := apperror.AppError{
+		IssueCode: entity.PayrollJobUnexpected,
+		Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobUnexpected) + ": " + err.Error(),
+	}
+	errors.As(err, &appErr)
+
+	err = u.finishFailedPayrollJob(ctx, job.ID, appErr)
+	if err != nil {
+		return true, errors.Wrap(err, "PayrollUseCase.ProcessNextPayrollJob().finishFailedPayrollJob()")
+	}
+
+	return true, nil
+}
+
+// runPayrollJob generates the payroll of a claimed job and marks the job as
+// succeeded in the same transaction, so a payroll never exists without its
+// job knowing about it.
+func (u *payrollUseCase) runPayrollJob(ctx context.Context, job entity.PayrollJob, progress *jobProgress) error {
+	// The payroll is generated and audited on behalf of the admin who queued
+	// the job
+	isAdmin := true
+	authCredential := authCredential.Credential{
+		UserID:    job.CreatedBy,
+		IsAdmin:   &isAdmin,
+		IPAddress: job.IPAddress,
+		RequestID: job.RequestID,
+	}
+
 	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
 		payrollRepoTx := u.payrollRepo.WithTx(tx)
 		userRepoTx := u.userRepo.WithTx(tx)
 		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
 		overtimeRepoTx := u.overtimeRepo.WithTx(tx)
 		reimbursementRepoTx := u.reimbursementRepo.WithTx(tx)
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+		salaryRepoTx := u.salaryRepo.WithTx(tx)
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		loanRepoTx := u.loanRepo.WithTx(tx)
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+
+		progress.report(ctx, entity.PayrollJobLoading, 0, 0)
 
 		// Check if payroll for the period already exists
-		payroll, err := payrollRepoTx.FindPayrollByPeriodID(ctx, periodID, entity.FindPayrollOptions{
+		payroll, err := payrollRepoTx.FindPayrollByPeriodID(ctx, job.PeriodID, entity.FindPayrollOptions{
 			PessimisticLock: true,
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindPayrollByPeriodID()")
+			return errors.Wrap(err, "PayrollUseCase.runPayrollJob().FindPayrollByPeriodID()")
 		}
 
 		if payroll != nil {
@@ -90,271 +328,537 @@ func (u *payrollUseCase) GeneratePayroll(ctx context.Context, authCredential aut
 			)
 		}
 
-		period, err := attendanceRepoTx.FindPeriodByID(ctx, periodID)
+		period, err := attendanceRepoTx.FindPeriodByID(ctx, job.PeriodID)
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindPeriodByID()")
+			return errors.Wrap(err, "PayrollUseCase.runPayrollJob().FindPeriodByID()")
 		}
 		if period == nil {
 			return apperror.NotFound(
 				apperror.AppError{
 					IssueCode: entity.AttendancePeriodNotFound,
 					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
-					Received:  periodID,
+					Received:  job.PeriodID,
 				},
 			)
 		}
 
-		users, err := userRepoTx.FindAllUsers(ctx, userEntity.FindUserOptions{
-			PessimisticLock: true,
-			MappedOptions: &userEntity.MappedOptions{
-				MappedBy: userEntity.MappedByUserID,
-			},
+		calculated, err := u.calculatePayroll(ctx, payrollSources{
+			payrollRepo:       payrollRepoTx,
+			userRepo:          userRepoTx,
+			overtimeRepo:      overtimeRepoTx,
+			attendanceRepo:    attendanceRepoTx,
+			reimbursementRepo: reimbursementRepoTx,
+			holidayRepo:       holidayRepoTx,
+			salaryRepo:        salaryRepoTx,
+			adjustmentRepo:    adjustmentRepoTx,
+			loanRepo:          loanRepoTx,
+			leaveRepo:         leaveRepoTx,
+		}, *period, true, progress)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.runPayrollJob().calculatePayroll()")
+		}
+
+		progress.report(ctx, entity.PayrollJobStoring, calculated.TotalEmployee, calculated.TotalEmployee)
+
+		generatedPayroll, err := u.storePayroll(ctx, payrollRepoTx, authCredential, *period, calculated, 1)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.runPayrollJob().storePayroll()")
+		}
+
+		err = payrollRepoTx.FinishPayrollJob(ctx, entity.FinishPayrollJob{
+			JobID:      job.ID,
+			Status:     entity.PayrollJobSucceeded,
+			PayrollID:  optional.NewString(generatedPayroll.PayrollID),
+			Result:     &generatedPayroll,
+			FinishedAt: time.Now(),
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindAllUsers()")
+			return errors.Wrap(err, "PayrollUseCase.runPayrollJob().FinishPayrollJob()")
 		}
 
-		attendances, err := attendanceRepoTx.FindAttendanceByPeriod(ctx, period.StartDate, period.EndDate, attendanceEntity.FindAttendanceOptions{
-			PessimisticLock: true,
-			MappedOptions: &attendanceEntity.MappedOptions{
-				MappedBy: attendanceEntity.MappedByUserID,
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "PayrollUseCase.runPayrollJob().WithAuditContext()")
+	}
+
+	return nil
+}
+
+func (u *payrollUseCase) finishFailedPayrollJob(ctx context.Context, jobID string, appErr apperror.AppError) error {
+	err := u.payrollRepo.FinishPayrollJob(ctx, entity.FinishPayrollJob{
+		JobID:         jobID,
+		Status:        entity.PayrollJobFailed,
+		FailureCode:   optional.NewString(appErr.IssueCode),
+		FailureReason: optional.NewString(appErr.Message),
+		FinishedAt:    time.Now(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "PayrollUseCase.finishFailedPayrollJob().FinishPayrollJob()")
+	}
+
+	return nil
+}
+
+func (u *payrollUseCase) RegeneratePayroll(ctx context.Context, authCredential authCredential.Credential, payrollID, reason string) (entity.GeneratedPayroll, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.RegeneratePayroll()",
+	)
+	defer span.End()
+
+	var generatedPayroll entity.GeneratedPayroll
+
+	if !*authCredential.IsAdmin {
+		return entity.GeneratedPayroll{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
 			},
-		})
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		payrollRepoTx := u.payrollRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+		overtimeRepoTx := u.overtimeRepo.WithTx(tx)
+		reimbursementRepoTx := u.reimbursementRepo.WithTx(tx)
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+		salaryRepoTx := u.salaryRepo.WithTx(tx)
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		loanRepoTx := u.loanRepo.WithTx(tx)
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+
+		payroll, err := payrollRepoTx.FindPayrollByID(ctx, payrollID)
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindAttendanceByPeriod()")
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().FindPayrollByID()")
+		}
+		if payroll == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  payrollID,
+				},
+			)
 		}
 
-		overtimes, err := overtimeRepoTx.FindOvertimeByPeriod(ctx, period.StartDate, period.EndDate, overtimeEntity.FindOvertimeOptions{
+		// Lock the active payroll of the period, only the latest version can be re-run
+		activePayroll, err := payrollRepoTx.FindPayrollByPeriodID(ctx, payroll.PeriodID, entity.FindPayrollOptions{
 			PessimisticLock: true,
-			MappedOptions: &overtimeEntity.MappedOptions{
-				MappedBy: overtimeEntity.MappedByUserID,
-			},
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindOvertimeByPeriod()")
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().FindPayrollByPeriodID()")
+		}
+		if activePayroll == nil || activePayroll.ID != payroll.ID {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyVoided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyVoided),
+					Received:  payrollID,
+				},
+			)
 		}
 
-		reimbursements, err := reimbursementRepoTx.FindReimbursementByPeriod(ctx, period.StartDate, period.EndDate, reimbursementEntity.FindReimbursementOptions{
-			PessimisticLock: true,
-			MappedOptions: &reimbursementEntity.MappedOptions{
-				MappedBy: reimbursementEntity.MappedByUserID,
-			},
+		// The money of a paid payroll is already out, it can only be corrected
+		// in a later period
+		if activePayroll.Status == entity.PayrollStatusPaid {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyPaid),
+					Received:  payrollID,
+				},
+			)
+		}
+
+		period, err := attendanceRepoTx.FindPeriodByID(ctx, payroll.PeriodID)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().FindPeriodByID()")
+		}
+		if period == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  payroll.PeriodID,
+				},
+			)
+		}
+
+		err = payrollRepoTx.VoidPayroll(ctx, entity.VoidPayroll{
+			PayrollID:  payroll.ID,
+			VoidedAt:   time.Now(),
+			VoidedBy:   authCredential.UserID,
+			VoidReason: reason,
+			IPAddress:  authCredential.IPAddress,
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().FindReimbursementByPeriod()")
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().VoidPayroll()")
 		}
 
-		var (
-			payslips                []entity.Payslip
-			totalTakeHomePayPayroll int64
-		)
+		calculated, err := u.calculatePayroll(ctx, payrollSources{
+			payrollRepo:       payrollRepoTx,
+			userRepo:          userRepoTx,
+			overtimeRepo:      overtimeRepoTx,
+			attendanceRepo:    attendanceRepoTx,
+			reimbursementRepo: reimbursementRepoTx,
+			holidayRepo:       holidayRepoTx,
+			salaryRepo:        salaryRepoTx,
+			adjustmentRepo:    adjustmentRepoTx,
+			loanRepo:          loanRepoTx,
+			leaveRepo:         leaveRepoTx,
+		}, *period, true, nil)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().calculatePayroll()")
+		}
 
-		payrollID := uuid.NewString()
-		timeNow := time.Now()
-		for _, user := range users.List {
-			var totalAttendanceDays int64
-			if attendances.IsMapped {
-				if attendanceList, ok := attendances.Mapped[user.ID]; ok {
-					totalAttendanceDays = int64(len(attendanceList))
-				}
-			}
+		generatedPayroll, err = u.storePayroll(ctx, payrollRepoTx, authCredential, *period, calculated, payroll.Version+1)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().storePayroll()")
+		}
 
-			var totalOvertimeHours time.Duration
-			if overtimes.IsMapped {
-				if overtimeList, ok := overtimes.Mapped[user.ID]; ok {
-					for _, overtime := range overtimeList {
-						totalOvertimeHours += overtime.OvertimeHours
-					}
-				}
-			}
+		return nil
+	})
+	if err != nil {
+		return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.RegeneratePayroll().WithAuditContext()")
+	}
 
-			var totalReimbursementAmount int64
-			if reimbursements.IsMapped {
-				if reimbursementList, ok := reimbursements.Mapped[user.ID]; ok {
-					for _, reimbursement := range reimbursementList {
-						totalReimbursementAmount += reimbursement.Amount
-					}
-				}
-			}
+	return generatedPayroll, nil
+}
 
-			attendancePay := int64(float64(user.Salary) * float64(totalAttendanceDays) / float64(period.EndDate.Sub(period.StartDate).Hours()/24))
-			hourlyRate := float64(user.Salary) / (period.EndDate.Sub(period.StartDate).Hours() / 24)
-			overtimePayMultiplier := 1.0 // This can be adjusted based on business rules
-			totalOvertimePay := int64(totalOvertimeHours.Hours() * hourlyRate * overtimePayMultiplier)
-			totalTakeHomePay := attendancePay + totalOvertimePay
-
-			payslips = append(payslips, entity.Payslip{
-				ID:                 uuid.NewString(),
-				UserID:             user.ID,
-				PayrollID:          payrollID,
-				BaseSalary:         user.Salary,
-				AttendanceDays:     totalAttendanceDays,
-				OvertimeHours:      optional.NewDuration(totalOvertimeHours),
-				OvertimePay:        int64(totalOvertimePay),
-				ReimbursementTotal: totalReimbursementAmount,
-				TotalTakeHome:      totalTakeHomePay,
-				CreatedAt:          timeNow,
-				UpdatedAt:          timeNow,
-				CreatedBy:          authCredential.UserID,
-				UpdatedBy:          authCredential.UserID,
-				IPAddress:          authCredential.IPAddress,
-			})
+// TransitionPayroll moves a payroll through its approval workflow and records
+// the action with its comment on the trail of the payroll.
+func (u *payrollUseCase) TransitionPayroll(ctx context.Context, authCredential authCredential.Credential, payrollID string, action entity.PayrollAction, comment string) (entity.PayrollApproval, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.TransitionPayroll()",
+	)
+	defer span.End()
 
-			totalTakeHomePayPayroll += totalTakeHomePay
-		}
+	var approval entity.PayrollApproval
 
-		// Store the generated payroll
-		err = payrollRepoTx.StoreNewPayroll(ctx, entity.Payroll{
-			ID:        payrollID,
-			PeriodID:  period.ID,
-			RunBy:     authCredential.UserID,
-			RunAt:     timeNow,
-			CreatedAt: timeNow,
-			UpdatedAt: timeNow,
-			CreatedBy: authCredential.UserID,
-			UpdatedBy: authCredential.UserID,
-			IPAddress: authCredential.IPAddress,
+	if !*authCredential.IsAdmin {
+		return entity.PayrollApproval{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		payrollRepoTx := u.payrollRepo.WithTx(tx)
+
+		payroll, err := payrollRepoTx.FindPayrollByID(ctx, payrollID, entity.FindPayrollOptions{
+			PessimisticLock: true,
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().StoreNewPayroll()")
+			return errors.Wrap(err, "PayrollUseCase.TransitionPayroll().FindPayrollByID()")
+		}
+		if payroll == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  payrollID,
+				},
+			)
+		}
+		if payroll.VoidedAt.IsPresent() {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyVoided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyVoided),
+					Received:  payrollID,
+				},
+			)
+		}
+
+		fromStatus, toStatus, ok := payroll.Status.Transition(action)
+		if !ok {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollInvalidTransition,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollInvalidTransition),
+					Expected:  fromStatus,
+					Received:  payroll.Status,
+				},
+			)
 		}
 
-		// Store the payslips
-		if len(payslips) > 0 {
-			err := payrollRepoTx.StoreNewPayslips(ctx, payslips)
+		if action.IsReview() {
+			approvals, err := payrollRepoTx.FindPayrollApprovalsByPayrollID(ctx, payroll.ID)
 			if err != nil {
-				return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().StoreNewPayslips()")
+				return errors.Wrap(err, "PayrollUseCase.TransitionPayroll().FindPayrollApprovalsByPayrollID()")
+			}
+
+			if submittedBy(approvals) == authCredential.UserID {
+				return apperror.Forbidden(
+					apperror.AppError{
+						IssueCode: entity.PayrollSelfReview,
+						Message:   entity.GetErrorMessageByIssueCode(entity.PayrollSelfReview),
+					},
+				)
 			}
 		}
 
-		// Store the payroll summary
-		err = payrollRepoTx.StoreNewPayrollSummary(ctx, entity.PayrollSummary{
-			ID:            uuid.NewString(),
-			PayrollID:     payrollID,
-			TotalTakeHome: totalTakeHomePayPayroll,
-			GeneratedBy:   authCredential.UserID,
-			GeneratedAt:   timeNow,
-			CreatedAt:     timeNow,
-			UpdatedAt:     timeNow,
-			CreatedBy:     authCredential.UserID,
-			UpdatedBy:     authCredential.UserID,
-			IPAddress:     authCredential.IPAddress,
+		timeNow := time.Now()
+		err = payrollRepoTx.UpdatePayrollStatus(ctx, entity.UpdatePayrollStatus{
+			PayrollID:  payroll.ID,
+			FromStatus: fromStatus,
+			ToStatus:   toStatus,
+			UpdatedAt:  timeNow,
+			UpdatedBy:  authCredential.UserID,
+			IPAddress:  authCredential.IPAddress,
 		})
 		if err != nil {
-			return errors.Wrap(err, "PayrollUseCase.GeneratePayroll().StoreNewPayrollSummary()")
+			return errors.Wrap(err, "PayrollUseCase.TransitionPayroll().UpdatePayrollStatus()")
+		}
+
+		approval = entity.PayrollApproval{
+			ID:         uuid.NewString(),
+			PayrollID:  payroll.ID,
+			Action:     action,
+			FromStatus: fromStatus,
+			ToStatus:   toStatus,
+			Comment:    optional.NewString(),
+			CreatedAt:  timeNow,
+			UpdatedAt:  timeNow,
+			CreatedBy:  authCredential.UserID,
+			UpdatedBy:  authCredential.UserID,
+			IPAddress:  authCredential.IPAddress,
+		}
+		if comment != "" {
+			approval.Comment = optional.NewString(comment)
 		}
 
-		generatedPayroll = entity.GeneratedPayroll{
-			PeriodID:      period.ID,
-			PayrollID:     payrollID,
-			TotalTakeHome: totalTakeHomePayPayroll,
-			TotalEmployee: int64(len(users.List)),
-			TotalPayslip:  int64(len(payslips)),
-			GeneratedBy:   authCredential.UserID,
-			GeneratedAt:   timeNow.Format(time.RFC3339),
+		err = payrollRepoTx.StoreNewPayrollApproval(ctx, approval)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.TransitionPayroll().StoreNewPayrollApproval()")
 		}
 
 		return nil
 	})
 	if err != nil {
-		return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.GeneratePayroll().WithAuditContext()")
+		return entity.PayrollApproval{}, errors.Wrap(err, "PayrollUseCase.TransitionPayroll().WithAuditContext()")
 	}
 
-	return generatedPayroll, nil
+	return approval, nil
 }
 
-func (u *payrollUseCase) ShowPayslip(ctx context.Context, authCredential authCredential.Credential, payrollID string) (*entity.PayslipData, error) {
+func (u *payrollUseCase) ListPayrollApprovals(ctx context.Context, authCredential authCredential.Credential, payrollID string) ([]entity.PayrollApproval, error) {
 	ctx, span := instrumentation.NewTraceSpan(
 		ctx,
-		"PayrollUseCase.ShowPayslip()",
+		"PayrollUseCase.ListPayrollApprovals()",
 	)
 	defer span.End()
 
-	var payslipData entity.PayslipData
-
-	user, err := u.userRepo.FindUserByID(ctx, authCredential.UserID)
-	if err != nil {
-		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindUserByID()")
-	}
-	if user == nil {
-		return &payslipData, apperror.NotFound(
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
 			apperror.AppError{
-				IssueCode: entity.UserNotFound,
-				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
-				Received:  authCredential.UserID,
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
 			},
 		)
 	}
 
-	period, err := u.attendanceRepo.FindAttendancePeriodByPayrollID(ctx, payrollID)
+	payroll, err := u.payrollRepo.FindPayrollByID(ctx, payrollID)
 	if err != nil {
-		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindAttendancePeriodByPayrollID()")
+		return nil, errors.Wrap(err, "PayrollUseCase.ListPayrollApprovals().FindPayrollByID()")
 	}
-	if period == nil {
-		return &payslipData, apperror.NotFound(
+	if payroll == nil {
+		return nil, apperror.NotFound(
 			apperror.AppError{
-				IssueCode: entity.AttendancePeriodNotFound,
-				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				IssueCode: entity.PayrollNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
 				Received:  payrollID,
 			},
 		)
 	}
 
-	payslip, err := u.payrollRepo.FindPayslipByUserIDPeriod(ctx, authCredential.UserID, payrollID)
+	approvals, err := u.payrollRepo.FindPayrollApprovalsByPayrollID(ctx, payroll.ID)
 	if err != nil {
-		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindPayslipByUserIDPeriod()")
+		return nil, errors.Wrap(err, "PayrollUseCase.ListPayrollApprovals().FindPayrollApprovalsByPayrollID()")
 	}
-	if payslip == nil {
-		return &payslipData, apperror.NotFound(
-			apperror.AppError{
+
+	return approvals, nil
+}
+
+func (u *payrollUseCase) PreviewPayroll(ctx context.Context, authCredential authCredential.Credential, periodID string) (entity.PayrollPreview, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.PreviewPayroll()",
+	)
+	defer span.End()
+
+	var payrollPreview entity.PayrollPreview
+
+	if !*authCredential.IsAdmin {
+		return entity.PayrollPreview{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
+	}
+
+	// The preview runs in a read-only snapshot so the numbers are consistent
+	// across tables while nothing can be written or locked.
+	txOptions := pgx.TxOptions{
+		IsoLevel:   pgx.RepeatableRead,
+		AccessMode: pgx.ReadOnly,
+	}
+	err := database.WithAuditContext(ctx, authCredential, txOptions, func(tx database.DBTx) error {
+		payrollRepoTx := u.payrollRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+		overtimeRepoTx := u.overtimeRepo.WithTx(tx)
+		reimbursementRepoTx := u.reimbursementRepo.WithTx(tx)
+		holidayRepoTx := u.holidayRepo.WithTx(tx)
+		salaryRepoTx := u.salaryRepo.WithTx(tx)
+		adjustmentRepoTx := u.adjustmentRepo.WithTx(tx)
+		loanRepoTx := u.loanRepo.WithTx(tx)
+		leaveRepoTx := u.leaveRepo.WithTx(tx)
+
+		period, err := attendanceRepoTx.FindPeriodByID(ctx, periodID)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.PreviewPayroll().FindPeriodByID()")
+		}
+		if period == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  periodID,
+				},
+			)
+		}
+
+		calculated, err := u.calculatePayroll(ctx, payrollSources{
+			payrollRepo:       payrollRepoTx,
+			userRepo:          userRepoTx,
+			overtimeRepo:      overtimeRepoTx,
+			attendanceRepo:    attendanceRepoTx,
+			reimbursementRepo: reimbursementRepoTx,
+			holidayRepo:       holidayRepoTx,
+			salaryRepo:        salaryRepoTx,
+			adjustmentRepo:    adjustmentRepoTx,
+			loanRepo:          loanRepoTx,
+			leaveRepo:         leaveRepoTx,
+		}, *period, false, nil)
+		if err != nil {
+			return errors.Wrap(err, "PayrollUseCase.PreviewPayroll().calculatePayroll()")
+		}
+
+		payrollPreview = entity.PayrollPreview{
+			PeriodID:           period.ID,
+			TotalGrossPay:      calculated.TotalGrossPay,
+			TotalReimbursement: calculated.TotalReimbursement,
+			TotalDeductions:    calculated.TotalDeductions,
+			TotalTakeHome:      calculated.TotalTakeHome,
+			TotalEmployee:      calculated.TotalEmployee,
+			TotalPayslip:       int64(len(calculated.Payslips)),
+			PayslipsData:       calculated.PayslipsData,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.PayrollPreview{}, errors.Wrap(err, "PayrollUseCase.PreviewPayroll().WithAuditContext()")
+	}
+
+	return payrollPreview, nil
+}
+
+func (u *payrollUseCase) ShowPayslip(ctx context.Context, authCredential authCredential.Credential, payrollID string) (*entity.PayslipData, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ShowPayslip()",
+	)
+	defer span.End()
+
+	var payslipData entity.PayslipData
+
+	user, err := u.userRepo.FindUserByID(ctx, authCredential.UserID)
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindUserByID()")
+	}
+	if user == nil {
+		return &payslipData, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.UserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+				Received:  authCredential.UserID,
+			},
+		)
+	}
+
+	period, err := u.attendanceRepo.FindAttendancePeriodByPayrollID(ctx, payrollID)
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindAttendancePeriodByPayrollID()")
+	}
+	if period == nil {
+		return &payslipData, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				Received:  payrollID,
+			},
+		)
+	}
+
+	latestPayroll, err := u.resolveLatestPayroll(ctx, *period, payrollID)
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().resolveLatestPayroll()")
+	}
+	if !latestPayroll.Status.IsApproved() {
+		return &payslipData, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.PayslipNotAvailable,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayslipNotAvailable),
+				Received:  payrollID,
+			},
+		)
+	}
+
+	payslip, err := u.payrollRepo.FindPayslipByUserIDPeriod(ctx, authCredential.UserID, latestPayroll.ID)
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindPayslipByUserIDPeriod()")
+	}
+	if payslip == nil {
+		return &payslipData, apperror.NotFound(
+			apperror.AppError{
 				IssueCode: entity.PayslipNotFound,
 				Message:   entity.GetErrorMessageByIssueCode(entity.PayslipNotFound),
 				Received: map[string]string{
 					"user_id":    authCredential.UserID,
-					"payroll_id": payrollID,
+					"payroll_id": latestPayroll.ID,
 				},
 			},
 		)
 	}
 
+	payslip.Items, err = u.payrollRepo.FindPayslipItemsByPayslipIDs(ctx, []string{payslip.ID})
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindPayslipItemsByPayslipIDs()")
+	}
+
+	payslip.SalarySegments, err = u.payrollRepo.FindPayslipSalarySegmentsByPayslipIDs(ctx, []string{payslip.ID})
+	if err != nil {
+		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindPayslipSalarySegmentsByPayslipIDs()")
+	}
+
 	reimbursements, err := u.reimbursementRepo.FindReimbursementByUserIDPeriod(ctx, authCredential.UserID, period.StartDate, period.EndDate)
 	if err != nil {
 		return &payslipData, errors.Wrap(err, "PayrollUseCase.ShowPayslip().FindReimbursementByUserIDPeriod()")
 	}
 
-	reimbursementData := make([]entity.ReimbursementData, 0, len(reimbursements))
-	for _, reimbursement := range reimbursements {
-		reimbursementData = append(reimbursementData, entity.ReimbursementData{
-			Description:       reimbursement.Description,
-			Amount:            reimbursement.Amount,
-			ReimbursementDate: reimbursement.ReimbursementDate.Format(time.RFC3339),
-		})
-	}
+	payslipData = newPayslipData(*payslip, *user, *period, reimbursements)
+	payslipData.VerificationID = entity.NewVerificationID(u.verificationKey, *payslip)
 
-	return &entity.PayslipData{
-		ID: payslip.ID,
-		User: entity.UserData{
-			ID:       user.ID,
-			Username: user.Username,
-		},
-		AttendancePeriod: entity.AttendancePeriodData{
-			StartDate: period.StartDate.Format(time.RFC3339),
-			EndDate:   period.EndDate.Format(time.RFC3339),
-		},
-		BaseSalary:     user.Salary,
-		WorkingDays:    int64(period.EndDate.Sub(period.StartDate).Hours() / 24),
-		AttendanceDays: payslip.AttendanceDays,
-		AttendancePay:  int64(float64(user.Salary) * float64(payslip.AttendanceDays) / float64(period.EndDate.Sub(period.StartDate).Hours()/24)),
-		Overtime: entity.OvertimeData{
-			OvertimeHours: iso8601.ToString(payslip.OvertimeHours.MustGet()),
-			RatePerHour:   int64(float64(user.Salary) / (period.EndDate.Sub(period.StartDate).Hours() / 24)),
-			Multiplier:    1,
-			OvertimePay:   payslip.OvertimePay,
-		},
-		Reimbursements:     reimbursementData,
-		ReimbursementTotal: payslip.ReimbursementTotal,
-		TotalTakeHome:      payslip.TotalTakeHome,
-	}, nil
+	return &payslipData, nil
 }
 
 func (u *payrollUseCase) ListPayslips(ctx context.Context, authCredential authCredential.Credential, payrollID string, resource *resourceful.Resource[string, dtos.PayslipDataResponse]) (*resourceful.Resource[string, dtos.PayslipDataResponse], error) {
@@ -373,6 +877,26 @@ func (u *payrollUseCase) ListPayslips(ctx context.Context, authCredential authCr
 		)
 	}
 
+	period, err := u.attendanceRepo.FindAttendancePeriodByPayrollID(ctx, payrollID)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().FindAttendancePeriodByPayrollID()")
+	}
+	if period == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				Received:  payrollID,
+			},
+		)
+	}
+
+	latestPayroll, err := u.resolveLatestPayroll(ctx, *period, payrollID)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().resolveLatestPayroll()")
+	}
+	payrollID = latestPayroll.ID
+
 	// Try get from cache first
 	key := resourceful.GetCacheKey(resourceful.ResourceTypePayslip, payrollID, *resource.Parameter)
 	resourceCache, err := redisc.GetAndUnmarshal[resourceful.Resource[string, dtos.PayslipDataResponse]](ctx, key)
@@ -384,30 +908,60 @@ func (u *payrollUseCase) ListPayslips(ctx context.Context, authCredential authCr
 		return &resourceCache, nil
 	}
 
-	users, err := u.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{
-		PessimisticLock: true,
+	payslipDataList, err := u.findPayslipsData(ctx, *period, payrollID, entity.FindPayslipOptions{
+		PessimisticLock:      true,
+		ResourcefulParameter: resource.Parameter,
 	})
 	if err != nil {
-		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().FindAllUsers()")
+		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().findPayslipsData()")
 	}
 
-	payslips, err := u.payrollRepo.FindPayslipByPayrollID(ctx, payrollID, entity.FindPayslipOptions{
-		PessimisticLock: true,
-		MappedOptions: &entity.MappedOptions{
-			MappedBy: entity.MappedByUserID,
-		},
-		ResourcefulParameter: resource.Parameter,
+	additionalData := resource.Parameter.GetAdditionalData()
+	listPayslipMetadata := additionalData.(entity.ListPayslipMetadata)
+	resource.SetResult(resourceful.Result[string, dtos.PayslipDataResponse]{
+		PaginationResult: dtos.NewListPayslipResponse(payslipDataList),
+		IDs:              listPayslipMetadata.IDs,
 	})
+
+	listPayslipMetadata.Count = int64(len(payslipDataList))
+	listPayslipMetadata.Page = resource.Parameter.Page.MustGet()
+	listPayslipMetadata.TotalPage = int64(math.Ceil(float64(listPayslipMetadata.TotalCount) / float64(resource.Parameter.Limit.MustGet())))
+
+	resource.SetMetadata(listPayslipMetadata)
+
+	// Store the resource in cache
+	err = redisc.SetWithExpiration(ctx, key, resource, 7*24*time.Hour)
 	if err != nil {
-		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().FindPayslipByPayrollID()")
+		return nil, errors.Wrap(err, "PayrollUseCase.ListPayslips().SetWithExpiration()")
+	}
+
+	return resource, nil
+}
+
+// ExportPayslips loads every payslip of the latest version of a payroll for
+// the bulk download, without pagination or cache.
+func (u *payrollUseCase) ExportPayslips(ctx context.Context, authCredential authCredential.Credential, payrollID string) (entity.PayslipExport, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ExportPayslips()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.PayslipExport{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
 	}
 
 	period, err := u.attendanceRepo.FindAttendancePeriodByPayrollID(ctx, payrollID)
 	if err != nil {
-		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().FindAttendancePeriodByPayrollID()")
+		return entity.PayslipExport{}, errors.Wrap(err, "PayrollUseCase.ExportPayslips().FindAttendancePeriodByPayrollID()")
 	}
 	if period == nil {
-		return nil, apperror.NotFound(
+		return entity.PayslipExport{}, apperror.NotFound(
 			apperror.AppError{
 				IssueCode: entity.AttendancePeriodNotFound,
 				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
@@ -416,85 +970,1271 @@ func (u *payrollUseCase) ListPayslips(ctx context.Context, authCredential authCr
 		)
 	}
 
-	reimbursements, err := u.reimbursementRepo.FindReimbursementByPeriod(ctx, period.StartDate, period.EndDate, reimbursementEntity.FindReimbursementOptions{
-		PessimisticLock: true,
-		MappedOptions: &reimbursementEntity.MappedOptions{
-			MappedBy: reimbursementEntity.MappedByUserID,
-		},
-	})
+	latestPayroll, err := u.resolveLatestPayroll(ctx, *period, payrollID)
 	if err != nil {
-		return nil, errors.Wrap(err, "PayrollUseCase.IndexPayslips().FindReimbursementByPeriod()")
+		return entity.PayslipExport{}, errors.Wrap(err, "PayrollUseCase.ExportPayslips().resolveLatestPayroll()")
+	}
+	// The exported documents carry verification IDs, only an approved payroll
+	// is issued
+	if !latestPayroll.Status.IsApproved() {
+		return entity.PayslipExport{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotApproved,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotApproved),
+				Expected:  entity.PayrollStatusApproved,
+				Received:  latestPayroll.Status,
+			},
+		)
 	}
 
-	var payslipDataList []entity.PayslipData
-	for _, user := range users.List {
-		payslips, found := payslips.Mapped[user.ID]
-		if !found {
-			continue // Skip users without payslips
-		}
+	payslipsData, err := u.findPayslipsData(ctx, *period, latestPayroll.ID, entity.FindPayslipOptions{})
+	if err != nil {
+		return entity.PayslipExport{}, errors.Wrap(err, "PayrollUseCase.ExportPayslips().findPayslipsData()")
+	}
 
-		// Each period should have only one payslip per user
-		payslip := payslips[0]
+	return entity.PayslipExport{
+		PayrollID: latestPayroll.ID,
+		AttendancePeriod: entity.AttendancePeriodData{
+			StartDate: period.StartDate.Format(time.RFC3339),
+			EndDate:   period.EndDate.Format(time.RFC3339),
+		},
+		Payslips: payslipsData,
+	}, nil
+}
 
-		reimbursementData := make([]entity.ReimbursementData, 0)
-		if reimbursements.IsMapped {
-			if reimbursementList, ok := reimbursements.Mapped[user.ID]; ok {
-				for _, reimbursement := range reimbursementList {
-					reimbursementData = append(reimbursementData, entity.ReimbursementData{
-						Description:       reimbursement.Description,
-						Amount:            reimbursement.Amount,
-						ReimbursementDate: reimbursement.ReimbursementDate.Format(time.RFC3339),
-					})
-				}
-			}
-		}
+// ExportBankTransfers lists the take-home transfers of the latest version of a
+// payroll. Payslips without a positive take-home pay have nothing to transfer
+// and are left out, every other employee must have a bank account.
+func (u *payrollUseCase) ExportBankTransfers(ctx context.Context, authCredential authCredential.Credential, payrollID string, executionDate time.Time) (entity.BankTransferBatch, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ExportBankTransfers()",
+	)
+	defer span.End()
 
-		payslipData := entity.PayslipData{
-			ID: payslip.ID,
-			User: entity.UserData{
-				ID:       user.ID,
-				Username: user.Username,
+	if !*authCredential.IsAdmin {
+		return entity.BankTransferBatch{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
 			},
-			AttendancePeriod: entity.AttendancePeriodData{
-				StartDate: period.StartDate.Format(time.RFC3339),
-				EndDate:   period.EndDate.Format(time.RFC3339),
+		)
+	}
+
+	period, err := u.attendanceRepo.FindAttendancePeriodByPayrollID(ctx, payrollID)
+	if err != nil {
+		return entity.BankTransferBatch{}, errors.Wrap(err, "PayrollUseCase.ExportBankTransfers().FindAttendancePeriodByPayrollID()")
+	}
+	if period == nil {
+		return entity.BankTransferBatch{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.AttendancePeriodNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+				Received:  payrollID,
 			},
-			BaseSalary:     user.Salary,
-			WorkingDays:    int64(period.EndDate.Sub(period.StartDate).Hours() / 24),
-			AttendanceDays: payslip.AttendanceDays,
-			AttendancePay:  int64(float64(user.Salary) * float64(payslip.AttendanceDays) / float64(period.EndDate.Sub(period.StartDate).Hours()/24)),
-			Overtime: entity.OvertimeData{
-				OvertimeHours: iso8601.ToString(payslip.OvertimeHours.MustGet()),
-				RatePerHour:   int64(float64(user.Salary) / (period.EndDate.Sub(period.StartDate).Hours() / 24)),
-				Multiplier:    1,
-				OvertimePay:   payslip.OvertimePay,
+		)
+	}
+
+	latestPayroll, err := u.resolveLatestPayroll(ctx, *period, payrollID)
+	if err != nil {
+		return entity.BankTransferBatch{}, errors.Wrap(err, "PayrollUseCase.ExportBankTransfers().resolveLatestPayroll()")
+	}
+	if !latestPayroll.Status.IsApproved() {
+		return entity.BankTransferBatch{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotApproved,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotApproved),
+				Expected:  entity.PayrollStatusApproved,
+				Received:  latestPayroll.Status,
 			},
-			Reimbursements:     reimbursementData,
-			ReimbursementTotal: payslip.ReimbursementTotal,
-			TotalTakeHome:      payslip.TotalTakeHome,
-		}
+		)
+	}
 
-		payslipDataList = append(payslipDataList, payslipData)
+	users, err := u.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{})
+	if err != nil {
+		return entity.BankTransferBatch{}, errors.Wrap(err, "PayrollUseCase.ExportBankTransfers().FindAllUsers()")
 	}
 
-	additionalData := resource.Parameter.GetAdditionalData()
-	listPayslipMetadata := additionalData.(entity.ListPayslipMetadata)
-	resource.SetResult(resourceful.Result[string, dtos.PayslipDataResponse]{
-		PaginationResult: dtos.NewListPayslipResponse(payslipDataList),
-		IDs:              listPayslipMetadata.IDs,
+	payslips, err := u.payrollRepo.FindPayslipByPayrollID(ctx, latestPayroll.ID, entity.FindPayslipOptions{
+		MappedOptions: &entity.MappedOptions{
+			MappedBy: entity.MappedByUserID,
+		},
 	})
+	if err != nil {
+		return entity.BankTransferBatch{}, errors.Wrap(err, "PayrollUseCase.ExportBankTransfers().FindPayslipByPayrollID()")
+	}
 
-	listPayslipMetadata.Count = int64(len(payslipDataList))
-	listPayslipMetadata.Page = resource.Parameter.Page.MustGet()
-	listPayslipMetadata.TotalPage = int64(math.Ceil(float64(listPayslipMetadata.TotalCount) / float64(resource.Parameter.Limit.MustGet())))
+	userIDs := make([]string, 0, len(payslips.List))
+	for _, payslip := range payslips.List {
+		userIDs = append(userIDs, payslip.UserID)
+	}
+	bankAccounts, err := u.bankAccountRepo.FindBankAccountsByUserIDs(ctx, userIDs, bankAccountEntity.FindBankAccountOptions{
+		MappedOptions: &bankAccountEntity.MappedOptions{
+			MappedBy: bankAccountEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return entity.BankTransferBatch{}, errors.Wrap(err, "PayrollUseCase.ExportBankTransfers().FindBankAccountsByUserIDs()")
+	}
 
-	resource.SetMetadata(listPayslipMetadata)
+	batch := entity.BankTransferBatch{
+		PayrollID: latestPayroll.ID,
+		AttendancePeriod: entity.AttendancePeriodData{
+			StartDate: period.StartDate.Format(time.RFC3339),
+			EndDate:   period.EndDate.Format(time.RFC3339),
+		},
+		CreatedAt:     time.Now(),
+		ExecutionDate: executionDate,
+	}
 
-	// Store the resource in cache
-	err = redisc.SetWithExpiration(ctx, key, resource, 7*24*time.Hour)
+	var missingBankAccounts []string
+	for _, user := range users.List {
+		payslips, found := payslips.Mapped[user.ID]
+		if !found {
+			continue // Skip users without payslips
+		}
+
+		// Each period should have only one payslip per user
+		payslip := payslips[0]
+		if !payslip.TotalTakeHome.IsPositive() {
+			continue
+		}
+
+		accounts, found := bankAccounts.Mapped[user.ID]
+		if !found {
+			missingBankAccounts = append(missingBankAccounts, user.ID)
+			continue
+		}
+
+		account := accounts[0]
+		batch.Transfers = append(batch.Transfers, entity.BankTransfer{
+			PayslipID:         payslip.ID,
+			UserID:            user.ID,
+			Username:          user.Username,
+			AccountHolderName: account.AccountHolderName,
+			AccountNumber:     account.AccountNumber,
+			BankName:          account.BankName,
+			BankCode:          account.BankCode,
+			BIC:               account.BIC,
+			Amount:            payslip.TotalTakeHome,
+		})
+		batch.TotalAmount = batch.TotalAmount.Add(payslip.TotalTakeHome)
+	}
+
+	if len(missingBankAccounts) > 0 {
+		return entity.BankTransferBatch{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.BankAccountMissing,
+				Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountMissing),
+				Received:  missingBankAccounts,
+			},
+		)
+	}
+
+	return batch, nil
+}
+
+// VerifyPayslip checks a verification ID printed on a payslip document
+// against the payslip as stored.
+func (u *payrollUseCase) VerifyPayslip(ctx context.Context, authCredential authCredential.Credential, payslipID, verificationID string) (entity.PayslipVerification, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.VerifyPayslip()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.PayslipVerification{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
+	}
+
+	payslip, err := u.payrollRepo.FindPayslipByID(ctx, payslipID)
 	if err != nil {
-		return nil, errors.Wrap(err, "PayrollUseCase.ListPayslips().SetWithExpiration()")
+		return entity.PayslipVerification{}, errors.Wrap(err, "PayrollUseCase.VerifyPayslip().FindPayslipByID()")
+	}
+	if payslip == nil {
+		return entity.PayslipVerification{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.PayslipNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayslipNotFound),
+				Received:  payslipID,
+			},
+		)
 	}
 
-	return resource, nil
+	payroll, err := u.payrollRepo.FindPayrollByID(ctx, payslip.PayrollID)
+	if err != nil {
+		return entity.PayslipVerification{}, errors.Wrap(err, "PayrollUseCase.VerifyPayslip().FindPayrollByID()")
+	}
+	if payroll == nil {
+		return entity.PayslipVerification{}, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+				Received:  payslip.PayrollID,
+			},
+		)
+	}
+
+	return entity.PayslipVerification{
+		PayslipID:     payslip.ID,
+		PayrollID:     payslip.PayrollID,
+		UserID:        payslip.UserID,
+		TotalTakeHome: payslip.TotalTakeHome,
+		Valid:         entity.VerifyVerificationID(u.verificationKey, *payslip, verificationID),
+		Superseded:    payroll.VoidedAt.IsPresent(),
+	}, nil
+}
+
+// ListMyPayslips lists the payslips of the caller, latest period first. Only
+// the active version of an approved or paid payroll is listed, like
+// ShowPayslip.
+func (u *payrollUseCase) ListMyPayslips(ctx context.Context, authCredential authCredential.Credential) ([]entity.PayslipHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ListMyPayslips()",
+	)
+	defer span.End()
+
+	history, err := u.payrollRepo.FindPayslipHistoryByUserID(ctx, authCredential.UserID, entity.FindPayslipHistoryOptions{
+		PayrollStatuses: []entity.PayrollStatus{entity.PayrollStatusApproved, entity.PayrollStatusPaid},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.ListMyPayslips().FindPayslipHistoryByUserID()")
+	}
+
+	return history, nil
+}
+
+// ShowPayslipYearToDate totals the payslips of the caller whose period ends
+// within a calendar or fiscal year, the current one when year is 0.
+func (u *payrollUseCase) ShowPayslipYearToDate(ctx context.Context, authCredential authCredential.Credential, basis entity.YearBasis, year int64) (entity.PayslipYearToDate, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.ShowPayslipYearToDate()",
+	)
+	defer span.End()
+
+	startMonth := time.January
+	if basis == entity.YearBasisFiscal {
+		startMonth = u.fiscalStartMonth
+	}
+
+	if year == 0 {
+		timeNow := time.Now()
+		year = int64(timeNow.Year())
+		if timeNow.Month() < startMonth {
+			year--
+		}
+	}
+
+	startDate := time.Date(int(year), startMonth, 1, 0, 0, 0, 0, time.UTC)
+	nextStartDate := startDate.AddDate(1, 0, 0)
+
+	history, err := u.payrollRepo.FindPayslipHistoryByUserID(ctx, authCredential.UserID, entity.FindPayslipHistoryOptions{
+		PayrollStatuses: []entity.PayrollStatus{entity.PayrollStatusApproved, entity.PayrollStatusPaid},
+		PeriodEndFrom:   optional.NewTime(startDate),
+		PeriodEndBefore: optional.NewTime(nextStartDate),
+	})
+	if err != nil {
+		return entity.PayslipYearToDate{}, errors.Wrap(err, "PayrollUseCase.ShowPayslipYearToDate().FindPayslipHistoryByUserID()")
+	}
+
+	yearToDate := entity.PayslipYearToDate{
+		Basis:        basis,
+		Year:         year,
+		StartDate:    startDate,
+		EndDate:      nextStartDate.AddDate(0, 0, -1),
+		TotalPayslip: int64(len(history)),
+	}
+	for _, payslip := range history {
+		yearToDate.GrossPay = yearToDate.GrossPay.Add(payslip.GrossPay)
+		yearToDate.OvertimePay = yearToDate.OvertimePay.Add(payslip.OvertimePay)
+		yearToDate.Reimbursement = yearToDate.Reimbursement.Add(payslip.ReimbursementTotal)
+		yearToDate.Deductions = yearToDate.Deductions.Add(payslip.TotalDeductions)
+		yearToDate.TakeHome = yearToDate.TakeHome.Add(payslip.TotalTakeHome)
+	}
+
+	return yearToDate, nil
+}
+
+// DiffPayrolls compares the payslips of two payroll runs, like two periods or
+// a regenerated payroll and the version it voided.
+func (u *payrollUseCase) DiffPayrolls(ctx context.Context, authCredential authCredential.Credential, fromPayrollID, toPayrollID string, thresholds entity.PayrollDiffThresholds) (entity.PayrollDiff, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"PayrollUseCase.DiffPayrolls()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.PayrollDiff{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+			},
+		)
+	}
+
+	runs := make([]entity.Payroll, 0, 2)
+	for _, payrollID := range []string{fromPayrollID, toPayrollID} {
+		payroll, err := u.payrollRepo.FindPayrollByID(ctx, payrollID)
+		if err != nil {
+			return entity.PayrollDiff{}, errors.Wrap(err, "PayrollUseCase.DiffPayrolls().FindPayrollByID()")
+		}
+		if payroll == nil {
+			return entity.PayrollDiff{}, apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  payrollID,
+				},
+			)
+		}
+		runs = append(runs, *payroll)
+	}
+	fromPayroll, toPayroll := runs[0], runs[1]
+
+	users, err := u.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{})
+	if err != nil {
+		return entity.PayrollDiff{}, errors.Wrap(err, "PayrollUseCase.DiffPayrolls().FindAllUsers()")
+	}
+
+	fromPayslips, err := u.payrollRepo.FindPayslipByPayrollID(ctx, fromPayroll.ID, entity.FindPayslipOptions{
+		MappedOptions: &entity.MappedOptions{
+			MappedBy: entity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return entity.PayrollDiff{}, errors.Wrap(err, "PayrollUseCase.DiffPayrolls().FindPayslipByPayrollID()")
+	}
+
+	toPayslips, err := u.payrollRepo.FindPayslipByPayrollID(ctx, toPayroll.ID, entity.FindPayslipOptions{
+		MappedOptions: &entity.MappedOptions{
+			MappedBy: entity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return entity.PayrollDiff{}, errors.Wrap(err, "PayrollUseCase.DiffPayrolls().FindPayslipByPayrollID()")
+	}
+
+	diff := entity.PayrollDiff{
+		From:       newPayrollRun(fromPayroll),
+		To:         newPayrollRun(toPayroll),
+		Thresholds: thresholds,
+		Summary: entity.PayrollDiffSummary{
+			TotalEmployee: entity.NewInt64Diff(int64(len(fromPayslips.List)), int64(len(toPayslips.List))),
+		},
+	}
+
+	for _, user := range users.List {
+		// Each payroll should have only one payslip per user
+		var fromPayslip, toPayslip *entity.Payslip
+		if payslips, found := fromPayslips.Mapped[user.ID]; found {
+			fromPayslip = &payslips[0]
+		}
+		if payslips, found := toPayslips.Mapped[user.ID]; found {
+			toPayslip = &payslips[0]
+		}
+		if fromPayslip == nil && toPayslip == nil {
+			continue // Skip users paid in neither run
+		}
+
+		payslipDiff := diffPayslip(fromPayslip, toPayslip, thresholds)
+		payslipDiff.UserID = user.ID
+		payslipDiff.Username = user.Username
+
+		switch payslipDiff.Status {
+		case entity.PayslipDiffNew:
+			diff.Summary.NewEmployee++
+		case entity.PayslipDiffMissing:
+			diff.Summary.MissingEmployee++
+		case entity.PayslipDiffChanged:
+			diff.Summary.ChangedEmployee++
+		}
+		if len(payslipDiff.Anomalies) > 0 {
+			diff.Summary.AnomalyEmployee++
+		}
+
+		diff.Employees = append(diff.Employees, payslipDiff)
+	}
+
+	diff.Summary.TotalBaseSalary = sumMoneyDiffs(diff.Employees, func(employee entity.PayslipDiff) entity.ValueDiff[money.Money] {
+		return employee.BaseSalary
+	})
+	diff.Summary.TotalOvertimePay = sumMoneyDiffs(diff.Employees, func(employee entity.PayslipDiff) entity.ValueDiff[money.Money] {
+		return employee.OvertimePay
+	})
+	diff.Summary.TotalReimbursement = sumMoneyDiffs(diff.Employees, func(employee entity.PayslipDiff) entity.ValueDiff[money.Money] {
+		return employee.Reimbursement
+	})
+	diff.Summary.TotalTakeHome = sumMoneyDiffs(diff.Employees, func(employee entity.PayslipDiff) entity.ValueDiff[money.Money] {
+		return employee.TakeHome
+	})
+
+	return diff, nil
+}
+
+// resolveLatestPayroll resolves a payroll, voided or not, to the latest
+// version generated for its period.
+func (u *payrollUseCase) resolveLatestPayroll(ctx context.Context, period attendanceEntity.AttendancePeriod, payrollID string) (*entity.Payroll, error) {
+	latestPayroll, err := u.payrollRepo.FindPayrollByPeriodID(ctx, period.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.resolveLatestPayroll().FindPayrollByPeriodID()")
+	}
+	if latestPayroll == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.PayrollNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+				Received:  payrollID,
+			},
+		)
+	}
+
+	return latestPayroll, nil
+}
+
+// findPayslipsData loads the stored payslips of a payroll with their line
+// items, salary segments and reimbursements, ordered like the users.
+func (u *payrollUseCase) findPayslipsData(ctx context.Context, period attendanceEntity.AttendancePeriod, payrollID string, opts entity.FindPayslipOptions) ([]entity.PayslipData, error) {
+	users, err := u.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{
+		PessimisticLock: opts.PessimisticLock,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.findPayslipsData().FindAllUsers()")
+	}
+
+	opts.MappedOptions = &entity.MappedOptions{
+		MappedBy: entity.MappedByUserID,
+	}
+	payslips, err := u.payrollRepo.FindPayslipByPayrollID(ctx, payrollID, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.findPayslipsData().FindPayslipByPayrollID()")
+	}
+
+	payslipIDs := make([]string, 0, len(payslips.List))
+	for _, payslip := range payslips.List {
+		payslipIDs = append(payslipIDs, payslip.ID)
+	}
+	payslipItems, err := u.payrollRepo.FindPayslipItemsByPayslipIDs(ctx, payslipIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.findPayslipsData().FindPayslipItemsByPayslipIDs()")
+	}
+	itemsByPayslipID := make(map[string][]entity.PayslipItem, len(payslipIDs))
+	for _, item := range payslipItems {
+		itemsByPayslipID[item.PayslipID] = append(itemsByPayslipID[item.PayslipID], item)
+	}
+
+	salarySegments, err := u.payrollRepo.FindPayslipSalarySegmentsByPayslipIDs(ctx, payslipIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.findPayslipsData().FindPayslipSalarySegmentsByPayslipIDs()")
+	}
+	segmentsByPayslipID := make(map[string][]entity.PayslipSalarySegment, len(payslipIDs))
+	for _, segment := range salarySegments {
+		segmentsByPayslipID[segment.PayslipID] = append(segmentsByPayslipID[segment.PayslipID], segment)
+	}
+
+	reimbursements, err := u.reimbursementRepo.FindReimbursementByPeriod(ctx, period.StartDate, period.EndDate, reimbursementEntity.FindReimbursementOptions{
+		PessimisticLock: opts.PessimisticLock,
+		MappedOptions: &reimbursementEntity.MappedOptions{
+			MappedBy: reimbursementEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "PayrollUseCase.findPayslipsData().FindReimbursementByPeriod()")
+	}
+
+	var payslipDataList []entity.PayslipData
+	for _, user := range users.List {
+		payslips, found := payslips.Mapped[user.ID]
+		if !found {
+			continue // Skip users without payslips
+		}
+
+		// Each period should have only one payslip per user
+		payslip := payslips[0]
+		payslip.Items = itemsByPayslipID[payslip.ID]
+		payslip.SalarySegments = segmentsByPayslipID[payslip.ID]
+
+		var userReimbursements []reimbursementEntity.Reimbursement
+		if reimbursements.IsMapped {
+			userReimbursements = reimbursements.Mapped[user.ID]
+		}
+
+		payslipData := newPayslipData(payslip, user, period, userReimbursements)
+		payslipData.VerificationID = entity.NewVerificationID(u.verificationKey, payslip)
+
+		payslipDataList = append(payslipDataList, payslipData)
+	}
+
+	return payslipDataList, nil
+}
+
+// payrollSources groups the repositories a payroll calculation reads from, so
+// the same calculation can run against any transaction.
+type payrollSources struct {
+	payrollRepo       payroll.Repository
+	userRepo          users.Repository
+	attendanceRepo    attendance.Repository
+	overtimeRepo      overtime.Repository
+	reimbursementRepo reimbursement.Repository
+	holidayRepo       holiday.Repository
+	salaryRepo        salary.Repository
+	adjustmentRepo    adjustment.Repository
+	loanRepo          loan.Repository
+	leaveRepo         leave.Repository
+}
+
+type calculatedPayroll struct {
+	Payslips           []entity.Payslip
+	PayslipsData       []entity.PayslipData
+	TotalGrossPay      money.Money
+	TotalReimbursement money.Money
+	TotalDeductions    money.Money
+	TotalTakeHome      money.Money
+	TotalEmployee      int64
+}
+
+// calculatePayroll aggregates attendance, overtime and reimbursements of every
+// user for the period. It only reads, the returned payslips carry no IDs or
+// audit fields and it is up to the caller to persist them.
+func (u *payrollUseCase) calculatePayroll(ctx context.Context, sources payrollSources, period attendanceEntity.AttendancePeriod, pessimisticLock bool, progress *jobProgress) (calculatedPayroll, error) {
+	var result calculatedPayroll
+
+	users, err := sources.userRepo.FindAllUsers(ctx, userEntity.FindUserOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &userEntity.MappedOptions{
+			MappedBy: userEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindAllUsers()")
+	}
+
+	attendances, err := sources.attendanceRepo.FindAttendanceByPeriod(ctx, period.StartDate, period.EndDate, attendanceEntity.FindAttendanceOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &attendanceEntity.MappedOptions{
+			MappedBy: attendanceEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindAttendanceByPeriod()")
+	}
+
+	overtimes, err := sources.overtimeRepo.FindOvertimeByPeriod(ctx, period.StartDate, period.EndDate, overtimeEntity.FindOvertimeOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &overtimeEntity.MappedOptions{
+			MappedBy: overtimeEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindOvertimeByPeriod()")
+	}
+
+	overtimePolicy, err := sources.overtimeRepo.FindActivePolicy(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindActivePolicy()")
+	}
+	if overtimePolicy == nil {
+		return result, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.OvertimePolicyNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.OvertimePolicyNotFound),
+			},
+		)
+	}
+
+	reimbursements, err := sources.reimbursementRepo.FindReimbursementByPeriod(ctx, period.StartDate, period.EndDate, reimbursementEntity.FindReimbursementOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &reimbursementEntity.MappedOptions{
+			MappedBy: reimbursementEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindReimbursementByPeriod()")
+	}
+
+	holidays, err := sources.holidayRepo.FindHolidaysByRange(ctx, period.StartDate, period.EndDate)
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindHolidaysByRange()")
+	}
+
+	deductionRules, err := sources.payrollRepo.FindActiveDeductionRules(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindActiveDeductionRules()")
+	}
+
+	deductions, err := entity.NewDeductionPipeline(deductionRules)
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().NewDeductionPipeline()")
+	}
+
+	salaryHistory, err := sources.salaryRepo.FindSalaryHistoryByRange(ctx, period.StartDate, period.EndDate, salaryEntity.FindSalaryHistoryOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &salaryEntity.MappedOptions{
+			MappedBy: salaryEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindSalaryHistoryByRange()")
+	}
+
+	allowances, err := sources.adjustmentRepo.FindAllowancesByRange(ctx, period.StartDate, period.EndDate, adjustmentEntity.FindAllowanceOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &adjustmentEntity.MappedOptions{
+			MappedBy: adjustmentEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindAllowancesByRange()")
+	}
+
+	adjustments, err := sources.adjustmentRepo.FindAdjustmentsByPeriodID(ctx, period.ID, adjustmentEntity.FindAdjustmentOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &adjustmentEntity.MappedOptions{
+			MappedBy: adjustmentEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindAdjustmentsByPeriodID()")
+	}
+
+	loans, err := sources.loanRepo.FindOutstandingLoans(ctx, period.ID, period.EndDate, loanEntity.FindLoanOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &loanEntity.MappedOptions{
+			MappedBy: loanEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindOutstandingLoans()")
+	}
+
+	leaves, err := sources.leaveRepo.FindApprovedLeaveByRange(ctx, period.StartDate, period.EndDate, leaveEntity.FindLeaveRequestOptions{
+		PessimisticLock: pessimisticLock,
+		MappedOptions: &leaveEntity.MappedOptions{
+			MappedBy: leaveEntity.MappedByUserID,
+		},
+	})
+	if err != nil {
+		return result, errors.Wrap(err, "PayrollUseCase.calculatePayroll().FindApprovedLeaveByRange()")
+	}
+
+	calendar := holidayEntity.NewCalendar(holidays)
+	workingDays := calendar.WorkingDays(period.StartDate, period.EndDate)
+
+	totalUsers := int64(len(users.List))
+	progress.report(ctx, entity.PayrollJobCalculating, 0, totalUsers)
+
+	for userIndex, user := range users.List {
+		// Users who joined after or left before the period get no payslip,
+		// the others are only paid for the days they were employed.
+		employedFrom, employedTo, employed := user.EmployedWithin(period.StartDate, period.EndDate)
+		if !employed {
+			progress.report(ctx, entity.PayrollJobCalculating, int64(userIndex+1), totalUsers)
+			continue
+		}
+		eligibleWorkingDays := calendar.WorkingDays(employedFrom, employedTo)
+
+		var userSalaryHistory []salaryEntity.SalaryHistory
+		if salaryHistory.IsMapped {
+			userSalaryHistory = salaryHistory.Mapped[user.ID]
+		}
+		salarySegments := salaryEntity.NewTimeline(user.Salary, userSalaryHistory).Segments(employedFrom, employedTo)
+
+		// Every segment is paid its own salary for the days attended within
+		// it, prorated over the working days of the whole period. A partial
+		// employment is prorated by the same rule, the segments only cover
+		// the days the user was employed.
+		segments := make([]entity.PayslipSalarySegment, len(salarySegments))
+		for i, salarySegment := range salarySegments {
+			segments[i] = entity.PayslipSalarySegment{
+				StartDate:           salarySegment.StartDate,
+				EndDate:             salarySegment.EndDate,
+				Salary:              salarySegment.Salary,
+				WorkingDays:         calendar.WorkingDays(salarySegment.StartDate, salarySegment.EndDate),
+				OvertimeRatePerHour: overtimePolicy.RatePerHour(salarySegment.Salary, workingDays),
+				SortOrder:           int64(i + 1),
+			}
+		}
+
+		var (
+			totalAttendanceDays int64
+			totalWorkedDuration time.Duration
+			segmentPaidDuration = make([]time.Duration, len(segments))
+			attendedDates       = make(map[string]bool)
+		)
+		if attendances.IsMapped {
+			if attendanceList, ok := attendances.Mapped[user.ID]; ok {
+				for _, attendance := range attendanceList {
+					if i := findSalarySegment(salarySegments, attendance.AttendanceDate); i >= 0 {
+						segments[i].AttendanceDays++
+						totalAttendanceDays++
+						totalWorkedDuration += attendance.WorkedDuration()
+						segmentPaidDuration[i] += u.paidDuration(attendance)
+						attendedDates[attendance.AttendanceDate.Format(time.DateOnly)] = true
+					}
+				}
+			}
+		}
+
+		// Approved paid leave counts as a full attended day on the working
+		// days the user did not attend anyway.
+		if leaves.IsMapped {
+			for _, request := range leaves.Mapped[user.ID] {
+				if !request.Paid {
+					continue
+				}
+				for _, date := range request.WorkingDates(calendar, employedFrom, employedTo) {
+					if attendedDates[date.Format(time.DateOnly)] {
+						continue
+					}
+					if i := findSalarySegment(salarySegments, date); i >= 0 {
+						segments[i].AttendanceDays++
+						totalAttendanceDays++
+						segmentPaidDuration[i] += u.workSchedule.Length()
+						attendedDates[date.Format(time.DateOnly)] = true
+					}
+				}
+			}
+		}
+
+		var attendancePay money.Money
+		for i := range segments {
+			if u.attendanceBasis == entity.AttendanceBasisHours {
+				segments[i].AttendancePay = calculateAttendanceHoursPay(segments[i].Salary, segmentPaidDuration[i], workingDays, u.workSchedule.Length())
+			} else {
+				segments[i].AttendancePay = calculateAttendancePay(segments[i].Salary, segments[i].AttendanceDays, workingDays)
+			}
+			attendancePay = attendancePay.Add(segments[i].AttendancePay)
+		}
+
+		// The base salary and hourly rate shown on the payslip are the ones in
+		// effect at the end of the period.
+		lastSegment := segments[len(segments)-1]
+		ratePerHour := lastSegment.OvertimeRatePerHour
+
+		var (
+			totalOvertimeHours time.Duration
+			overtimePay        = new(big.Rat)
+		)
+		if overtimes.IsMapped {
+			if overtimeList, ok := overtimes.Mapped[user.ID]; ok {
+				for _, overtime := range overtimeList {
+					i := findSalarySegment(salarySegments, overtime.OverTimeDate)
+					if i < 0 {
+						continue
+					}
+					overtimeRatePerHour := segments[i].OvertimeRatePerHour
+					totalOvertimeHours += overtime.OvertimeHours
+					overtimePay.Add(overtimePay, overtimePolicy.CalculatePay(overtime, overtimeRatePerHour, calendar.IsWorkingDay(overtime.OverTimeDate)))
+				}
+			}
+		}
+
+		var (
+			userReimbursements       []reimbursementEntity.Reimbursement
+			totalReimbursementAmount money.Money
+		)
+		if reimbursements.IsMapped {
+			if reimbursementList, ok := reimbursements.Mapped[user.ID]; ok {
+				userReimbursements = reimbursementList
+				for _, reimbursement := range reimbursementList {
+					totalReimbursementAmount = totalReimbursementAmount.Add(reimbursement.Amount)
+				}
+			}
+		}
+
+		// Allowances are paid in full in every period they are active in,
+		// adjustments only with the period they are attached to.
+		var earnings []entity.PayslipItem
+		if allowances.IsMapped {
+			for _, allowance := range allowances.Mapped[user.ID] {
+				earnings = append(earnings, entity.PayslipItem{
+					ItemType: entity.PayslipItemTypeAllowance,
+					Code:     allowance.Code,
+					Name:     allowance.Name,
+					Amount:   allowance.Amount,
+					Taxable:  allowance.Taxable,
+				})
+			}
+		}
+		if adjustments.IsMapped {
+			for _, payrollAdjustment := range adjustments.Mapped[user.ID] {
+				earnings = append(earnings, entity.PayslipItem{
+					ItemType: entity.PayslipItemTypeAdjustment,
+					Code:     payrollAdjustment.Code,
+					Name:     payrollAdjustment.Name,
+					Amount:   payrollAdjustment.Amount,
+					Taxable:  payrollAdjustment.Taxable,
+				})
+			}
+		}
+
+		var taxableEarnings, nonTaxableEarnings money.Money
+		for i := range earnings {
+			earnings[i].SortOrder = int64(i + 1)
+			if earnings[i].Taxable {
+				taxableEarnings = taxableEarnings.Add(earnings[i].Amount)
+			} else {
+				nonTaxableEarnings = nonTaxableEarnings.Add(earnings[i].Amount)
+			}
+		}
+
+		totalOvertimePay := money.FromRat(overtimePay, entity.OvertimePayRounding)
+		grossPay := attendancePay.Add(totalOvertimePay).Add(taxableEarnings)
+
+		items := deductions.Apply(grossPay)
+		var totalDeductions money.Money
+		for i := range items {
+			items[i].SortOrder += int64(len(earnings))
+			totalDeductions = totalDeductions.Add(items[i].Amount)
+		}
+		if len(earnings) > 0 {
+			items = append(earnings, items...)
+		}
+		// Reimbursements and non-taxable earnings skip the deductions and are
+		// paid in full on top of the net pay.
+		totalTakeHomePay := grossPay.Sub(totalDeductions).Add(totalReimbursementAmount).Add(nonTaxableEarnings)
+
+		// Loan installments come last and are capped by what is left of the
+		// net pay, the part not deducted stays on the outstanding balance.
+		if loans.IsMapped {
+			for _, userLoan := range loans.Mapped[user.ID] {
+				repayment := money.Min(userLoan.DueInstallment(), totalTakeHomePay)
+				if !repayment.IsPositive() {
+					break
+				}
+				items = append(items, entity.PayslipItem{
+					ItemType:  entity.PayslipItemTypeLoanRepayment,
+					Code:      "loan_repayment",
+					Name:      "Loan Repayment",
+					Amount:    repayment,
+					LoanID:    optional.NewString(userLoan.ID),
+					SortOrder: int64(len(items) + 1),
+				})
+				totalDeductions = totalDeductions.Add(repayment)
+				totalTakeHomePay = totalTakeHomePay.Sub(repayment)
+			}
+		}
+
+		payslip := entity.Payslip{
+			UserID:                user.ID,
+			BaseSalary:            lastSegment.Salary,
+			WorkingDays:           optional.NewInt64(workingDays),
+			EligibleWorkingDays:   optional.NewInt64(eligibleWorkingDays),
+			AttendanceDays:        totalAttendanceDays,
+			WorkedDuration:        optional.NewDuration(totalWorkedDuration),
+			OvertimeHours:         optional.NewDuration(totalOvertimeHours),
+			OvertimePay:           totalOvertimePay,
+			OvertimePolicyID:      optional.NewString(overtimePolicy.ID),
+			OvertimePolicyVersion: optional.NewInt64(overtimePolicy.Version),
+			OvertimeRatePerHour:   optional.NewMoney(ratePerHour),
+			GrossPay:              grossPay,
+			ReimbursementTotal:    totalReimbursementAmount,
+			TotalDeductions:       totalDeductions,
+			TotalTakeHome:         totalTakeHomePay,
+			Department:            user.Department,
+			CostCentre:            user.CostCentre,
+			Items:                 items,
+			SalarySegments:        segments,
+		}
+
+		result.Payslips = append(result.Payslips, payslip)
+		result.PayslipsData = append(result.PayslipsData, newPayslipData(payslip, user, period, userReimbursements))
+		result.TotalGrossPay = result.TotalGrossPay.Add(grossPay)
+		result.TotalReimbursement = result.TotalReimbursement.Add(totalReimbursementAmount)
+		result.TotalDeductions = result.TotalDeductions.Add(totalDeductions)
+		result.TotalTakeHome = result.TotalTakeHome.Add(totalTakeHomePay)
+
+		progress.report(ctx, entity.PayrollJobCalculating, int64(userIndex+1), totalUsers)
+	}
+
+	result.TotalEmployee = int64(len(result.Payslips))
+
+	return result, nil
+}
+
+// storePayroll persists a calculated payroll, its payslips and summary as the
+// given version of the period.
+func (u *payrollUseCase) storePayroll(ctx context.Context, payrollRepoTx payroll.Repository, authCredential authCredential.Credential, period attendanceEntity.AttendancePeriod, calculated calculatedPayroll, version int64) (entity.GeneratedPayroll, error) {
+	payrollID := uuid.NewString()
+	timeNow := time.Now()
+
+	payslips := make([]entity.Payslip, 0, len(calculated.Payslips))
+	var (
+		payslipItems   []entity.PayslipItem
+		salarySegments []entity.PayslipSalarySegment
+	)
+	for _, payslip := range calculated.Payslips {
+		payslip.ID = uuid.NewString()
+		payslip.PayrollID = payrollID
+		payslip.CreatedAt = timeNow
+		payslip.UpdatedAt = timeNow
+		payslip.CreatedBy = authCredential.UserID
+		payslip.UpdatedBy = authCredential.UserID
+		payslip.IPAddress = authCredential.IPAddress
+
+		for _, item := range payslip.Items {
+			item.ID = uuid.NewString()
+			item.PayslipID = payslip.ID
+			item.CreatedAt = timeNow
+			item.UpdatedAt = timeNow
+			item.CreatedBy = authCredential.UserID
+			item.UpdatedBy = authCredential.UserID
+			item.IPAddress = authCredential.IPAddress
+
+			payslipItems = append(payslipItems, item)
+		}
+
+		for _, segment := range payslip.SalarySegments {
+			segment.ID = uuid.NewString()
+			segment.PayslipID = payslip.ID
+			segment.CreatedAt = timeNow
+			segment.UpdatedAt = timeNow
+			segment.CreatedBy = authCredential.UserID
+			segment.UpdatedBy = authCredential.UserID
+			segment.IPAddress = authCredential.IPAddress
+
+			salarySegments = append(salarySegments, segment)
+		}
+
+		payslips = append(payslips, payslip)
+	}
+
+	// Store the generated payroll
+	err := payrollRepoTx.StoreNewPayroll(ctx, entity.Payroll{
+		ID:        payrollID,
+		PeriodID:  period.ID,
+		Version:   version,
+		Status:    entity.PayrollStatusDraft,
+		RunBy:     authCredential.UserID,
+		RunAt:     timeNow,
+		CreatedAt: timeNow,
+		UpdatedAt: timeNow,
+		CreatedBy: authCredential.UserID,
+		UpdatedBy: authCredential.UserID,
+		IPAddress: authCredential.IPAddress,
+	})
+	if err != nil {
+		return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.storePayroll().StoreNewPayroll()")
+	}
+
+	// Store the payslips
+	if len(payslips) > 0 {
+		err := payrollRepoTx.StoreNewPayslips(ctx, payslips)
+		if err != nil {
+			return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.storePayroll().StoreNewPayslips()")
+		}
+	}
+
+	// Store the payslip line items
+	if len(payslipItems) > 0 {
+		err := payrollRepoTx.StoreNewPayslipItems(ctx, payslipItems)
+		if err != nil {
+			return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.storePayroll().StoreNewPayslipItems()")
+		}
+	}
+
+	// Store the salary split of the payslips
+	if len(salarySegments) > 0 {
+		err := payrollRepoTx.StoreNewPayslipSalarySegments(ctx, salarySegments)
+		if err != nil {
+			return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.storePayroll().StoreNewPayslipSalarySegments()")
+		}
+	}
+
+	// Store the payroll summary
+	err = payrollRepoTx.StoreNewPayrollSummary(ctx, entity.PayrollSummary{
+		ID:                 uuid.NewString(),
+		PayrollID:          payrollID,
+		TotalGrossPay:      calculated.TotalGrossPay,
+		TotalReimbursement: calculated.TotalReimbursement,
+		TotalDeductions:    calculated.TotalDeductions,
+		TotalTakeHome:      calculated.TotalTakeHome,
+		GeneratedBy:        authCredential.UserID,
+		GeneratedAt:        timeNow,
+		CreatedAt:          timeNow,
+		UpdatedAt:          timeNow,
+		CreatedBy:          authCredential.UserID,
+		UpdatedBy:          authCredential.UserID,
+		IPAddress:          authCredential.IPAddress,
+	})
+	if err != nil {
+		return entity.GeneratedPayroll{}, errors.Wrap(err, "PayrollUseCase.storePayroll().StoreNewPayrollSummary()")
+	}
+
+	return entity.GeneratedPayroll{
+		PeriodID:           period.ID,
+		PayrollID:          payrollID,
+		Version:            version,
+		Status:             entity.PayrollStatusDraft,
+		TotalGrossPay:      calculated.TotalGrossPay,
+		TotalReimbursement: calculated.TotalReimbursement,
+		TotalDeductions:    calculated.TotalDeductions,
+		TotalTakeHome:      calculated.TotalTakeHome,
+		TotalEmployee:      calculated.TotalEmployee,
+		TotalPayslip:       int64(len(payslips)),
+		GeneratedBy:        authCredential.UserID,
+		GeneratedAt:        timeNow.Format(time.RFC3339),
+	}, nil
+}
+
+func newPayrollRun(payroll entity.Payroll) entity.PayrollRun {
+	return entity.PayrollRun{
+		PayrollID: payroll.ID,
+		PeriodID:  payroll.PeriodID,
+		Version:   payroll.Version,
+		Status:    payroll.Status,
+		Voided:    payroll.VoidedAt.IsPresent(),
+	}
+}
+
+// diffPayslip compares the payslips of an employee in two runs, either of
+// them is nil when the employee is not paid in that run.
+func diffPayslip(from, to *entity.Payslip, thresholds entity.PayrollDiffThresholds) entity.PayslipDiff {
+	var fromPayslip, toPayslip entity.Payslip
+	if from != nil {
+		fromPayslip = *from
+	}
+	if to != nil {
+		toPayslip = *to
+	}
+
+	diff := entity.PayslipDiff{
+		BaseSalary:     entity.NewMoneyDiff(fromPayslip.BaseSalary, toPayslip.BaseSalary),
+		AttendanceDays: entity.NewInt64Diff(fromPayslip.AttendanceDays, toPayslip.AttendanceDays),
+		OvertimeHours:  entity.NewDurationDiff(fromPayslip.OvertimeHours.GetOrDefault(), toPayslip.OvertimeHours.GetOrDefault()),
+		OvertimePay:    entity.NewMoneyDiff(fromPayslip.OvertimePay, toPayslip.OvertimePay),
+		Reimbursement:  entity.NewMoneyDiff(fromPayslip.ReimbursementTotal, toPayslip.ReimbursementTotal),
+		TakeHome:       entity.NewMoneyDiff(fromPayslip.TotalTakeHome, toPayslip.TotalTakeHome),
+	}
+
+	switch {
+	case from == nil:
+		diff.Status = entity.PayslipDiffNew
+	case to == nil:
+		diff.Status = entity.PayslipDiffMissing
+	case diff.BaseSalary.Delta.IsZero() && diff.AttendanceDays.Delta == 0 && diff.OvertimeHours.Delta == 0 &&
+		diff.OvertimePay.Delta.IsZero() && diff.Reimbursement.Delta.IsZero() && diff.TakeHome.Delta.IsZero():
+		diff.Status = entity.PayslipDiffUnchanged
+	default:
+		diff.Status = entity.PayslipDiffChanged
+	}
+
+	// Changes are only meaningful for an employee paid in both runs
+	if from != nil && to != nil {
+		if exceedsPercent(diff.TakeHome, thresholds.TakeHomeChangePercent) {
+			diff.Anomalies = append(diff.Anomalies, entity.PayrollAnomalyTakeHomeChange)
+		}
+		if exceedsPercent(diff.BaseSalary, thresholds.BaseSalaryChangePercent) {
+			diff.Anomalies = append(diff.Anomalies, entity.PayrollAnomalyBaseSalaryChange)
+		}
+		if thresholds.AttendanceDaysChange > 0 && max(diff.AttendanceDays.Delta, -diff.AttendanceDays.Delta) >= thresholds.AttendanceDaysChange {
+			diff.Anomalies = append(diff.Anomalies, entity.PayrollAnomalyAttendanceDaysChange)
+		}
+		if thresholds.OvertimeHoursChange > 0 && max(diff.OvertimeHours.Delta, -diff.OvertimeHours.Delta) >= thresholds.OvertimeHoursChange {
+			diff.Anomalies = append(diff.Anomalies, entity.PayrollAnomalyOvertimeHoursChange)
+		}
+	}
+	if to != nil && !to.TotalTakeHome.IsPositive() {
+		diff.Anomalies = append(diff.Anomalies, entity.PayrollAnomalyZeroTakeHome)
+	}
+
+	return diff
+}
+
+// exceedsPercent reports whether an amount moved by at least percent of its
+// first value. Any change of an amount that was zero exceeds it.
+func exceedsPercent(diff entity.ValueDiff[money.Money], percent int64) bool {
+	if percent <= 0 || diff.Delta.IsZero() {
+		return false
+	}
+	if diff.From.IsZero() {
+		return true
+	}
+
+	delta, from := diff.Delta.Cents(), diff.From.Cents()
+	return max(delta, -delta)*100 >= percent*max(from, -from)
+}
+
+// sumMoneyDiffs totals an amount of every employee in both runs.
+func sumMoneyDiffs(employees []entity.PayslipDiff, amount func(entity.PayslipDiff) entity.ValueDiff[money.Money]) entity.ValueDiff[money.Money] {
+	var from, to money.Money
+	for _, employee := range employees {
+		from = from.Add(amount(employee).From)
+		to = to.Add(amount(employee).To)
+	}
+	return entity.NewMoneyDiff(from, to)
+}
+
+// submittedBy returns who submitted the payroll for its current review, the
+// latest submission of the trail.
+func submittedBy(approvals []entity.PayrollApproval) string {
+	for i := len(approvals) - 1; i >= 0; i-- {
+		if approvals[i].Action == entity.PayrollActionSubmit {
+			return approvals[i].CreatedBy
+		}
+	}
+	return ""
+}
+
+// calculateAttendancePay pays the salary pro rata for the attended working
+// days, rounded with entity.AttendancePayRounding.
+func calculateAttendancePay(salary money.Money, attendanceDays, workingDays int64) money.Money {
+	if workingDays <= 0 {
+		return money.Zero
+	}
+	return salary.Mul(money.Ratio(attendanceDays, workingDays), entity.AttendancePayRounding)
+}
+
+// calculateAttendanceHoursPay pays the salary pro rata for the hours worked
+// out of the scheduled hours of the period, counted in whole minutes and
+// rounded with entity.AttendancePayRounding.
+func calculateAttendanceHoursPay(salary money.Money, worked time.Duration, workingDays int64, dayLength time.Duration) money.Money {
+	scheduledMinutes := workingDays * int64(dayLength/time.Minute)
+	if scheduledMinutes <= 0 {
+		return money.Zero
+	}
+	return salary.Mul(money.Ratio(int64(worked/time.Minute), scheduledMinutes), entity.AttendancePayRounding)
+}
+
+// paidDuration is the time an attendance is paid for when the salary is paid
+// for the hours worked. A presence submitted without clocking in counts as a
+// full scheduled day, a clock in without a clock out counts for nothing.
+func (u *payrollUseCase) paidDuration(attendance attendanceEntity.Attendance) time.Duration {
+	if !attendance.HasClockEvents() {
+		return u.workSchedule.Length()
+	}
+	return u.workSchedule.CappedWorkedDuration(attendance)
+}
+
+// findSalarySegment returns the index of the segment date falls in, or -1.
+func findSalarySegment(segments []salaryEntity.SalarySegment, date time.Time) int {
+	for i, segment := range segments {
+		if segment.Contains(date) {
+			return i
+		}
+	}
+	return -1
+}
+
+// newPayslipData expands a stored or calculated payslip into the breakdown
+// shown to employees and admins.
+func newPayslipData(payslip entity.Payslip, user userEntity.User, period attendanceEntity.AttendancePeriod, reimbursements []reimbursementEntity.Reimbursement) entity.PayslipData {
+	reimbursementData := make([]entity.ReimbursementData, 0, len(reimbursements))
+	for _, reimbursement := range reimbursements {
+		reimbursementData = append(reimbursementData, entity.ReimbursementData{
+			Description:       reimbursement.Description,
+			Amount:            reimbursement.Amount,
+			ReimbursementDate: reimbursement.ReimbursementDate.Format(time.RFC3339),
+		})
+	}
+
+	var earnings, deductions []entity.PayslipItemData
+	for _, item := range payslip.Items {
+		switch {
+		case item.ItemType.IsEarning():
+			earnings = append(earnings, entity.PayslipItemData{
+				Code:    item.Code,
+				Name:    item.Name,
+				Amount:  item.Amount,
+				Taxable: item.Taxable,
+			})
+		case item.ItemType.IsDeduction():
+			deductions = append(deductions, entity.PayslipItemData{
+				Code:   item.Code,
+				Name:   item.Name,
+				Amount: item.Amount,
+			})
+		}
+	}
+
+	// Payslips generated before the holiday calendar existed counted every
+	// day of the period as a working day.
+	workingDays := payslip.WorkingDays.GetOrDefault(int64(period.EndDate.Sub(period.StartDate).Hours() / 24))
+
+	// The salary is the one stored on the payslip, so a later salary change
+	// never alters a past payslip. Payslips generated before salary history
+	// existed have no segments and were paid the base salary throughout.
+	var (
+		attendancePay  money.Money
+		salarySegments = make([]entity.SalarySegmentData, 0, len(payslip.SalarySegments))
+	)
+	for _, segment := range payslip.SalarySegments {
+		attendancePay = attendancePay.Add(segment.AttendancePay)
+		salarySegments = append(salarySegments, entity.SalarySegmentData{
+			StartDate:           segment.StartDate.Format(time.RFC3339),
+			EndDate:             segment.EndDate.Format(time.RFC3339),
+			Salary:              segment.Salary,
+			WorkingDays:         segment.WorkingDays,
+			AttendanceDays:      segment.AttendanceDays,
+			AttendancePay:       segment.AttendancePay,
+			OvertimeRatePerHour: segment.OvertimeRatePerHour,
+		})
+	}
+	if len(payslip.SalarySegments) == 0 {
+		attendancePay = calculateAttendancePay(payslip.BaseSalary, payslip.AttendanceDays, workingDays)
+	}
+
+	// Payslips generated before overtime policies existed used the day rate
+	// as the hourly rate with a flat multiplier.
+	overtimeHours := payslip.OvertimeHours.MustGet()
+	ratePerHour := payslip.OvertimeRatePerHour.GetOrDefault(payslip.BaseSalary.Div(int64(period.EndDate.Sub(period.StartDate).Hours()/24), money.RoundHalfUp))
+	multiplier := 1.0
+	if _, ok := payslip.OvertimePolicyVersion.Get(); ok && overtimeHours > 0 && ratePerHour.IsPositive() {
+		// Tiers can apply several multipliers within a period, show the effective one
+		multiplier = math.Round(payslip.OvertimePay.Float64()/(overtimeHours.Hours()*ratePerHour.Float64())*100) / 100
+	}
+
+	workedDuration := optional.NewString()
+	payslip.WorkedDuration.IfPresent(func(d time.Duration) {
+		workedDuration.Set(iso8601.ToString(d))
+	})
+
+	// Payslips generated before proration paid every employee for the whole
+	// period.
+	eligibleWorkingDays := payslip.EligibleWorkingDays.GetOrDefault(workingDays)
+	prorationFactor := 1.0
+	if workingDays > 0 {
+		prorationFactor = math.Round(float64(eligibleWorkingDays)/float64(workingDays)*10000) / 10000
+	}
+
+	return entity.PayslipData{
+		ID: payslip.ID,
+		User: entity.UserData{
+			ID:       user.ID,
+			Username: user.Username,
+		},
+		AttendancePeriod: entity.AttendancePeriodData{
+			StartDate: period.StartDate.Format(time.RFC3339),
+			EndDate:   period.EndDate.Format(time.RFC3339),
+		},
+		BaseSalary:          payslip.BaseSalary,
+		WorkingDays:         workingDays,
+		EligibleWorkingDays: eligibleWorkingDays,
+		ProrationFactor:     prorationFactor,
+		AttendanceDays:      payslip.AttendanceDays,
+		WorkedDuration:      workedDuration,
+		AttendancePay:       attendancePay,
+		SalarySegments:      salarySegments,
+		Overtime: entity.OvertimeData{
+			OvertimeHours: iso8601.ToString(overtimeHours),
+			RatePerHour:   ratePerHour,
+			Multiplier:    multiplier,
+			OvertimePay:   payslip.OvertimePay,
+			PolicyVersion: payslip.OvertimePolicyVersion,
+		},
+		Earnings:           earnings,
+		GrossPay:           payslip.GrossPay,
+		Reimbursements:     reimbursementData,
+		ReimbursementTotal: payslip.ReimbursementTotal,
+		Deductions:         deductions,
+		TotalDeductions:    payslip.TotalDeductions,
+		TotalTakeHome:      payslip.TotalTakeHome,
+	}
 }
@@ -2,6 +2,8 @@ package usecase_test
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -9,11 +11,22 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	adjustmentEntity "github.com/vnnyx/employee-management/internal/adjustment/entity"
+	mockAdjustment "github.com/vnnyx/employee-management/internal/adjustment/mock"
 	attEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
 	mockAtt "github.com/vnnyx/employee-management/internal/attendance/mock"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	bankAccountEntity "github.com/vnnyx/employee-management/internal/bankaccount/entity"
+	mockBankAccount "github.com/vnnyx/employee-management/internal/bankaccount/mock"
+	holidayEntity "github.com/vnnyx/employee-management/internal/holiday/entity"
+	mockHoliday "github.com/vnnyx/employee-management/internal/holiday/mock"
+	leaveEntity "github.com/vnnyx/employee-management/internal/leave/entity"
+	mockLeave "github.com/vnnyx/employee-management/internal/leave/mock"
+	loanEntity "github.com/vnnyx/employee-management/internal/loan/entity"
+	mockLoan "github.com/vnnyx/employee-management/internal/loan/mock"
 	overtimeEntity "github.com/vnnyx/employee-management/internal/overtime/entity"
 	mockOvertime "github.com/vnnyx/employee-management/internal/overtime/mock"
 	"github.com/vnnyx/employee-management/internal/payroll/entity"
@@ -21,16 +34,360 @@ import (
 	"github.com/vnnyx/employee-management/internal/payroll/usecase"
 	reimbursementEntity "github.com/vnnyx/employee-management/internal/reimbursement/entity"
 	mockReimbursement "github.com/vnnyx/employee-management/internal/reimbursement/mock"
+	salaryEntity "github.com/vnnyx/employee-management/internal/salary/entity"
+	mockSalary "github.com/vnnyx/employee-management/internal/salary/mock"
 	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
 	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
 	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 	"github.com/vnnyx/employee-management/pkg/testutil"
 	"go.uber.org/mock/gomock"
 )
 
+const testKey = "test-key-for-payslip-verification"
+
 func TestGeneratePayroll(t *testing.T) {
+	type mockParams struct {
+		payrollRepo       *mockPayroll.MockRepository
+		payrollRepoTx     *mockPayroll.MockRepository
+		userRepo          *mockUser.MockRepository
+		attRepo           *mockAtt.MockRepository
+		overTimeRepo      *mockOvertime.MockRepository
+		reimbursementRepo *mockReimbursement.MockRepository
+		holidayRepo       *mockHoliday.MockRepository
+		salaryRepo        *mockSalary.MockRepository
+		bankAccountRepo   *mockBankAccount.MockRepository
+		adjustmentRepo    *mockAdjustment.MockRepository
+		loanRepo          *mockLoan.MockRepository
+		leaveRepo         *mockLeave.MockRepository
+	}
+
+	type setupMockFunc func(mockParams)
+
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		periodID       string
+		payrollJob     entity.PayrollJob
+		expectedErr    error
+		setupMock      setupMockFunc
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - payroll job queued",
+			authCredential: adminCredential,
+			periodID:       "period-1",
+			payrollJob: entity.PayrollJob{
+				ID:        "job-1",
+				PeriodID:  "period-1",
+				Status:    entity.PayrollJobQueued,
+				RequestID: "req-123",
+				CreatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				CreatedBy: "admin-1",
+				UpdatedBy: "admin-1",
+				IPAddress: "127.0.0.1",
+			},
+			setupMock: func(m mockParams) {
+				m.attRepo.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(nil, nil)
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.payrollRepoTx.EXPECT().StoreNewPayrollJob(gomock.Any(), entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "period-1",
+					Status:    entity.PayrollJobQueued,
+					RequestID: "req-123",
+					CreatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					CreatedBy: "admin-1",
+					UpdatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "error - job already queued for the period",
+			authCredential: adminCredential,
+			periodID:       "period-1",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollJobAlreadyQueued,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobAlreadyQueued),
+					Received:  "period-1",
+				},
+			),
+			setupMock: func(m mockParams) {
+				m.attRepo.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(nil, nil)
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.payrollRepoTx.EXPECT().StoreNewPayrollJob(gomock.Any(), gomock.Any()).Return(&pgconn.PgError{
+					Code:           "23505",
+					ConstraintName: "payroll_jobs_period_id_active_key",
+				})
+			},
+		},
+		{
+			name:           "error - payroll already exists",
+			authCredential: adminCredential,
+			periodID:       "period-1",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyGenerated,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyGenerated),
+				},
+			),
+			setupMock: func(m mockParams) {
+				m.attRepo.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+				}, nil)
+			},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: adminCredential,
+			periodID:       "invalid-period",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  "invalid-period",
+				},
+			),
+			setupMock: func(m mockParams) {
+				m.attRepo.EXPECT().FindPeriodByID(gomock.Any(), "invalid-period").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			patches.ApplyFunc(uuid.NewString, func() string {
+				return "job-1"
+			})
+			patches.ApplyFunc(time.Now, func() time.Time {
+				return time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParams := mockParams{
+				payrollRepo:       mockPayroll.NewMockRepository(ctrl),
+				payrollRepoTx:     mockPayroll.NewMockRepository(ctrl),
+				userRepo:          mockUser.NewMockRepository(ctrl),
+				attRepo:           mockAtt.NewMockRepository(ctrl),
+				overTimeRepo:      mockOvertime.NewMockRepository(ctrl),
+				reimbursementRepo: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:         mockLeave.NewMockRepository(ctrl),
+			}
+			if tt.setupMock != nil {
+				tt.setupMock(mockParams)
+			}
+
+			useCase := usecase.NewPayrollUseCase(
+				mockParams.payrollRepo,
+				mockParams.userRepo,
+				mockParams.attRepo,
+				mockParams.overTimeRepo,
+				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.GeneratePayroll(context.Background(), tt.authCredential, tt.periodID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.payrollJob, result)
+			}
+		})
+	}
+}
+
+func TestShowPayrollJob(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		jobID          string
+		payrollJob     entity.PayrollJob
+		expectedErr    error
+		setupMock      func(payrollRepo *mockPayroll.MockRepository)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	succeededJob := entity.PayrollJob{
+		ID:            "job-1",
+		PeriodID:      "period-1",
+		Status:        entity.PayrollJobSucceeded,
+		Stage:         optional.NewString(string(entity.PayrollJobStoring)),
+		ProgressDone:  2,
+		ProgressTotal: 2,
+		Attempts:      1,
+		PayrollID:     optional.NewString("payroll-1"),
+		Result: &entity.GeneratedPayroll{
+			PeriodID:      "period-1",
+			PayrollID:     "payroll-1",
+			Version:       1,
+			TotalGrossPay: money.New(2000),
+			TotalTakeHome: money.New(2000),
+			TotalEmployee: 2,
+			TotalPayslip:  2,
+			GeneratedBy:   "admin-1",
+			GeneratedAt:   "2023-10-01T00:00:00Z",
+		},
+		CreatedBy: "admin-1",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - show payroll job",
+			authCredential: adminCredential,
+			jobID:          "job-1",
+			payrollJob:     succeededJob,
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayrollJobByID(gomock.Any(), "job-1").Return(&succeededJob, nil)
+			},
+		},
+		{
+			name:           "error - payroll job not found",
+			authCredential: adminCredential,
+			jobID:          "job-2",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollJobNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollJobNotFound),
+					Received:  "job-2",
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayrollJobByID(gomock.Any(), "job-2").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			jobID: "job-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.ShowPayrollJob(context.Background(), tt.authCredential, tt.jobID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.payrollJob, result)
+			}
+		})
+	}
+}
+
+func TestProcessNextPayrollJob(t *testing.T) {
 	type mockParams struct {
 		payrollRepo         *mockPayroll.MockRepository
 		payrollRepoTx       *mockPayroll.MockRepository
@@ -42,47 +399,58 @@ func TestGeneratePayroll(t *testing.T) {
 		overTimeRepoTx      *mockOvertime.MockRepository
 		reimbursementRepo   *mockReimbursement.MockRepository
 		reimbursementRepoTx *mockReimbursement.MockRepository
+		holidayRepo         *mockHoliday.MockRepository
+		holidayRepoTx       *mockHoliday.MockRepository
+		salaryRepo          *mockSalary.MockRepository
+		salaryRepoTx        *mockSalary.MockRepository
+		bankAccountRepo     *mockBankAccount.MockRepository
+		adjustmentRepo      *mockAdjustment.MockRepository
+		adjustmentRepoTx    *mockAdjustment.MockRepository
+		loanRepo            *mockLoan.MockRepository
+		loanRepoTx          *mockLoan.MockRepository
+		leaveRepo           *mockLeave.MockRepository
+		leaveRepoTx         *mockLeave.MockRepository
 	}
 
 	type setupMockFunc func(mockParams)
 
 	type testCase struct {
-		name             string
-		authCredential   authCredential.Credential
-		periodID         string
-		generatedPayroll entity.GeneratedPayroll
-		expectedErr      error
-		setupMock        setupMockFunc
-		patched          func()
+		name        string
+		processed   bool
+		expectedErr error
+		setupMock   setupMockFunc
+		patched     func()
 	}
 
 	tests := []testCase{
 		{
-			name: "success - payroll generated",
-			authCredential: authCredential.Credential{
-				UserID:    "admin-1",
-				IPAddress: "127.0.0.1",
-				Username:  "admin",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-				RequestID: "req-123",
-			},
-			periodID: "period-1",
-			generatedPayroll: entity.GeneratedPayroll{
-				PeriodID:      "period-1",
-				PayrollID:     "payroll-1",
-				TotalTakeHome: 199,
-				TotalEmployee: 1,
-				TotalPayslip:  1,
-				GeneratedBy:   "admin-1",
-				GeneratedAt:   "2023-10-01T00:00:00Z",
-			},
-			expectedErr: nil,
+			name:      "success - payroll generated",
+			processed: true,
 			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), entity.ClaimPayrollJob{
+					ClaimedAt:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					StaleBefore: time.Date(2023, 9, 30, 23, 59, 0, 0, time.UTC),
+				}).Return(&entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "period-1",
+					Status:    entity.PayrollJobRunning,
+					Attempts:  1,
+					RequestID: "req-123",
+					CreatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}, nil)
+				m.payrollRepo.EXPECT().UpdatePayrollJobProgress(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
 				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
 				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
 				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
 				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
 
 				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
 					PessimisticLock: true,
@@ -102,19 +470,23 @@ func TestGeneratePayroll(t *testing.T) {
 				}).Return(userEntity.FindUserResult{
 					List: []userEntity.User{
 						{
-							ID:       "user-1",
-							Username: "testuser",
-							IsAdmin:  false,
-							Salary:   1000.00,
+							ID:         "user-1",
+							Username:   "testuser",
+							IsAdmin:    false,
+							Salary:     money.New(1000),
+							Department: optional.NewString("Engineering"),
+							CostCentre: optional.NewString("CC-100"),
 						},
 					},
 					Mapped: map[any][]userEntity.User{
 						"user-1": {
 							{
-								ID:       "user-1",
-								Username: "testuser",
-								IsAdmin:  false,
-								Salary:   1000.00,
+								ID:         "user-1",
+								Username:   "testuser",
+								IsAdmin:    false,
+								Salary:     money.New(1000),
+								Department: optional.NewString("Engineering"),
+								CostCentre: optional.NewString("CC-100"),
 							},
 						},
 					},
@@ -188,6 +560,90 @@ func TestGeneratePayroll(t *testing.T) {
 					MappedBy: overtimeEntity.MappedByUserID,
 				}, nil)
 
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             3,
+					WeekdayMultiplier:   1.5,
+					WeekendMultiplier:   2,
+					StandardHoursPerDay: 8,
+					Tiers: []overtimeEntity.OvertimeTier{
+						{AfterHours: 2, Multiplier: 2},
+					},
+				}, nil)
+
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return([]entity.DeductionRule{
+					{
+						Code: "income_tax",
+						Name: "Income Tax",
+						Kind: entity.DeductionKindProgressiveTax,
+						Brackets: []entity.TaxBracket{
+							{UpTo: money.New(50), Rate: 0.05},
+							{UpTo: money.New(0), Rate: 0.1},
+						},
+					},
+					{
+						Code:      "social_security",
+						Name:      "Social Security",
+						Kind:      entity.DeductionKindPercentage,
+						Rate:      0.1,
+						CapAmount: money.New(40),
+					},
+					{
+						Code:   "union_fee",
+						Name:   "Union Fee",
+						Kind:   entity.DeductionKindFixed,
+						Amount: money.New(5),
+					},
+				}, nil)
+
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)).Return([]holidayEntity.Holiday{
+					{
+						ID:          "holiday-1",
+						HolidayDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+						Name:        "Company Day",
+					},
+				}, nil)
+
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC), salaryEntity.FindSalaryHistoryOptions{
+					PessimisticLock: true,
+					MappedOptions: &salaryEntity.MappedOptions{
+						MappedBy: salaryEntity.MappedByUserID,
+					},
+				}).Return(salaryEntity.FindSalaryHistoryResult{
+					Mapped: map[any][]salaryEntity.SalaryHistory{
+						"user-1": {
+							{
+								ID:            "salary-1",
+								UserID:        "user-1",
+								Salary:        money.New(1000),
+								EffectiveFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+							},
+						},
+					},
+					IsMapped: true,
+					MappedBy: salaryEntity.MappedByUserID,
+				}, nil)
+
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC), adjustmentEntity.FindAllowanceOptions{
+					PessimisticLock: true,
+					MappedOptions: &adjustmentEntity.MappedOptions{
+						MappedBy: adjustmentEntity.MappedByUserID,
+					},
+				}).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", adjustmentEntity.FindAdjustmentOptions{
+					PessimisticLock: true,
+					MappedOptions: &adjustmentEntity.MappedOptions{
+						MappedBy: adjustmentEntity.MappedByUserID,
+					},
+				}).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC), loanEntity.FindLoanOptions{
+					PessimisticLock: true,
+					MappedOptions: &loanEntity.MappedOptions{
+						MappedBy: loanEntity.MappedByUserID,
+					},
+				}).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
 				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC), reimbursementEntity.FindReimbursementOptions{
 					PessimisticLock: true,
 					MappedOptions: &reimbursementEntity.MappedOptions{
@@ -198,14 +654,14 @@ func TestGeneratePayroll(t *testing.T) {
 						{
 							ID:                "reimbursement-1",
 							UserID:            "user-1",
-							Amount:            100.00,
+							Amount:            money.New(100),
 							Description:       optional.NewString("Travel Expenses"),
 							ReimbursementDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 						},
 						{
 							ID:                "reimbursement-2",
 							UserID:            "user-1",
-							Amount:            50.00,
+							Amount:            money.New(50),
 							Description:       optional.NewString("Meal Expenses"),
 							ReimbursementDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
 						},
@@ -215,14 +671,14 @@ func TestGeneratePayroll(t *testing.T) {
 							{
 								ID:                "reimbursement-1",
 								UserID:            "user-1",
-								Amount:            100.00,
+								Amount:            money.New(100),
 								Description:       optional.NewString("Travel Expenses"),
 								ReimbursementDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 							},
 							{
 								ID:                "reimbursement-2",
 								UserID:            "user-1",
-								Amount:            50.00,
+								Amount:            money.New(50),
 								Description:       optional.NewString("Meal Expenses"),
 								ReimbursementDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
 							},
@@ -236,21 +692,48 @@ func TestGeneratePayroll(t *testing.T) {
 					return testutil.EqualVerbose(
 						[]entity.Payslip{
 							{
-								ID:                 "payslip-1",
-								UserID:             "user-1",
-								PayrollID:          "payroll-1",
-								BaseSalary:         1000.00,
-								AttendanceDays:     1,
-								OvertimeHours:      optional.NewDuration(5 * time.Hour),
-								ReimbursementTotal: 150.00,
+								ID:                    "payslip-1",
+								UserID:                "user-1",
+								PayrollID:             "payroll-1",
+								BaseSalary:            money.New(1000),
+								WorkingDays:           optional.NewInt64(21),
+								EligibleWorkingDays:   optional.NewInt64(21),
+								AttendanceDays:        1,
+								WorkedDuration:        optional.NewDuration(0),
+								OvertimeHours:         optional.NewDuration(5 * time.Hour),
+								OvertimePay:           money.MustParse("59.50"),
+								OvertimePolicyID:      optional.NewString("policy-1"),
+								OvertimePolicyVersion: optional.NewInt64(3),
+								OvertimeRatePerHour:   optional.NewMoney(money.MustParse("5.95")),
+								GrossPay:              money.MustParse("107.12"),
+								ReimbursementTotal:    money.New(150),
+								TotalDeductions:       money.MustParse("17.21"),
+								TotalTakeHome:         money.MustParse("239.91"),
+								Department:            optional.NewString("Engineering"),
+								CostCentre:            optional.NewString("CC-100"),
+								Items: []entity.PayslipItem{
+									{ItemType: entity.PayslipItemTypeDeduction, Code: "income_tax", Name: "Income Tax", Amount: money.MustParse("8.21"), SortOrder: 1},
+									{ItemType: entity.PayslipItemTypeDeduction, Code: "social_security", Name: "Social Security", Amount: money.New(4), SortOrder: 2},
+									{ItemType: entity.PayslipItemTypeDeduction, Code: "union_fee", Name: "Union Fee", Amount: money.New(5), SortOrder: 3},
+								},
+								SalarySegments: []entity.PayslipSalarySegment{
+									{
+										StartDate:           time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+										EndDate:             time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+										Salary:              money.New(1000),
+										WorkingDays:         21,
+										AttendanceDays:      1,
+										AttendancePay:       money.MustParse("47.62"),
+										OvertimeRatePerHour: money.MustParse("5.95"),
+										SortOrder:           1,
+									},
+								},
 							},
 						},
 						args,
 						cmpopts.IgnoreFields(entity.Payslip{},
 							"ID",
 							"PayrollID",
-							"OvertimePay",
-							"TotalTakeHome",
 							"CreatedAt",
 							"UpdatedAt",
 							"CreatedBy",
@@ -260,34 +743,82 @@ func TestGeneratePayroll(t *testing.T) {
 					)
 				}))
 
-				m.payrollRepoTx.EXPECT().StoreNewPayroll(gomock.Any(), mock.MatchedBy(func(args entity.Payroll) bool {
+				m.payrollRepoTx.EXPECT().StoreNewPayslipItems(gomock.Any(), mock.MatchedBy(func(args []entity.PayslipItem) bool {
 					return testutil.EqualVerbose(
-						entity.Payroll{
-							ID:       "payroll-1",
-							PeriodID: "period-1",
-							RunBy:    "admin-1",
-							RunAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+						[]entity.PayslipItem{
+							{PayslipID: "payroll-1", ItemType: entity.PayslipItemTypeDeduction, Code: "income_tax", Name: "Income Tax", Amount: money.MustParse("8.21"), SortOrder: 1, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1"},
+							{PayslipID: "payroll-1", ItemType: entity.PayslipItemTypeDeduction, Code: "social_security", Name: "Social Security", Amount: money.New(4), SortOrder: 2, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1"},
+							{PayslipID: "payroll-1", ItemType: entity.PayslipItemTypeDeduction, Code: "union_fee", Name: "Union Fee", Amount: money.New(5), SortOrder: 3, CreatedBy: "admin-1", UpdatedBy: "admin-1", IPAddress: "127.0.0.1"},
 						},
 						args,
-						cmpopts.IgnoreFields(entity.Payroll{},
+						cmpopts.IgnoreFields(entity.PayslipItem{},
 							"ID",
 							"CreatedAt",
 							"UpdatedAt",
-							"CreatedBy",
-							"UpdatedBy",
-							"IPAddress",
 						),
 					)
 				}))
 
-				m.payrollRepoTx.EXPECT().StoreNewPayrollSummary(gomock.Any(), mock.MatchedBy(func(args entity.PayrollSummary) bool {
+				m.payrollRepoTx.EXPECT().StoreNewPayslipSalarySegments(gomock.Any(), mock.MatchedBy(func(args []entity.PayslipSalarySegment) bool {
+					return testutil.EqualVerbose(
+						[]entity.PayslipSalarySegment{
+							{
+								PayslipID:           "payroll-1",
+								StartDate:           time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+								EndDate:             time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+								Salary:              money.New(1000),
+								WorkingDays:         21,
+								AttendanceDays:      1,
+								AttendancePay:       money.MustParse("47.62"),
+								OvertimeRatePerHour: money.MustParse("5.95"),
+								SortOrder:           1,
+								CreatedBy:           "admin-1",
+								UpdatedBy:           "admin-1",
+								IPAddress:           "127.0.0.1",
+							},
+						},
+						args,
+						cmpopts.IgnoreFields(entity.PayslipSalarySegment{},
+							"ID",
+							"CreatedAt",
+							"UpdatedAt",
+						),
+					)
+				}))
+
+				m.payrollRepoTx.EXPECT().StoreNewPayroll(gomock.Any(), mock.MatchedBy(func(args entity.Payroll) bool {
+					return testutil.EqualVerbose(
+						entity.Payroll{
+							ID:       "payroll-1",
+							PeriodID: "period-1",
+							Version:  1,
+							Status:   entity.PayrollStatusDraft,
+							RunBy:    "admin-1",
+							RunAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Payroll{},
+							"ID",
+							"CreatedAt",
+							"UpdatedAt",
+							"CreatedBy",
+							"UpdatedBy",
+							"IPAddress",
+						),
+					)
+				}))
+
+				m.payrollRepoTx.EXPECT().StoreNewPayrollSummary(gomock.Any(), mock.MatchedBy(func(args entity.PayrollSummary) bool {
 					return testutil.EqualVerbose(
 						entity.PayrollSummary{
-							ID:            "payroll-summary-1",
-							PayrollID:     "payroll-1",
-							TotalTakeHome: 199,
-							GeneratedBy:   "admin-1",
-							GeneratedAt:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+							ID:                 "payroll-summary-1",
+							PayrollID:          "payroll-1",
+							TotalGrossPay:      money.MustParse("107.12"),
+							TotalReimbursement: money.New(150),
+							TotalDeductions:    money.MustParse("17.21"),
+							TotalTakeHome:      money.MustParse("239.91"),
+							GeneratedBy:        "admin-1",
+							GeneratedAt:        time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 						},
 						args,
 						cmpopts.IgnoreFields(entity.PayrollSummary{},
@@ -300,39 +831,65 @@ func TestGeneratePayroll(t *testing.T) {
 						),
 					)
 				}))
+
+				// 21 working days: 47.62 attendance pay, Sunday 2h and the Monday
+				// holiday 3h all at 2x of 5.95. Gross 107.12 less 8.21 tax, 4 social
+				// security and 5 union fee plus the untaxed reimbursements
+				m.payrollRepoTx.EXPECT().FinishPayrollJob(gomock.Any(), entity.FinishPayrollJob{
+					JobID:     "job-1",
+					Status:    entity.PayrollJobSucceeded,
+					PayrollID: optional.NewString("payroll-1"),
+					Result: &entity.GeneratedPayroll{
+						PeriodID:           "period-1",
+						PayrollID:          "payroll-1",
+						Version:            1,
+						Status:             entity.PayrollStatusDraft,
+						TotalGrossPay:      money.MustParse("107.12"),
+						TotalReimbursement: money.New(150),
+						TotalDeductions:    money.MustParse("17.21"),
+						TotalTakeHome:      money.MustParse("239.91"),
+						TotalEmployee:      1,
+						TotalPayslip:       1,
+						GeneratedBy:        "admin-1",
+						GeneratedAt:        "2023-10-01T00:00:00Z",
+					},
+					FinishedAt: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(nil)
 			},
 			patched: func() {
 				gomonkey.ApplyFunc(uuid.NewString, func() string {
 					return "payroll-1"
 				})
-				gomonkey.ApplyFunc(time.Now, func() time.Time {
-					return time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
-				},
-				)
 			},
 		},
 		{
-			name: "error - payroll already exists",
-			authCredential: authCredential.Credential{
-				UserID:    "admin-1",
-				IPAddress: "127.0.0.1",
-				Username:  "admin",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-				RequestID: "req-123",
-			},
-			periodID: "period-1",
-			expectedErr: apperror.BadRequest(
-				apperror.AppError{
-					IssueCode: entity.PayrollAlreadyGenerated,
-					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyGenerated),
-				},
-			),
+			name:      "error - payroll already exists",
+			processed: true,
 			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), entity.ClaimPayrollJob{
+					ClaimedAt:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					StaleBefore: time.Date(2023, 9, 30, 23, 59, 0, 0, time.UTC),
+				}).Return(&entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "period-1",
+					Status:    entity.PayrollJobRunning,
+					Attempts:  1,
+					RequestID: "req-123",
+					CreatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}, nil)
+				m.payrollRepo.EXPECT().UpdatePayrollJobProgress(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
 				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
 				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
 				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
 				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
 
 				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
 					PessimisticLock: true,
@@ -342,57 +899,137 @@ func TestGeneratePayroll(t *testing.T) {
 					RunBy:    "admin-1",
 					RunAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
 				}, nil)
+
+				m.payrollRepo.EXPECT().FinishPayrollJob(gomock.Any(), entity.FinishPayrollJob{
+					JobID:         "job-1",
+					Status:        entity.PayrollJobFailed,
+					FailureCode:   optional.NewString(entity.PayrollAlreadyGenerated),
+					FailureReason: optional.NewString(entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyGenerated)),
+					FinishedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(nil)
 			},
 		},
 		{
-			name: "error - period not found",
-			authCredential: authCredential.Credential{
-				UserID:    "admin-1",
-				IPAddress: "127.0.0.1",
-				Username:  "admin",
-				IsAdmin:   func(b bool) *bool { return &b }(true),
-				RequestID: "req-123",
-			},
-			periodID: "invalid-period",
-			expectedErr: apperror.NotFound(
-				apperror.AppError{
-					IssueCode: entity.AttendancePeriodNotFound,
-					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
-					Received:  "invalid-period",
-				},
-			),
+			name:      "error - period not found",
+			processed: true,
 			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), entity.ClaimPayrollJob{
+					ClaimedAt:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					StaleBefore: time.Date(2023, 9, 30, 23, 59, 0, 0, time.UTC),
+				}).Return(&entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "invalid-period",
+					Status:    entity.PayrollJobRunning,
+					Attempts:  1,
+					RequestID: "req-123",
+					CreatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}, nil)
+				m.payrollRepo.EXPECT().UpdatePayrollJobProgress(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
 				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
 				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
 				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
 				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
 				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
 
 				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "invalid-period", entity.FindPayrollOptions{
 					PessimisticLock: true,
 				}).Return(nil, nil)
 				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "invalid-period").Return(nil, nil)
+
+				m.payrollRepo.EXPECT().FinishPayrollJob(gomock.Any(), entity.FinishPayrollJob{
+					JobID:         "job-1",
+					Status:        entity.PayrollJobFailed,
+					FailureCode:   optional.NewString(entity.AttendancePeriodNotFound),
+					FailureReason: optional.NewString(entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound)),
+					FinishedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(nil)
 			},
 		},
 		{
-			name: "error - user not admin",
-			authCredential: authCredential.Credential{
-				UserID:    "user-1",
-				IPAddress: "127.0.0.1",
-				Username:  "testuser",
-				IsAdmin:   func(b bool) *bool { return &b }(false),
-				RequestID: "req-123",
+			name:      "success - no job queued",
+			processed: false,
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+		},
+		{
+			name:      "success - job abandoned after too many attempts",
+			processed: true,
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), gomock.Any()).Return(&entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "period-1",
+					Status:    entity.PayrollJobRunning,
+					Attempts:  4,
+					RequestID: "req-123",
+					CreatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}, nil)
+
+				m.payrollRepo.EXPECT().FinishPayrollJob(gomock.Any(), entity.FinishPayrollJob{
+					JobID:         "job-1",
+					Status:        entity.PayrollJobFailed,
+					FailureCode:   optional.NewString(entity.PayrollJobAbandoned),
+					FailureReason: optional.NewString(entity.GetErrorMessageByIssueCode(entity.PayrollJobAbandoned)),
+					FinishedAt:    time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(nil)
+			},
+		},
+		{
+			name:      "success - unexpected error fails the job",
+			processed: true,
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), entity.ClaimPayrollJob{
+					ClaimedAt:   time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					StaleBefore: time.Date(2023, 9, 30, 23, 59, 0, 0, time.UTC),
+				}).Return(&entity.PayrollJob{
+					ID:        "job-1",
+					PeriodID:  "period-1",
+					Status:    entity.PayrollJobRunning,
+					Attempts:  1,
+					RequestID: "req-123",
+					CreatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}, nil)
+				m.payrollRepo.EXPECT().UpdatePayrollJobProgress(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(nil, errors.New("connection reset"))
+
+				m.payrollRepo.EXPECT().FinishPayrollJob(gomock.Any(), mock.MatchedBy(func(args entity.FinishPayrollJob) bool {
+					return args.JobID == "job-1" &&
+						args.Status == entity.PayrollJobFailed &&
+						args.FailureCode.GetOrDefault() == entity.PayrollJobUnexpected &&
+						strings.HasSuffix(args.FailureReason.GetOrDefault(), "connection reset")
+				})).Return(nil)
+			},
+		},
+		{
+			name:        "error - claim payroll job",
+			processed:   false,
+			expectedErr: errors.New("connection reset"),
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().ClaimPayrollJob(gomock.Any(), gomock.Any()).Return(nil, errors.New("connection reset"))
 			},
-			periodID:         "period-1",
-			generatedPayroll: entity.GeneratedPayroll{},
-			expectedErr: apperror.Forbidden(
-				apperror.AppError{
-					IssueCode: entity.PayrollNotAuthorized,
-					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
-				},
-			),
-			setupMock: func(m mockParams) {},
-			patched:   func() {},
 		},
 	}
 
@@ -406,6 +1043,9 @@ func TestGeneratePayroll(t *testing.T) {
 			) error {
 				return fn(nil)
 			})
+			patches.ApplyFunc(time.Now, func() time.Time {
+				return time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+			})
 			defer patches.Reset()
 
 			ctrl := gomock.NewController(t)
@@ -422,6 +1062,17 @@ func TestGeneratePayroll(t *testing.T) {
 				overTimeRepoTx:      mockOvertime.NewMockRepository(ctrl),
 				reimbursementRepo:   mockReimbursement.NewMockRepository(ctrl),
 				reimbursementRepoTx: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:         mockHoliday.NewMockRepository(ctrl),
+				holidayRepoTx:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:          mockSalary.NewMockRepository(ctrl),
+				salaryRepoTx:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:     mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:      mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:            mockLoan.NewMockRepository(ctrl),
+				adjustmentRepoTx:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepoTx:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:           mockLeave.NewMockRepository(ctrl),
+				leaveRepoTx:         mockLeave.NewMockRepository(ctrl),
 			}
 			if tt.setupMock != nil {
 				tt.setupMock(mockParams)
@@ -437,16 +1088,22 @@ func TestGeneratePayroll(t *testing.T) {
 				mockParams.attRepo,
 				mockParams.overTimeRepo,
 				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
 			)
-			result, err := useCase.GeneratePayroll(context.Background(), tt.authCredential, tt.periodID)
+			processed, err := useCase.ProcessNextPayrollJob(context.Background())
 
 			if tt.expectedErr != nil {
 				assert.ErrorContains(t, err, tt.expectedErr.Error())
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, result)
-				assert.Equal(t, tt.generatedPayroll.PeriodID, result.PeriodID)
 			}
+			assert.Equal(t, tt.processed, processed)
 		})
 	}
 }
@@ -458,6 +1115,12 @@ func TestShowPay(t *testing.T) {
 		attendanceRepo    *mockAtt.MockRepository
 		overtimeRepo      *mockOvertime.MockRepository
 		reimbursementRepo *mockReimbursement.MockRepository
+		holidayRepo       *mockHoliday.MockRepository
+		salaryRepo        *mockSalary.MockRepository
+		bankAccountRepo   *mockBankAccount.MockRepository
+		adjustmentRepo    *mockAdjustment.MockRepository
+		loanRepo          *mockLoan.MockRepository
+		leaveRepo         *mockLeave.MockRepository
 	}
 
 	type setupMockFunc func(mockParams)
@@ -487,12 +1150,13 @@ func TestShowPay(t *testing.T) {
 				ID:                 "payslip-1",
 				UserID:             "user-1",
 				PayrollID:          "payroll-1",
-				BaseSalary:         1000.00,
+				BaseSalary:         money.New(1000),
 				AttendanceDays:     20,
 				OvertimeHours:      optional.NewDuration(5 * time.Hour),
-				OvertimePay:        100.00,
-				ReimbursementTotal: 150.00,
-				TotalTakeHome:      1150.00,
+				OvertimePay:        money.New(100),
+				ReimbursementTotal: money.New(150),
+				TotalDeductions:    money.New(50),
+				TotalTakeHome:      money.New(1100),
 			},
 			expectedErr: nil,
 			setupMock: func(m mockParams) {
@@ -500,7 +1164,7 @@ func TestShowPay(t *testing.T) {
 					ID:       "user-1",
 					Username: "testuser",
 					IsAdmin:  false,
-					Salary:   1000.00,
+					Salary:   money.New(1000),
 				}, nil)
 
 				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(&attEntity.AttendancePeriod{
@@ -509,29 +1173,61 @@ func TestShowPay(t *testing.T) {
 					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
 				}, nil)
 
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+					Version:  1,
+					Status:   entity.PayrollStatusApproved,
+				}, nil)
+
 				m.payrollRepo.EXPECT().FindPayslipByUserIDPeriod(gomock.Any(), "user-1", "payroll-1").Return(&entity.Payslip{
 					ID:                 "payslip-1",
 					UserID:             "user-1",
 					PayrollID:          "payroll-1",
-					BaseSalary:         1000.00,
+					BaseSalary:         money.New(1000),
 					AttendanceDays:     20,
 					OvertimeHours:      optional.NewDuration(5 * time.Hour),
-					OvertimePay:        100.00,
-					ReimbursementTotal: 150.00,
-					TotalTakeHome:      1150.00,
+					OvertimePay:        money.New(100),
+					ReimbursementTotal: money.New(150),
+					TotalDeductions:    money.New(50),
+					TotalTakeHome:      money.New(1100),
+				}, nil)
+
+				m.payrollRepo.EXPECT().FindPayslipItemsByPayslipIDs(gomock.Any(), []string{"payslip-1"}).Return([]entity.PayslipItem{
+					{
+						PayslipID: "payslip-1",
+						ItemType:  entity.PayslipItemTypeDeduction,
+						Code:      "income_tax",
+						Name:      "Income Tax",
+						Amount:    money.New(50),
+					},
+				}, nil)
+
+				m.payrollRepo.EXPECT().FindPayslipSalarySegmentsByPayslipIDs(gomock.Any(), []string{"payslip-1"}).Return([]entity.PayslipSalarySegment{
+					{
+						PayslipID:           "payslip-1",
+						StartDate:           time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+						EndDate:             time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+						Salary:              money.New(1000),
+						WorkingDays:         22,
+						AttendanceDays:      20,
+						AttendancePay:       money.MustParse("909.09"),
+						OvertimeRatePerHour: money.MustParse("5.68"),
+						SortOrder:           1,
+					},
 				}, nil)
 
 				m.reimbursementRepo.EXPECT().FindReimbursementByUserIDPeriod(gomock.Any(), "user-1", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)).Return([]reimbursementEntity.Reimbursement{
 					{
 						ID:          "reimbursement-1",
 						UserID:      "user-1",
-						Amount:      150.00,
+						Amount:      money.New(150),
 						Description: optional.NewString("Travel Expenses"),
 					},
 					{
 						ID:          "reimbursement-2",
 						UserID:      "user-1",
-						Amount:      50.00,
+						Amount:      money.New(50),
 						Description: optional.NewString("Meal Expenses"),
 					},
 				}, nil)
@@ -583,7 +1279,7 @@ func TestShowPay(t *testing.T) {
 					ID:       "user-1",
 					Username: "testuser",
 					IsAdmin:  false,
-					Salary:   1000.00,
+					Salary:   money.New(1000),
 				}, nil)
 
 				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(nil, nil)
@@ -613,7 +1309,7 @@ func TestShowPay(t *testing.T) {
 					ID:       "user-1",
 					Username: "testuser",
 					IsAdmin:  false,
-					Salary:   1000.00,
+					Salary:   money.New(1000),
 				}, nil)
 
 				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(&attEntity.AttendancePeriod{
@@ -622,10 +1318,58 @@ func TestShowPay(t *testing.T) {
 					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
 				}, nil)
 
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+					Version:  1,
+					Status:   entity.PayrollStatusApproved,
+				}, nil)
+
 				m.payrollRepo.EXPECT().FindPayslipByUserIDPeriod(gomock.Any(), "user-1", "payroll-1").Return(nil, nil)
 			},
 			patched: func() {},
 		},
+		{
+			name: "error - payroll not approved yet",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID:       "payroll-1",
+			expectedPayslip: nil,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayslipNotAvailable,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayslipNotAvailable),
+					Received:  "payroll-1",
+				},
+			),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{
+					ID:       "user-1",
+					Username: "testuser",
+					IsAdmin:  false,
+					Salary:   money.New(1000),
+				}, nil)
+
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+					EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				}, nil)
+
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+					Version:  1,
+					Status:   entity.PayrollStatusSubmitted,
+				}, nil)
+			},
+			patched: func() {},
+		},
 	}
 
 	for _, tt := range tests {
@@ -639,6 +1383,12 @@ func TestShowPay(t *testing.T) {
 				attendanceRepo:    mockAtt.NewMockRepository(ctrl),
 				overtimeRepo:      mockOvertime.NewMockRepository(ctrl),
 				reimbursementRepo: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:         mockLeave.NewMockRepository(ctrl),
 			}
 
 			if tt.setupMock != nil {
@@ -655,6 +1405,13 @@ func TestShowPay(t *testing.T) {
 				mockParams.attendanceRepo,
 				mockParams.overtimeRepo,
 				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
 			)
 			payslip, err := useCase.ShowPayslip(context.Background(), tt.authCredential, tt.payrollID)
 			if tt.expectedErr != nil {
@@ -665,8 +1422,2973 @@ func TestShowPay(t *testing.T) {
 				assert.Equal(t, tt.expectedPayslip.ID, payslip.ID)
 				assert.Equal(t, tt.expectedPayslip.BaseSalary, payslip.BaseSalary)
 				assert.Equal(t, tt.expectedPayslip.AttendanceDays, payslip.AttendanceDays)
+				assert.Equal(t, money.MustParse("909.09"), payslip.AttendancePay)
+				assert.Len(t, payslip.SalarySegments, 1)
 				assert.Equal(t, tt.expectedPayslip.ReimbursementTotal, payslip.ReimbursementTotal)
+				assert.Equal(t, tt.expectedPayslip.TotalDeductions, payslip.TotalDeductions)
+				assert.Equal(t, []entity.PayslipItemData{{Code: "income_tax", Name: "Income Tax", Amount: money.New(50)}}, payslip.Deductions)
 				assert.Equal(t, tt.expectedPayslip.TotalTakeHome, payslip.TotalTakeHome)
+				assert.Equal(t, entity.NewVerificationID(testKey, *tt.expectedPayslip), payslip.VerificationID)
+			}
+		})
+	}
+}
+
+func TestPreviewPayroll(t *testing.T) {
+	type mockParams struct {
+		payrollRepo         *mockPayroll.MockRepository
+		payrollRepoTx       *mockPayroll.MockRepository
+		userRepo            *mockUser.MockRepository
+		userRepoTx          *mockUser.MockRepository
+		attRepo             *mockAtt.MockRepository
+		attRepoTx           *mockAtt.MockRepository
+		overTimeRepo        *mockOvertime.MockRepository
+		overTimeRepoTx      *mockOvertime.MockRepository
+		reimbursementRepo   *mockReimbursement.MockRepository
+		reimbursementRepoTx *mockReimbursement.MockRepository
+		holidayRepo         *mockHoliday.MockRepository
+		holidayRepoTx       *mockHoliday.MockRepository
+		salaryRepo          *mockSalary.MockRepository
+		salaryRepoTx        *mockSalary.MockRepository
+		bankAccountRepo     *mockBankAccount.MockRepository
+		adjustmentRepo      *mockAdjustment.MockRepository
+		adjustmentRepoTx    *mockAdjustment.MockRepository
+		loanRepo            *mockLoan.MockRepository
+		loanRepoTx          *mockLoan.MockRepository
+		leaveRepo           *mockLeave.MockRepository
+		leaveRepoTx         *mockLeave.MockRepository
+	}
+
+	type testCase struct {
+		name             string
+		authCredential   authCredential.Credential
+		periodID         string
+		expectedPreview  entity.PayrollPreview
+		expectedSegments []entity.SalarySegmentData
+		// expectedPayslip checks the working days and proration of the
+		// single payslip of a successful preview
+		expectedPayslip    entity.PayslipData
+		expectedEarnings   []entity.PayslipItemData
+		expectedDeductions []entity.PayslipItemData
+		// attendanceBasis also checks the attendance pay and worked duration
+		// of the payslip when it is set
+		attendanceBasis entity.AttendanceBasis
+		expectedErr     error
+		setupMock       func(mockParams)
+	}
+
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)
+	midDate := time.Date(2023, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []testCase{
+		{
+			name: "success - preview calculated without storing",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 45.45 attendance pay and 5h of overtime at 5.68
+				TotalGrossPay:      money.MustParse("73.85"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("223.85"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      1,
+			},
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{
+					MappedOptions: &userEntity.MappedOptions{
+						MappedBy: userEntity.MappedByUserID,
+					},
+				}).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				attendance := attEntity.Attendance{ID: "att-1", UserID: "user-1", AttendanceDate: startDate}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, attEntity.FindAttendanceOptions{
+					MappedOptions: &attEntity.MappedOptions{
+						MappedBy: attEntity.MappedByUserID,
+					},
+				}).Return(attEntity.FindAttendanceResult{
+					List:     []attEntity.Attendance{attendance},
+					Mapped:   map[any][]attEntity.Attendance{"user-1": {attendance}},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtime := overtimeEntity.Overtime{ID: "overtime-1", UserID: "user-1", OverTimeDate: startDate, OvertimeHours: 5 * time.Hour}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, overtimeEntity.FindOvertimeOptions{
+					MappedOptions: &overtimeEntity.MappedOptions{
+						MappedBy: overtimeEntity.MappedByUserID,
+					},
+				}).Return(overtimeEntity.FindOvertimeResult{
+					List:     []overtimeEntity.Overtime{overtime},
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": {overtime}},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					Description:       optional.NewString("Travel Expenses"),
+					ReimbursementDate: startDate,
+				}
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, reimbursementEntity.FindReimbursementOptions{
+					MappedOptions: &reimbursementEntity.MappedOptions{
+						MappedBy: reimbursementEntity.MappedByUserID,
+					},
+				}).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - paid for the clocked hours",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 12 of the 176 scheduled hours paid 68.18 and 5h of
+				// overtime at 5.68
+				TotalGrossPay:      money.MustParse("96.58"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("246.58"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      2,
+				AttendancePay:       money.MustParse("68.18"),
+				WorkedDuration:      optional.NewString("PT14H"),
+			},
+			attendanceBasis: entity.AttendanceBasisHours,
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{
+					MappedOptions: &userEntity.MappedOptions{
+						MappedBy: userEntity.MappedByUserID,
+					},
+				}).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				// 4h worked, then 10h worked of which only the 8 scheduled
+				// hours are paid
+				attendances := []attEntity.Attendance{
+					{
+						ID:             "att-1",
+						UserID:         "user-1",
+						AttendanceDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+						ClockInAt:      optional.NewTime(time.Date(2023, 10, 2, 9, 0, 0, 0, time.UTC)),
+						ClockOutAt:     optional.NewTime(time.Date(2023, 10, 2, 13, 0, 0, 0, time.UTC)),
+					},
+					{
+						ID:             "att-2",
+						UserID:         "user-1",
+						AttendanceDate: time.Date(2023, 10, 3, 0, 0, 0, 0, time.UTC),
+						ClockInAt:      optional.NewTime(time.Date(2023, 10, 3, 8, 0, 0, 0, time.UTC)),
+						ClockOutAt:     optional.NewTime(time.Date(2023, 10, 3, 18, 0, 0, 0, time.UTC)),
+					},
+				}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, attEntity.FindAttendanceOptions{
+					MappedOptions: &attEntity.MappedOptions{
+						MappedBy: attEntity.MappedByUserID,
+					},
+				}).Return(attEntity.FindAttendanceResult{
+					List:     attendances,
+					Mapped:   map[any][]attEntity.Attendance{"user-1": attendances},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtime := overtimeEntity.Overtime{ID: "overtime-1", UserID: "user-1", OverTimeDate: startDate, OvertimeHours: 5 * time.Hour}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, overtimeEntity.FindOvertimeOptions{
+					MappedOptions: &overtimeEntity.MappedOptions{
+						MappedBy: overtimeEntity.MappedByUserID,
+					},
+				}).Return(overtimeEntity.FindOvertimeResult{
+					List:     []overtimeEntity.Overtime{overtime},
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": {overtime}},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					Description:       optional.NewString("Travel Expenses"),
+					ReimbursementDate: startDate,
+				}
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, reimbursementEntity.FindReimbursementOptions{
+					MappedOptions: &reimbursementEntity.MappedOptions{
+						MappedBy: reimbursementEntity.MappedByUserID,
+					},
+				}).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - approved paid leave counted as attended",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 4h worked and two days of paid leave, 20 of the 176
+				// scheduled hours paid 113.64, and 5h of overtime at 5.68
+				TotalGrossPay:      money.MustParse("142.04"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("292.04"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      3,
+				AttendancePay:       money.MustParse("113.64"),
+				WorkedDuration:      optional.NewString("PT4H"),
+			},
+			attendanceBasis: entity.AttendanceBasisHours,
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{
+					MappedOptions: &userEntity.MappedOptions{
+						MappedBy: userEntity.MappedByUserID,
+					},
+				}).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				attendances := []attEntity.Attendance{
+					{
+						ID:             "att-1",
+						UserID:         "user-1",
+						AttendanceDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+						ClockInAt:      optional.NewTime(time.Date(2023, 10, 2, 9, 0, 0, 0, time.UTC)),
+						ClockOutAt:     optional.NewTime(time.Date(2023, 10, 2, 13, 0, 0, 0, time.UTC)),
+					},
+				}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, attEntity.FindAttendanceOptions{
+					MappedOptions: &attEntity.MappedOptions{
+						MappedBy: attEntity.MappedByUserID,
+					},
+				}).Return(attEntity.FindAttendanceResult{
+					List:     attendances,
+					Mapped:   map[any][]attEntity.Attendance{"user-1": attendances},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtime := overtimeEntity.Overtime{ID: "overtime-1", UserID: "user-1", OverTimeDate: startDate, OvertimeHours: 5 * time.Hour}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, overtimeEntity.FindOvertimeOptions{
+					MappedOptions: &overtimeEntity.MappedOptions{
+						MappedBy: overtimeEntity.MappedByUserID,
+					},
+				}).Return(overtimeEntity.FindOvertimeResult{
+					List:     []overtimeEntity.Overtime{overtime},
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": {overtime}},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					Description:       optional.NewString("Travel Expenses"),
+					ReimbursementDate: startDate,
+				}
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+
+				// The attended 2nd is not paid twice, the weekend of the 7th
+				// and unpaid leave are not paid at all
+				leaves := []leaveEntity.LeaveRequest{
+					{
+						ID:        "leave-1",
+						UserID:    "user-1",
+						Paid:      true,
+						StartDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2023, 10, 4, 0, 0, 0, 0, time.UTC),
+						Days:      3,
+						Status:    leaveEntity.LeaveRequestStatusApproved,
+					},
+					{
+						ID:        "leave-2",
+						UserID:    "user-1",
+						Paid:      false,
+						StartDate: time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC),
+						EndDate:   time.Date(2023, 10, 8, 0, 0, 0, 0, time.UTC),
+						Days:      2,
+						Status:    leaveEntity.LeaveRequestStatusApproved,
+					},
+				}
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), startDate, endDate, leaveEntity.FindLeaveRequestOptions{
+					MappedOptions: &leaveEntity.MappedOptions{
+						MappedBy: leaveEntity.MappedByUserID,
+					},
+				}).Return(leaveEntity.FindLeaveRequestResult{
+					List:     leaves,
+					Mapped:   map[any][]leaveEntity.LeaveRequest{"user-1": leaves},
+					IsMapped: true,
+					MappedBy: leaveEntity.MappedByUserID,
+				}, nil)
+
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, reimbursementEntity.FindReimbursementOptions{
+					MappedOptions: &reimbursementEntity.MappedOptions{
+						MappedBy: reimbursementEntity.MappedByUserID,
+					},
+				}).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - salary change prorated within the period",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 22 working days, one attended day and one overtime hour on
+				// each side of the raise: 45.45 + 5.68 at 1000, 95.45 + 11.93 at 2100
+				TotalGrossPay:      money.MustParse("158.51"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("308.51"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      2,
+			},
+			expectedSegments: []entity.SalarySegmentData{
+				{
+					StartDate:           "2023-10-01T00:00:00Z",
+					EndDate:             "2023-10-15T00:00:00Z",
+					Salary:              money.New(1000),
+					WorkingDays:         10,
+					AttendanceDays:      1,
+					AttendancePay:       money.MustParse("45.45"),
+					OvertimeRatePerHour: money.MustParse("5.68"),
+				},
+				{
+					StartDate:           "2023-10-16T00:00:00Z",
+					EndDate:             "2023-10-31T00:00:00Z",
+					Salary:              money.New(2100),
+					WorkingDays:         12,
+					AttendanceDays:      1,
+					AttendancePay:       money.MustParse("95.45"),
+					OvertimeRatePerHour: money.MustParse("11.93"),
+				},
+			},
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				// The current salary is already the raised one, the history decides
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(2100)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				attendances := []attEntity.Attendance{
+					{ID: "att-1", UserID: "user-1", AttendanceDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)},
+					{ID: "att-2", UserID: "user-1", AttendanceDate: midDate},
+				}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(attEntity.FindAttendanceResult{
+					List:     attendances,
+					Mapped:   map[any][]attEntity.Attendance{"user-1": attendances},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtimes := []overtimeEntity.Overtime{
+					{ID: "overtime-1", UserID: "user-1", OverTimeDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), OvertimeHours: time.Hour},
+					{ID: "overtime-2", UserID: "user-1", OverTimeDate: midDate, OvertimeHours: time.Hour},
+				}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(overtimeEntity.FindOvertimeResult{
+					List:     overtimes,
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": overtimes},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+
+				salaryHistory := []salaryEntity.SalaryHistory{
+					{ID: "salary-1", UserID: "user-1", Salary: money.New(1000), EffectiveFrom: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{ID: "salary-2", UserID: "user-1", Salary: money.New(2100), EffectiveFrom: midDate},
+				}
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{
+					List:     salaryHistory,
+					Mapped:   map[any][]salaryEntity.SalaryHistory{"user-1": salaryHistory},
+					IsMapped: true,
+					MappedBy: salaryEntity.MappedByUserID,
+				}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					ReimbursementDate: startDate,
+				}
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - joiner prorated and leaver excluded",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// Employed for 12 of the 22 working days, one attended day and
+				// one overtime hour at 2200: 100.00 + 12.50
+				TotalGrossPay:      money.MustParse("112.50"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("262.50"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 12,
+				ProrationFactor:     0.5455,
+				AttendanceDays:      1,
+			},
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				joiner := userEntity.User{ID: "user-1", Username: "joiner", Salary: money.New(2200), EmploymentStartDate: midDate}
+				leaver := userEntity.User{
+					ID:                  "user-2",
+					Username:            "leaver",
+					Salary:              money.New(1000),
+					EmploymentStartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					EmploymentEndDate:   optional.NewTime(time.Date(2023, 9, 30, 0, 0, 0, 0, time.UTC)),
+				}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{joiner, leaver},
+					Mapped:   map[any][]userEntity.User{"user-1": {joiner}, "user-2": {leaver}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				// Days recorded before the joining date are not paid
+				attendances := []attEntity.Attendance{
+					{ID: "att-1", UserID: "user-1", AttendanceDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC)},
+					{ID: "att-2", UserID: "user-1", AttendanceDate: midDate},
+				}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(attEntity.FindAttendanceResult{
+					List:     attendances,
+					Mapped:   map[any][]attEntity.Attendance{"user-1": attendances},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtimes := []overtimeEntity.Overtime{
+					{ID: "overtime-1", UserID: "user-1", OverTimeDate: time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC), OvertimeHours: time.Hour},
+					{ID: "overtime-2", UserID: "user-1", OverTimeDate: midDate, OvertimeHours: time.Hour},
+				}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(overtimeEntity.FindOvertimeResult{
+					List:     overtimes,
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": overtimes},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					ReimbursementDate: midDate,
+				}
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - allowance and adjustment written as line items",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 45.45 attendance pay, 5h of overtime at 5.68 and the taxable
+				// transport allowance, the non-taxable bonus only reaches take home
+				TotalGrossPay:      money.MustParse("173.85"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.MustParse("373.85"),
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      1,
+			},
+			expectedEarnings: []entity.PayslipItemData{
+				{Code: "transport", Name: "Transport Allowance", Amount: money.New(100), Taxable: true},
+				{Code: "bonus", Name: "Project Bonus", Amount: money.New(50)},
+			},
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				attendance := attEntity.Attendance{ID: "att-1", UserID: "user-1", AttendanceDate: startDate}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(attEntity.FindAttendanceResult{
+					List:     []attEntity.Attendance{attendance},
+					Mapped:   map[any][]attEntity.Attendance{"user-1": {attendance}},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtime := overtimeEntity.Overtime{ID: "overtime-1", UserID: "user-1", OverTimeDate: startDate, OvertimeHours: 5 * time.Hour}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(overtimeEntity.FindOvertimeResult{
+					List:     []overtimeEntity.Overtime{overtime},
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": {overtime}},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+
+				allowance := adjustmentEntity.Allowance{
+					ID:        "allowance-1",
+					UserID:    "user-1",
+					Code:      "transport",
+					Name:      "Transport Allowance",
+					Amount:    money.New(100),
+					Taxable:   true,
+					StartDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				}
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, adjustmentEntity.FindAllowanceOptions{
+					MappedOptions: &adjustmentEntity.MappedOptions{
+						MappedBy: adjustmentEntity.MappedByUserID,
+					},
+				}).Return(adjustmentEntity.FindAllowanceResult{
+					List:     []adjustmentEntity.Allowance{allowance},
+					Mapped:   map[any][]adjustmentEntity.Allowance{"user-1": {allowance}},
+					IsMapped: true,
+					MappedBy: adjustmentEntity.MappedByUserID,
+				}, nil)
+
+				adjustment := adjustmentEntity.Adjustment{
+					ID:       "adjustment-1",
+					UserID:   "user-1",
+					PeriodID: "period-1",
+					Code:     "bonus",
+					Name:     "Project Bonus",
+					Amount:   money.New(50),
+				}
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", adjustmentEntity.FindAdjustmentOptions{
+					MappedOptions: &adjustmentEntity.MappedOptions{
+						MappedBy: adjustmentEntity.MappedByUserID,
+					},
+				}).Return(adjustmentEntity.FindAdjustmentResult{
+					List:     []adjustmentEntity.Adjustment{adjustment},
+					Mapped:   map[any][]adjustmentEntity.Adjustment{"user-1": {adjustment}},
+					IsMapped: true,
+					MappedBy: adjustmentEntity.MappedByUserID,
+				}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					ReimbursementDate: startDate,
+				}
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "success - loan installments capped by net pay",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedPreview: entity.PayrollPreview{
+				PeriodID: "period-1",
+				// 223.85 of net pay covers the first installment in full and
+				// only 23.85 of the second one, take home never goes negative
+				TotalGrossPay:      money.MustParse("73.85"),
+				TotalReimbursement: money.New(150),
+				TotalTakeHome:      money.Money{},
+				TotalEmployee:      1,
+				TotalPayslip:       1,
+			},
+			expectedPayslip: entity.PayslipData{
+				WorkingDays:         22,
+				EligibleWorkingDays: 22,
+				ProrationFactor:     1,
+				AttendanceDays:      1,
+			},
+			expectedDeductions: []entity.PayslipItemData{
+				{Code: "loan_repayment", Name: "Loan Repayment", Amount: money.New(200)},
+				{Code: "loan_repayment", Name: "Loan Repayment", Amount: money.MustParse("23.85")},
+			},
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+
+				attendance := attEntity.Attendance{ID: "att-1", UserID: "user-1", AttendanceDate: startDate}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(attEntity.FindAttendanceResult{
+					List:     []attEntity.Attendance{attendance},
+					Mapped:   map[any][]attEntity.Attendance{"user-1": {attendance}},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+
+				overtime := overtimeEntity.Overtime{ID: "overtime-1", UserID: "user-1", OverTimeDate: startDate, OvertimeHours: 5 * time.Hour}
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(overtimeEntity.FindOvertimeResult{
+					List:     []overtimeEntity.Overtime{overtime},
+					Mapped:   map[any][]overtimeEntity.Overtime{"user-1": {overtime}},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+
+				loans := []loanEntity.Loan{
+					{ID: "loan-1", UserID: "user-1", Principal: money.New(1000), InstallmentAmount: money.New(200)},
+					{ID: "loan-2", UserID: "user-1", Principal: money.New(500), InstallmentAmount: money.New(100), RepaidAmount: money.New(450)},
+				}
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", endDate, loanEntity.FindLoanOptions{
+					MappedOptions: &loanEntity.MappedOptions{
+						MappedBy: loanEntity.MappedByUserID,
+					},
+				}).Return(loanEntity.FindLoanResult{
+					List:     loans,
+					Mapped:   map[any][]loanEntity.Loan{"user-1": loans},
+					IsMapped: true,
+					MappedBy: loanEntity.MappedByUserID,
+				}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+
+				reimbursement := reimbursementEntity.Reimbursement{
+					ID:                "reimbursement-1",
+					UserID:            "user-1",
+					Amount:            money.New(150),
+					ReimbursementDate: startDate,
+				}
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					List:     []reimbursementEntity.Reimbursement{reimbursement},
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{"user-1": {reimbursement}},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name: "error - period not found",
+			authCredential: authCredential.Credential{
+				UserID:    "admin-1",
+				IPAddress: "127.0.0.1",
+				Username:  "admin",
+				IsAdmin:   func(b bool) *bool { return &b }(true),
+				RequestID: "req-123",
+			},
+			periodID: "invalid-period",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  "invalid-period",
+				},
+			),
+			setupMock: func(m mockParams) {
+				m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+				m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+				m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+				m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+				m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+				m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "invalid-period").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			periodID: "period-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				assert.Equal(t, pgx.ReadOnly, txOpt.AccessMode)
+				return fn(nil)
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParams := mockParams{
+				payrollRepo:         mockPayroll.NewMockRepository(ctrl),
+				payrollRepoTx:       mockPayroll.NewMockRepository(ctrl),
+				userRepo:            mockUser.NewMockRepository(ctrl),
+				userRepoTx:          mockUser.NewMockRepository(ctrl),
+				attRepo:             mockAtt.NewMockRepository(ctrl),
+				attRepoTx:           mockAtt.NewMockRepository(ctrl),
+				overTimeRepo:        mockOvertime.NewMockRepository(ctrl),
+				overTimeRepoTx:      mockOvertime.NewMockRepository(ctrl),
+				reimbursementRepo:   mockReimbursement.NewMockRepository(ctrl),
+				reimbursementRepoTx: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:         mockHoliday.NewMockRepository(ctrl),
+				holidayRepoTx:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:          mockSalary.NewMockRepository(ctrl),
+				salaryRepoTx:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:     mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:      mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:            mockLoan.NewMockRepository(ctrl),
+				adjustmentRepoTx:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepoTx:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:           mockLeave.NewMockRepository(ctrl),
+				leaveRepoTx:         mockLeave.NewMockRepository(ctrl),
+			}
+			tt.setupMock(mockParams)
+
+			useCase := usecase.NewPayrollUseCase(
+				mockParams.payrollRepo,
+				mockParams.userRepo,
+				mockParams.attRepo,
+				mockParams.overTimeRepo,
+				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{
+					VerificationKey: testKey,
+					AttendanceBasis: tt.attendanceBasis,
+					WorkSchedule:    attEntity.WorkSchedule{Start: 9 * time.Hour, End: 17 * time.Hour},
+				},
+			)
+			result, err := useCase.PreviewPayroll(context.Background(), tt.authCredential, tt.periodID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPreview.PeriodID, result.PeriodID)
+			assert.Equal(t, tt.expectedPreview.TotalGrossPay, result.TotalGrossPay)
+			assert.Equal(t, tt.expectedPreview.TotalReimbursement, result.TotalReimbursement)
+			assert.Equal(t, tt.expectedPreview.TotalTakeHome, result.TotalTakeHome)
+			assert.Equal(t, tt.expectedPreview.TotalEmployee, result.TotalEmployee)
+			assert.Equal(t, tt.expectedPreview.TotalPayslip, result.TotalPayslip)
+			assert.Len(t, result.PayslipsData, 1)
+			assert.Empty(t, result.PayslipsData[0].ID)
+			assert.Len(t, result.PayslipsData[0].Reimbursements, 1)
+			assert.Equal(t, tt.expectedPayslip.WorkingDays, result.PayslipsData[0].WorkingDays)
+			assert.Equal(t, tt.expectedPayslip.EligibleWorkingDays, result.PayslipsData[0].EligibleWorkingDays)
+			assert.Equal(t, tt.expectedPayslip.ProrationFactor, result.PayslipsData[0].ProrationFactor)
+			assert.Equal(t, tt.expectedPayslip.AttendanceDays, result.PayslipsData[0].AttendanceDays)
+			if tt.attendanceBasis != "" {
+				assert.Equal(t, tt.expectedPayslip.AttendancePay, result.PayslipsData[0].AttendancePay)
+				assert.Equal(t, tt.expectedPayslip.WorkedDuration, result.PayslipsData[0].WorkedDuration)
+			}
+			if tt.expectedSegments != nil {
+				assert.Equal(t, tt.expectedSegments, result.PayslipsData[0].SalarySegments)
+				assert.Equal(t, money.MustParse("140.90"), result.PayslipsData[0].AttendancePay)
+				assert.Equal(t, money.New(2100), result.PayslipsData[0].BaseSalary)
+			}
+			if tt.expectedEarnings != nil {
+				assert.Equal(t, tt.expectedEarnings, result.PayslipsData[0].Earnings)
+			}
+			if tt.expectedDeductions != nil {
+				assert.Equal(t, tt.expectedDeductions, result.PayslipsData[0].Deductions)
+				assert.Equal(t, money.MustParse("223.85"), result.PayslipsData[0].TotalDeductions)
+			}
+		})
+	}
+}
+
+func TestRegeneratePayroll(t *testing.T) {
+	type mockParams struct {
+		payrollRepo         *mockPayroll.MockRepository
+		payrollRepoTx       *mockPayroll.MockRepository
+		userRepo            *mockUser.MockRepository
+		userRepoTx          *mockUser.MockRepository
+		attRepo             *mockAtt.MockRepository
+		attRepoTx           *mockAtt.MockRepository
+		overTimeRepo        *mockOvertime.MockRepository
+		overTimeRepoTx      *mockOvertime.MockRepository
+		reimbursementRepo   *mockReimbursement.MockRepository
+		reimbursementRepoTx *mockReimbursement.MockRepository
+		holidayRepo         *mockHoliday.MockRepository
+		holidayRepoTx       *mockHoliday.MockRepository
+		salaryRepo          *mockSalary.MockRepository
+		salaryRepoTx        *mockSalary.MockRepository
+		bankAccountRepo     *mockBankAccount.MockRepository
+		adjustmentRepo      *mockAdjustment.MockRepository
+		adjustmentRepoTx    *mockAdjustment.MockRepository
+		loanRepo            *mockLoan.MockRepository
+		loanRepoTx          *mockLoan.MockRepository
+		leaveRepo           *mockLeave.MockRepository
+		leaveRepoTx         *mockLeave.MockRepository
+	}
+
+	type testCase struct {
+		name             string
+		authCredential   authCredential.Credential
+		payrollID        string
+		reason           string
+		generatedPayroll entity.GeneratedPayroll
+		expectedErr      error
+		setupMock        func(mockParams)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	withTx := func(m mockParams) {
+		m.payrollRepo.EXPECT().WithTx(gomock.Any()).Return(m.payrollRepoTx)
+		m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+		m.attRepo.EXPECT().WithTx(gomock.Any()).Return(m.attRepoTx)
+		m.overTimeRepo.EXPECT().WithTx(gomock.Any()).Return(m.overTimeRepoTx)
+		m.reimbursementRepo.EXPECT().WithTx(gomock.Any()).Return(m.reimbursementRepoTx)
+		m.holidayRepo.EXPECT().WithTx(gomock.Any()).Return(m.holidayRepoTx)
+		m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+		m.adjustmentRepo.EXPECT().WithTx(gomock.Any()).Return(m.adjustmentRepoTx)
+		m.loanRepo.EXPECT().WithTx(gomock.Any()).Return(m.loanRepoTx)
+		m.leaveRepo.EXPECT().WithTx(gomock.Any()).Return(m.leaveRepoTx)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - previous version voided and new version generated",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			reason:         "late overtime approval",
+			generatedPayroll: entity.GeneratedPayroll{
+				PeriodID:      "period-1",
+				PayrollID:     "payroll-2",
+				Version:       2,
+				Status:        entity.PayrollStatusDraft,
+				TotalGrossPay: money.MustParse("45.45"),
+				TotalTakeHome: money.MustParse("45.45"),
+				TotalEmployee: 1,
+				TotalPayslip:  1,
+				GeneratedBy:   "admin-1",
+				GeneratedAt:   now.Format(time.RFC3339),
+			},
+			setupMock: func(m mockParams) {
+				withTx(m)
+
+				payroll := &entity.Payroll{ID: "payroll-1", PeriodID: "period-1", Version: 1}
+				m.payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(payroll, nil)
+				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(payroll, nil)
+				m.attRepoTx.EXPECT().FindPeriodByID(gomock.Any(), "period-1").Return(&attEntity.AttendancePeriod{
+					ID:        "period-1",
+					StartDate: startDate,
+					EndDate:   endDate,
+				}, nil)
+
+				m.payrollRepoTx.EXPECT().VoidPayroll(gomock.Any(), entity.VoidPayroll{
+					PayrollID:  "payroll-1",
+					VoidedAt:   now,
+					VoidedBy:   "admin-1",
+					VoidReason: "late overtime approval",
+					IPAddress:  "127.0.0.1",
+				}).Return(nil)
+
+				user := userEntity.User{ID: "user-1", Username: "testuser", Salary: money.New(1000)}
+				m.userRepoTx.EXPECT().FindAllUsers(gomock.Any(), gomock.Any()).Return(userEntity.FindUserResult{
+					List:     []userEntity.User{user},
+					Mapped:   map[any][]userEntity.User{"user-1": {user}},
+					IsMapped: true,
+					MappedBy: userEntity.MappedByUserID,
+				}, nil)
+				attendance := attEntity.Attendance{ID: "att-1", UserID: "user-1", AttendanceDate: startDate}
+				m.attRepoTx.EXPECT().FindAttendanceByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(attEntity.FindAttendanceResult{
+					List:     []attEntity.Attendance{attendance},
+					Mapped:   map[any][]attEntity.Attendance{"user-1": {attendance}},
+					IsMapped: true,
+					MappedBy: attEntity.MappedByUserID,
+				}, nil)
+				m.overTimeRepoTx.EXPECT().FindOvertimeByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(overtimeEntity.FindOvertimeResult{
+					Mapped:   map[any][]overtimeEntity.Overtime{},
+					IsMapped: true,
+					MappedBy: overtimeEntity.MappedByUserID,
+				}, nil)
+				m.overTimeRepoTx.EXPECT().FindActivePolicy(gomock.Any()).Return(&overtimeEntity.OvertimePolicy{
+					ID:                  "policy-1",
+					Version:             1,
+					WeekdayMultiplier:   1,
+					WeekendMultiplier:   1,
+					StandardHoursPerDay: 8,
+				}, nil)
+				m.holidayRepoTx.EXPECT().FindHolidaysByRange(gomock.Any(), startDate, endDate).Return(nil, nil)
+				m.payrollRepoTx.EXPECT().FindActiveDeductionRules(gomock.Any()).Return(nil, nil)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(salaryEntity.FindSalaryHistoryResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAllowancesByRange(gomock.Any(), startDate, endDate, gomock.Any()).Return(adjustmentEntity.FindAllowanceResult{}, nil)
+				m.adjustmentRepoTx.EXPECT().FindAdjustmentsByPeriodID(gomock.Any(), "period-1", gomock.Any()).Return(adjustmentEntity.FindAdjustmentResult{}, nil)
+				m.loanRepoTx.EXPECT().FindOutstandingLoans(gomock.Any(), "period-1", gomock.Any(), gomock.Any()).Return(loanEntity.FindLoanResult{}, nil)
+				m.leaveRepoTx.EXPECT().FindApprovedLeaveByRange(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(leaveEntity.FindLeaveRequestResult{}, nil)
+				m.reimbursementRepoTx.EXPECT().FindReimbursementByPeriod(gomock.Any(), startDate, endDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					Mapped:   map[any][]reimbursementEntity.Reimbursement{},
+					IsMapped: true,
+					MappedBy: reimbursementEntity.MappedByUserID,
+				}, nil)
+
+				m.payrollRepoTx.EXPECT().StoreNewPayslips(gomock.Any(), gomock.Len(1)).Return(nil)
+				m.payrollRepoTx.EXPECT().StoreNewPayslipSalarySegments(gomock.Any(), gomock.Len(1)).Return(nil)
+				m.payrollRepoTx.EXPECT().StoreNewPayroll(gomock.Any(), mock.MatchedBy(func(args entity.Payroll) bool {
+					return testutil.EqualVerbose(
+						entity.Payroll{
+							PeriodID: "period-1",
+							Version:  2,
+							Status:   entity.PayrollStatusDraft,
+							RunBy:    "admin-1",
+							RunAt:    now,
+						},
+						args,
+						cmpopts.IgnoreFields(entity.Payroll{},
+							"ID",
+							"CreatedAt",
+							"UpdatedAt",
+							"CreatedBy",
+							"UpdatedBy",
+							"IPAddress",
+						),
+					)
+				})).Return(nil)
+				m.payrollRepoTx.EXPECT().StoreNewPayrollSummary(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-x",
+			reason:         "rerun",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-x",
+				},
+			),
+			setupMock: func(m mockParams) {
+				withTx(m)
+				m.payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-x").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - payroll already voided",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			reason:         "rerun",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyVoided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyVoided),
+					Received:  "payroll-1",
+				},
+			),
+			setupMock: func(m mockParams) {
+				withTx(m)
+				m.payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+					Version:  1,
+				}, nil)
+				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(&entity.Payroll{ID: "payroll-2", PeriodID: "period-1", Version: 2}, nil)
+			},
+		},
+		{
+			name:           "error - payroll already paid",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			reason:         "rerun",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyPaid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyPaid),
+					Received:  "payroll-1",
+				},
+			),
+			setupMock: func(m mockParams) {
+				withTx(m)
+				payroll := &entity.Payroll{ID: "payroll-1", PeriodID: "period-1", Version: 1, Status: entity.PayrollStatusPaid}
+				m.payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(payroll, nil)
+				m.payrollRepoTx.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(payroll, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID: "payroll-1",
+			reason:    "rerun",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+			patches.ApplyFunc(uuid.NewString, func() string {
+				return "payroll-2"
+			})
+			patches.ApplyFunc(time.Now, func() time.Time {
+				return now
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParams := mockParams{
+				payrollRepo:         mockPayroll.NewMockRepository(ctrl),
+				payrollRepoTx:       mockPayroll.NewMockRepository(ctrl),
+				userRepo:            mockUser.NewMockRepository(ctrl),
+				userRepoTx:          mockUser.NewMockRepository(ctrl),
+				attRepo:             mockAtt.NewMockRepository(ctrl),
+				attRepoTx:           mockAtt.NewMockRepository(ctrl),
+				overTimeRepo:        mockOvertime.NewMockRepository(ctrl),
+				overTimeRepoTx:      mockOvertime.NewMockRepository(ctrl),
+				reimbursementRepo:   mockReimbursement.NewMockRepository(ctrl),
+				reimbursementRepoTx: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:         mockHoliday.NewMockRepository(ctrl),
+				holidayRepoTx:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:          mockSalary.NewMockRepository(ctrl),
+				salaryRepoTx:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:     mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:      mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:            mockLoan.NewMockRepository(ctrl),
+				adjustmentRepoTx:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepoTx:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:           mockLeave.NewMockRepository(ctrl),
+				leaveRepoTx:         mockLeave.NewMockRepository(ctrl),
+			}
+			tt.setupMock(mockParams)
+
+			useCase := usecase.NewPayrollUseCase(
+				mockParams.payrollRepo,
+				mockParams.userRepo,
+				mockParams.attRepo,
+				mockParams.overTimeRepo,
+				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.RegeneratePayroll(context.Background(), tt.authCredential, tt.payrollID, tt.reason)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.generatedPayroll, result)
+			}
+		})
+	}
+}
+
+func TestExportPayslips(t *testing.T) {
+	type mockParams struct {
+		payrollRepo       *mockPayroll.MockRepository
+		userRepo          *mockUser.MockRepository
+		attendanceRepo    *mockAtt.MockRepository
+		overtimeRepo      *mockOvertime.MockRepository
+		reimbursementRepo *mockReimbursement.MockRepository
+		holidayRepo       *mockHoliday.MockRepository
+		salaryRepo        *mockSalary.MockRepository
+		bankAccountRepo   *mockBankAccount.MockRepository
+		adjustmentRepo    *mockAdjustment.MockRepository
+		loanRepo          *mockLoan.MockRepository
+		leaveRepo         *mockLeave.MockRepository
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	period := &attEntity.AttendancePeriod{
+		ID:        "period-1",
+		StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	payslips := []entity.Payslip{
+		{
+			ID:                 "payslip-1",
+			UserID:             "user-1",
+			PayrollID:          "payroll-2",
+			BaseSalary:         money.New(1000),
+			WorkingDays:        optional.NewInt64(22),
+			AttendanceDays:     22,
+			OvertimeHours:      optional.NewDuration(0),
+			GrossPay:           money.New(1000),
+			ReimbursementTotal: money.New(150),
+			TotalTakeHome:      money.New(1150),
+		},
+		{
+			ID:             "payslip-2",
+			UserID:         "user-2",
+			PayrollID:      "payroll-2",
+			BaseSalary:     money.New(2000),
+			WorkingDays:    optional.NewInt64(22),
+			AttendanceDays: 11,
+			OvertimeHours:  optional.NewDuration(0),
+			GrossPay:       money.New(1000),
+			TotalTakeHome:  money.New(1000),
+		},
+	}
+
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payrollID      string
+		expected       entity.PayslipExport
+		expectedErr    error
+		setupMock      func(mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - every payslip of the latest payroll version",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expected: entity.PayslipExport{
+				PayrollID: "payroll-2",
+				AttendancePeriod: entity.AttendancePeriodData{
+					StartDate: "2023-10-01T00:00:00Z",
+					EndDate:   "2023-10-31T00:00:00Z",
+				},
+			},
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-2",
+					PeriodID: "period-1",
+					Version:  2,
+					Status:   entity.PayrollStatusApproved,
+				}, nil)
+				m.userRepo.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{}).Return(userEntity.FindUserResult{
+					List: []userEntity.User{
+						{ID: "user-1", Username: "alice"},
+						{ID: "user-2", Username: "bob"},
+						{ID: "user-3", Username: "carol"},
+					},
+				}, nil)
+				m.payrollRepo.EXPECT().FindPayslipByPayrollID(gomock.Any(), "payroll-2", entity.FindPayslipOptions{
+					MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID},
+				}).Return(entity.FindPayslipResult{
+					List: payslips,
+					Mapped: map[any][]entity.Payslip{
+						"user-1": {payslips[0]},
+						"user-2": {payslips[1]},
+					},
+					IsMapped: true,
+					MappedBy: entity.MappedByUserID,
+				}, nil)
+				m.payrollRepo.EXPECT().FindPayslipItemsByPayslipIDs(gomock.Any(), []string{"payslip-1", "payslip-2"}).Return(nil, nil)
+				m.payrollRepo.EXPECT().FindPayslipSalarySegmentsByPayslipIDs(gomock.Any(), []string{"payslip-1", "payslip-2"}).Return(nil, nil)
+				m.reimbursementRepo.EXPECT().FindReimbursementByPeriod(gomock.Any(), period.StartDate, period.EndDate, gomock.Any()).Return(reimbursementEntity.FindReimbursementResult{
+					Mapped: map[any][]reimbursementEntity.Reimbursement{
+						"user-1": {{ID: "reimbursement-1", UserID: "user-1", Amount: money.New(150), ReimbursementDate: time.Date(2023, 10, 5, 0, 0, 0, 0, time.UTC)}},
+					},
+					IsMapped: true,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - payroll not approved",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotApproved,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotApproved),
+					Expected:  entity.PayrollStatusApproved,
+					Received:  entity.PayrollStatusSubmitted,
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-2",
+					PeriodID: "period-1",
+					Version:  2,
+					Status:   entity.PayrollStatusSubmitted,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  "payroll-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "alice",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID: "payroll-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParams := mockParams{
+				payrollRepo:       mockPayroll.NewMockRepository(ctrl),
+				userRepo:          mockUser.NewMockRepository(ctrl),
+				attendanceRepo:    mockAtt.NewMockRepository(ctrl),
+				overtimeRepo:      mockOvertime.NewMockRepository(ctrl),
+				reimbursementRepo: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:         mockLeave.NewMockRepository(ctrl),
+			}
+			tt.setupMock(mockParams)
+
+			useCase := usecase.NewPayrollUseCase(
+				mockParams.payrollRepo,
+				mockParams.userRepo,
+				mockParams.attendanceRepo,
+				mockParams.overtimeRepo,
+				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			export, err := useCase.ExportPayslips(context.Background(), tt.authCredential, tt.payrollID)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected.PayrollID, export.PayrollID)
+			assert.Equal(t, tt.expected.AttendancePeriod, export.AttendancePeriod)
+			// Users without a payslip are left out
+			assert.Len(t, export.Payslips, 2)
+			assert.Equal(t, "alice", export.Payslips[0].User.Username)
+			assert.Len(t, export.Payslips[0].Reimbursements, 1)
+			assert.Equal(t, money.New(1150), export.Payslips[0].TotalTakeHome)
+			assert.Equal(t, entity.NewVerificationID(testKey, payslips[0]), export.Payslips[0].VerificationID)
+			assert.Equal(t, "bob", export.Payslips[1].User.Username)
+			assert.Empty(t, export.Payslips[1].Reimbursements)
+			assert.NotEqual(t, export.Payslips[0].VerificationID, export.Payslips[1].VerificationID)
+		})
+	}
+}
+
+func TestExportBankTransfers(t *testing.T) {
+	type mockParams struct {
+		payrollRepo       *mockPayroll.MockRepository
+		userRepo          *mockUser.MockRepository
+		attendanceRepo    *mockAtt.MockRepository
+		overtimeRepo      *mockOvertime.MockRepository
+		reimbursementRepo *mockReimbursement.MockRepository
+		holidayRepo       *mockHoliday.MockRepository
+		salaryRepo        *mockSalary.MockRepository
+		bankAccountRepo   *mockBankAccount.MockRepository
+		adjustmentRepo    *mockAdjustment.MockRepository
+		loanRepo          *mockLoan.MockRepository
+		leaveRepo         *mockLeave.MockRepository
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	now := time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+	executionDate := time.Date(2023, 11, 3, 0, 0, 0, 0, time.UTC)
+
+	period := &attEntity.AttendancePeriod{
+		ID:        "period-1",
+		StartDate: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	payslips := []entity.Payslip{
+		{ID: "payslip-1", UserID: "user-1", PayrollID: "payroll-2", TotalTakeHome: money.New(1150)},
+		{ID: "payslip-2", UserID: "user-2", PayrollID: "payroll-2", TotalTakeHome: money.MustParse("999.50")},
+		{ID: "payslip-3", UserID: "user-3", PayrollID: "payroll-2", TotalTakeHome: money.New(0)},
+	}
+
+	users := userEntity.FindUserResult{
+		List: []userEntity.User{
+			{ID: "user-1", Username: "alice"},
+			{ID: "user-2", Username: "bob"},
+			{ID: "user-3", Username: "carol"},
+			{ID: "user-4", Username: "dave"},
+		},
+	}
+
+	findPayslips := func(m mockParams) {
+		m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+		m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+			ID:       "payroll-2",
+			PeriodID: "period-1",
+			Version:  2,
+			Status:   entity.PayrollStatusApproved,
+		}, nil)
+		m.userRepo.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{}).Return(users, nil)
+		m.payrollRepo.EXPECT().FindPayslipByPayrollID(gomock.Any(), "payroll-2", entity.FindPayslipOptions{
+			MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID},
+		}).Return(entity.FindPayslipResult{
+			List: payslips,
+			Mapped: map[any][]entity.Payslip{
+				"user-1": {payslips[0]},
+				"user-2": {payslips[1]},
+				"user-3": {payslips[2]},
+			},
+			IsMapped: true,
+			MappedBy: entity.MappedByUserID,
+		}, nil)
+	}
+
+	bankAccountOptions := bankAccountEntity.FindBankAccountOptions{
+		MappedOptions: &bankAccountEntity.MappedOptions{MappedBy: bankAccountEntity.MappedByUserID},
+	}
+
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payrollID      string
+		expected       entity.BankTransferBatch
+		expectedErr    error
+		setupMock      func(mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - transfers of the latest payroll version without zero take-home pay",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expected: entity.BankTransferBatch{
+				PayrollID: "payroll-2",
+				AttendancePeriod: entity.AttendancePeriodData{
+					StartDate: "2023-10-01T00:00:00Z",
+					EndDate:   "2023-10-31T00:00:00Z",
+				},
+				CreatedAt:     now,
+				ExecutionDate: executionDate,
+				Transfers: []entity.BankTransfer{
+					{
+						PayslipID:         "payslip-1",
+						UserID:            "user-1",
+						Username:          "alice",
+						AccountHolderName: "Alice Smith",
+						AccountNumber:     "NL91ABNA0417164300",
+						BankName:          "ABN AMRO",
+						BankCode:          "ABNA",
+						BIC:               optional.NewString("ABNANL2A"),
+						Amount:            money.New(1150),
+					},
+					{
+						PayslipID:         "payslip-2",
+						UserID:            "user-2",
+						Username:          "bob",
+						AccountHolderName: "Bob Jones",
+						AccountNumber:     "1234567890",
+						BankName:          "Bank Central Asia",
+						BankCode:          "014",
+						Amount:            money.MustParse("999.50"),
+					},
+				},
+				TotalAmount: money.MustParse("2149.50"),
+			},
+			setupMock: func(m mockParams) {
+				findPayslips(m)
+				m.bankAccountRepo.EXPECT().FindBankAccountsByUserIDs(gomock.Any(), []string{"user-1", "user-2", "user-3"}, bankAccountOptions).Return(bankAccountEntity.FindBankAccountResult{
+					Mapped: map[any][]bankAccountEntity.BankAccount{
+						"user-1": {{ID: "account-1", UserID: "user-1", AccountHolderName: "Alice Smith", AccountNumber: "NL91ABNA0417164300", BankName: "ABN AMRO", BankCode: "ABNA", BIC: optional.NewString("ABNANL2A")}},
+						"user-2": {{ID: "account-2", UserID: "user-2", AccountHolderName: "Bob Jones", AccountNumber: "1234567890", BankName: "Bank Central Asia", BankCode: "014"}},
+					},
+					IsMapped: true,
+					MappedBy: bankAccountEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - bank account missing",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.BankAccountMissing,
+					Message:   entity.GetErrorMessageByIssueCode(entity.BankAccountMissing),
+					Received:  []string{"user-2"},
+				}),
+			setupMock: func(m mockParams) {
+				findPayslips(m)
+				m.bankAccountRepo.EXPECT().FindBankAccountsByUserIDs(gomock.Any(), []string{"user-1", "user-2", "user-3"}, bankAccountOptions).Return(bankAccountEntity.FindBankAccountResult{
+					Mapped: map[any][]bankAccountEntity.BankAccount{
+						"user-1": {{ID: "account-1", UserID: "user-1"}},
+					},
+					IsMapped: true,
+					MappedBy: bankAccountEntity.MappedByUserID,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - payroll not approved",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotApproved,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotApproved),
+					Expected:  entity.PayrollStatusApproved,
+					Received:  entity.PayrollStatusDraft,
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(period, nil)
+				m.payrollRepo.EXPECT().FindPayrollByPeriodID(gomock.Any(), "period-1").Return(&entity.Payroll{
+					ID:       "payroll-2",
+					PeriodID: "period-1",
+					Version:  2,
+					Status:   entity.PayrollStatusDraft,
+				}, nil)
+			},
+		},
+		{
+			name:           "error - period not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.AttendancePeriodNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.AttendancePeriodNotFound),
+					Received:  "payroll-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.attendanceRepo.EXPECT().FindAttendancePeriodByPayrollID(gomock.Any(), "payroll-1").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "alice",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID: "payroll-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(time.Now, func() time.Time {
+				return now
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParams := mockParams{
+				payrollRepo:       mockPayroll.NewMockRepository(ctrl),
+				userRepo:          mockUser.NewMockRepository(ctrl),
+				attendanceRepo:    mockAtt.NewMockRepository(ctrl),
+				overtimeRepo:      mockOvertime.NewMockRepository(ctrl),
+				reimbursementRepo: mockReimbursement.NewMockRepository(ctrl),
+				holidayRepo:       mockHoliday.NewMockRepository(ctrl),
+				salaryRepo:        mockSalary.NewMockRepository(ctrl),
+				bankAccountRepo:   mockBankAccount.NewMockRepository(ctrl),
+				adjustmentRepo:    mockAdjustment.NewMockRepository(ctrl),
+				loanRepo:          mockLoan.NewMockRepository(ctrl),
+				leaveRepo:         mockLeave.NewMockRepository(ctrl),
+			}
+			tt.setupMock(mockParams)
+
+			useCase := usecase.NewPayrollUseCase(
+				mockParams.payrollRepo,
+				mockParams.userRepo,
+				mockParams.attendanceRepo,
+				mockParams.overtimeRepo,
+				mockParams.reimbursementRepo,
+				mockParams.holidayRepo,
+				mockParams.salaryRepo,
+				mockParams.bankAccountRepo,
+				mockParams.adjustmentRepo,
+				mockParams.loanRepo,
+				mockParams.leaveRepo,
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			batch, err := useCase.ExportBankTransfers(context.Background(), tt.authCredential, tt.payrollID, executionDate)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, batch)
+		})
+	}
+}
+
+func TestVerifyPayslip(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payslipID      string
+		verificationID string
+		expected       entity.PayslipVerification
+		expectedErr    error
+		setupMock      func(payrollRepo *mockPayroll.MockRepository)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	payslip := entity.Payslip{
+		ID:            "payslip-1",
+		UserID:        "user-1",
+		PayrollID:     "payroll-1",
+		TotalTakeHome: money.New(1150),
+	}
+	verificationID := entity.NewVerificationID(testKey, payslip)
+
+	editedPayslip := payslip
+	editedPayslip.TotalTakeHome = money.New(2150)
+
+	tests := []testCase{
+		{
+			name:           "success - verification ID matches",
+			authCredential: adminCredential,
+			payslipID:      "payslip-1",
+			verificationID: verificationID,
+			expected: entity.PayslipVerification{
+				PayslipID:     "payslip-1",
+				PayrollID:     "payroll-1",
+				UserID:        "user-1",
+				TotalTakeHome: money.New(1150),
+				Valid:         true,
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-1").Return(&payslip, nil)
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{ID: "payroll-1", Status: entity.PayrollStatusApproved}, nil)
+			},
+		},
+		{
+			name:           "success - typed over without dashes in lower case",
+			authCredential: adminCredential,
+			payslipID:      "payslip-1",
+			verificationID: strings.ToLower(strings.ReplaceAll(verificationID, "-", "")),
+			expected: entity.PayslipVerification{
+				PayslipID:     "payslip-1",
+				PayrollID:     "payroll-1",
+				UserID:        "user-1",
+				TotalTakeHome: money.New(1150),
+				Valid:         true,
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-1").Return(&payslip, nil)
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{ID: "payroll-1", Status: entity.PayrollStatusApproved}, nil)
+			},
+		},
+		{
+			name:           "success - amount differs from the issued document",
+			authCredential: adminCredential,
+			payslipID:      "payslip-1",
+			verificationID: entity.NewVerificationID(testKey, editedPayslip),
+			expected: entity.PayslipVerification{
+				PayslipID:     "payslip-1",
+				PayrollID:     "payroll-1",
+				UserID:        "user-1",
+				TotalTakeHome: money.New(1150),
+				Valid:         false,
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-1").Return(&payslip, nil)
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{ID: "payroll-1", Status: entity.PayrollStatusApproved}, nil)
+			},
+		},
+		{
+			name:           "success - payroll regenerated since",
+			authCredential: adminCredential,
+			payslipID:      "payslip-1",
+			verificationID: verificationID,
+			expected: entity.PayslipVerification{
+				PayslipID:     "payslip-1",
+				PayrollID:     "payroll-1",
+				UserID:        "user-1",
+				TotalTakeHome: money.New(1150),
+				Valid:         true,
+				Superseded:    true,
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-1").Return(&payslip, nil)
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{
+					ID:       "payroll-1",
+					Status:   entity.PayrollStatusApproved,
+					VoidedAt: optional.NewTime(time.Date(2023, 11, 2, 0, 0, 0, 0, time.UTC)),
+				}, nil)
+			},
+		},
+		{
+			name:           "error - payslip not found",
+			authCredential: adminCredential,
+			payslipID:      "payslip-9",
+			verificationID: verificationID,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayslipNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayslipNotFound),
+					Received:  "payslip-9",
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-9").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - find payslip failed",
+			authCredential: adminCredential,
+			payslipID:      "payslip-1",
+			verificationID: verificationID,
+			expectedErr:    errors.New("db error"),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipByID(gomock.Any(), "payslip-1").Return(nil, errors.New("db error"))
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payslipID:      "payslip-1",
+			verificationID: verificationID,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.VerifyPayslip(context.Background(), tt.authCredential, tt.payslipID, tt.verificationID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTransitionPayroll(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payrollID      string
+		action         entity.PayrollAction
+		comment        string
+		approval       entity.PayrollApproval
+		expectedErr    error
+		setupMock      func(payrollRepoTx *mockPayroll.MockRepository)
+	}
+
+	now := time.Date(2023, 11, 1, 9, 0, 0, 0, time.UTC)
+
+	submitterCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	reviewerCredential := authCredential.Credential{
+		UserID:    "admin-2",
+		IPAddress: "127.0.0.1",
+		Username:  "reviewer",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	findPayroll := func(payrollRepoTx *mockPayroll.MockRepository, status entity.PayrollStatus) {
+		payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1", entity.FindPayrollOptions{
+			PessimisticLock: true,
+		}).Return(&entity.Payroll{
+			ID:       "payroll-1",
+			PeriodID: "period-1",
+			Version:  1,
+			Status:   status,
+		}, nil)
+	}
+	submitted := []entity.PayrollApproval{
+		{ID: "approval-0", PayrollID: "payroll-1", Action: entity.PayrollActionSubmit, CreatedBy: "admin-1"},
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - draft submitted",
+			authCredential: submitterCredential,
+			payrollID:      "payroll-1",
+			action:         entity.PayrollActionSubmit,
+			approval: entity.PayrollApproval{
+				ID:         "approval-1",
+				PayrollID:  "payroll-1",
+				Action:     entity.PayrollActionSubmit,
+				FromStatus: entity.PayrollStatusDraft,
+				ToStatus:   entity.PayrollStatusSubmitted,
+				Comment:    optional.NewString(),
+				CreatedAt:  now,
+				UpdatedAt:  now,
+				CreatedBy:  "admin-1",
+				UpdatedBy:  "admin-1",
+				IPAddress:  "127.0.0.1",
+			},
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				findPayroll(payrollRepoTx, entity.PayrollStatusDraft)
+				payrollRepoTx.EXPECT().UpdatePayrollStatus(gomock.Any(), entity.UpdatePayrollStatus{
+					PayrollID:  "payroll-1",
+					FromStatus: entity.PayrollStatusDraft,
+					ToStatus:   entity.PayrollStatusSubmitted,
+					UpdatedAt:  now,
+					UpdatedBy:  "admin-1",
+					IPAddress:  "127.0.0.1",
+				}).Return(nil)
+				payrollRepoTx.EXPECT().StoreNewPayrollApproval(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "success - submitted payroll rejected by another admin",
+			authCredential: reviewerCredential,
+			payrollID:      "payroll-1",
+			action:         entity.PayrollActionReject,
+			comment:        "overtime of october is missing",
+			approval: entity.PayrollApproval{
+				ID:         "approval-1",
+				PayrollID:  "payroll-1",
+				Action:     entity.PayrollActionReject,
+				FromStatus: entity.PayrollStatusSubmitted,
+				ToStatus:   entity.PayrollStatusDraft,
+				Comment:    optional.NewString("overtime of october is missing"),
+				CreatedAt:  now,
+				UpdatedAt:  now,
+				CreatedBy:  "admin-2",
+				UpdatedBy:  "admin-2",
+				IPAddress:  "127.0.0.1",
+			},
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				findPayroll(payrollRepoTx, entity.PayrollStatusSubmitted)
+				payrollRepoTx.EXPECT().FindPayrollApprovalsByPayrollID(gomock.Any(), "payroll-1").Return(submitted, nil)
+				payrollRepoTx.EXPECT().UpdatePayrollStatus(gomock.Any(), gomock.Any()).Return(nil)
+				payrollRepoTx.EXPECT().StoreNewPayrollApproval(gomock.Any(), entity.PayrollApproval{
+					ID:         "approval-1",
+					PayrollID:  "payroll-1",
+					Action:     entity.PayrollActionReject,
+					FromStatus: entity.PayrollStatusSubmitted,
+					ToStatus:   entity.PayrollStatusDraft,
+					Comment:    optional.NewString("overtime of october is missing"),
+					CreatedAt:  now,
+					UpdatedAt:  now,
+					CreatedBy:  "admin-2",
+					UpdatedBy:  "admin-2",
+					IPAddress:  "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "error - submitter approves own payroll",
+			authCredential: submitterCredential,
+			payrollID:      "payroll-1",
+			action:         entity.PayrollActionApprove,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollSelfReview,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollSelfReview),
+				},
+			),
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				findPayroll(payrollRepoTx, entity.PayrollStatusSubmitted)
+				payrollRepoTx.EXPECT().FindPayrollApprovalsByPayrollID(gomock.Any(), "payroll-1").Return(submitted, nil)
+			},
+		},
+		{
+			name:           "error - draft cannot be paid",
+			authCredential: reviewerCredential,
+			payrollID:      "payroll-1",
+			action:         entity.PayrollActionPay,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollInvalidTransition,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollInvalidTransition),
+					Expected:  entity.PayrollStatusApproved,
+					Received:  entity.PayrollStatusDraft,
+				},
+			),
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				findPayroll(payrollRepoTx, entity.PayrollStatusDraft)
+			},
+		},
+		{
+			name:           "error - payroll voided",
+			authCredential: submitterCredential,
+			payrollID:      "payroll-1",
+			action:         entity.PayrollActionSubmit,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.PayrollAlreadyVoided,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollAlreadyVoided),
+					Received:  "payroll-1",
+				},
+			),
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(&entity.Payroll{
+					ID:       "payroll-1",
+					PeriodID: "period-1",
+					Version:  1,
+					Status:   entity.PayrollStatusDraft,
+					VoidedAt: optional.NewTime(now),
+				}, nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: submitterCredential,
+			payrollID:      "payroll-9",
+			action:         entity.PayrollActionSubmit,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-9",
+				},
+			),
+			setupMock: func(payrollRepoTx *mockPayroll.MockRepository) {
+				payrollRepoTx.EXPECT().FindPayrollByID(gomock.Any(), "payroll-9", entity.FindPayrollOptions{
+					PessimisticLock: true,
+				}).Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID: "payroll-1",
+			action:    entity.PayrollActionApprove,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(database.WithAuditContext, func(
+				ctx context.Context,
+				cred authCredential.Credential,
+				txOpt pgx.TxOptions,
+				fn func(tx database.DBTx) error,
+			) error {
+				return fn(nil)
+			})
+			defer patches.Reset()
+			patches.ApplyFunc(uuid.NewString, func() string {
+				return "approval-1"
+			})
+			patches.ApplyFunc(time.Now, func() time.Time {
+				return now
+			})
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			payrollRepoTx := mockPayroll.NewMockRepository(ctrl)
+			if tt.setupMock != nil {
+				payrollRepo.EXPECT().WithTx(gomock.Any()).Return(payrollRepoTx)
+				tt.setupMock(payrollRepoTx)
+			}
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.TransitionPayroll(context.Background(), tt.authCredential, tt.payrollID, tt.action, tt.comment)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, testutil.EqualVerbose(tt.approval, result))
+			}
+		})
+	}
+}
+
+func TestListPayrollApprovals(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payrollID      string
+		approvals      []entity.PayrollApproval
+		expectedErr    error
+		setupMock      func(payrollRepo *mockPayroll.MockRepository)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	approvals := []entity.PayrollApproval{
+		{ID: "approval-1", PayrollID: "payroll-1", Action: entity.PayrollActionSubmit, FromStatus: entity.PayrollStatusDraft, ToStatus: entity.PayrollStatusSubmitted, CreatedBy: "admin-1"},
+		{ID: "approval-2", PayrollID: "payroll-1", Action: entity.PayrollActionApprove, FromStatus: entity.PayrollStatusSubmitted, ToStatus: entity.PayrollStatusApproved, CreatedBy: "admin-2"},
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - approval trail listed",
+			authCredential: adminCredential,
+			payrollID:      "payroll-1",
+			approvals:      approvals,
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{ID: "payroll-1"}, nil)
+				payrollRepo.EXPECT().FindPayrollApprovalsByPayrollID(gomock.Any(), "payroll-1").Return(approvals, nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: adminCredential,
+			payrollID:      "payroll-9",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-9",
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-9").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			payrollID: "payroll-1",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.ListPayrollApprovals(context.Background(), tt.authCredential, tt.payrollID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.approvals, result)
+			}
+		})
+	}
+}
+
+func TestDiffPayrolls(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		fromPayrollID  string
+		toPayrollID    string
+		diff           entity.PayrollDiff
+		expectedErr    error
+		setupMock      func(payrollRepo *mockPayroll.MockRepository, userRepo *mockUser.MockRepository)
+	}
+
+	adminCredential := authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+
+	thresholds := entity.PayrollDiffThresholds{
+		TakeHomeChangePercent:   20,
+		BaseSalaryChangePercent: 10,
+		AttendanceDaysChange:    5,
+		OvertimeHoursChange:     10 * time.Hour,
+	}
+
+	users := userEntity.FindUserResult{
+		List: []userEntity.User{
+			{ID: "user-1", Username: "alice"},
+			{ID: "user-2", Username: "bob"},
+			{ID: "user-3", Username: "carol"},
+			{ID: "user-4", Username: "dave"},
+			{ID: "user-5", Username: "eve"},
+		},
+	}
+
+	mapped := func(payslips ...entity.Payslip) entity.FindPayslipResult {
+		result := entity.FindPayslipResult{
+			List:     payslips,
+			Mapped:   map[any][]entity.Payslip{},
+			IsMapped: true,
+			MappedBy: entity.MappedByUserID,
+		}
+		for _, payslip := range payslips {
+			result.Mapped[payslip.UserID] = append(result.Mapped[payslip.UserID], payslip)
+		}
+		return result
+	}
+
+	// alice took 6 days off and got a raise in april, bob is paid the same,
+	// carol left and dave joined
+	marchPayslips := mapped(
+		entity.Payslip{UserID: "user-1", BaseSalary: money.New(1000), AttendanceDays: 22, OvertimeHours: optional.NewDuration(2 * time.Hour), OvertimePay: money.New(20), TotalTakeHome: money.New(1020)},
+		entity.Payslip{UserID: "user-2", BaseSalary: money.New(800), AttendanceDays: 20, OvertimeHours: optional.NewDuration(), ReimbursementTotal: money.New(50), TotalTakeHome: money.New(850)},
+		entity.Payslip{UserID: "user-3", BaseSalary: money.New(900), AttendanceDays: 21, OvertimeHours: optional.NewDuration(), TotalTakeHome: money.New(900)},
+	)
+	aprilPayslips := mapped(
+		entity.Payslip{UserID: "user-1", BaseSalary: money.New(1200), AttendanceDays: 16, OvertimeHours: optional.NewDuration(14 * time.Hour), OvertimePay: money.New(140), TotalTakeHome: money.MustParse("1012.50")},
+		entity.Payslip{UserID: "user-2", BaseSalary: money.New(800), AttendanceDays: 20, OvertimeHours: optional.NewDuration(), ReimbursementTotal: money.New(50), TotalTakeHome: money.New(850)},
+		entity.Payslip{UserID: "user-4", BaseSalary: money.New(700), AttendanceDays: 0, OvertimeHours: optional.NewDuration(), TotalTakeHome: money.New(0)},
+	)
+
+	findPayrolls := func(payrollRepo *mockPayroll.MockRepository) {
+		payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{
+			ID: "payroll-1", PeriodID: "period-1", Version: 1, Status: entity.PayrollStatusPaid,
+		}, nil)
+		payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-2").Return(&entity.Payroll{
+			ID: "payroll-2", PeriodID: "period-2", Version: 1, Status: entity.PayrollStatusDraft,
+		}, nil)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - changes, new and missing employees flagged",
+			authCredential: adminCredential,
+			fromPayrollID:  "payroll-1",
+			toPayrollID:    "payroll-2",
+			diff: entity.PayrollDiff{
+				From:       entity.PayrollRun{PayrollID: "payroll-1", PeriodID: "period-1", Version: 1, Status: entity.PayrollStatusPaid},
+				To:         entity.PayrollRun{PayrollID: "payroll-2", PeriodID: "period-2", Version: 1, Status: entity.PayrollStatusDraft},
+				Thresholds: thresholds,
+				Summary: entity.PayrollDiffSummary{
+					TotalEmployee:      entity.NewInt64Diff(3, 3),
+					NewEmployee:        1,
+					MissingEmployee:    1,
+					ChangedEmployee:    1,
+					AnomalyEmployee:    2,
+					TotalBaseSalary:    entity.NewMoneyDiff(money.New(2700), money.New(2700)),
+					TotalOvertimePay:   entity.NewMoneyDiff(money.New(20), money.New(140)),
+					TotalReimbursement: entity.NewMoneyDiff(money.New(50), money.New(50)),
+					TotalTakeHome:      entity.NewMoneyDiff(money.New(2770), money.MustParse("1862.50")),
+				},
+				Employees: []entity.PayslipDiff{
+					{
+						UserID:         "user-1",
+						Username:       "alice",
+						Status:         entity.PayslipDiffChanged,
+						BaseSalary:     entity.NewMoneyDiff(money.New(1000), money.New(1200)),
+						AttendanceDays: entity.NewInt64Diff(22, 16),
+						OvertimeHours:  entity.NewDurationDiff(2*time.Hour, 14*time.Hour),
+						OvertimePay:    entity.NewMoneyDiff(money.New(20), money.New(140)),
+						Reimbursement:  entity.NewMoneyDiff(money.New(0), money.New(0)),
+						TakeHome:       entity.NewMoneyDiff(money.New(1020), money.MustParse("1012.50")),
+						Anomalies: []entity.PayrollAnomaly{
+							entity.PayrollAnomalyBaseSalaryChange,
+							entity.PayrollAnomalyAttendanceDaysChange,
+							entity.PayrollAnomalyOvertimeHoursChange,
+						},
+					},
+					{
+						UserID:         "user-2",
+						Username:       "bob",
+						Status:         entity.PayslipDiffUnchanged,
+						BaseSalary:     entity.NewMoneyDiff(money.New(800), money.New(800)),
+						AttendanceDays: entity.NewInt64Diff(20, 20),
+						OvertimeHours:  entity.NewDurationDiff(0, 0),
+						OvertimePay:    entity.NewMoneyDiff(money.New(0), money.New(0)),
+						Reimbursement:  entity.NewMoneyDiff(money.New(50), money.New(50)),
+						TakeHome:       entity.NewMoneyDiff(money.New(850), money.New(850)),
+					},
+					{
+						UserID:         "user-3",
+						Username:       "carol",
+						Status:         entity.PayslipDiffMissing,
+						BaseSalary:     entity.NewMoneyDiff(money.New(900), money.New(0)),
+						AttendanceDays: entity.NewInt64Diff(21, 0),
+						OvertimeHours:  entity.NewDurationDiff(0, 0),
+						OvertimePay:    entity.NewMoneyDiff(money.New(0), money.New(0)),
+						Reimbursement:  entity.NewMoneyDiff(money.New(0), money.New(0)),
+						TakeHome:       entity.NewMoneyDiff(money.New(900), money.New(0)),
+					},
+					{
+						UserID:         "user-4",
+						Username:       "dave",
+						Status:         entity.PayslipDiffNew,
+						BaseSalary:     entity.NewMoneyDiff(money.New(0), money.New(700)),
+						AttendanceDays: entity.NewInt64Diff(0, 0),
+						OvertimeHours:  entity.NewDurationDiff(0, 0),
+						OvertimePay:    entity.NewMoneyDiff(money.New(0), money.New(0)),
+						Reimbursement:  entity.NewMoneyDiff(money.New(0), money.New(0)),
+						TakeHome:       entity.NewMoneyDiff(money.New(0), money.New(0)),
+						Anomalies:      []entity.PayrollAnomaly{entity.PayrollAnomalyZeroTakeHome},
+					},
+				},
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository, userRepo *mockUser.MockRepository) {
+				findPayrolls(payrollRepo)
+				userRepo.EXPECT().FindAllUsers(gomock.Any(), userEntity.FindUserOptions{}).Return(users, nil)
+				payrollRepo.EXPECT().FindPayslipByPayrollID(gomock.Any(), "payroll-1", entity.FindPayslipOptions{
+					MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID},
+				}).Return(marchPayslips, nil)
+				payrollRepo.EXPECT().FindPayslipByPayrollID(gomock.Any(), "payroll-2", entity.FindPayslipOptions{
+					MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID},
+				}).Return(aprilPayslips, nil)
+			},
+		},
+		{
+			name:           "error - payroll not found",
+			authCredential: adminCredential,
+			fromPayrollID:  "payroll-1",
+			toPayrollID:    "payroll-9",
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotFound),
+					Received:  "payroll-9",
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository, userRepo *mockUser.MockRepository) {
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-1").Return(&entity.Payroll{ID: "payroll-1"}, nil)
+				payrollRepo.EXPECT().FindPayrollByID(gomock.Any(), "payroll-9").Return(nil, nil)
+			},
+		},
+		{
+			name: "error - user not admin",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "testuser",
+				IsAdmin:   func(b bool) *bool { return &b }(false),
+				RequestID: "req-123",
+			},
+			fromPayrollID: "payroll-1",
+			toPayrollID:   "payroll-2",
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.PayrollNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.PayrollNotAuthorized),
+				},
+			),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository, userRepo *mockUser.MockRepository) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			userRepo := mockUser.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo, userRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				userRepo,
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.DiffPayrolls(context.Background(), tt.authCredential, tt.fromPayrollID, tt.toPayrollID, thresholds)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.diff, result)
+			}
+		})
+	}
+}
+
+func TestListMyPayslips(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		history        []entity.PayslipHistory
+		expectedErr    error
+		setupMock      func(payrollRepo *mockPayroll.MockRepository)
+	}
+
+	employeeCredential := authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+
+	history := []entity.PayslipHistory{
+		{
+			Payslip:         entity.Payslip{ID: "ps-2", UserID: "user-1", PayrollID: "payroll-2", TotalTakeHome: money.New(950)},
+			PayrollVersion:  1,
+			PayrollStatus:   entity.PayrollStatusApproved,
+			PeriodID:        "period-2",
+			PeriodStartDate: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			PeriodEndDate:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Payslip:         entity.Payslip{ID: "ps-1", UserID: "user-1", PayrollID: "payroll-1", TotalTakeHome: money.New(900)},
+			PayrollVersion:  2,
+			PayrollStatus:   entity.PayrollStatusPaid,
+			PeriodID:        "period-1",
+			PeriodStartDate: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			PeriodEndDate:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - approved and paid payslips listed",
+			authCredential: employeeCredential,
+			history:        history,
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", entity.FindPayslipHistoryOptions{
+					PayrollStatuses: []entity.PayrollStatus{entity.PayrollStatusApproved, entity.PayrollStatusPaid},
+				}).Return(history, nil)
+			},
+		},
+		{
+			name:           "error - find history fails",
+			authCredential: employeeCredential,
+			expectedErr:    errors.New("db error"),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", gomock.Any()).Return(nil, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				usecase.PayrollConfig{VerificationKey: testKey},
+			)
+			result, err := useCase.ListMyPayslips(context.Background(), tt.authCredential)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.history, result)
+			}
+		})
+	}
+}
+
+func TestShowPayslipYearToDate(t *testing.T) {
+	type testCase struct {
+		name          string
+		basis         entity.YearBasis
+		year          int64
+		yearToDate    entity.PayslipYearToDate
+		expectedErr   error
+		setupMock     func(payrollRepo *mockPayroll.MockRepository)
+		payrollConfig usecase.PayrollConfig
+	}
+
+	employeeCredential := authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+
+	// February 2025 is still in the fiscal year that started in April 2024
+	now := time.Date(2025, 2, 10, 9, 0, 0, 0, time.UTC)
+	statuses := []entity.PayrollStatus{entity.PayrollStatusApproved, entity.PayrollStatusPaid}
+	history := []entity.PayslipHistory{
+		{
+			Payslip: entity.Payslip{
+				ID: "ps-2", GrossPay: money.New(1100), OvertimePay: money.New(100), ReimbursementTotal: money.New(50),
+				TotalDeductions: money.New(110), TotalTakeHome: money.New(1040),
+			},
+		},
+		{
+			Payslip: entity.Payslip{
+				ID: "ps-1", GrossPay: money.New(1000), OvertimePay: money.New(0), ReimbursementTotal: money.New(0),
+				TotalDeductions: money.MustParse("99.50"), TotalTakeHome: money.MustParse("900.50"),
+			},
+		},
+	}
+
+	tests := []testCase{
+		{
+			name:          "success - calendar year",
+			basis:         entity.YearBasisCalendar,
+			year:          2024,
+			payrollConfig: usecase.PayrollConfig{VerificationKey: testKey, FiscalYearStartMonth: 4},
+			yearToDate: entity.PayslipYearToDate{
+				Basis:         entity.YearBasisCalendar,
+				Year:          2024,
+				StartDate:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:       time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+				TotalPayslip:  2,
+				GrossPay:      money.New(2100),
+				OvertimePay:   money.New(100),
+				Reimbursement: money.New(50),
+				Deductions:    money.MustParse("209.50"),
+				TakeHome:      money.MustParse("1940.50"),
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", entity.FindPayslipHistoryOptions{
+					PayrollStatuses: statuses,
+					PeriodEndFrom:   optional.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+					PeriodEndBefore: optional.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}).Return(history, nil)
+			},
+		},
+		{
+			name:          "success - current fiscal year",
+			basis:         entity.YearBasisFiscal,
+			payrollConfig: usecase.PayrollConfig{VerificationKey: testKey, FiscalYearStartMonth: 4},
+			yearToDate: entity.PayslipYearToDate{
+				Basis:     entity.YearBasisFiscal,
+				Year:      2024,
+				StartDate: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", entity.FindPayslipHistoryOptions{
+					PayrollStatuses: statuses,
+					PeriodEndFrom:   optional.NewTime(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)),
+					PeriodEndBefore: optional.NewTime(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)),
+				}).Return(nil, nil)
+			},
+		},
+		{
+			name:          "success - fiscal year follows the calendar when not configured",
+			basis:         entity.YearBasisFiscal,
+			payrollConfig: usecase.PayrollConfig{VerificationKey: testKey},
+			yearToDate: entity.PayslipYearToDate{
+				Basis:     entity.YearBasisFiscal,
+				Year:      2025,
+				StartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				EndDate:   time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", entity.FindPayslipHistoryOptions{
+					PayrollStatuses: statuses,
+					PeriodEndFrom:   optional.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					PeriodEndBefore: optional.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				}).Return(nil, nil)
+			},
+		},
+		{
+			name:          "error - find history fails",
+			basis:         entity.YearBasisCalendar,
+			year:          2024,
+			payrollConfig: usecase.PayrollConfig{VerificationKey: testKey},
+			expectedErr:   errors.New("db error"),
+			setupMock: func(payrollRepo *mockPayroll.MockRepository) {
+				payrollRepo.EXPECT().FindPayslipHistoryByUserID(gomock.Any(), "user-1", gomock.Any()).Return(nil, errors.New("db error"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := gomonkey.ApplyFunc(time.Now, func() time.Time {
+				return now
+			})
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payrollRepo := mockPayroll.NewMockRepository(ctrl)
+			tt.setupMock(payrollRepo)
+
+			useCase := usecase.NewPayrollUseCase(
+				payrollRepo,
+				mockUser.NewMockRepository(ctrl),
+				mockAtt.NewMockRepository(ctrl),
+				mockOvertime.NewMockRepository(ctrl),
+				mockReimbursement.NewMockRepository(ctrl),
+				mockHoliday.NewMockRepository(ctrl),
+				mockSalary.NewMockRepository(ctrl),
+				mockBankAccount.NewMockRepository(ctrl),
+				mockAdjustment.NewMockRepository(ctrl),
+				mockLoan.NewMockRepository(ctrl),
+				mockLeave.NewMockRepository(ctrl),
+				tt.payrollConfig,
+			)
+			result, err := useCase.ShowPayslipYearToDate(context.Background(), employeeCredential, tt.basis, tt.year)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.yearToDate, result)
 			}
 		})
 	}
@@ -0,0 +1,65 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/payroll"
+	"go.uber.org/zap"
+)
+
+const defaultPollInterval = 2 * time.Second
+
+type PayrollJobWorkerConfig struct {
+	// PollInterval is how long an idle worker waits before looking for a
+	// queued job again.
+	PollInterval time.Duration
+}
+
+// PayrollJobWorker runs queued payroll jobs one at a time. Several workers,
+// in one or many instances of the service, can share the same queue.
+type PayrollJobWorker struct {
+	uc           payroll.UseCase
+	logger       *zap.SugaredLogger
+	pollInterval time.Duration
+}
+
+func NewPayrollJobWorker(uc payroll.UseCase, logger *zap.SugaredLogger, config PayrollJobWorkerConfig) *PayrollJobWorker {
+	pollInterval := config.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+
+	return &PayrollJobWorker{
+		uc:           uc,
+		logger:       logger,
+		pollInterval: pollInterval,
+	}
+}
+
+// Run processes jobs until ctx is cancelled. A job interrupted by the
+// cancellation is put back in the queue.
+func (w *PayrollJobWorker) Run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		processed, err := w.uc.ProcessNextPayrollJob(ctx)
+		if err != nil {
+			w.logger.Errorf("PayrollJobWorker.Run().ProcessNextPayrollJob(): %v", err)
+		}
+
+		// Keep draining the queue while there is work
+		if processed {
+			timer.Reset(0)
+			continue
+		}
+		timer.Reset(w.pollInterval)
+	}
+}
@@ -0,0 +1,14 @@
+package entity
+
+const (
+	ReimbursementPeriodClosed = "REIMBURSEMENT_PERIOD_CLOSED"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case ReimbursementPeriodClosed:
+		return "Reimbursement cannot be submitted, the payroll of the period has already been generated"
+	default:
+		return "An unknown error occurred"
+	}
+}
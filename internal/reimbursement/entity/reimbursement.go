@@ -3,13 +3,14 @@ package entity
 import (
 	"time"
 
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
 type Reimbursement struct {
 	ID                string          `db:"id"`
 	UserID            string          `db:"user_id"`
-	Amount            int64           `db:"amount"`
+	Amount            money.Money     `db:"amount"`
 	Description       optional.String `db:"description"`
 	ReimbursementDate time.Time       `db:"reimbursement_date"`
 	CreatedAt         time.Time       `db:"created_at"`
@@ -20,7 +21,7 @@ type Reimbursement struct {
 }
 
 type SubmitReimbursement struct {
-	Amount      int64
+	Amount      money.Money
 	Date        time.Time
 	Description optional.String
 }
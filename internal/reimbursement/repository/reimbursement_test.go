@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vnnyx/employee-management/internal/reimbursement/entity"
 	"github.com/vnnyx/employee-management/internal/reimbursement/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
@@ -39,7 +40,7 @@ func TestStoreNewReimbursement(t *testing.T) {
 			input: entity.Reimbursement{
 				ID:                "rb-1",
 				UserID:            "user-1",
-				Amount:            5000,
+				Amount:            money.New(5000),
 				Description:       optional.NewString("Travel expenses"),
 				ReimbursementDate: now,
 				CreatedAt:         now,
@@ -115,8 +116,8 @@ func TestFindReimbursementByPeriod(t *testing.T) {
 					"id", "user_id", "amount", "description", "reimbursement_date",
 					"created_at", "updated_at", "created_by", "updated_by", "ip_address",
 				}).
-					AddRow("rb-1", "user-1", 1000, "desc", now, now, now, "admin", "admin", "127.0.0.1").
-					AddRow("rb-2", "user-2", 2000, "desc", now, now, now, "admin", "admin", "127.0.0.1")
+					AddRow("rb-1", "user-1", "1000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1").
+					AddRow("rb-2", "user-2", "2000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1")
 
 				mock.ExpectQuery("SELECT (.+) FROM reimbursements").
 					WithArgs(startDate, endDate).
@@ -133,8 +134,8 @@ func TestFindReimbursementByPeriod(t *testing.T) {
 					"id", "user_id", "amount", "description", "reimbursement_date",
 					"created_at", "updated_at", "created_by", "updated_by", "ip_address",
 				}).
-					AddRow("rb-1", "user-1", 1000, "desc", now, now, now, "admin", "admin", "127.0.0.1").
-					AddRow("rb-2", "user-1", 2000, "desc", now, now, now, "admin", "admin", "127.0.0.1")
+					AddRow("rb-1", "user-1", "1000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1").
+					AddRow("rb-2", "user-1", "2000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1")
 
 				mock.ExpectQuery("SELECT (.+) FROM reimbursements").
 					WithArgs(startDate, endDate).
@@ -171,7 +172,7 @@ func TestFindReimbursementByPeriod(t *testing.T) {
 					"id", "user_id", "amount", "description", "reimbursement_date",
 					"created_at", "updated_at", "created_by", "updated_by", "ip_address",
 				}).
-					AddRow("rb-1", "user-1", 1000, "desc", now, now, now, "admin", "admin", "127.0.0.1")
+					AddRow("rb-1", "user-1", "1000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1")
 
 				mock.ExpectQuery("SELECT (.+) FROM reimbursements").
 					WithArgs(startDate, endDate).
@@ -235,7 +236,7 @@ func TestFindReimbursementByUserIDPeriod(t *testing.T) {
 					WillReturnRows(pgxmock.NewRows([]string{
 						"id", "user_id", "amount", "description", "reimbursement_date",
 						"created_at", "updated_at", "created_by", "updated_by", "ip_address",
-					}).AddRow("rb-1", "user-1", 1000, "desc", now, now, now, "admin", "admin", "127.0.0.1"))
+					}).AddRow("rb-1", "user-1", "1000.00", "desc", now, now, now, "admin", "admin", "127.0.0.1"))
 			},
 			expectErr: false,
 			expectLen: 1,
@@ -7,20 +7,24 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/attendance"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
 	"github.com/vnnyx/employee-management/internal/reimbursement"
 	"github.com/vnnyx/employee-management/internal/reimbursement/entity"
+	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
 	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
 )
 
 type reimbursementUseCase struct {
 	reimbursementRepo reimbursement.Repository
+	attendanceRepo    attendance.Repository
 }
 
-func NewReimbursementUseCase(reimbursementRepo reimbursement.Repository) reimbursement.UseCase {
+func NewReimbursementUseCase(reimbursementRepo reimbursement.Repository, attendanceRepo attendance.Repository) reimbursement.UseCase {
 	return &reimbursementUseCase{
 		reimbursementRepo: reimbursementRepo,
+		attendanceRepo:    attendanceRepo,
 	}
 }
 
@@ -33,9 +37,24 @@ func (u *reimbursementUseCase) SubmitReimbursement(ctx context.Context, authCred
 
 	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
 		reimbursementRepoTx := u.reimbursementRepo.WithTx(tx)
+		attendanceRepoTx := u.attendanceRepo.WithTx(tx)
+
+		closedPeriod, err := attendanceRepoTx.FindClosedPeriodByDate(ctx, payload.Date)
+		if err != nil {
+			return errors.Wrap(err, "ReimbursementUseCase.SubmitReimbursement().FindClosedPeriodByDate()")
+		}
+		if closedPeriod != nil {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.ReimbursementPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.ReimbursementPeriodClosed),
+					Received:  closedPeriod.ID,
+				},
+			)
+		}
 
 		timeNow := time.Now()
-		err := reimbursementRepoTx.StoreNewReimbursement(ctx, entity.Reimbursement{
+		err = reimbursementRepoTx.StoreNewReimbursement(ctx, entity.Reimbursement{
 			ID:                uuid.NewString(),
 			UserID:            authCredential.UserID,
 			Amount:            payload.Amount,
@@ -9,11 +9,15 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
+	attendanceEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
+	mockattendance "github.com/vnnyx/employee-management/internal/attendance/mock"
 	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
 	"github.com/vnnyx/employee-management/internal/reimbursement/entity"
 	mockreimbursement "github.com/vnnyx/employee-management/internal/reimbursement/mock"
 	"github.com/vnnyx/employee-management/internal/reimbursement/usecase"
+	"github.com/vnnyx/employee-management/pkg/apperror"
 	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 	"go.uber.org/mock/gomock"
 )
@@ -23,6 +27,7 @@ func TestSubmitReimbursement(t *testing.T) {
 		name           string
 		authCredential authCredential.Credential
 		payload        entity.SubmitReimbursement
+		closedPeriod   *attendanceEntity.ClosedAttendancePeriod
 		mockError      error
 		expectedErr    error
 	}{
@@ -34,7 +39,7 @@ func TestSubmitReimbursement(t *testing.T) {
 				Username:  "tester",
 			},
 			payload: entity.SubmitReimbursement{
-				Amount:      100000,
+				Amount:      money.New(100000),
 				Date:        time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC),
 				Description: optional.NewString("Lunch with client"),
 			},
@@ -49,7 +54,7 @@ func TestSubmitReimbursement(t *testing.T) {
 				Username:  "tester2",
 			},
 			payload: entity.SubmitReimbursement{
-				Amount:      200000,
+				Amount:      money.New(200000),
 				Date:        time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC),
 				Description: optional.NewString("Taxi to airport"),
 			},
@@ -58,6 +63,29 @@ func TestSubmitReimbursement(t *testing.T) {
 				"ReimbursementUseCase.SubmitReimbursement().WithAuditContext(): ReimbursementUseCase.SubmitReimbursement().StoreNewReimbursement(): db error",
 			),
 		},
+		{
+			name: "error - period closed by its payroll",
+			authCredential: authCredential.Credential{
+				UserID:    "user-1",
+				IPAddress: "127.0.0.1",
+				Username:  "tester",
+			},
+			payload: entity.SubmitReimbursement{
+				Amount:      money.New(100000),
+				Date:        time.Date(2025, 6, 7, 0, 0, 0, 0, time.UTC),
+				Description: optional.NewString("Lunch with client"),
+			},
+			closedPeriod: &attendanceEntity.ClosedAttendancePeriod{
+				AttendancePeriod: attendanceEntity.AttendancePeriod{ID: "period-1"},
+				PayrollID:        "payroll-1",
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.ReimbursementPeriodClosed,
+					Message:   entity.GetErrorMessageByIssueCode(entity.ReimbursementPeriodClosed),
+				},
+			),
+		},
 	}
 
 	for _, tt := range tests {
@@ -76,20 +104,31 @@ func TestSubmitReimbursement(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockRepo := mockreimbursement.NewMockRepository(ctrl)
-			useCase := usecase.NewReimbursementUseCase(mockRepo)
+			mockAttendanceRepo := mockattendance.NewMockRepository(ctrl)
+			useCase := usecase.NewReimbursementUseCase(mockRepo, mockAttendanceRepo)
 
 			mockRepoTx := mockreimbursement.NewMockRepository(ctrl)
 			mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockRepoTx)
 
-			mockRepoTx.
+			mockAttendanceRepoTx := mockattendance.NewMockRepository(ctrl)
+			mockAttendanceRepo.EXPECT().WithTx(gomock.Any()).Return(mockAttendanceRepoTx)
+
+			mockAttendanceRepoTx.
 				EXPECT().
-				StoreNewReimbursement(gomock.Any(), gomock.Any()).
-				Return(tt.mockError)
+				FindClosedPeriodByDate(gomock.Any(), tt.payload.Date).
+				Return(tt.closedPeriod, nil)
+
+			if tt.closedPeriod == nil {
+				mockRepoTx.
+					EXPECT().
+					StoreNewReimbursement(gomock.Any(), gomock.Any()).
+					Return(tt.mockError)
+			}
 
 			err := useCase.SubmitReimbursement(context.Background(), tt.authCredential, tt.payload)
 
 			if tt.expectedErr != nil {
-				assert.EqualError(t, err, tt.expectedErr.Error())
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
 			} else {
 				assert.NoError(t, err)
 			}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/report"
+	"github.com/vnnyx/employee-management/internal/report/document"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type ReportHandler struct {
+	uc report.UseCase
+}
+
+func NewReportHandler(uc report.UseCase) *ReportHandler {
+	return &ReportHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      Show Payroll Cost Report
+// @Description  Break the cost of the payrolls of the periods starting within a range down by component, per period and optionally per department or cost centre, with the change of the total cost from the previous period. Voided payrolls are left out.
+// @Tags         Report
+// @Produce      json
+// @Param        from query string true "First period start date (YYYY-MM-DD)"
+// @Param        to query string true "Last period start date (YYYY-MM-DD)"
+// @Param        group_by query string false "Group the payslips by" Enums(none, department, cost_centre) default(none)
+// @Success      200 {object} dtos.Response{data=dtos.PayrollCostReportResponse} "Payroll Cost Report Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/reports/payroll-cost [GET]
+// @Security     BearerAuth
+func (h *ReportHandler) ShowPayrollCostReport(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"ReportHandler.ShowPayrollCostReport()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.PayrollCostReportRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "ReportHandler().ShowPayrollCostReport().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "ReportHandler().ShowPayrollCostReport().req.Validate()")
+	}
+
+	data, err := h.uc.ShowPayrollCostReport(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "ReportHandler().ShowPayrollCostReport().uc.ShowPayrollCostReport()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewPayrollCostReportResponse(data),
+		},
+	)
+}
+
+// @Summary      Download Payroll Cost Report
+// @Description  Download the payroll cost report as CSV, one row per period or per group within a period
+// @Tags         Report
+// @Produce      text/csv
+// @Param        from query string true "First period start date (YYYY-MM-DD)"
+// @Param        to query string true "Last period start date (YYYY-MM-DD)"
+// @Param        group_by query string false "Group the payslips by" Enums(none, department, cost_centre) default(none)
+// @Success      200 {file} file "Payroll cost report"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/reports/payroll-cost.csv [GET]
+// @Security     BearerAuth
+func (h *ReportHandler) DownloadPayrollCostReport(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"ReportHandler.DownloadPayrollCostReport()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var req dtos.PayrollCostReportRequest
+	if err := c.QueryParser(&req); err != nil {
+		return errors.Wrap(err, "ReportHandler().DownloadPayrollCostReport().c.QueryParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "ReportHandler().DownloadPayrollCostReport().req.Validate()")
+	}
+
+	data, err := h.uc.ShowPayrollCostReport(ctx, authCredential, req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "ReportHandler().DownloadPayrollCostReport().uc.ShowPayrollCostReport()")
+	}
+
+	var buf bytes.Buffer
+	err = document.WritePayrollCostCSV(&buf, data)
+	if err != nil {
+		return errors.Wrap(err, "ReportHandler().DownloadPayrollCostReport().document.WritePayrollCostCSV()")
+	}
+
+	c.Set(fiber.HeaderContentType, "text/csv")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, document.PayrollCostFileName(data)))
+	return c.Status(fiber.StatusOK).Send(buf.Bytes())
+}
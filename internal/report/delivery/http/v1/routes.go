@@ -0,0 +1,10 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapReport(routes fiber.Router, h *ReportHandler) {
+	reports := routes.Group("/reports")
+
+	reports.Get("/payroll-cost", h.ShowPayrollCostReport)
+	reports.Get("/payroll-cost.csv", h.DownloadPayrollCostReport)
+}
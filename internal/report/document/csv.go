@@ -0,0 +1,93 @@
+package document
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+)
+
+const fileDateFormat = "2006-01-02"
+
+// PayrollCostFileName is the name a payroll cost report is downloaded as.
+func PayrollCostFileName(report entity.PayrollCostReport) string {
+	name := fmt.Sprintf("payroll-cost-%s-%s", report.StartDate.Format(fileDateFormat), report.EndDate.Format(fileDateFormat))
+	if report.GroupBy != entity.GroupByNone {
+		name += "-by-" + string(report.GroupBy)
+	}
+	return name + ".csv"
+}
+
+// WritePayrollCostCSV writes one row per period, or per group within a period
+// when the report is grouped, oldest period first. Payslips without a
+// department or cost centre are written with an empty group.
+func WritePayrollCostCSV(w io.Writer, report entity.PayrollCostReport) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"period_start_date", "period_end_date", "payroll_status"}
+	if report.GroupBy != entity.GroupByNone {
+		header = append(header, string(report.GroupBy))
+	}
+	header = append(header,
+		"headcount",
+		"base_pay",
+		"overtime_pay",
+		"earnings",
+		"gross_pay",
+		"reimbursement",
+		"deductions",
+		"take_home_pay",
+		"total_cost",
+		"total_cost_change",
+	)
+	if err := cw.Write(header); err != nil {
+		return errors.Wrap(err, "document.WritePayrollCostCSV().Write()")
+	}
+
+	for _, period := range report.Periods {
+		groups := []entity.PayrollCostGroup{period.Total}
+		if report.GroupBy != entity.GroupByNone {
+			groups = period.Groups
+		}
+
+		for _, group := range groups {
+			record := []string{
+				period.StartDate.Format(fileDateFormat),
+				period.EndDate.Format(fileDateFormat),
+				period.PayrollStatus,
+			}
+			if report.GroupBy != entity.GroupByNone {
+				record = append(record, group.Key.GetOrDefault())
+			}
+
+			totalCostChange := ""
+			if change, ok := group.TotalCostChange.Get(); ok {
+				totalCostChange = change.String()
+			}
+
+			record = append(record,
+				fmt.Sprint(group.Cost.Headcount),
+				group.Cost.BasePay.String(),
+				group.Cost.OvertimePay.String(),
+				group.Cost.Earnings.String(),
+				group.Cost.GrossPay.String(),
+				group.Cost.Reimbursement.String(),
+				group.Cost.Deductions.String(),
+				group.Cost.TakeHome.String(),
+				group.Cost.TotalCost.String(),
+				totalCostChange,
+			)
+			if err := cw.Write(record); err != nil {
+				return errors.Wrap(err, "document.WritePayrollCostCSV().Write()")
+			}
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return errors.Wrap(err, "document.WritePayrollCostCSV().Flush()")
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package entity
+
+const (
+	ReportNotAuthorized = "REPORT_NOT_AUTHORIZED"
+	ReportInvalidRange  = "REPORT_INVALID_RANGE"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case ReportNotAuthorized:
+		return "You are not authorized to view reports"
+	case ReportInvalidRange:
+		return "The end of the report range cannot be before its start"
+	default:
+		return "An unknown error occurred"
+	}
+}
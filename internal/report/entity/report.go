@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+type GroupBy string
+
+const (
+	GroupByNone       GroupBy = "none"
+	GroupByDepartment GroupBy = "department"
+	GroupByCostCentre GroupBy = "cost_centre"
+)
+
+// PayrollCostFilter selects the payrolls of the periods starting between
+// StartDate and EndDate, both included.
+type PayrollCostFilter struct {
+	StartDate time.Time
+	EndDate   time.Time
+	GroupBy   GroupBy
+}
+
+// PayrollCost breaks the payslips of a payroll down by component. BasePay is
+// the attendance pay, Earnings are the allowances and adjustments, taxable or
+// not. TotalCost is what the company pays out: the gross pay, non-taxable
+// earnings and reimbursements, deductions included.
+type PayrollCost struct {
+	Headcount     int64       `db:"headcount"`
+	BasePay       money.Money `db:"base_pay"`
+	OvertimePay   money.Money `db:"overtime_pay"`
+	Earnings      money.Money `db:"earnings"`
+	GrossPay      money.Money `db:"gross_pay"`
+	Reimbursement money.Money `db:"reimbursement"`
+	Deductions    money.Money `db:"deductions"`
+	TakeHome      money.Money `db:"take_home"`
+	TotalCost     money.Money `db:"total_cost"`
+}
+
+func (c PayrollCost) Add(other PayrollCost) PayrollCost {
+	return PayrollCost{
+		Headcount:     c.Headcount + other.Headcount,
+		BasePay:       c.BasePay.Add(other.BasePay),
+		OvertimePay:   c.OvertimePay.Add(other.OvertimePay),
+		Earnings:      c.Earnings.Add(other.Earnings),
+		GrossPay:      c.GrossPay.Add(other.GrossPay),
+		Reimbursement: c.Reimbursement.Add(other.Reimbursement),
+		Deductions:    c.Deductions.Add(other.Deductions),
+		TakeHome:      c.TakeHome.Add(other.TakeHome),
+		TotalCost:     c.TotalCost.Add(other.TotalCost),
+	}
+}
+
+// PayrollCostRow is the cost of a group within the payroll of a period.
+// GroupKey is empty for payslips without a department or cost centre, and
+// for every payslip when the report is not grouped.
+type PayrollCostRow struct {
+	PeriodID        string          `db:"period_id"`
+	PeriodStartDate time.Time       `db:"period_start_date"`
+	PeriodEndDate   time.Time       `db:"period_end_date"`
+	PayrollID       string          `db:"payroll_id"`
+	PayrollStatus   string          `db:"payroll_status"`
+	GroupKey        optional.String `db:"group_key"`
+	PayrollCost
+}
+
+// PayrollCostGroup is the cost of a group within a period. TotalCostChange
+// compares it with the same group in the previous period of the report, it is
+// empty when the group has no cost there.
+type PayrollCostGroup struct {
+	Key             optional.String
+	Cost            PayrollCost
+	TotalCostChange optional.Money
+}
+
+type PayrollCostPeriod struct {
+	PeriodID      string
+	StartDate     time.Time
+	EndDate       time.Time
+	PayrollID     string
+	PayrollStatus string
+	Total         PayrollCostGroup
+	Groups        []PayrollCostGroup
+}
+
+// PayrollCostReport holds the cost of the payrolls of every period in the
+// range, oldest first, to follow the trend across periods.
+type PayrollCostReport struct {
+	StartDate time.Time
+	EndDate   time.Time
+	GroupBy   GroupBy
+	Periods   []PayrollCostPeriod
+	Total     PayrollCost
+}
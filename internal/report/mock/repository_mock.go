@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/report/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/report/repository.go -destination internal/report/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	report "github.com/vnnyx/employee-management/internal/report"
+	entity "github.com/vnnyx/employee-management/internal/report/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindPayrollCosts mocks base method.
+func (m *MockRepository) FindPayrollCosts(ctx context.Context, filter entity.PayrollCostFilter) ([]entity.PayrollCostRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayrollCosts", ctx, filter)
+	ret0, _ := ret[0].([]entity.PayrollCostRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayrollCosts indicates an expected call of FindPayrollCosts.
+func (mr *MockRepositoryMockRecorder) FindPayrollCosts(ctx, filter any) *MockRepositoryFindPayrollCostsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayrollCosts", reflect.TypeOf((*MockRepository)(nil).FindPayrollCosts), ctx, filter)
+	return &MockRepositoryFindPayrollCostsCall{Call: call}
+}
+
+// MockRepositoryFindPayrollCostsCall wrap *gomock.Call
+type MockRepositoryFindPayrollCostsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindPayrollCostsCall) Return(arg0 []entity.PayrollCostRow, arg1 error) *MockRepositoryFindPayrollCostsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindPayrollCostsCall) Do(f func(context.Context, entity.PayrollCostFilter) ([]entity.PayrollCostRow, error)) *MockRepositoryFindPayrollCostsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindPayrollCostsCall) DoAndReturn(f func(context.Context, entity.PayrollCostFilter) ([]entity.PayrollCostRow, error)) *MockRepositoryFindPayrollCostsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) report.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(report.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 report.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) report.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) report.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
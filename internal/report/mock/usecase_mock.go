@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/report/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/report/usecase.go -destination internal/report/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/report/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ShowPayrollCostReport mocks base method.
+func (m *MockUseCase) ShowPayrollCostReport(ctx context.Context, authCredential entity.Credential, filter entity0.PayrollCostFilter) (entity0.PayrollCostReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShowPayrollCostReport", ctx, authCredential, filter)
+	ret0, _ := ret[0].(entity0.PayrollCostReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ShowPayrollCostReport indicates an expected call of ShowPayrollCostReport.
+func (mr *MockUseCaseMockRecorder) ShowPayrollCostReport(ctx, authCredential, filter any) *MockUseCaseShowPayrollCostReportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowPayrollCostReport", reflect.TypeOf((*MockUseCase)(nil).ShowPayrollCostReport), ctx, authCredential, filter)
+	return &MockUseCaseShowPayrollCostReportCall{Call: call}
+}
+
+// MockUseCaseShowPayrollCostReportCall wrap *gomock.Call
+type MockUseCaseShowPayrollCostReportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseShowPayrollCostReportCall) Return(arg0 entity0.PayrollCostReport, arg1 error) *MockUseCaseShowPayrollCostReportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseShowPayrollCostReportCall) Do(f func(context.Context, entity.Credential, entity0.PayrollCostFilter) (entity0.PayrollCostReport, error)) *MockUseCaseShowPayrollCostReportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseShowPayrollCostReportCall) DoAndReturn(f func(context.Context, entity.Credential, entity0.PayrollCostFilter) (entity0.PayrollCostReport, error)) *MockUseCaseShowPayrollCostReportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
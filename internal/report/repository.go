@@ -0,0 +1,14 @@
+package report
+
+import (
+	"context"
+
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	FindPayrollCosts(ctx context.Context, filter entity.PayrollCostFilter) ([]entity.PayrollCostRow, error)
+}
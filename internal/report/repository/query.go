@@ -0,0 +1,45 @@
+package repository
+
+// Payslips are grouped by the department and cost centre they were generated
+// with. Base pay is what is left of the gross pay without overtime and the
+// taxable earnings, the non-taxable ones are paid on top of it.
+const findPayrollCostsQuery = `
+SELECT
+	ap.id AS period_id,
+	ap.start_date AS period_start_date,
+	ap.end_date AS period_end_date,
+	p.id AS payroll_id,
+	p.status AS payroll_status,
+	CASE $3::TEXT
+		WHEN 'department' THEN ps.department
+		WHEN 'cost_centre' THEN ps.cost_centre
+	END AS group_key,
+	COUNT(ps.id) AS headcount,
+	SUM(ps.gross_pay - COALESCE(ps.overtime_pay, 0) - COALESCE(it.taxable_earnings, 0)) AS base_pay,
+	SUM(COALESCE(ps.overtime_pay, 0)) AS overtime_pay,
+	SUM(COALESCE(it.earnings, 0)) AS earnings,
+	SUM(ps.gross_pay) AS gross_pay,
+	SUM(COALESCE(ps.reimbursement_total, 0)) AS reimbursement,
+	SUM(ps.total_deductions) AS deductions,
+	SUM(COALESCE(ps.total_take_home, 0)) AS take_home,
+	SUM(
+		ps.gross_pay
+		+ COALESCE(it.earnings, 0) - COALESCE(it.taxable_earnings, 0)
+		+ COALESCE(ps.reimbursement_total, 0)
+	) AS total_cost
+FROM payslips ps
+JOIN payrolls p ON p.id = ps.payroll_id
+JOIN attendance_periods ap ON ap.id = p.period_id
+LEFT JOIN LATERAL (
+	SELECT
+		SUM(pi.amount) AS earnings,
+		SUM(pi.amount) FILTER (WHERE pi.taxable) AS taxable_earnings
+	FROM payslip_items pi
+	WHERE pi.payslip_id = ps.id AND pi.item_type IN ('allowance', 'adjustment')
+) it ON TRUE
+WHERE p.voided_at IS NULL
+	AND ps.voided_at IS NULL
+	AND ap.start_date BETWEEN $1::DATE AND $2::DATE
+GROUP BY ap.id, ap.start_date, ap.end_date, p.id, p.status, group_key
+ORDER BY ap.start_date ASC, group_key ASC NULLS LAST
+`
@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/report"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type reportRepo struct {
+	db database.Queryer
+}
+
+func NewReportRepository(db database.Queryer) report.Repository {
+	return &reportRepo{
+		db: db,
+	}
+}
+
+func (r *reportRepo) WithTx(tx database.DBTx) report.Repository {
+	return &reportRepo{
+		db: tx,
+	}
+}
+
+func (r *reportRepo) FindPayrollCosts(ctx context.Context, filter entity.PayrollCostFilter) ([]entity.PayrollCostRow, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"ReportRepository.FindPayrollCosts()",
+	)
+	defer span.End()
+
+	var rows []entity.PayrollCostRow
+	err := pgxscan.Select(ctx, r.db, &rows, findPayrollCostsQuery, filter.StartDate, filter.EndDate, string(filter.GroupBy))
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return rows, nil
+}
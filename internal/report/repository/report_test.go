@@ -0,0 +1,116 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	"github.com/vnnyx/employee-management/internal/report/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+func TestFindPayrollCosts(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewReportRepository(mock)
+	periodStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	filter := entity.PayrollCostFilter{
+		StartDate: periodStart,
+		EndDate:   time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		GroupBy:   entity.GroupByDepartment,
+	}
+	columns := []string{
+		"period_id", "period_start_date", "period_end_date", "payroll_id", "payroll_status", "group_key",
+		"headcount", "base_pay", "overtime_pay", "earnings", "gross_pay", "reimbursement", "deductions", "take_home", "total_cost",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expected  []entity.PayrollCostRow
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslips").
+					WithArgs(filter.StartDate, filter.EndDate, "department").
+					WillReturnRows(pgxmock.NewRows(columns).
+						AddRow("period-1", periodStart, periodEnd, "payroll-1", "paid", "Engineering",
+							int64(2), money.New(3000), money.New(200), money.New(100), money.New(3250), money.New(50), money.New(400), money.New(2950), money.New(3350)).
+						AddRow("period-1", periodStart, periodEnd, "payroll-1", "paid", nil,
+							int64(1), money.New(1000), money.New(0), money.New(0), money.New(1000), money.New(0), money.New(100), money.New(900), money.New(1000)))
+			},
+			expected: []entity.PayrollCostRow{
+				{
+					PeriodID:        "period-1",
+					PeriodStartDate: periodStart,
+					PeriodEndDate:   periodEnd,
+					PayrollID:       "payroll-1",
+					PayrollStatus:   "paid",
+					GroupKey:        optional.NewString("Engineering"),
+					PayrollCost: entity.PayrollCost{
+						Headcount:     2,
+						BasePay:       money.New(3000),
+						OvertimePay:   money.New(200),
+						Earnings:      money.New(100),
+						GrossPay:      money.New(3250),
+						Reimbursement: money.New(50),
+						Deductions:    money.New(400),
+						TakeHome:      money.New(2950),
+						TotalCost:     money.New(3350),
+					},
+				},
+				{
+					PeriodID:        "period-1",
+					PeriodStartDate: periodStart,
+					PeriodEndDate:   periodEnd,
+					PayrollID:       "payroll-1",
+					PayrollStatus:   "paid",
+					PayrollCost: entity.PayrollCost{
+						Headcount:   1,
+						BasePay:     money.New(1000),
+						OvertimePay: money.New(0),
+						Earnings:    money.New(0),
+						GrossPay:    money.New(1000),
+						Deductions:  money.New(100),
+						TakeHome:    money.New(900),
+						TotalCost:   money.New(1000),
+					},
+				},
+			},
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM payslips").
+					WithArgs(filter.StartDate, filter.EndDate, "department").
+					WillReturnError(errors.New("query failed"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			rows, err := repo.FindPayrollCosts(context.Background(), filter)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
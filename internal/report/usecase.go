@@ -0,0 +1,12 @@
+package report
+
+import (
+	"context"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+)
+
+type UseCase interface {
+	ShowPayrollCostReport(ctx context.Context, authCredential authCredential.Credential, filter entity.PayrollCostFilter) (entity.PayrollCostReport, error)
+}
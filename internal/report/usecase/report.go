@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/report"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+const reportDateFormat = "2006-01-02"
+
+type reportUseCase struct {
+	reportRepo report.Repository
+}
+
+func NewReportUseCase(reportRepo report.Repository) report.UseCase {
+	return &reportUseCase{
+		reportRepo: reportRepo,
+	}
+}
+
+// ShowPayrollCostReport reports the cost of the payrolls that were not voided,
+// whatever their approval status, so finance can follow a draft as well.
+func (u *reportUseCase) ShowPayrollCostReport(ctx context.Context, authCredential authCredential.Credential, filter entity.PayrollCostFilter) (entity.PayrollCostReport, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"ReportUseCase.ShowPayrollCostReport()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.PayrollCostReport{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.ReportNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.ReportNotAuthorized),
+			},
+		)
+	}
+
+	if filter.EndDate.Before(filter.StartDate) {
+		return entity.PayrollCostReport{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.ReportInvalidRange,
+				Message:   entity.GetErrorMessageByIssueCode(entity.ReportInvalidRange),
+				Received:  filter.EndDate.Format(reportDateFormat),
+				Expected:  filter.StartDate.Format(reportDateFormat),
+			},
+		)
+	}
+
+	rows, err := u.reportRepo.FindPayrollCosts(ctx, filter)
+	if err != nil {
+		return entity.PayrollCostReport{}, errors.Wrap(err, "ReportUseCase.ShowPayrollCostReport().FindPayrollCosts()")
+	}
+
+	return newPayrollCostReport(filter, rows), nil
+}
+
+// newPayrollCostReport folds the rows, ordered by period, into one entry per
+// period and compares the total cost of every group with the period before.
+func newPayrollCostReport(filter entity.PayrollCostFilter, rows []entity.PayrollCostRow) entity.PayrollCostReport {
+	report := entity.PayrollCostReport{
+		StartDate: filter.StartDate,
+		EndDate:   filter.EndDate,
+		GroupBy:   filter.GroupBy,
+	}
+
+	for _, row := range rows {
+		if len(report.Periods) == 0 || report.Periods[len(report.Periods)-1].PeriodID != row.PeriodID {
+			report.Periods = append(report.Periods, entity.PayrollCostPeriod{
+				PeriodID:      row.PeriodID,
+				StartDate:     row.PeriodStartDate,
+				EndDate:       row.PeriodEndDate,
+				PayrollID:     row.PayrollID,
+				PayrollStatus: row.PayrollStatus,
+			})
+		}
+
+		period := &report.Periods[len(report.Periods)-1]
+		period.Total.Cost = period.Total.Cost.Add(row.PayrollCost)
+		if filter.GroupBy != entity.GroupByNone {
+			period.Groups = append(period.Groups, entity.PayrollCostGroup{
+				Key:  row.GroupKey,
+				Cost: row.PayrollCost,
+			})
+		}
+		report.Total = report.Total.Add(row.PayrollCost)
+	}
+
+	for i := 1; i < len(report.Periods); i++ {
+		previous := report.Periods[i-1]
+		period := &report.Periods[i]
+
+		period.Total.TotalCostChange = optional.NewMoney(period.Total.Cost.TotalCost.Sub(previous.Total.Cost.TotalCost))
+
+		previousCosts := make(map[string]money.Money, len(previous.Groups))
+		for _, group := range previous.Groups {
+			previousCosts[group.Key.GetOrDefault()] = group.Cost.TotalCost
+		}
+		for j := range period.Groups {
+			if previousCost, ok := previousCosts[period.Groups[j].Key.GetOrDefault()]; ok {
+				period.Groups[j].TotalCostChange = optional.NewMoney(period.Groups[j].Cost.TotalCost.Sub(previousCost))
+			}
+		}
+	}
+
+	return report
+}
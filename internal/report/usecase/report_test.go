@@ -0,0 +1,221 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/report/entity"
+	mockReport "github.com/vnnyx/employee-management/internal/report/mock"
+	"github.com/vnnyx/employee-management/internal/report/usecase"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+type mockParams struct {
+	reportRepo *mockReport.MockRepository
+}
+
+func TestShowPayrollCostReport(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		filter         entity.PayrollCostFilter
+		expectedReport entity.PayrollCostReport
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	januaryStart := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	januaryEnd := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	februaryStart := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	februaryEnd := time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	cost := func(headcount, totalCost int64) entity.PayrollCost {
+		return entity.PayrollCost{
+			Headcount: headcount,
+			BasePay:   money.New(totalCost),
+			GrossPay:  money.New(totalCost),
+			TakeHome:  money.New(totalCost),
+			TotalCost: money.New(totalCost),
+		}
+	}
+	row := func(periodID string, startDate, endDate time.Time, key optional.String, payrollCost entity.PayrollCost) entity.PayrollCostRow {
+		return entity.PayrollCostRow{
+			PeriodID:        periodID,
+			PeriodStartDate: startDate,
+			PeriodEndDate:   endDate,
+			PayrollID:       "payroll-" + periodID,
+			PayrollStatus:   "paid",
+			GroupKey:        key,
+			PayrollCost:     payrollCost,
+		}
+	}
+
+	groupedFilter := entity.PayrollCostFilter{
+		StartDate: januaryStart,
+		EndDate:   februaryStart,
+		GroupBy:   entity.GroupByDepartment,
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - grouped by department with the change from the previous period",
+			authCredential: adminCredential,
+			filter:         groupedFilter,
+			expectedReport: entity.PayrollCostReport{
+				StartDate: januaryStart,
+				EndDate:   februaryStart,
+				GroupBy:   entity.GroupByDepartment,
+				Periods: []entity.PayrollCostPeriod{
+					{
+						PeriodID:      "january",
+						StartDate:     januaryStart,
+						EndDate:       januaryEnd,
+						PayrollID:     "payroll-january",
+						PayrollStatus: "paid",
+						Total:         entity.PayrollCostGroup{Cost: cost(3, 3000)},
+						Groups: []entity.PayrollCostGroup{
+							{Key: optional.NewString("Engineering"), Cost: cost(2, 2000)},
+							{Cost: cost(1, 1000)},
+						},
+					},
+					{
+						PeriodID:      "february",
+						StartDate:     februaryStart,
+						EndDate:       februaryEnd,
+						PayrollID:     "payroll-february",
+						PayrollStatus: "paid",
+						Total: entity.PayrollCostGroup{
+							Cost:            cost(4, 3500),
+							TotalCostChange: optional.NewMoney(money.New(500)),
+						},
+						Groups: []entity.PayrollCostGroup{
+							{
+								Key:             optional.NewString("Engineering"),
+								Cost:            cost(2, 2200),
+								TotalCostChange: optional.NewMoney(money.New(200)),
+							},
+							{Key: optional.NewString("Sales"), Cost: cost(1, 500)},
+							{
+								Cost:            cost(1, 800),
+								TotalCostChange: optional.NewMoney(money.New(-200)),
+							},
+						},
+					},
+				},
+				Total: cost(7, 6500),
+			},
+			setupMock: func(m mockParams) {
+				m.reportRepo.EXPECT().FindPayrollCosts(gomock.Any(), groupedFilter).Return([]entity.PayrollCostRow{
+					row("january", januaryStart, januaryEnd, optional.NewString("Engineering"), cost(2, 2000)),
+					row("january", januaryStart, januaryEnd, optional.String{}, cost(1, 1000)),
+					row("february", februaryStart, februaryEnd, optional.NewString("Engineering"), cost(2, 2200)),
+					row("february", februaryStart, februaryEnd, optional.NewString("Sales"), cost(1, 500)),
+					row("february", februaryStart, februaryEnd, optional.String{}, cost(1, 800)),
+				}, nil)
+			},
+		},
+		{
+			name:           "success - not grouped",
+			authCredential: adminCredential,
+			filter: entity.PayrollCostFilter{
+				StartDate: januaryStart,
+				EndDate:   januaryStart,
+				GroupBy:   entity.GroupByNone,
+			},
+			expectedReport: entity.PayrollCostReport{
+				StartDate: januaryStart,
+				EndDate:   januaryStart,
+				GroupBy:   entity.GroupByNone,
+				Periods: []entity.PayrollCostPeriod{
+					{
+						PeriodID:      "january",
+						StartDate:     januaryStart,
+						EndDate:       januaryEnd,
+						PayrollID:     "payroll-january",
+						PayrollStatus: "paid",
+						Total:         entity.PayrollCostGroup{Cost: cost(3, 3000)},
+					},
+				},
+				Total: cost(3, 3000),
+			},
+			setupMock: func(m mockParams) {
+				m.reportRepo.EXPECT().FindPayrollCosts(gomock.Any(), gomock.Any()).Return([]entity.PayrollCostRow{
+					row("january", januaryStart, januaryEnd, optional.String{}, cost(3, 3000)),
+				}, nil)
+			},
+		},
+		{
+			name:           "error - range ends before it starts",
+			authCredential: adminCredential,
+			filter: entity.PayrollCostFilter{
+				StartDate: februaryStart,
+				EndDate:   januaryStart,
+				GroupBy:   entity.GroupByNone,
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.ReportInvalidRange,
+					Message:   entity.GetErrorMessageByIssueCode(entity.ReportInvalidRange),
+					Received:  "2025-01-01",
+					Expected:  "2025-02-01",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			filter:         groupedFilter,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.ReportNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.ReportNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				reportRepo: mockReport.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewReportUseCase(m.reportRepo)
+
+			report, err := useCase.ShowPayrollCostReport(context.Background(), tt.authCredential, tt.filter)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedReport, report)
+			}
+		})
+	}
+}
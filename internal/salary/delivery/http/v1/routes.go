@@ -0,0 +1,11 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapSalary(routes fiber.Router, h *SalaryHandler) {
+	salaries := routes.Group("/users/:userId/salaries")
+
+	salaries.Get("/", h.ListSalaryHistory)
+	salaries.Post("/", h.ScheduleSalaryChange)
+	salaries.Delete("/:salaryId", h.CancelSalaryChange)
+}
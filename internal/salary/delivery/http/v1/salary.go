@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/salary"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type SalaryHandler struct {
+	uc salary.UseCase
+}
+
+func NewSalaryHandler(uc salary.UseCase) *SalaryHandler {
+	return &SalaryHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      List Salary History
+// @Description  List the salary changes of a user, including scheduled ones
+// @Tags         Salary
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Success      200 {object} dtos.Response{data=[]dtos.SalaryHistoryResponse} "Salary History Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/salaries [GET]
+// @Security     BearerAuth
+func (h *SalaryHandler) ListSalaryHistory(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"SalaryHandler.ListSalaryHistory()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().ListSalaryHistory().c.ParamsParser()")
+	}
+
+	salaryHistory, err := h.uc.ListSalaryHistory(ctx, authCredential, param.UserID.String())
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().ListSalaryHistory().uc.ListSalaryHistory()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewListSalaryHistoryResponse(salaryHistory),
+		},
+	)
+}
+
+// @Summary      Schedule Salary Change
+// @Description  Record a new salary for a user from the effective date on, payroll prorates periods the change falls in
+// @Tags         Salary
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.SalaryChangeRequest true "Salary Change Request"
+// @Success      201 {object} dtos.Response{data=dtos.SalaryHistoryResponse} "Salary History Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/salaries [POST]
+// @Security     BearerAuth
+func (h *SalaryHandler) ScheduleSalaryChange(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"SalaryHandler.ScheduleSalaryChange()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().ScheduleSalaryChange().c.ParamsParser()")
+	}
+
+	var req dtos.SalaryChangeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "SalaryHandler().ScheduleSalaryChange().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "SalaryHandler().ScheduleSalaryChange().req.Validate()")
+	}
+
+	data, err := h.uc.ScheduleSalaryChange(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().ScheduleSalaryChange().uc.ScheduleSalaryChange()")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewSalaryHistoryResponse(data),
+		},
+	)
+}
+
+// @Summary      Cancel Salary Change
+// @Description  Remove a salary change that is not effective yet
+// @Tags         Salary
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        salaryId path string true "Salary Change ID"
+// @Success      200 {object} dtos.Response "Success"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/salaries/{salaryId} [DELETE]
+// @Security     BearerAuth
+func (h *SalaryHandler) CancelSalaryChange(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"SalaryHandler.CancelSalaryChange()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID   uuid.UUID `params:"userId"`
+		SalaryID uuid.UUID `params:"salaryId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().CancelSalaryChange().c.ParamsParser()")
+	}
+
+	err = h.uc.CancelSalaryChange(ctx, authCredential, param.UserID.String(), param.SalaryID.String())
+	if err != nil {
+		return errors.Wrap(err, "SalaryHandler().CancelSalaryChange().uc.CancelSalaryChange()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+		},
+	)
+}
@@ -0,0 +1,26 @@
+package entity
+
+const (
+	SalaryNotAuthorized          = "SALARY_NOT_AUTHORIZED"
+	SalaryUserNotFound           = "SALARY_USER_NOT_FOUND"
+	SalaryChangeNotFound         = "SALARY_CHANGE_NOT_FOUND"
+	SalaryChangeAlreadyExists    = "SALARY_CHANGE_ALREADY_EXISTS"
+	SalaryChangeAlreadyEffective = "SALARY_CHANGE_ALREADY_EFFECTIVE"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case SalaryNotAuthorized:
+		return "You are not authorized to manage salaries"
+	case SalaryUserNotFound:
+		return "User not found"
+	case SalaryChangeNotFound:
+		return "Salary change not found"
+	case SalaryChangeAlreadyExists:
+		return "A salary change effective on the same date already exists"
+	case SalaryChangeAlreadyEffective:
+		return "The salary change is already effective and can no longer be cancelled"
+	default:
+		return "An unknown error occurred"
+	}
+}
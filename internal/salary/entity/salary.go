@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+// SalaryHistory is the salary of a user from EffectiveFrom until the next
+// change. Changes can be scheduled ahead, so payroll always reads the salary
+// effective on a date instead of the current one.
+type SalaryHistory struct {
+	ID            string          `db:"id"`
+	UserID        string          `db:"user_id"`
+	Salary        money.Money     `db:"salary"`
+	EffectiveFrom time.Time       `db:"effective_from"`
+	Reason        optional.String `db:"reason"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+	CreatedBy     string          `db:"created_by"`
+	UpdatedBy     string          `db:"updated_by"`
+	IPAddress     string          `db:"ip_address"`
+}
+
+type ScheduleSalaryChange struct {
+	Salary        money.Money
+	EffectiveFrom time.Time
+	Reason        optional.String
+}
+
+type MappedBy string
+
+const (
+	MappedByUserID MappedBy = "user_id"
+)
+
+type MappedOptions struct {
+	MappedBy MappedBy
+}
+
+type FindSalaryHistoryOptions struct {
+	PessimisticLock bool
+	*MappedOptions
+}
+
+type FindSalaryHistoryResult struct {
+	List     []SalaryHistory
+	Mapped   map[any][]SalaryHistory
+	IsMapped bool
+	MappedBy MappedBy
+}
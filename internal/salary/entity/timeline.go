@@ -0,0 +1,93 @@
+package entity
+
+import (
+	"sort"
+	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+// SalarySegment is a run of consecutive days paid with the same salary, both
+// dates included.
+type SalarySegment struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Salary    money.Money
+}
+
+// Timeline resolves the salary of one user on any date. Dates before the
+// first change fall back to the salary the user had before salary history
+// was recorded.
+type Timeline struct {
+	fallback money.Money
+	changes  []SalaryHistory
+}
+
+func NewTimeline(fallback money.Money, changes []SalaryHistory) Timeline {
+	sorted := make([]SalaryHistory, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].EffectiveFrom.Before(sorted[j].EffectiveFrom)
+	})
+	return Timeline{
+		fallback: fallback,
+		changes:  sorted,
+	}
+}
+
+// SalaryOn returns the salary effective on date.
+func (t Timeline) SalaryOn(date time.Time) money.Money {
+	salary := t.fallback
+	day := truncateDate(date)
+	for _, change := range t.changes {
+		if truncateDate(change.EffectiveFrom).After(day) {
+			break
+		}
+		salary = change.Salary
+	}
+	return salary
+}
+
+// Segments splits the dates between startDate and endDate, both included,
+// wherever the salary changes. A period without changes is a single segment.
+func (t Timeline) Segments(startDate, endDate time.Time) []SalarySegment {
+	start := truncateDate(startDate)
+	end := truncateDate(endDate)
+	if start.After(end) {
+		return nil
+	}
+
+	segments := []SalarySegment{{
+		StartDate: start,
+		EndDate:   end,
+		Salary:    t.SalaryOn(start),
+	}}
+	for _, change := range t.changes {
+		effectiveFrom := truncateDate(change.EffectiveFrom)
+		if !effectiveFrom.After(start) || effectiveFrom.After(end) {
+			continue
+		}
+
+		last := &segments[len(segments)-1]
+		if last.Salary.Equal(change.Salary) {
+			continue
+		}
+		last.EndDate = effectiveFrom.AddDate(0, 0, -1)
+		segments = append(segments, SalarySegment{
+			StartDate: effectiveFrom,
+			EndDate:   end,
+			Salary:    change.Salary,
+		})
+	}
+	return segments
+}
+
+func truncateDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Contains reports whether date falls within the segment.
+func (s SalarySegment) Contains(date time.Time) bool {
+	day := truncateDate(date)
+	return !day.Before(s.StartDate) && !day.After(s.EndDate)
+}
@@ -0,0 +1,281 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/salary/repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/salary/repository.go -destination internal/salary/mock/repository_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	salary "github.com/vnnyx/employee-management/internal/salary"
+	entity "github.com/vnnyx/employee-management/internal/salary/entity"
+	database "github.com/vnnyx/employee-management/pkg/database"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteSalaryHistory mocks base method.
+func (m *MockRepository) DeleteSalaryHistory(ctx context.Context, salaryHistoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSalaryHistory", ctx, salaryHistoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSalaryHistory indicates an expected call of DeleteSalaryHistory.
+func (mr *MockRepositoryMockRecorder) DeleteSalaryHistory(ctx, salaryHistoryID any) *MockRepositoryDeleteSalaryHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSalaryHistory", reflect.TypeOf((*MockRepository)(nil).DeleteSalaryHistory), ctx, salaryHistoryID)
+	return &MockRepositoryDeleteSalaryHistoryCall{Call: call}
+}
+
+// MockRepositoryDeleteSalaryHistoryCall wrap *gomock.Call
+type MockRepositoryDeleteSalaryHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryDeleteSalaryHistoryCall) Return(arg0 error) *MockRepositoryDeleteSalaryHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryDeleteSalaryHistoryCall) Do(f func(context.Context, string) error) *MockRepositoryDeleteSalaryHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryDeleteSalaryHistoryCall) DoAndReturn(f func(context.Context, string) error) *MockRepositoryDeleteSalaryHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSalaryHistoryByID mocks base method.
+func (m *MockRepository) FindSalaryHistoryByID(ctx context.Context, userID, salaryHistoryID string) (*entity.SalaryHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalaryHistoryByID", ctx, userID, salaryHistoryID)
+	ret0, _ := ret[0].(*entity.SalaryHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryHistoryByID indicates an expected call of FindSalaryHistoryByID.
+func (mr *MockRepositoryMockRecorder) FindSalaryHistoryByID(ctx, userID, salaryHistoryID any) *MockRepositoryFindSalaryHistoryByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryHistoryByID", reflect.TypeOf((*MockRepository)(nil).FindSalaryHistoryByID), ctx, userID, salaryHistoryID)
+	return &MockRepositoryFindSalaryHistoryByIDCall{Call: call}
+}
+
+// MockRepositoryFindSalaryHistoryByIDCall wrap *gomock.Call
+type MockRepositoryFindSalaryHistoryByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindSalaryHistoryByIDCall) Return(arg0 *entity.SalaryHistory, arg1 error) *MockRepositoryFindSalaryHistoryByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindSalaryHistoryByIDCall) Do(f func(context.Context, string, string) (*entity.SalaryHistory, error)) *MockRepositoryFindSalaryHistoryByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindSalaryHistoryByIDCall) DoAndReturn(f func(context.Context, string, string) (*entity.SalaryHistory, error)) *MockRepositoryFindSalaryHistoryByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSalaryHistoryByRange mocks base method.
+func (m *MockRepository) FindSalaryHistoryByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindSalaryHistoryOptions) (entity.FindSalaryHistoryResult, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, startDate, endDate}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindSalaryHistoryByRange", varargs...)
+	ret0, _ := ret[0].(entity.FindSalaryHistoryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryHistoryByRange indicates an expected call of FindSalaryHistoryByRange.
+func (mr *MockRepositoryMockRecorder) FindSalaryHistoryByRange(ctx, startDate, endDate any, opts ...any) *MockRepositoryFindSalaryHistoryByRangeCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, startDate, endDate}, opts...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryHistoryByRange", reflect.TypeOf((*MockRepository)(nil).FindSalaryHistoryByRange), varargs...)
+	return &MockRepositoryFindSalaryHistoryByRangeCall{Call: call}
+}
+
+// MockRepositoryFindSalaryHistoryByRangeCall wrap *gomock.Call
+type MockRepositoryFindSalaryHistoryByRangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindSalaryHistoryByRangeCall) Return(arg0 entity.FindSalaryHistoryResult, arg1 error) *MockRepositoryFindSalaryHistoryByRangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindSalaryHistoryByRangeCall) Do(f func(context.Context, time.Time, time.Time, ...entity.FindSalaryHistoryOptions) (entity.FindSalaryHistoryResult, error)) *MockRepositoryFindSalaryHistoryByRangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindSalaryHistoryByRangeCall) DoAndReturn(f func(context.Context, time.Time, time.Time, ...entity.FindSalaryHistoryOptions) (entity.FindSalaryHistoryResult, error)) *MockRepositoryFindSalaryHistoryByRangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// FindSalaryHistoryByUserID mocks base method.
+func (m *MockRepository) FindSalaryHistoryByUserID(ctx context.Context, userID string) ([]entity.SalaryHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSalaryHistoryByUserID", ctx, userID)
+	ret0, _ := ret[0].([]entity.SalaryHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSalaryHistoryByUserID indicates an expected call of FindSalaryHistoryByUserID.
+func (mr *MockRepositoryMockRecorder) FindSalaryHistoryByUserID(ctx, userID any) *MockRepositoryFindSalaryHistoryByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSalaryHistoryByUserID", reflect.TypeOf((*MockRepository)(nil).FindSalaryHistoryByUserID), ctx, userID)
+	return &MockRepositoryFindSalaryHistoryByUserIDCall{Call: call}
+}
+
+// MockRepositoryFindSalaryHistoryByUserIDCall wrap *gomock.Call
+type MockRepositoryFindSalaryHistoryByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryFindSalaryHistoryByUserIDCall) Return(arg0 []entity.SalaryHistory, arg1 error) *MockRepositoryFindSalaryHistoryByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryFindSalaryHistoryByUserIDCall) Do(f func(context.Context, string) ([]entity.SalaryHistory, error)) *MockRepositoryFindSalaryHistoryByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryFindSalaryHistoryByUserIDCall) DoAndReturn(f func(context.Context, string) ([]entity.SalaryHistory, error)) *MockRepositoryFindSalaryHistoryByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// StoreNewSalaryHistory mocks base method.
+func (m *MockRepository) StoreNewSalaryHistory(ctx context.Context, salaryHistory entity.SalaryHistory) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreNewSalaryHistory", ctx, salaryHistory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreNewSalaryHistory indicates an expected call of StoreNewSalaryHistory.
+func (mr *MockRepositoryMockRecorder) StoreNewSalaryHistory(ctx, salaryHistory any) *MockRepositoryStoreNewSalaryHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreNewSalaryHistory", reflect.TypeOf((*MockRepository)(nil).StoreNewSalaryHistory), ctx, salaryHistory)
+	return &MockRepositoryStoreNewSalaryHistoryCall{Call: call}
+}
+
+// MockRepositoryStoreNewSalaryHistoryCall wrap *gomock.Call
+type MockRepositoryStoreNewSalaryHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryStoreNewSalaryHistoryCall) Return(arg0 error) *MockRepositoryStoreNewSalaryHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryStoreNewSalaryHistoryCall) Do(f func(context.Context, entity.SalaryHistory) error) *MockRepositoryStoreNewSalaryHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryStoreNewSalaryHistoryCall) DoAndReturn(f func(context.Context, entity.SalaryHistory) error) *MockRepositoryStoreNewSalaryHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx database.DBTx) salary.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(salary.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *MockRepositoryWithTxCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+	return &MockRepositoryWithTxCall{Call: call}
+}
+
+// MockRepositoryWithTxCall wrap *gomock.Call
+type MockRepositoryWithTxCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryWithTxCall) Return(arg0 salary.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryWithTxCall) Do(f func(database.DBTx) salary.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryWithTxCall) DoAndReturn(f func(database.DBTx) salary.Repository) *MockRepositoryWithTxCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,159 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/salary/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/salary/usecase.go -destination internal/salary/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/salary/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CancelSalaryChange mocks base method.
+func (m *MockUseCase) CancelSalaryChange(ctx context.Context, authCredential entity.Credential, userID, salaryHistoryID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSalaryChange", ctx, authCredential, userID, salaryHistoryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelSalaryChange indicates an expected call of CancelSalaryChange.
+func (mr *MockUseCaseMockRecorder) CancelSalaryChange(ctx, authCredential, userID, salaryHistoryID any) *MockUseCaseCancelSalaryChangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSalaryChange", reflect.TypeOf((*MockUseCase)(nil).CancelSalaryChange), ctx, authCredential, userID, salaryHistoryID)
+	return &MockUseCaseCancelSalaryChangeCall{Call: call}
+}
+
+// MockUseCaseCancelSalaryChangeCall wrap *gomock.Call
+type MockUseCaseCancelSalaryChangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseCancelSalaryChangeCall) Return(arg0 error) *MockUseCaseCancelSalaryChangeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseCancelSalaryChangeCall) Do(f func(context.Context, entity.Credential, string, string) error) *MockUseCaseCancelSalaryChangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseCancelSalaryChangeCall) DoAndReturn(f func(context.Context, entity.Credential, string, string) error) *MockUseCaseCancelSalaryChangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListSalaryHistory mocks base method.
+func (m *MockUseCase) ListSalaryHistory(ctx context.Context, authCredential entity.Credential, userID string) ([]entity0.SalaryHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSalaryHistory", ctx, authCredential, userID)
+	ret0, _ := ret[0].([]entity0.SalaryHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSalaryHistory indicates an expected call of ListSalaryHistory.
+func (mr *MockUseCaseMockRecorder) ListSalaryHistory(ctx, authCredential, userID any) *MockUseCaseListSalaryHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSalaryHistory", reflect.TypeOf((*MockUseCase)(nil).ListSalaryHistory), ctx, authCredential, userID)
+	return &MockUseCaseListSalaryHistoryCall{Call: call}
+}
+
+// MockUseCaseListSalaryHistoryCall wrap *gomock.Call
+type MockUseCaseListSalaryHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseListSalaryHistoryCall) Return(arg0 []entity0.SalaryHistory, arg1 error) *MockUseCaseListSalaryHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseListSalaryHistoryCall) Do(f func(context.Context, entity.Credential, string) ([]entity0.SalaryHistory, error)) *MockUseCaseListSalaryHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseListSalaryHistoryCall) DoAndReturn(f func(context.Context, entity.Credential, string) ([]entity0.SalaryHistory, error)) *MockUseCaseListSalaryHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ScheduleSalaryChange mocks base method.
+func (m *MockUseCase) ScheduleSalaryChange(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.ScheduleSalaryChange) (entity0.SalaryHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleSalaryChange", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.SalaryHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScheduleSalaryChange indicates an expected call of ScheduleSalaryChange.
+func (mr *MockUseCaseMockRecorder) ScheduleSalaryChange(ctx, authCredential, userID, payload any) *MockUseCaseScheduleSalaryChangeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleSalaryChange", reflect.TypeOf((*MockUseCase)(nil).ScheduleSalaryChange), ctx, authCredential, userID, payload)
+	return &MockUseCaseScheduleSalaryChangeCall{Call: call}
+}
+
+// MockUseCaseScheduleSalaryChangeCall wrap *gomock.Call
+type MockUseCaseScheduleSalaryChangeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseScheduleSalaryChangeCall) Return(arg0 entity0.SalaryHistory, arg1 error) *MockUseCaseScheduleSalaryChangeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseScheduleSalaryChangeCall) Do(f func(context.Context, entity.Credential, string, entity0.ScheduleSalaryChange) (entity0.SalaryHistory, error)) *MockUseCaseScheduleSalaryChangeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseScheduleSalaryChangeCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.ScheduleSalaryChange) (entity0.SalaryHistory, error)) *MockUseCaseScheduleSalaryChangeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
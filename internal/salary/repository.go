@@ -0,0 +1,19 @@
+package salary
+
+import (
+	"context"
+	"time"
+
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+)
+
+type Repository interface {
+	WithTx(tx database.DBTx) Repository
+
+	StoreNewSalaryHistory(ctx context.Context, salaryHistory entity.SalaryHistory) error
+	DeleteSalaryHistory(ctx context.Context, salaryHistoryID string) error
+	FindSalaryHistoryByID(ctx context.Context, userID, salaryHistoryID string) (*entity.SalaryHistory, error)
+	FindSalaryHistoryByUserID(ctx context.Context, userID string) ([]entity.SalaryHistory, error)
+	FindSalaryHistoryByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindSalaryHistoryOptions) (entity.FindSalaryHistoryResult, error)
+}
@@ -0,0 +1,93 @@
+package repository
+
+const insertSalaryHistoryQuery = `
+INSERT INTO salary_history (
+	id,
+	user_id,
+	salary,
+	effective_from,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+)
+VALUES (
+	:id,
+	:user_id,
+	:salary,
+	:effective_from,
+	:reason,
+	:created_at,
+	:updated_at,
+	:created_by,
+	:updated_by,
+	:ip_address
+)
+RETURNING id
+`
+
+const deleteSalaryHistoryQuery = `
+DELETE FROM salary_history
+WHERE id = $1
+`
+
+const findSalaryHistoryByIDQuery = `
+SELECT
+	id,
+	user_id,
+	salary,
+	effective_from,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM salary_history
+WHERE user_id = $1 AND id = $2
+`
+
+const findSalaryHistoryByUserIDQuery = `
+SELECT
+	id,
+	user_id,
+	salary,
+	effective_from,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM salary_history
+WHERE user_id = $1
+ORDER BY effective_from ASC
+`
+
+// The salary effective on the first day of the range is the latest change on
+// or before it, every later change inside the range splits the period. The
+// outer query reads salary_history directly so the rows can be locked.
+const findSalaryHistoryByRangeQuery = `
+SELECT
+	id,
+	user_id,
+	salary,
+	effective_from,
+	reason,
+	created_at,
+	updated_at,
+	created_by,
+	updated_by,
+	ip_address
+FROM salary_history
+WHERE (effective_from > $1::DATE AND effective_from <= $2::DATE)
+OR id IN (
+	SELECT DISTINCT ON (user_id) id
+	FROM salary_history
+	WHERE effective_from <= $1::DATE
+	ORDER BY user_id, effective_from DESC
+)
+ORDER BY user_id, effective_from ASC
+`
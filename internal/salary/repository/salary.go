@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/salary"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type salaryRepo struct {
+	db database.Queryer
+}
+
+func NewSalaryRepository(db database.Queryer) salary.Repository {
+	return &salaryRepo{
+		db: db,
+	}
+}
+
+func (r *salaryRepo) WithTx(tx database.DBTx) salary.Repository {
+	return &salaryRepo{
+		db: tx,
+	}
+}
+
+func (r *salaryRepo) StoreNewSalaryHistory(ctx context.Context, salaryHistory entity.SalaryHistory) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryRepository.StoreNewSalaryHistory()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(insertSalaryHistoryQuery, salaryHistory)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to insert salary history"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *salaryRepo) DeleteSalaryHistory(ctx context.Context, salaryHistoryID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryRepository.DeleteSalaryHistory()",
+	)
+	defer span.End()
+
+	_, err := r.db.Exec(ctx, deleteSalaryHistoryQuery, salaryHistoryID)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapDbExec)
+	}
+
+	return nil
+}
+
+func (r *salaryRepo) FindSalaryHistoryByID(ctx context.Context, userID, salaryHistoryID string) (*entity.SalaryHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryRepository.FindSalaryHistoryByID()",
+	)
+	defer span.End()
+
+	var salaryHistory entity.SalaryHistory
+	err := pgxscan.Get(ctx, r.db, &salaryHistory, findSalaryHistoryByIDQuery, userID, salaryHistoryID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	return &salaryHistory, nil
+}
+
+func (r *salaryRepo) FindSalaryHistoryByUserID(ctx context.Context, userID string) ([]entity.SalaryHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryRepository.FindSalaryHistoryByUserID()",
+	)
+	defer span.End()
+
+	var salaryHistory []entity.SalaryHistory
+	err := pgxscan.Select(ctx, r.db, &salaryHistory, findSalaryHistoryByUserIDQuery, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	return salaryHistory, nil
+}
+
+func (r *salaryRepo) FindSalaryHistoryByRange(ctx context.Context, startDate, endDate time.Time, opts ...entity.FindSalaryHistoryOptions) (entity.FindSalaryHistoryResult, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryRepository.FindSalaryHistoryByRange()",
+	)
+	defer span.End()
+
+	var result entity.FindSalaryHistoryResult
+
+	query := findSalaryHistoryByRangeQuery
+	if len(opts) > 0 && opts[0].PessimisticLock {
+		query += " FOR UPDATE"
+	}
+
+	var salaryHistory []entity.SalaryHistory
+	err := pgxscan.Select(ctx, r.db, &salaryHistory, query, startDate, endDate)
+	if err != nil {
+		return result, errors.Wrap(err, constants.ErrWrapPgxscanSelect)
+	}
+
+	result.List = salaryHistory
+
+	if len(opts) > 0 && opts[0].MappedOptions != nil {
+		result.IsMapped = true
+		result.MappedBy = opts[0].MappedOptions.MappedBy
+
+		mappedSalaryHistory := make(map[any][]entity.SalaryHistory)
+		var keyFunc func(salaryHistory entity.SalaryHistory) any
+
+		switch opts[0].MappedOptions.MappedBy {
+		case entity.MappedByUserID:
+			keyFunc = func(salaryHistory entity.SalaryHistory) any {
+				return salaryHistory.UserID
+			}
+		default:
+			return result, errors.New("unsupported mapped by option")
+		}
+
+		for _, item := range salaryHistory {
+			key := keyFunc(item)
+			mappedSalaryHistory[key] = append(mappedSalaryHistory[key], item)
+		}
+
+		result.Mapped = mappedSalaryHistory
+	}
+
+	return result, nil
+}
@@ -0,0 +1,351 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	"github.com/vnnyx/employee-management/internal/salary/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+)
+
+var salaryHistoryColumns = []string{
+	"id", "user_id", "salary", "effective_from", "reason",
+	"created_at", "updated_at", "created_by", "updated_by", "ip_address",
+}
+
+func TestStoreNewSalaryHistory(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewSalaryRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		input     entity.SalaryHistory
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO salary_history").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("salary-1"))
+			},
+			input: entity.SalaryHistory{
+				ID:            "salary-1",
+				UserID:        "user-1",
+				Salary:        money.New(1200),
+				EffectiveFrom: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				Reason:        optional.NewString("Annual raise"),
+				CreatedAt:     now,
+				UpdatedAt:     now,
+				CreatedBy:     "admin",
+				UpdatedBy:     "admin",
+				IPAddress:     "127.0.0.1",
+			},
+			expectErr: false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO salary_history").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnError(errors.New("insert failed"))
+			},
+			input:     entity.SalaryHistory{},
+			expectErr: true,
+		},
+		{
+			name: "empty returned ID",
+			setupMock: func() {
+				mock.ExpectQuery("INSERT INTO salary_history").
+					WithArgs(pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(),
+						pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg(), pgxmock.AnyArg()).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow(""))
+			},
+			input:     entity.SalaryHistory{ID: "salary-2"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.StoreNewSalaryHistory(context.Background(), tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteSalaryHistory(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewSalaryRepository(mock)
+
+	tests := []struct {
+		name            string
+		setupMock       func()
+		salaryHistoryID string
+		expectErr       bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM salary_history").
+					WithArgs("salary-1").
+					WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			},
+			salaryHistoryID: "salary-1",
+			expectErr:       false,
+		},
+		{
+			name: "db error",
+			setupMock: func() {
+				mock.ExpectExec("DELETE FROM salary_history").
+					WithArgs("salary-2").
+					WillReturnError(errors.New("delete failed"))
+			},
+			salaryHistoryID: "salary-2",
+			expectErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.DeleteSalaryHistory(context.Background(), tt.salaryHistoryID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestFindSalaryHistoryByID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewSalaryRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		setupMock       func()
+		salaryHistoryID string
+		expectNil       bool
+		expectErr       bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history WHERE user_id (.+) AND id").
+					WithArgs("user-1", "salary-1").
+					WillReturnRows(pgxmock.NewRows(salaryHistoryColumns).
+						AddRow("salary-1", "user-1", "1200.00", time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), optional.NewString("Annual raise"), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			salaryHistoryID: "salary-1",
+			expectNil:       false,
+			expectErr:       false,
+		},
+		{
+			name: "not found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history WHERE user_id (.+) AND id").
+					WithArgs("user-1", "salary-2").
+					WillReturnError(pgx.ErrNoRows)
+			},
+			salaryHistoryID: "salary-2",
+			expectNil:       true,
+			expectErr:       false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history WHERE user_id (.+) AND id").
+					WithArgs("user-1", "salary-3").
+					WillReturnError(errors.New("db error"))
+			},
+			salaryHistoryID: "salary-3",
+			expectNil:       true,
+			expectErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindSalaryHistoryByID(context.Background(), "user-1", tt.salaryHistoryID)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else if tt.expectNil {
+				assert.NoError(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, result)
+				assert.Equal(t, money.New(1200), result.Salary)
+			}
+		})
+	}
+}
+
+func TestFindSalaryHistoryByUserID(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewSalaryRepository(mock)
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		setupMock   func()
+		expectedLen int
+		expectErr   bool
+	}{
+		{
+			name: "found",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history WHERE user_id").
+					WithArgs("user-1").
+					WillReturnRows(pgxmock.NewRows(salaryHistoryColumns).
+						AddRow("salary-1", "user-1", "1000.00", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.NewString("Initial salary"), now, now, "admin", "admin", "127.0.0.1").
+						AddRow("salary-2", "user-1", "1200.00", time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC), optional.NewString("Annual raise"), now, now, "admin", "admin", "127.0.0.1"))
+			},
+			expectedLen: 2,
+			expectErr:   false,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history WHERE user_id").
+					WithArgs("user-1").
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindSalaryHistoryByUserID(context.Background(), "user-1")
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestFindSalaryHistoryByRange(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewSalaryRepository(mock)
+	now := time.Now()
+	startDate := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMock      func()
+		opts           []entity.FindSalaryHistoryOptions
+		expectedLen    int
+		expectedMapped map[any]int
+		expectErr      bool
+	}{
+		{
+			name: "success - mapped by user",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history (.+) DISTINCT ON").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(salaryHistoryColumns).
+						AddRow("salary-1", "user-1", "1000.00", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.String{}, now, now, "admin", "admin", "127.0.0.1").
+						AddRow("salary-2", "user-1", "1200.00", time.Date(2025, 9, 16, 0, 0, 0, 0, time.UTC), optional.String{}, now, now, "admin", "admin", "127.0.0.1").
+						AddRow("salary-3", "user-2", "900.00", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC), optional.String{}, now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindSalaryHistoryOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: entity.MappedByUserID}},
+			},
+			expectedLen:    3,
+			expectedMapped: map[any]int{"user-1": 2, "user-2": 1},
+			expectErr:      false,
+		},
+		{
+			name: "success - pessimistic lock",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history (.+) DISTINCT ON (.+) FOR UPDATE").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(salaryHistoryColumns).
+						AddRow("salary-1", "user-1", "1000.00", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), optional.String{}, now, now, "admin", "admin", "127.0.0.1"))
+			},
+			opts: []entity.FindSalaryHistoryOptions{
+				{PessimisticLock: true},
+			},
+			expectedLen: 1,
+			expectErr:   false,
+		},
+		{
+			name: "error - unsupported mapped by",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history (.+) DISTINCT ON").
+					WithArgs(startDate, endDate).
+					WillReturnRows(pgxmock.NewRows(salaryHistoryColumns))
+			},
+			opts: []entity.FindSalaryHistoryOptions{
+				{MappedOptions: &entity.MappedOptions{MappedBy: "unknown"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "query error",
+			setupMock: func() {
+				mock.ExpectQuery("SELECT (.+) FROM salary_history (.+) DISTINCT ON").
+					WithArgs(startDate, endDate).
+					WillReturnError(errors.New("db error"))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			result, err := repo.FindSalaryHistoryByRange(context.Background(), startDate, endDate, tt.opts...)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.List, tt.expectedLen)
+				for key, expectedLen := range tt.expectedMapped {
+					assert.Len(t, result.Mapped[key], expectedLen)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package salary
+
+import (
+	"context"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+)
+
+type UseCase interface {
+	ListSalaryHistory(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.SalaryHistory, error)
+	ScheduleSalaryChange(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.ScheduleSalaryChange) (entity.SalaryHistory, error)
+	CancelSalaryChange(ctx context.Context, authCredential authCredential.Credential, userID, salaryHistoryID string) error
+}
@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/salary"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+const salaryDateFormat = "2006-01-02"
+
+type salaryUseCase struct {
+	salaryRepo salary.Repository
+	userRepo   users.Repository
+}
+
+func NewSalaryUseCase(salaryRepo salary.Repository, userRepo users.Repository) salary.UseCase {
+	return &salaryUseCase{
+		salaryRepo: salaryRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (u *salaryUseCase) ListSalaryHistory(ctx context.Context, authCredential authCredential.Credential, userID string) ([]entity.SalaryHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryUseCase.ListSalaryHistory()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return nil, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.SalaryNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+			},
+		)
+	}
+
+	user, err := u.userRepo.FindUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "SalaryUseCase.ListSalaryHistory().FindUserByID()")
+	}
+	if user == nil {
+		return nil, apperror.NotFound(
+			apperror.AppError{
+				IssueCode: entity.SalaryUserNotFound,
+				Message:   entity.GetErrorMessageByIssueCode(entity.SalaryUserNotFound),
+				Received:  userID,
+			},
+		)
+	}
+
+	salaryHistory, err := u.salaryRepo.FindSalaryHistoryByUserID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "SalaryUseCase.ListSalaryHistory().FindSalaryHistoryByUserID()")
+	}
+
+	return salaryHistory, nil
+}
+
+// ScheduleSalaryChange records a new salary from the effective date on. The
+// date may be in the past to record a retroactive change, payrolls already
+// generated keep their numbers until they are regenerated.
+func (u *salaryUseCase) ScheduleSalaryChange(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.ScheduleSalaryChange) (entity.SalaryHistory, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryUseCase.ScheduleSalaryChange()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return entity.SalaryHistory{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.SalaryNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+			},
+		)
+	}
+
+	timeNow := time.Now()
+	newSalaryHistory := entity.SalaryHistory{
+		ID:            uuid.NewString(),
+		UserID:        userID,
+		Salary:        payload.Salary,
+		EffectiveFrom: payload.EffectiveFrom,
+		Reason:        payload.Reason,
+		CreatedAt:     timeNow,
+		UpdatedAt:     timeNow,
+		CreatedBy:     authCredential.UserID,
+		UpdatedBy:     authCredential.UserID,
+		IPAddress:     authCredential.IPAddress,
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		salaryRepoTx := u.salaryRepo.WithTx(tx)
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		user, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "SalaryUseCase.ScheduleSalaryChange().FindUserByID()")
+		}
+		if user == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.SalaryUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryUserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		err = salaryRepoTx.StoreNewSalaryHistory(ctx, newSalaryHistory)
+		if err != nil {
+			if database.IsUniqueViolation(err, "salary_history_user_id_effective_from_key") {
+				return apperror.BadRequest(
+					apperror.AppError{
+						IssueCode: entity.SalaryChangeAlreadyExists,
+						Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeAlreadyExists),
+						Received:  payload.EffectiveFrom.Format(salaryDateFormat),
+					},
+				)
+			}
+			return errors.Wrap(err, "SalaryUseCase.ScheduleSalaryChange().StoreNewSalaryHistory()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.SalaryHistory{}, errors.Wrap(err, "SalaryUseCase.ScheduleSalaryChange().WithAuditContext()")
+	}
+
+	return newSalaryHistory, nil
+}
+
+// CancelSalaryChange removes a scheduled change. Changes that are already
+// effective may have been paid out and are kept as history.
+func (u *salaryUseCase) CancelSalaryChange(ctx context.Context, authCredential authCredential.Credential, userID, salaryHistoryID string) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"SalaryUseCase.CancelSalaryChange()",
+	)
+	defer span.End()
+
+	if !*authCredential.IsAdmin {
+		return apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.SalaryNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		salaryRepoTx := u.salaryRepo.WithTx(tx)
+
+		existingSalaryHistory, err := salaryRepoTx.FindSalaryHistoryByID(ctx, userID, salaryHistoryID)
+		if err != nil {
+			return errors.Wrap(err, "SalaryUseCase.CancelSalaryChange().FindSalaryHistoryByID()")
+		}
+		if existingSalaryHistory == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.SalaryChangeNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeNotFound),
+					Received:  salaryHistoryID,
+				},
+			)
+		}
+
+		today := time.Now().Format(salaryDateFormat)
+		if existingSalaryHistory.EffectiveFrom.Format(salaryDateFormat) <= today {
+			return apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.SalaryChangeAlreadyEffective,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeAlreadyEffective),
+					Received:  existingSalaryHistory.EffectiveFrom.Format(salaryDateFormat),
+				},
+			)
+		}
+
+		err = salaryRepoTx.DeleteSalaryHistory(ctx, salaryHistoryID)
+		if err != nil {
+			return errors.Wrap(err, "SalaryUseCase.CancelSalaryChange().DeleteSalaryHistory()")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "SalaryUseCase.CancelSalaryChange().WithAuditContext()")
+	}
+
+	return nil
+}
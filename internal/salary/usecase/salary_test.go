@@ -0,0 +1,350 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/salary/entity"
+	mockSalary "github.com/vnnyx/employee-management/internal/salary/mock"
+	"github.com/vnnyx/employee-management/internal/salary/usecase"
+	userEntity "github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/testutil"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	salaryRepo   *mockSalary.MockRepository
+	salaryRepoTx *mockSalary.MockRepository
+	userRepo     *mockUser.MockRepository
+	userRepoTx   *mockUser.MockRepository
+}
+
+func TestListSalaryHistory(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedLen    int
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - history listed",
+			authCredential: adminCredential,
+			expectedLen:    2,
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.salaryRepo.EXPECT().FindSalaryHistoryByUserID(gomock.Any(), "user-1").Return([]entity.SalaryHistory{
+					{ID: "salary-1", UserID: "user-1", Salary: money.New(1000), EffectiveFrom: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+					{ID: "salary-2", UserID: "user-1", Salary: money.New(1200), EffectiveFrom: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)},
+				}, nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.SalaryUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.SalaryNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				salaryRepo: mockSalary.NewMockRepository(ctrl),
+				userRepo:   mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewSalaryUseCase(m.salaryRepo, m.userRepo)
+
+			history, err := useCase.ListSalaryHistory(context.Background(), tt.authCredential, "user-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, history, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestScheduleSalaryChange(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.ScheduleSalaryChange
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	payload := entity.ScheduleSalaryChange{
+		Salary:        money.New(1200),
+		EffectiveFrom: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		Reason:        optional.NewString("Annual raise"),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - change scheduled",
+			authCredential: adminCredential,
+			payload:        payload,
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.salaryRepoTx.EXPECT().StoreNewSalaryHistory(gomock.Any(), mock.MatchedBy(func(args entity.SalaryHistory) bool {
+					return testutil.EqualVerbose(
+						entity.SalaryHistory{
+							UserID:        "user-1",
+							Salary:        money.New(1200),
+							EffectiveFrom: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+							Reason:        optional.NewString("Annual raise"),
+							CreatedBy:     "admin-1",
+							UpdatedBy:     "admin-1",
+							IPAddress:     "127.0.0.1",
+						},
+						args,
+						cmpopts.IgnoreFields(entity.SalaryHistory{}, "ID", "CreatedAt", "UpdatedAt"),
+					)
+				})).Return(nil)
+			},
+		},
+		{
+			name:           "error - change already exists on date",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.SalaryChangeAlreadyExists,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeAlreadyExists),
+					Received:  "2025-09-01",
+				}),
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&userEntity.User{ID: "user-1"}, nil)
+				m.salaryRepoTx.EXPECT().StoreNewSalaryHistory(gomock.Any(), gomock.Any()).Return(&pgconn.PgError{
+					Code:           "23505",
+					ConstraintName: "salary_history_user_id_effective_from_key",
+				})
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload:        payload,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.SalaryUserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryUserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload:        payload,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.SalaryNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				salaryRepo:   mockSalary.NewMockRepository(ctrl),
+				salaryRepoTx: mockSalary.NewMockRepository(ctrl),
+				userRepo:     mockUser.NewMockRepository(ctrl),
+				userRepoTx:   mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewSalaryUseCase(m.salaryRepo, m.userRepo)
+
+			salaryHistory, err := useCase.ScheduleSalaryChange(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, salaryHistory.ID)
+				assert.True(t, tt.payload.Salary.Equal(salaryHistory.Salary))
+			}
+		})
+	}
+}
+
+func TestCancelSalaryChange(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+
+	tests := []testCase{
+		{
+			name:           "success - scheduled change cancelled",
+			authCredential: adminCredential,
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByID(gomock.Any(), "user-1", "salary-1").Return(&entity.SalaryHistory{
+					ID:            "salary-1",
+					UserID:        "user-1",
+					Salary:        money.New(1200),
+					EffectiveFrom: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+				}, nil)
+				m.salaryRepoTx.EXPECT().DeleteSalaryHistory(gomock.Any(), "salary-1").Return(nil)
+			},
+		},
+		{
+			name:           "error - change already effective",
+			authCredential: adminCredential,
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.SalaryChangeAlreadyEffective,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeAlreadyEffective),
+					Received:  "2025-08-20",
+				}),
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByID(gomock.Any(), "user-1", "salary-1").Return(&entity.SalaryHistory{
+					ID:            "salary-1",
+					UserID:        "user-1",
+					Salary:        money.New(1200),
+					EffectiveFrom: time.Date(2025, 8, 20, 0, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+		},
+		{
+			name:           "error - change not found",
+			authCredential: adminCredential,
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.SalaryChangeNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryChangeNotFound),
+					Received:  "salary-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.salaryRepo.EXPECT().WithTx(gomock.Any()).Return(m.salaryRepoTx)
+				m.salaryRepoTx.EXPECT().FindSalaryHistoryByID(gomock.Any(), "user-1", "salary-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.SalaryNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.SalaryNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return now })
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				salaryRepo:   mockSalary.NewMockRepository(ctrl),
+				salaryRepoTx: mockSalary.NewMockRepository(ctrl),
+				userRepo:     mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewSalaryUseCase(m.salaryRepo, m.userRepo)
+
+			err := useCase.CancelSalaryChange(context.Background(), tt.authCredential, "user-1", "salary-1")
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,23 +2,52 @@ package server
 
 import (
 	"github.com/gofiber/fiber/v2"
+	"github.com/pkg/errors"
+	adjustmentV1 "github.com/vnnyx/employee-management/internal/adjustment/delivery/http/v1"
+	adjustmentRepo "github.com/vnnyx/employee-management/internal/adjustment/repository"
+	adjustmentUseCase "github.com/vnnyx/employee-management/internal/adjustment/usecase"
 	attendanceV1 "github.com/vnnyx/employee-management/internal/attendance/delivery/http/v1"
+	attendanceEntity "github.com/vnnyx/employee-management/internal/attendance/entity"
 	attendanceRepo "github.com/vnnyx/employee-management/internal/attendance/repository"
 	attendanceUseCase "github.com/vnnyx/employee-management/internal/attendance/usecase"
 	authV1 "github.com/vnnyx/employee-management/internal/auth/delivery/http/v1"
 	authRepo "github.com/vnnyx/employee-management/internal/auth/repository"
 	authUseCase "github.com/vnnyx/employee-management/internal/auth/usecase"
+	bankAccountV1 "github.com/vnnyx/employee-management/internal/bankaccount/delivery/http/v1"
+	bankAccountRepo "github.com/vnnyx/employee-management/internal/bankaccount/repository"
+	bankAccountUseCase "github.com/vnnyx/employee-management/internal/bankaccount/usecase"
+	holidayV1 "github.com/vnnyx/employee-management/internal/holiday/delivery/http/v1"
+	holidayRepo "github.com/vnnyx/employee-management/internal/holiday/repository"
+	holidayUseCase "github.com/vnnyx/employee-management/internal/holiday/usecase"
+	leaveV1 "github.com/vnnyx/employee-management/internal/leave/delivery/http/v1"
+	leaveRepo "github.com/vnnyx/employee-management/internal/leave/repository"
+	leaveUseCase "github.com/vnnyx/employee-management/internal/leave/usecase"
+	loanV1 "github.com/vnnyx/employee-management/internal/loan/delivery/http/v1"
+	loanRepo "github.com/vnnyx/employee-management/internal/loan/repository"
+	loanUseCase "github.com/vnnyx/employee-management/internal/loan/usecase"
 	"github.com/vnnyx/employee-management/internal/middleware"
 	overtimeV1 "github.com/vnnyx/employee-management/internal/overtime/delivery/http/v1"
 	overtimeRepo "github.com/vnnyx/employee-management/internal/overtime/repository"
 	overtimeUseCase "github.com/vnnyx/employee-management/internal/overtime/usecase"
 	payrollV1 "github.com/vnnyx/employee-management/internal/payroll/delivery/http/v1"
+	payrollDocument "github.com/vnnyx/employee-management/internal/payroll/document"
+	payrollEntity "github.com/vnnyx/employee-management/internal/payroll/entity"
 	payrollRepo "github.com/vnnyx/employee-management/internal/payroll/repository"
 	payrollUseCase "github.com/vnnyx/employee-management/internal/payroll/usecase"
+	payrollWorker "github.com/vnnyx/employee-management/internal/payroll/worker"
 	reimbursementV1 "github.com/vnnyx/employee-management/internal/reimbursement/delivery/http/v1"
 	reimbursementRepo "github.com/vnnyx/employee-management/internal/reimbursement/repository"
 	reimbursementUseCase "github.com/vnnyx/employee-management/internal/reimbursement/usecase"
+	reportV1 "github.com/vnnyx/employee-management/internal/report/delivery/http/v1"
+	reportRepo "github.com/vnnyx/employee-management/internal/report/repository"
+	reportUseCase "github.com/vnnyx/employee-management/internal/report/usecase"
+	salaryV1 "github.com/vnnyx/employee-management/internal/salary/delivery/http/v1"
+	salaryRepo "github.com/vnnyx/employee-management/internal/salary/repository"
+	salaryUseCase "github.com/vnnyx/employee-management/internal/salary/usecase"
+	usersV1 "github.com/vnnyx/employee-management/internal/users/delivery/http/v1"
 	userRepo "github.com/vnnyx/employee-management/internal/users/repository"
+	userUseCase "github.com/vnnyx/employee-management/internal/users/usecase"
+	"github.com/vnnyx/employee-management/pkg/bankfile"
 )
 
 func (s *Server) MapHandlers() error {
@@ -37,26 +66,86 @@ func (s *Server) MapHandlers() error {
 	reimbursementRepo := reimbursementRepo.NewReimbursementRepository(s.DB)
 	payrollRepo := payrollRepo.NewPayrollRepository(s.DB)
 	userRepo := userRepo.NewUserRepository(s.DB)
+	holidayRepo := holidayRepo.NewHolidayRepository(s.DB)
+	salaryRepo := salaryRepo.NewSalaryRepository(s.DB)
+	bankAccountRepo := bankAccountRepo.NewBankAccountRepository(s.DB)
+	adjustmentRepo := adjustmentRepo.NewAdjustmentRepository(s.DB)
+	loanRepo := loanRepo.NewLoanRepository(s.DB)
+	reportRepo := reportRepo.NewReportRepository(s.DB)
+	leaveRepo := leaveRepo.NewLeaveRepository(s.DB)
+
+	workSchedule, err := attendanceEntity.ParseWorkSchedule(
+		s.Config.Attendance.WorkStart,
+		s.Config.Attendance.WorkEnd,
+		s.Config.Attendance.GracePeriod,
+	)
+	if err != nil {
+		return errors.Wrap(err, "Server.MapHandlers().ParseWorkSchedule()")
+	}
 
 	authUC := authUseCase.NewAuthUseCase(authRepo, authUseCase.AuthConfig{
 		Key: s.Config.App.Key,
 	})
-	attendanceUC := attendanceUseCase.NewAttendanceUseCase(attendanceRepo)
-	overtimeUC := overtimeUseCase.NewOvertimeUseCase(overtimeRepo)
-	reimbursementUC := reimbursementUseCase.NewReimbursementUseCase(reimbursementRepo)
+	attendanceUC := attendanceUseCase.NewAttendanceUseCase(attendanceRepo, holidayRepo, userRepo, leaveRepo, attendanceUseCase.AttendanceConfig{
+		Schedule: workSchedule,
+	})
+	overtimeUC := overtimeUseCase.NewOvertimeUseCase(overtimeRepo, holidayRepo, attendanceRepo, userRepo)
+	reimbursementUC := reimbursementUseCase.NewReimbursementUseCase(reimbursementRepo, attendanceRepo)
 	payrollUC := payrollUseCase.NewPayrollUseCase(
 		payrollRepo,
 		userRepo,
 		attendanceRepo,
 		overtimeRepo,
 		reimbursementRepo,
+		holidayRepo,
+		salaryRepo,
+		bankAccountRepo,
+		adjustmentRepo,
+		loanRepo,
+		leaveRepo,
+		payrollUseCase.PayrollConfig{
+			VerificationKey:      s.Config.Payroll.VerificationKey,
+			FiscalYearStartMonth: s.Config.Payroll.FiscalYearStartMonth,
+			AttendanceBasis:      payrollEntity.AttendanceBasis(s.Config.Payroll.AttendanceBasis),
+			WorkSchedule:         workSchedule,
+		},
 	)
+	holidayUC := holidayUseCase.NewHolidayUseCase(holidayRepo)
+	salaryUC := salaryUseCase.NewSalaryUseCase(salaryRepo, userRepo)
+	bankAccountUC := bankAccountUseCase.NewBankAccountUseCase(bankAccountRepo, userRepo)
+	userUC := userUseCase.NewUserUseCase(userRepo)
+	adjustmentUC := adjustmentUseCase.NewAdjustmentUseCase(adjustmentRepo, userRepo, attendanceRepo)
+	loanUC := loanUseCase.NewLoanUseCase(loanRepo, userRepo)
+	reportUC := reportUseCase.NewReportUseCase(reportRepo)
+	leaveUC := leaveUseCase.NewLeaveUseCase(leaveRepo, userRepo, holidayRepo, attendanceRepo)
 
 	authHandler := authV1.NewAuthHandler(authUC)
 	attendanceHandler := attendanceV1.NewAttendanceHandler(attendanceUC)
 	overtimeHandler := overtimeV1.NewOvertimeHandler(overtimeUC)
 	reimbursementHandler := reimbursementV1.NewReimbursementHandler(reimbursementUC)
-	payrollHandler := payrollV1.NewPayrollHandler(payrollUC)
+	payrollHandler := payrollV1.NewPayrollHandler(
+		payrollUC,
+		payrollDocument.NewPayslipRenderer(payrollDocument.PayslipRendererConfig{
+			CompanyName: s.Config.App.Name,
+		}),
+		payrollDocument.NewBankFileWriter(payrollDocument.BankFileConfig{
+			Debtor: bankfile.Account{
+				Name:          s.Config.Bank.DebtorName,
+				AccountNumber: s.Config.Bank.DebtorAccount,
+				BankCode:      s.Config.Bank.DebtorBankCode,
+				BIC:           s.Config.Bank.DebtorBIC,
+			},
+			Currency: s.Config.Bank.Currency,
+		}),
+	)
+	holidayHandler := holidayV1.NewHolidayHandler(holidayUC)
+	salaryHandler := salaryV1.NewSalaryHandler(salaryUC)
+	bankAccountHandler := bankAccountV1.NewBankAccountHandler(bankAccountUC)
+	usersHandler := usersV1.NewUsersHandler(userUC)
+	adjustmentHandler := adjustmentV1.NewAdjustmentHandler(adjustmentUC)
+	loanHandler := loanV1.NewLoanHandler(loanUC)
+	reportHandler := reportV1.NewReportHandler(reportUC)
+	leaveHandler := leaveV1.NewLeaveHandler(leaveUC)
 
 	externalV1 := s.Fiber.Group("/external/api/v1")
 
@@ -69,6 +158,20 @@ func (s *Server) MapHandlers() error {
 	overtimeV1.MapOvertime(externalV1, overtimeHandler)
 	reimbursementV1.MapReimbursement(externalV1, reimbursementHandler)
 	payrollV1.MapPayroll(externalV1, payrollHandler)
+	holidayV1.MapHoliday(externalV1, holidayHandler)
+	salaryV1.MapSalary(externalV1, salaryHandler)
+	bankAccountV1.MapBankAccount(externalV1, bankAccountHandler)
+	usersV1.MapUsers(externalV1, usersHandler)
+	adjustmentV1.MapAdjustment(externalV1, adjustmentHandler)
+	loanV1.MapLoan(externalV1, loanHandler)
+	reportV1.MapReport(externalV1, reportHandler)
+	leaveV1.MapLeave(externalV1, leaveHandler)
+
+	for range s.Config.Worker.PayrollConcurrency {
+		s.workers = append(s.workers, payrollWorker.NewPayrollJobWorker(payrollUC, s.Logger, payrollWorker.PayrollJobWorkerConfig{
+			PollInterval: s.Config.Worker.PollInterval,
+		}))
+	}
 
 	return nil
 }
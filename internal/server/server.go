@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -9,6 +10,7 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 
 	"github.com/goccy/go-json"
@@ -32,6 +34,14 @@ type Server struct {
 	Logger *zap.SugaredLogger
 	Fiber  *fiber.App
 	DB     *pgxpool.Pool
+
+	workers []worker
+}
+
+// worker is a background loop that runs next to the HTTP server until its
+// context is cancelled.
+type worker interface {
+	Run(ctx context.Context)
 }
 
 func NewServer(config configapi.Config, logger *zap.SugaredLogger, db *pgxpool.Pool) *Server {
@@ -102,16 +112,35 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	// Background Workers
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
+	var wg sync.WaitGroup
+	for _, w := range s.workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			w.Run(workerCtx)
+		}()
+	}
+	// Interrupted jobs are put back in the queue before the app exits
+	defer wg.Wait()
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	go func() {
 		<-quit
+		stopWorkers()
 		s.Fiber.Shutdown()
 	}()
 
 	// Run Fiber
 	s.Logger.Infof("App started")
 	s.Logger.Infof("Listening at :%d", s.Config.App.Port)
-	return s.Fiber.Listen(fmt.Sprintf(":%s", strconv.FormatInt(s.Config.App.Port, 10)))
+	err = s.Fiber.Listen(fmt.Sprintf(":%s", strconv.FormatInt(s.Config.App.Port, 10)))
+	stopWorkers()
+
+	return err
 }
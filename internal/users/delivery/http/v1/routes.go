@@ -0,0 +1,12 @@
+package v1
+
+import "github.com/gofiber/fiber/v2"
+
+func MapUsers(routes fiber.Router, h *UsersHandler) {
+	users := routes.Group("/users/:userId")
+
+	users.Put("/employment", h.UpdateUserEmployment)
+	users.Put("/department", h.UpdateUserDepartment)
+	users.Put("/timezone", h.UpdateUserTimezone)
+	users.Put("/manager", h.UpdateUserManager)
+}
@@ -0,0 +1,222 @@
+package v1
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/constants"
+	"github.com/vnnyx/employee-management/internal/dtos"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+type UsersHandler struct {
+	uc users.UseCase
+}
+
+func NewUsersHandler(uc users.UseCase) *UsersHandler {
+	return &UsersHandler{
+		uc: uc,
+	}
+}
+
+// @Summary      Update User Employment
+// @Description  Set the dates a user is employed between, payroll excludes users outside a period and prorates the ones joining or leaving within it
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.UserEmploymentRequest true "User Employment Request"
+// @Success      200 {object} dtos.Response{data=dtos.UserEmploymentResponse} "User Employment Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/employment [PUT]
+// @Security     BearerAuth
+func (h *UsersHandler) UpdateUserEmployment(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"UsersHandler.UpdateUserEmployment()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserEmployment().c.ParamsParser()")
+	}
+
+	var req dtos.UserEmploymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserEmployment().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserEmployment().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateUserEmployment(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserEmployment().uc.UpdateUserEmployment()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewUserEmploymentResponse(data),
+		},
+	)
+}
+
+// @Summary      Update User Department
+// @Description  Set the department and cost centre payroll costs of a user are reported under, payslips keep the ones they were generated with
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.UserDepartmentRequest true "User Department Request"
+// @Success      200 {object} dtos.Response{data=dtos.UserDepartmentResponse} "User Department Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/department [PUT]
+// @Security     BearerAuth
+func (h *UsersHandler) UpdateUserDepartment(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"UsersHandler.UpdateUserDepartment()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserDepartment().c.ParamsParser()")
+	}
+
+	var req dtos.UserDepartmentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserDepartment().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserDepartment().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateUserDepartment(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserDepartment().uc.UpdateUserDepartment()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewUserDepartmentResponse(data),
+		},
+	)
+}
+
+// @Summary      Update User Timezone
+// @Description  Set the IANA timezone attendance and overtime of a user are dated and checked in, records already submitted keep their dates
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.UserTimezoneRequest true "User Timezone Request"
+// @Success      200 {object} dtos.Response{data=dtos.UserTimezoneResponse} "User Timezone Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/timezone [PUT]
+// @Security     BearerAuth
+func (h *UsersHandler) UpdateUserTimezone(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"UsersHandler.UpdateUserTimezone()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserTimezone().c.ParamsParser()")
+	}
+
+	var req dtos.UserTimezoneRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserTimezone().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserTimezone().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateUserTimezone(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserTimezone().uc.UpdateUserTimezone()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewUserTimezoneResponse(data),
+		},
+	)
+}
+
+// @Summary      Update User Manager
+// @Description  Set the manager approving the leave of a user, without a manager only admins approve it
+// @Tags         Users
+// @Accept       json
+// @Produce      json
+// @Param        userId path string true "User ID"
+// @Param        request body dtos.UserManagerRequest true "User Manager Request"
+// @Success      200 {object} dtos.Response{data=dtos.UserManagerResponse} "User Manager Response"
+// @Failure      400 {object} apperror.Error "Bad Request"
+// @Router       /v1/users/{userId}/manager [PUT]
+// @Security     BearerAuth
+func (h *UsersHandler) UpdateUserManager(c *fiber.Ctx) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		c.UserContext(),
+		"UsersHandler.UpdateUserManager()",
+	)
+	defer span.End()
+
+	authCredential := c.Locals(constants.KeyAuthCredential).(authCredential.Credential)
+
+	var param struct {
+		UserID uuid.UUID `params:"userId"`
+	}
+	err := c.ParamsParser(&param)
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserManager().c.ParamsParser()")
+	}
+
+	var req dtos.UserManagerRequest
+	if err := c.BodyParser(&req); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserManager().c.BodyParser()")
+	}
+
+	if err := req.Validate(); err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserManager().req.Validate()")
+	}
+
+	data, err := h.uc.UpdateUserManager(ctx, authCredential, param.UserID.String(), req.ToRequestEntity())
+	if err != nil {
+		return errors.Wrap(err, "UsersHandler().UpdateUserManager().uc.UpdateUserManager()")
+	}
+
+	return c.Status(fiber.StatusOK).JSON(
+		dtos.Response{
+			RequestID: authCredential.RequestID,
+			Data:      dtos.NewUserManagerResponse(data),
+		},
+	)
+}
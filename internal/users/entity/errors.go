@@ -0,0 +1,26 @@
+package entity
+
+const (
+	UserNotAuthorized     = "USER_NOT_AUTHORIZED"
+	UserNotFound          = "USER_NOT_FOUND"
+	UserEmploymentInvalid = "USER_EMPLOYMENT_INVALID"
+	UserManagerNotFound   = "USER_MANAGER_NOT_FOUND"
+	UserManagerInvalid    = "USER_MANAGER_INVALID"
+)
+
+func GetErrorMessageByIssueCode(issueCode string) string {
+	switch issueCode {
+	case UserNotAuthorized:
+		return "You are not authorized to manage users"
+	case UserNotFound:
+		return "User not found"
+	case UserEmploymentInvalid:
+		return "The employment end date cannot be before its start date"
+	case UserManagerNotFound:
+		return "Manager not found"
+	case UserManagerInvalid:
+		return "A user cannot be their own manager"
+	default:
+		return "An unknown error occurred"
+	}
+}
@@ -2,21 +2,109 @@ package entity
 
 import (
 	"time"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"github.com/vnnyx/employee-management/pkg/timezone"
 )
 
 type User struct {
-	ID        string    `db:"id"`
-	Username  string    `db:"username"`
-	Password  string    `db:"password"`
-	IsAdmin   bool      `db:"is_admin"`
-	Salary    int64     `db:"salary"`
-	CreatedAt time.Time `db:"created_at"`
+	ID                  string          `db:"id"`
+	Username            string          `db:"username"`
+	Password            string          `db:"password"`
+	IsAdmin             bool            `db:"is_admin"`
+	Salary              money.Money     `db:"salary"`
+	EmploymentStartDate time.Time       `db:"employment_start_date"`
+	EmploymentEndDate   optional.Time   `db:"employment_end_date"`
+	Department          optional.String `db:"department"`
+	CostCentre          optional.String `db:"cost_centre"`
+	Timezone            string          `db:"timezone"`
+	ManagerID           optional.String `db:"manager_id"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+	CreatedBy           string          `db:"created_by"`
+	UpdatedBy           string          `db:"updated_by"`
+	IPAddress           string          `db:"ip_address"`
+}
+
+// EmployedWithin clips the dates between startDate and endDate, both
+// included, to the employment of the user. ok is false when the user was not
+// employed on any of them.
+func (u User) EmployedWithin(startDate, endDate time.Time) (from, to time.Time, ok bool) {
+	from = truncateDate(startDate)
+	to = truncateDate(endDate)
+
+	if employmentStart := truncateDate(u.EmploymentStartDate); employmentStart.After(from) {
+		from = employmentStart
+	}
+	if employmentEnd, present := u.EmploymentEndDate.Get(); present {
+		if employmentEnd = truncateDate(employmentEnd); employmentEnd.Before(to) {
+			to = employmentEnd
+		}
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, false
+	}
+	return from, to, true
+}
+
+// Location is the timezone the user works in, users without a valid one work
+// in timezone.Default.
+func (u User) Location() *time.Location {
+	location, err := timezone.Load(u.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func truncateDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// UpdateUserEmployment sets the dates a user is employed between, an empty
+// EmploymentEndDate means the user is still employed.
+type UpdateUserEmployment struct {
+	UserID              string        `db:"user_id"`
+	EmploymentStartDate time.Time     `db:"employment_start_date"`
+	EmploymentEndDate   optional.Time `db:"employment_end_date"`
+	UpdatedAt           time.Time     `db:"updated_at"`
+	UpdatedBy           string        `db:"updated_by"`
+	IPAddress           string        `db:"ip_address"`
+}
+
+// UpdateUserDepartment sets the department and cost centre payroll costs of
+// a user are reported under, an empty value leaves the user unassigned.
+type UpdateUserDepartment struct {
+	UserID     string          `db:"user_id"`
+	Department optional.String `db:"department"`
+	CostCentre optional.String `db:"cost_centre"`
+	UpdatedAt  time.Time       `db:"updated_at"`
+	UpdatedBy  string          `db:"updated_by"`
+	IPAddress  string          `db:"ip_address"`
+}
+
+// UpdateUserTimezone sets the IANA timezone the attendance and overtime of a
+// user are dated and checked in.
+type UpdateUserTimezone struct {
+	UserID    string    `db:"user_id"`
+	Timezone  string    `db:"timezone"`
 	UpdatedAt time.Time `db:"updated_at"`
-	CreatedBy string    `db:"created_by"`
 	UpdatedBy string    `db:"updated_by"`
 	IPAddress string    `db:"ip_address"`
 }
 
+// UpdateUserManager sets the manager approving the leave of a user, an empty
+// ManagerID leaves the approval to admins.
+type UpdateUserManager struct {
+	UserID    string          `db:"user_id"`
+	ManagerID optional.String `db:"manager_id"`
+	UpdatedAt time.Time       `db:"updated_at"`
+	UpdatedBy string          `db:"updated_by"`
+	IPAddress string          `db:"ip_address"`
+}
+
 type MappedBy string
 
 const (
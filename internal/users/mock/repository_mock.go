@@ -126,6 +126,158 @@ func (c *MockRepositoryFindUserByIDCall) DoAndReturn(f func(context.Context, str
 	return c
 }
 
+// UpdateUserDepartment mocks base method.
+func (m *MockRepository) UpdateUserDepartment(ctx context.Context, update entity.UpdateUserDepartment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDepartment", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserDepartment indicates an expected call of UpdateUserDepartment.
+func (mr *MockRepositoryMockRecorder) UpdateUserDepartment(ctx, update any) *MockRepositoryUpdateUserDepartmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDepartment", reflect.TypeOf((*MockRepository)(nil).UpdateUserDepartment), ctx, update)
+	return &MockRepositoryUpdateUserDepartmentCall{Call: call}
+}
+
+// MockRepositoryUpdateUserDepartmentCall wrap *gomock.Call
+type MockRepositoryUpdateUserDepartmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateUserDepartmentCall) Return(arg0 error) *MockRepositoryUpdateUserDepartmentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateUserDepartmentCall) Do(f func(context.Context, entity.UpdateUserDepartment) error) *MockRepositoryUpdateUserDepartmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateUserDepartmentCall) DoAndReturn(f func(context.Context, entity.UpdateUserDepartment) error) *MockRepositoryUpdateUserDepartmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserEmployment mocks base method.
+func (m *MockRepository) UpdateUserEmployment(ctx context.Context, update entity.UpdateUserEmployment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmployment", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserEmployment indicates an expected call of UpdateUserEmployment.
+func (mr *MockRepositoryMockRecorder) UpdateUserEmployment(ctx, update any) *MockRepositoryUpdateUserEmploymentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmployment", reflect.TypeOf((*MockRepository)(nil).UpdateUserEmployment), ctx, update)
+	return &MockRepositoryUpdateUserEmploymentCall{Call: call}
+}
+
+// MockRepositoryUpdateUserEmploymentCall wrap *gomock.Call
+type MockRepositoryUpdateUserEmploymentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateUserEmploymentCall) Return(arg0 error) *MockRepositoryUpdateUserEmploymentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateUserEmploymentCall) Do(f func(context.Context, entity.UpdateUserEmployment) error) *MockRepositoryUpdateUserEmploymentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateUserEmploymentCall) DoAndReturn(f func(context.Context, entity.UpdateUserEmployment) error) *MockRepositoryUpdateUserEmploymentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserManager mocks base method.
+func (m *MockRepository) UpdateUserManager(ctx context.Context, update entity.UpdateUserManager) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserManager", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserManager indicates an expected call of UpdateUserManager.
+func (mr *MockRepositoryMockRecorder) UpdateUserManager(ctx, update any) *MockRepositoryUpdateUserManagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserManager", reflect.TypeOf((*MockRepository)(nil).UpdateUserManager), ctx, update)
+	return &MockRepositoryUpdateUserManagerCall{Call: call}
+}
+
+// MockRepositoryUpdateUserManagerCall wrap *gomock.Call
+type MockRepositoryUpdateUserManagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateUserManagerCall) Return(arg0 error) *MockRepositoryUpdateUserManagerCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateUserManagerCall) Do(f func(context.Context, entity.UpdateUserManager) error) *MockRepositoryUpdateUserManagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateUserManagerCall) DoAndReturn(f func(context.Context, entity.UpdateUserManager) error) *MockRepositoryUpdateUserManagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserTimezone mocks base method.
+func (m *MockRepository) UpdateUserTimezone(ctx context.Context, update entity.UpdateUserTimezone) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTimezone", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserTimezone indicates an expected call of UpdateUserTimezone.
+func (mr *MockRepositoryMockRecorder) UpdateUserTimezone(ctx, update any) *MockRepositoryUpdateUserTimezoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTimezone", reflect.TypeOf((*MockRepository)(nil).UpdateUserTimezone), ctx, update)
+	return &MockRepositoryUpdateUserTimezoneCall{Call: call}
+}
+
+// MockRepositoryUpdateUserTimezoneCall wrap *gomock.Call
+type MockRepositoryUpdateUserTimezoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockRepositoryUpdateUserTimezoneCall) Return(arg0 error) *MockRepositoryUpdateUserTimezoneCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockRepositoryUpdateUserTimezoneCall) Do(f func(context.Context, entity.UpdateUserTimezone) error) *MockRepositoryUpdateUserTimezoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockRepositoryUpdateUserTimezoneCall) DoAndReturn(f func(context.Context, entity.UpdateUserTimezone) error) *MockRepositoryUpdateUserTimezoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx database.DBTx) users.Repository {
 	m.ctrl.T.Helper()
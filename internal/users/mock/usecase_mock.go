@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/users/usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source internal/users/usecase.go -destination internal/users/mock/usecase_mock.go -package=mocks -typed
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/vnnyx/employee-management/internal/auth/entity"
+	entity0 "github.com/vnnyx/employee-management/internal/users/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateUserDepartment mocks base method.
+func (m *MockUseCase) UpdateUserDepartment(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.UpdateUserDepartment) (entity0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserDepartment", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserDepartment indicates an expected call of UpdateUserDepartment.
+func (mr *MockUseCaseMockRecorder) UpdateUserDepartment(ctx, authCredential, userID, payload any) *MockUseCaseUpdateUserDepartmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserDepartment", reflect.TypeOf((*MockUseCase)(nil).UpdateUserDepartment), ctx, authCredential, userID, payload)
+	return &MockUseCaseUpdateUserDepartmentCall{Call: call}
+}
+
+// MockUseCaseUpdateUserDepartmentCall wrap *gomock.Call
+type MockUseCaseUpdateUserDepartmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateUserDepartmentCall) Return(arg0 entity0.User, arg1 error) *MockUseCaseUpdateUserDepartmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateUserDepartmentCall) Do(f func(context.Context, entity.Credential, string, entity0.UpdateUserDepartment) (entity0.User, error)) *MockUseCaseUpdateUserDepartmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateUserDepartmentCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpdateUserDepartment) (entity0.User, error)) *MockUseCaseUpdateUserDepartmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserEmployment mocks base method.
+func (m *MockUseCase) UpdateUserEmployment(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.UpdateUserEmployment) (entity0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserEmployment", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserEmployment indicates an expected call of UpdateUserEmployment.
+func (mr *MockUseCaseMockRecorder) UpdateUserEmployment(ctx, authCredential, userID, payload any) *MockUseCaseUpdateUserEmploymentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserEmployment", reflect.TypeOf((*MockUseCase)(nil).UpdateUserEmployment), ctx, authCredential, userID, payload)
+	return &MockUseCaseUpdateUserEmploymentCall{Call: call}
+}
+
+// MockUseCaseUpdateUserEmploymentCall wrap *gomock.Call
+type MockUseCaseUpdateUserEmploymentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateUserEmploymentCall) Return(arg0 entity0.User, arg1 error) *MockUseCaseUpdateUserEmploymentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateUserEmploymentCall) Do(f func(context.Context, entity.Credential, string, entity0.UpdateUserEmployment) (entity0.User, error)) *MockUseCaseUpdateUserEmploymentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateUserEmploymentCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpdateUserEmployment) (entity0.User, error)) *MockUseCaseUpdateUserEmploymentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserManager mocks base method.
+func (m *MockUseCase) UpdateUserManager(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.UpdateUserManager) (entity0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserManager", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserManager indicates an expected call of UpdateUserManager.
+func (mr *MockUseCaseMockRecorder) UpdateUserManager(ctx, authCredential, userID, payload any) *MockUseCaseUpdateUserManagerCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserManager", reflect.TypeOf((*MockUseCase)(nil).UpdateUserManager), ctx, authCredential, userID, payload)
+	return &MockUseCaseUpdateUserManagerCall{Call: call}
+}
+
+// MockUseCaseUpdateUserManagerCall wrap *gomock.Call
+type MockUseCaseUpdateUserManagerCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateUserManagerCall) Return(arg0 entity0.User, arg1 error) *MockUseCaseUpdateUserManagerCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateUserManagerCall) Do(f func(context.Context, entity.Credential, string, entity0.UpdateUserManager) (entity0.User, error)) *MockUseCaseUpdateUserManagerCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateUserManagerCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpdateUserManager) (entity0.User, error)) *MockUseCaseUpdateUserManagerCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateUserTimezone mocks base method.
+func (m *MockUseCase) UpdateUserTimezone(ctx context.Context, authCredential entity.Credential, userID string, payload entity0.UpdateUserTimezone) (entity0.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTimezone", ctx, authCredential, userID, payload)
+	ret0, _ := ret[0].(entity0.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTimezone indicates an expected call of UpdateUserTimezone.
+func (mr *MockUseCaseMockRecorder) UpdateUserTimezone(ctx, authCredential, userID, payload any) *MockUseCaseUpdateUserTimezoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTimezone", reflect.TypeOf((*MockUseCase)(nil).UpdateUserTimezone), ctx, authCredential, userID, payload)
+	return &MockUseCaseUpdateUserTimezoneCall{Call: call}
+}
+
+// MockUseCaseUpdateUserTimezoneCall wrap *gomock.Call
+type MockUseCaseUpdateUserTimezoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUseCaseUpdateUserTimezoneCall) Return(arg0 entity0.User, arg1 error) *MockUseCaseUpdateUserTimezoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUseCaseUpdateUserTimezoneCall) Do(f func(context.Context, entity.Credential, string, entity0.UpdateUserTimezone) (entity0.User, error)) *MockUseCaseUpdateUserTimezoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUseCaseUpdateUserTimezoneCall) DoAndReturn(f func(context.Context, entity.Credential, string, entity0.UpdateUserTimezone) (entity0.User, error)) *MockUseCaseUpdateUserTimezoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -12,4 +12,8 @@ type Repository interface {
 
 	FindAllUsers(ctx context.Context, opts ...entity.FindUserOptions) (entity.FindUserResult, error)
 	FindUserByID(ctx context.Context, userID string) (*entity.User, error)
+	UpdateUserEmployment(ctx context.Context, update entity.UpdateUserEmployment) error
+	UpdateUserDepartment(ctx context.Context, update entity.UpdateUserDepartment) error
+	UpdateUserTimezone(ctx context.Context, update entity.UpdateUserTimezone) error
+	UpdateUserManager(ctx context.Context, update entity.UpdateUserManager) error
 }
@@ -5,7 +5,13 @@ SELECT
   id,
   username,
   is_admin,
-  salary
+  salary,
+  employment_start_date,
+  employment_end_date,
+  department,
+  cost_centre,
+  timezone,
+  manager_id
 FROM users
 `
 
@@ -14,7 +20,55 @@ SELECT
   id,
   username,
   is_admin,
-  salary
+  salary,
+  employment_start_date,
+  employment_end_date,
+  department,
+  cost_centre,
+  timezone,
+  manager_id
 FROM users
 WHERE id = $1
 `
+
+const updateUserEmploymentQuery = `
+UPDATE users SET
+	employment_start_date = :employment_start_date,
+	employment_end_date = :employment_end_date,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :user_id
+RETURNING id
+`
+
+const updateUserDepartmentQuery = `
+UPDATE users SET
+	department = :department,
+	cost_centre = :cost_centre,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :user_id
+RETURNING id
+`
+
+const updateUserTimezoneQuery = `
+UPDATE users SET
+	timezone = :timezone,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :user_id
+RETURNING id
+`
+
+const updateUserManagerQuery = `
+UPDATE users SET
+	manager_id = :manager_id,
+	updated_at = :updated_at,
+	updated_by = :updated_by,
+	ip_address = :ip_address
+WHERE id = :user_id
+RETURNING id
+`
@@ -5,6 +5,7 @@ import (
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
+	"github.com/jmoiron/sqlx"
 	"github.com/pkg/errors"
 	"github.com/vnnyx/employee-management/internal/constants"
 	"github.com/vnnyx/employee-management/internal/users"
@@ -57,6 +58,12 @@ func (r *userRepo) FindAllUsers(ctx context.Context, opts ...entity.FindUserOpti
 			&user.Username,
 			&user.IsAdmin,
 			&user.Salary,
+			&user.EmploymentStartDate,
+			&user.EmploymentEndDate,
+			&user.Department,
+			&user.CostCentre,
+			&user.Timezone,
+			&user.ManagerID,
 		)
 		if err != nil {
 			return result, errors.Wrap(err, constants.ErrWrapDbQueryRowScan)
@@ -114,3 +121,107 @@ func (r *userRepo) FindUserByID(ctx context.Context, userID string) (*entity.Use
 
 	return &user, nil
 }
+
+func (r *userRepo) UpdateUserEmployment(ctx context.Context, update entity.UpdateUserEmployment) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserRepository.UpdateUserEmployment()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateUserEmploymentQuery, update)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update user employment"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *userRepo) UpdateUserDepartment(ctx context.Context, update entity.UpdateUserDepartment) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserRepository.UpdateUserDepartment()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateUserDepartmentQuery, update)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update user department"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *userRepo) UpdateUserTimezone(ctx context.Context, update entity.UpdateUserTimezone) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserRepository.UpdateUserTimezone()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateUserTimezoneQuery, update)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update user timezone"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
+
+func (r *userRepo) UpdateUserManager(ctx context.Context, update entity.UpdateUserManager) error {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserRepository.UpdateUserManager()",
+	)
+	defer span.End()
+
+	query, args, err := sqlx.Named(updateUserManagerQuery, update)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapSqlxNamed)
+	}
+	query = database.Rebind(query)
+
+	var returnedID string
+	err = pgxscan.Get(ctx, r.db, &returnedID, query, args...)
+	if err != nil {
+		return errors.Wrap(err, constants.ErrWrapPgxscanGet)
+	}
+
+	if returnedID == "" {
+		return errors.Wrap(errors.New("failed to update user manager"), constants.ErrWrapPgxscanGet)
+	}
+
+	return nil
+}
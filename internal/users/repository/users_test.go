@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pashagolub/pgxmock/v4"
@@ -10,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/vnnyx/employee-management/internal/users/entity"
 	"github.com/vnnyx/employee-management/internal/users/repository"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
 func TestFindUserByID(t *testing.T) {
@@ -18,6 +21,8 @@ func TestFindUserByID(t *testing.T) {
 	defer mock.Close()
 
 	repo := repository.NewUserRepository(mock)
+	startDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name      string
@@ -30,17 +35,19 @@ func TestFindUserByID(t *testing.T) {
 			name:   "success - user found",
 			userID: "123",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{"id", "username", "is_admin", "salary"}).
-					AddRow("123", "john_doe", true, 5000)
+				rows := pgxmock.NewRows([]string{"id", "username", "is_admin", "salary", "employment_start_date", "employment_end_date"}).
+					AddRow("123", "john_doe", true, "5000.00", startDate, optional.NewTime(endDate))
 				mock.ExpectQuery("SELECT (.+) FROM users WHERE id =").
 					WithArgs("123").
 					WillReturnRows(rows)
 			},
 			wantUser: &entity.User{
-				ID:       "123",
-				Username: "john_doe",
-				IsAdmin:  true,
-				Salary:   5000,
+				ID:                  "123",
+				Username:            "john_doe",
+				IsAdmin:             true,
+				Salary:              money.New(5000),
+				EmploymentStartDate: startDate,
+				EmploymentEndDate:   optional.NewTime(endDate),
 			},
 			expectErr: false,
 		},
@@ -83,12 +90,17 @@ func TestFindUserByID(t *testing.T) {
 	}
 }
 
+var findUsersColumns = []string{
+	"id", "username", "is_admin", "salary", "employment_start_date", "employment_end_date", "department", "cost_centre", "timezone", "manager_id",
+}
+
 func TestFindAllUsers(t *testing.T) {
 	mock, err := pgxmock.NewPool()
 	assert.NoError(t, err)
 	defer mock.Close()
 
 	repo := repository.NewUserRepository(mock)
+	startDate := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name       string
@@ -101,9 +113,9 @@ func TestFindAllUsers(t *testing.T) {
 		{
 			name: "success - no options",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{"id", "username", "is_admin", "salary"}).
-					AddRow("1", "user1", false, 1000).
-					AddRow("2", "user2", true, 2000)
+				rows := pgxmock.NewRows(findUsersColumns).
+					AddRow("1", "user1", false, "1000.00", startDate, optional.NewTime(), optional.NewString("Engineering"), optional.NewString("CC-100"), "Asia/Jakarta", optional.NewString("2")).
+					AddRow("2", "user2", true, "2000.00", startDate, optional.NewTime(), optional.NewString(), optional.NewString(), "UTC", optional.NewString())
 				mock.ExpectQuery("SELECT (.+) FROM users$").
 					WillReturnRows(rows)
 			},
@@ -115,10 +127,10 @@ func TestFindAllUsers(t *testing.T) {
 		{
 			name: "success - with mapped by user id",
 			setupMock: func() {
-				rows := pgxmock.NewRows([]string{"id", "username", "is_admin", "salary"}).
-					AddRow("1", "user1", false, 1000).
-					AddRow("2", "user2", true, 2000).
-					AddRow("1", "user3", false, 1500)
+				rows := pgxmock.NewRows(findUsersColumns).
+					AddRow("1", "user1", false, "1000.00", startDate, optional.NewTime(), optional.NewString("Engineering"), optional.NewString("CC-100"), "Asia/Jakarta", optional.NewString("2")).
+					AddRow("2", "user2", true, "2000.00", startDate, optional.NewTime(), optional.NewString(), optional.NewString(), "UTC", optional.NewString()).
+					AddRow("1", "user3", false, "1500.00", startDate, optional.NewTime(), optional.NewString(), optional.NewString(), "UTC", optional.NewString())
 				mock.ExpectQuery("SELECT (.+) FROM users$").
 					WillReturnRows(rows)
 			},
@@ -157,6 +169,10 @@ func TestFindAllUsers(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Len(t, result.List, tt.wantCount)
+				assert.Equal(t, optional.NewString("Engineering"), result.List[0].Department)
+				assert.Equal(t, optional.NewString("CC-100"), result.List[0].CostCentre)
+				assert.Equal(t, "Asia/Jakarta", result.List[0].Timezone)
+				assert.Equal(t, optional.NewString("2"), result.List[0].ManagerID)
 
 				if tt.wantMapped {
 					assert.True(t, result.IsMapped)
@@ -176,3 +192,217 @@ func TestFindAllUsers(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserEmployment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewUserRepository(mock)
+	input := entity.UpdateUserEmployment{
+		UserID:              "user-1",
+		EmploymentStartDate: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		EmploymentEndDate:   optional.NewTime(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)),
+		UpdatedAt:           time.Now(),
+		UpdatedBy:           "admin-1",
+		IPAddress:           "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.EmploymentStartDate, input.EmploymentEndDate, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("user-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - user not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.EmploymentStartDate, input.EmploymentEndDate, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateUserEmployment(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateUserDepartment(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewUserRepository(mock)
+	input := entity.UpdateUserDepartment{
+		UserID:     "user-1",
+		Department: optional.NewString("Engineering"),
+		CostCentre: optional.NewString("CC-200"),
+		UpdatedAt:  time.Now(),
+		UpdatedBy:  "admin-1",
+		IPAddress:  "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.Department, input.CostCentre, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("user-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - user not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.Department, input.CostCentre, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateUserDepartment(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateUserTimezone(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewUserRepository(mock)
+	input := entity.UpdateUserTimezone{
+		UserID:    "user-1",
+		Timezone:  "America/New_York",
+		UpdatedAt: time.Now(),
+		UpdatedBy: "admin-1",
+		IPAddress: "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.Timezone, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("user-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - user not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.Timezone, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateUserTimezone(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUpdateUserManager(t *testing.T) {
+	mock, err := pgxmock.NewPool()
+	assert.NoError(t, err)
+	defer mock.Close()
+
+	repo := repository.NewUserRepository(mock)
+	input := entity.UpdateUserManager{
+		UserID:    "user-1",
+		ManagerID: optional.NewString("manager-1"),
+		UpdatedAt: time.Now(),
+		UpdatedBy: "admin-1",
+		IPAddress: "127.0.0.1",
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func()
+		expectErr bool
+	}{
+		{
+			name: "success",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.ManagerID, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}).AddRow("user-1"))
+			},
+			expectErr: false,
+		},
+		{
+			name: "error - user not updated",
+			setupMock: func() {
+				mock.ExpectQuery("UPDATE users").
+					WithArgs(input.ManagerID, input.UpdatedAt, input.UpdatedBy, input.IPAddress, input.UserID).
+					WillReturnRows(pgxmock.NewRows([]string{"id"}))
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMock()
+			err := repo.UpdateUserManager(context.Background(), input)
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1 +1,15 @@
-package users
\ No newline at end of file
+package users
+
+import (
+	"context"
+
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/users/entity"
+)
+
+type UseCase interface {
+	UpdateUserEmployment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserEmployment) (entity.User, error)
+	UpdateUserDepartment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserDepartment) (entity.User, error)
+	UpdateUserTimezone(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserTimezone) (entity.User, error)
+	UpdateUserManager(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserManager) (entity.User, error)
+}
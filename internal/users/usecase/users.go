@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/users"
+	"github.com/vnnyx/employee-management/internal/users/entity"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/observability/instrumentation"
+)
+
+const userDateFormat = "2006-01-02"
+
+type userUseCase struct {
+	userRepo users.Repository
+}
+
+func NewUserUseCase(userRepo users.Repository) users.UseCase {
+	return &userUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// UpdateUserEmployment sets the dates a user is employed between. Payrolls
+// already generated keep their numbers until they are regenerated.
+func (u *userUseCase) UpdateUserEmployment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserEmployment) (entity.User, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserUseCase.UpdateUserEmployment()",
+	)
+	defer span.End()
+
+	var user entity.User
+
+	if !*authCredential.IsAdmin {
+		return entity.User{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.UserNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+			},
+		)
+	}
+
+	if endDate, ok := payload.EmploymentEndDate.Get(); ok && endDate.Before(payload.EmploymentStartDate) {
+		return entity.User{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.UserEmploymentInvalid,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserEmploymentInvalid),
+				Received:  endDate.Format(userDateFormat),
+				Expected:  payload.EmploymentStartDate.Format(userDateFormat),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		existingUser, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserEmployment().FindUserByID()")
+		}
+		if existingUser == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		timeNow := time.Now()
+		err = userRepoTx.UpdateUserEmployment(ctx, entity.UpdateUserEmployment{
+			UserID:              userID,
+			EmploymentStartDate: payload.EmploymentStartDate,
+			EmploymentEndDate:   payload.EmploymentEndDate,
+			UpdatedAt:           timeNow,
+			UpdatedBy:           authCredential.UserID,
+			IPAddress:           authCredential.IPAddress,
+		})
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserEmployment().UpdateUserEmployment()")
+		}
+
+		user = *existingUser
+		user.EmploymentStartDate = payload.EmploymentStartDate
+		user.EmploymentEndDate = payload.EmploymentEndDate
+
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, errors.Wrap(err, "UserUseCase.UpdateUserEmployment().WithAuditContext()")
+	}
+
+	return user, nil
+}
+
+// UpdateUserDepartment moves a user to another department or cost centre.
+// Payslips keep the ones they were generated with, so cost reports of earlier
+// periods do not change.
+func (u *userUseCase) UpdateUserDepartment(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserDepartment) (entity.User, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserUseCase.UpdateUserDepartment()",
+	)
+	defer span.End()
+
+	var user entity.User
+
+	if !*authCredential.IsAdmin {
+		return entity.User{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.UserNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		existingUser, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserDepartment().FindUserByID()")
+		}
+		if existingUser == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		timeNow := time.Now()
+		err = userRepoTx.UpdateUserDepartment(ctx, entity.UpdateUserDepartment{
+			UserID:     userID,
+			Department: payload.Department,
+			CostCentre: payload.CostCentre,
+			UpdatedAt:  timeNow,
+			UpdatedBy:  authCredential.UserID,
+			IPAddress:  authCredential.IPAddress,
+		})
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserDepartment().UpdateUserDepartment()")
+		}
+
+		user = *existingUser
+		user.Department = payload.Department
+		user.CostCentre = payload.CostCentre
+
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, errors.Wrap(err, "UserUseCase.UpdateUserDepartment().WithAuditContext()")
+	}
+
+	return user, nil
+}
+
+// UpdateUserTimezone moves a user to another timezone. Attendance and overtime
+// already recorded keep the dates they were submitted on.
+func (u *userUseCase) UpdateUserTimezone(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserTimezone) (entity.User, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserUseCase.UpdateUserTimezone()",
+	)
+	defer span.End()
+
+	var user entity.User
+
+	if !*authCredential.IsAdmin {
+		return entity.User{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.UserNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		existingUser, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserTimezone().FindUserByID()")
+		}
+		if existingUser == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		timeNow := time.Now()
+		err = userRepoTx.UpdateUserTimezone(ctx, entity.UpdateUserTimezone{
+			UserID:    userID,
+			Timezone:  payload.Timezone,
+			UpdatedAt: timeNow,
+			UpdatedBy: authCredential.UserID,
+			IPAddress: authCredential.IPAddress,
+		})
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserTimezone().UpdateUserTimezone()")
+		}
+
+		user = *existingUser
+		user.Timezone = payload.Timezone
+
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, errors.Wrap(err, "UserUseCase.UpdateUserTimezone().WithAuditContext()")
+	}
+
+	return user, nil
+}
+
+// UpdateUserManager sets who approves the leave of a user. Requests already
+// decided keep the manager who decided them.
+func (u *userUseCase) UpdateUserManager(ctx context.Context, authCredential authCredential.Credential, userID string, payload entity.UpdateUserManager) (entity.User, error) {
+	ctx, span := instrumentation.NewTraceSpan(
+		ctx,
+		"UserUseCase.UpdateUserManager()",
+	)
+	defer span.End()
+
+	var user entity.User
+
+	if !*authCredential.IsAdmin {
+		return entity.User{}, apperror.Forbidden(
+			apperror.AppError{
+				IssueCode: entity.UserNotAuthorized,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+			},
+		)
+	}
+
+	if managerID, ok := payload.ManagerID.Get(); ok && managerID == userID {
+		return entity.User{}, apperror.BadRequest(
+			apperror.AppError{
+				IssueCode: entity.UserManagerInvalid,
+				Message:   entity.GetErrorMessageByIssueCode(entity.UserManagerInvalid),
+				Received:  managerID,
+			},
+		)
+	}
+
+	err := database.WithAuditContext(ctx, authCredential, pgx.TxOptions{}, func(tx database.DBTx) error {
+		userRepoTx := u.userRepo.WithTx(tx)
+
+		existingUser, err := userRepoTx.FindUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserManager().FindUserByID()")
+		}
+		if existingUser == nil {
+			return apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  userID,
+				},
+			)
+		}
+
+		if managerID, ok := payload.ManagerID.Get(); ok {
+			manager, err := userRepoTx.FindUserByID(ctx, managerID)
+			if err != nil {
+				return errors.Wrap(err, "UserUseCase.UpdateUserManager().FindUserByID()")
+			}
+			if manager == nil {
+				return apperror.NotFound(
+					apperror.AppError{
+						IssueCode: entity.UserManagerNotFound,
+						Message:   entity.GetErrorMessageByIssueCode(entity.UserManagerNotFound),
+						Received:  managerID,
+					},
+				)
+			}
+		}
+
+		timeNow := time.Now()
+		err = userRepoTx.UpdateUserManager(ctx, entity.UpdateUserManager{
+			UserID:    userID,
+			ManagerID: payload.ManagerID,
+			UpdatedAt: timeNow,
+			UpdatedBy: authCredential.UserID,
+			IPAddress: authCredential.IPAddress,
+		})
+		if err != nil {
+			return errors.Wrap(err, "UserUseCase.UpdateUserManager().UpdateUserManager()")
+		}
+
+		user = *existingUser
+		user.ManagerID = payload.ManagerID
+
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, errors.Wrap(err, "UserUseCase.UpdateUserManager().WithAuditContext()")
+	}
+
+	return user, nil
+}
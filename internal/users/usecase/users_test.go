@@ -0,0 +1,529 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	authCredential "github.com/vnnyx/employee-management/internal/auth/entity"
+	"github.com/vnnyx/employee-management/internal/users/entity"
+	mockUser "github.com/vnnyx/employee-management/internal/users/mock"
+	"github.com/vnnyx/employee-management/internal/users/usecase"
+	"github.com/vnnyx/employee-management/pkg/apperror"
+	"github.com/vnnyx/employee-management/pkg/database"
+	"github.com/vnnyx/employee-management/pkg/money"
+	"github.com/vnnyx/employee-management/pkg/optional"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	adminCredential = authCredential.Credential{
+		UserID:    "admin-1",
+		IPAddress: "127.0.0.1",
+		Username:  "admin",
+		IsAdmin:   func(b bool) *bool { return &b }(true),
+		RequestID: "req-123",
+	}
+	userCredential = authCredential.Credential{
+		UserID:    "user-1",
+		IPAddress: "127.0.0.1",
+		Username:  "testuser",
+		IsAdmin:   func(b bool) *bool { return &b }(false),
+		RequestID: "req-123",
+	}
+)
+
+func patchAuditContext() *gomonkey.Patches {
+	return gomonkey.ApplyFunc(database.WithAuditContext, func(
+		ctx context.Context,
+		cred authCredential.Credential,
+		txOpt pgx.TxOptions,
+		fn func(tx database.DBTx) error,
+	) error {
+		return fn(nil)
+	})
+}
+
+type mockParams struct {
+	userRepo   *mockUser.MockRepository
+	userRepoTx *mockUser.MockRepository
+}
+
+func TestUpdateUserEmployment(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateUserEmployment
+		expectedUser   entity.User
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	startDate := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)
+	existingUser := entity.User{
+		ID:                  "user-1",
+		Username:            "employee_1",
+		Salary:              money.New(1000),
+		EmploymentStartDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		EmploymentEndDate:   optional.NewTime(),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - leaving date set",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserEmployment{
+				EmploymentStartDate: startDate,
+				EmploymentEndDate:   optional.NewTime(endDate),
+			},
+			expectedUser: entity.User{
+				ID:                  "user-1",
+				Username:            "employee_1",
+				Salary:              money.New(1000),
+				EmploymentStartDate: startDate,
+				EmploymentEndDate:   optional.NewTime(endDate),
+			},
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().UpdateUserEmployment(gomock.Any(), entity.UpdateUserEmployment{
+					UserID:              "user-1",
+					EmploymentStartDate: startDate,
+					EmploymentEndDate:   optional.NewTime(endDate),
+					UpdatedAt:           now,
+					UpdatedBy:           "admin-1",
+					IPAddress:           "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "error - end date before start date",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserEmployment{
+				EmploymentStartDate: endDate,
+				EmploymentEndDate:   optional.NewTime(startDate),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.UserEmploymentInvalid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserEmploymentInvalid),
+					Received:  "2025-03-10",
+					Expected:  "2025-09-15",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserEmployment{
+				EmploymentStartDate: startDate,
+				EmploymentEndDate:   optional.NewTime(),
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload: entity.UpdateUserEmployment{
+				EmploymentStartDate: startDate,
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.UserNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return now })
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				userRepo:   mockUser.NewMockRepository(ctrl),
+				userRepoTx: mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewUserUseCase(m.userRepo)
+
+			user, err := useCase.UpdateUserEmployment(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+		})
+	}
+}
+
+func TestUpdateUserDepartment(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateUserDepartment
+		expectedUser   entity.User
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	existingUser := entity.User{
+		ID:         "user-1",
+		Username:   "employee_1",
+		Salary:     money.New(1000),
+		Department: optional.NewString("Sales"),
+		CostCentre: optional.NewString("CC-100"),
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - moved to another department",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserDepartment{
+				Department: optional.NewString("Engineering"),
+				CostCentre: optional.NewString(),
+			},
+			expectedUser: entity.User{
+				ID:         "user-1",
+				Username:   "employee_1",
+				Salary:     money.New(1000),
+				Department: optional.NewString("Engineering"),
+				CostCentre: optional.NewString(),
+			},
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().UpdateUserDepartment(gomock.Any(), entity.UpdateUserDepartment{
+					UserID:     "user-1",
+					Department: optional.NewString("Engineering"),
+					CostCentre: optional.NewString(),
+					UpdatedAt:  now,
+					UpdatedBy:  "admin-1",
+					IPAddress:  "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserDepartment{
+				Department: optional.NewString("Engineering"),
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload: entity.UpdateUserDepartment{
+				Department: optional.NewString("Engineering"),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.UserNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return now })
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				userRepo:   mockUser.NewMockRepository(ctrl),
+				userRepoTx: mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewUserUseCase(m.userRepo)
+
+			user, err := useCase.UpdateUserDepartment(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+		})
+	}
+}
+
+func TestUpdateUserTimezone(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateUserTimezone
+		expectedUser   entity.User
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	existingUser := entity.User{
+		ID:       "user-1",
+		Username: "employee_1",
+		Salary:   money.New(1000),
+		Timezone: "UTC",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - moved to another timezone",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserTimezone{
+				Timezone: "Asia/Jakarta",
+			},
+			expectedUser: entity.User{
+				ID:       "user-1",
+				Username: "employee_1",
+				Salary:   money.New(1000),
+				Timezone: "Asia/Jakarta",
+			},
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().UpdateUserTimezone(gomock.Any(), entity.UpdateUserTimezone{
+					UserID:    "user-1",
+					Timezone:  "Asia/Jakarta",
+					UpdatedAt: now,
+					UpdatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "error - user not found",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserTimezone{
+				Timezone: "Asia/Jakarta",
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotFound),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload: entity.UpdateUserTimezone{
+				Timezone: "Asia/Jakarta",
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.UserNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return now })
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				userRepo:   mockUser.NewMockRepository(ctrl),
+				userRepoTx: mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewUserUseCase(m.userRepo)
+
+			user, err := useCase.UpdateUserTimezone(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+		})
+	}
+}
+
+func TestUpdateUserManager(t *testing.T) {
+	type testCase struct {
+		name           string
+		authCredential authCredential.Credential
+		payload        entity.UpdateUserManager
+		expectedUser   entity.User
+		expectedErr    error
+		setupMock      func(m mockParams)
+	}
+
+	now := time.Date(2025, 8, 20, 10, 0, 0, 0, time.UTC)
+	existingUser := entity.User{
+		ID:       "user-1",
+		Username: "employee_1",
+		Salary:   money.New(1000),
+	}
+	manager := entity.User{
+		ID:       "manager-1",
+		Username: "manager_1",
+	}
+
+	tests := []testCase{
+		{
+			name:           "success - manager assigned",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserManager{
+				ManagerID: optional.NewString("manager-1"),
+			},
+			expectedUser: entity.User{
+				ID:        "user-1",
+				Username:  "employee_1",
+				Salary:    money.New(1000),
+				ManagerID: optional.NewString("manager-1"),
+			},
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "manager-1").Return(&manager, nil)
+				m.userRepoTx.EXPECT().UpdateUserManager(gomock.Any(), entity.UpdateUserManager{
+					UserID:    "user-1",
+					ManagerID: optional.NewString("manager-1"),
+					UpdatedAt: now,
+					UpdatedBy: "admin-1",
+					IPAddress: "127.0.0.1",
+				}).Return(nil)
+			},
+		},
+		{
+			name:           "success - manager removed",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserManager{
+				ManagerID: optional.NewString(),
+			},
+			expectedUser: entity.User{
+				ID:        "user-1",
+				Username:  "employee_1",
+				Salary:    money.New(1000),
+				ManagerID: optional.NewString(),
+			},
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().UpdateUserManager(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:           "error - own manager",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserManager{
+				ManagerID: optional.NewString("user-1"),
+			},
+			expectedErr: apperror.BadRequest(
+				apperror.AppError{
+					IssueCode: entity.UserManagerInvalid,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserManagerInvalid),
+					Received:  "user-1",
+				}),
+			setupMock: func(m mockParams) {},
+		},
+		{
+			name:           "error - manager not found",
+			authCredential: adminCredential,
+			payload: entity.UpdateUserManager{
+				ManagerID: optional.NewString("manager-9"),
+			},
+			expectedErr: apperror.NotFound(
+				apperror.AppError{
+					IssueCode: entity.UserManagerNotFound,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserManagerNotFound),
+					Received:  "manager-9",
+				}),
+			setupMock: func(m mockParams) {
+				m.userRepo.EXPECT().WithTx(gomock.Any()).Return(m.userRepoTx)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "user-1").Return(&existingUser, nil)
+				m.userRepoTx.EXPECT().FindUserByID(gomock.Any(), "manager-9").Return(nil, nil)
+			},
+		},
+		{
+			name:           "error - user not admin",
+			authCredential: userCredential,
+			payload: entity.UpdateUserManager{
+				ManagerID: optional.NewString("manager-1"),
+			},
+			expectedErr: apperror.Forbidden(
+				apperror.AppError{
+					IssueCode: entity.UserNotAuthorized,
+					Message:   entity.GetErrorMessageByIssueCode(entity.UserNotAuthorized),
+				}),
+			setupMock: func(m mockParams) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patches := patchAuditContext()
+			defer patches.Reset()
+			patches.ApplyFunc(time.Now, func() time.Time { return now })
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := mockParams{
+				userRepo:   mockUser.NewMockRepository(ctrl),
+				userRepoTx: mockUser.NewMockRepository(ctrl),
+			}
+			tt.setupMock(m)
+
+			useCase := usecase.NewUserUseCase(m.userRepo)
+
+			user, err := useCase.UpdateUserManager(context.Background(), tt.authCredential, "user-1", tt.payload)
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedUser, user)
+			}
+		})
+	}
+}
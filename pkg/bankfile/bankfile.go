@@ -0,0 +1,113 @@
+// Package bankfile writes credit transfer batches in the file layouts banks
+// accept for bulk uploads.
+//
+// A Batch is a set of transfers from one debtor account, executed on the same
+// day in the same currency. The same batch can be written as a generic CSV, a
+// fixed-width text file or an ISO 20022 pain.001 XML message.
+package bankfile
+
+import (
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+type Format string
+
+const (
+	// FormatCSV is a generic CSV with a header row, one transfer per row.
+	FormatCSV Format = "csv"
+	// FormatFixedWidth is a fixed-width text file of header, detail and
+	// trailer records, the layout of most legacy bank upload portals.
+	FormatFixedWidth Format = "fixed_width"
+	// FormatPain001 is an ISO 20022 pain.001.001.03 customer credit transfer
+	// initiation message.
+	FormatPain001 Format = "pain001"
+)
+
+// Formats lists every supported format.
+var Formats = []Format{FormatCSV, FormatFixedWidth, FormatPain001}
+
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv"
+	case FormatPain001:
+		return "application/xml"
+	default:
+		return "text/plain"
+	}
+}
+
+func (f Format) Extension() string {
+	switch f {
+	case FormatCSV:
+		return "csv"
+	case FormatPain001:
+		return "xml"
+	default:
+		return "txt"
+	}
+}
+
+// Account identifies the owner of a bank account and the bank holding it.
+// AccountNumber may be an IBAN, BIC is optional where banks are identified by
+// their local clearing code.
+type Account struct {
+	Name          string
+	AccountNumber string
+	BankCode      string
+	BIC           string
+}
+
+type Transfer struct {
+	// EndToEndID is passed unchanged to the creditor, at most 35 characters.
+	EndToEndID string
+	Creditor   Account
+	Amount     money.Money
+	// RemittanceInformation is the free text shown on the creditor statement.
+	RemittanceInformation string
+}
+
+type Batch struct {
+	// MessageID identifies the batch towards the bank, at most 35 characters.
+	MessageID     string
+	CreatedAt     time.Time
+	ExecutionDate time.Time
+	Currency      string
+	Debtor        Account
+	Transfers     []Transfer
+}
+
+// Total is the control sum of the batch.
+func (b Batch) Total() money.Money {
+	var total money.Money
+	for _, transfer := range b.Transfers {
+		total = total.Add(transfer.Amount)
+	}
+	return total
+}
+
+// Write writes the batch in the given format.
+func Write(w io.Writer, format Format, batch Batch) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, batch)
+	case FormatFixedWidth:
+		return writeFixedWidth(w, batch)
+	case FormatPain001:
+		return writePain001(w, batch)
+	}
+	return errors.Errorf("unsupported bank file format %q", format)
+}
+
+var ibanPattern = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+
+// IsIBAN reports whether an account number has the shape of an IBAN. The
+// check digits are not verified, the bank does that on upload.
+func IsIBAN(accountNumber string) bool {
+	return ibanPattern.MatchString(accountNumber)
+}
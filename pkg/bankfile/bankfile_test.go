@@ -0,0 +1,173 @@
+package bankfile_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/pkg/bankfile"
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+func testBatch() bankfile.Batch {
+	return bankfile.Batch{
+		MessageID:     "PAYROLL0001",
+		CreatedAt:     time.Date(2025, 6, 28, 9, 30, 0, 0, time.UTC),
+		ExecutionDate: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		Currency:      "EUR",
+		Debtor: bankfile.Account{
+			Name:          "Acme Corp",
+			AccountNumber: "DE89370400440532013000",
+			BankCode:      "37040044",
+			BIC:           "COBADEFFXXX",
+		},
+		Transfers: []bankfile.Transfer{
+			{
+				EndToEndID:            "SLIP0001",
+				Creditor:              bankfile.Account{Name: "Jane Doe", AccountNumber: "NL91ABNA0417164300", BankCode: "ABNA", BIC: "ABNANL2A"},
+				Amount:                money.MustParse("1500.50"),
+				RemittanceInformation: "Salary June 2025",
+			},
+			{
+				EndToEndID:            "SLIP0002",
+				Creditor:              bankfile.Account{Name: "John Smith", AccountNumber: "1234567890", BankCode: "014"},
+				Amount:                money.MustParse("2250.25"),
+				RemittanceInformation: "Salary June 2025",
+			},
+		},
+	}
+}
+
+func TestTotal(t *testing.T) {
+	assert.Equal(t, money.MustParse("3750.75"), testBatch().Total())
+	assert.True(t, bankfile.Batch{}.Total().IsZero())
+}
+
+func TestIsIBAN(t *testing.T) {
+	assert.True(t, bankfile.IsIBAN("DE89370400440532013000"))
+	assert.True(t, bankfile.IsIBAN("NL91ABNA0417164300"))
+	assert.False(t, bankfile.IsIBAN("1234567890"))
+	assert.False(t, bankfile.IsIBAN("de89370400440532013000"))
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := bankfile.Write(&buf, bankfile.FormatCSV, testBatch())
+	assert.NoError(t, err)
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, "end_to_end_id", records[0][0])
+	assert.Equal(t, []string{"SLIP0001", "Jane Doe", "NL91ABNA0417164300", "ABNA", "ABNANL2A", "1500.50", "EUR", "2025-06-30", "Salary June 2025"}, records[1])
+	assert.Equal(t, []string{"SLIP0002", "John Smith", "1234567890", "014", "", "2250.25", "EUR", "2025-06-30", "Salary June 2025"}, records[2])
+}
+
+func TestWriteFixedWidth(t *testing.T) {
+	var buf bytes.Buffer
+	err := bankfile.Write(&buf, bankfile.FormatFixedWidth, testBatch())
+	assert.NoError(t, err)
+
+	output := buf.String()
+	assert.True(t, strings.HasSuffix(output, "\r\n"))
+
+	records := strings.Split(strings.TrimSuffix(output, "\r\n"), "\r\n")
+	assert.Len(t, records, 4)
+	for _, record := range records {
+		assert.Len(t, record, 140)
+	}
+
+	assert.True(t, strings.HasPrefix(records[0], "H2025062820250630DE89370400440532013000"))
+	assert.Equal(t, "D000001ABNA       NL91ABNA0417164300", records[1][:36])
+	assert.Contains(t, records[1], "JANE DOE")
+	assert.Contains(t, records[1], "000000000150050SLIP0001")
+	assert.Contains(t, records[2], "000000000225025SLIP0002")
+	assert.Equal(t, "T000002000000000000375075", strings.TrimRight(records[3], " "))
+}
+
+func TestWriteFixedWidthNegativeAmount(t *testing.T) {
+	batch := testBatch()
+	batch.Transfers[0].Amount = money.MustParse("-1.00")
+
+	err := bankfile.Write(&bytes.Buffer{}, bankfile.FormatFixedWidth, batch)
+	assert.Error(t, err)
+}
+
+type pain001Document struct {
+	GrpHdr struct {
+		MsgId   string
+		CreDtTm string
+		NbOfTxs string
+		CtrlSum string
+	} `xml:"CstmrCdtTrfInitn>GrpHdr"`
+	PmtInf struct {
+		ReqdExctnDt string
+		DbtrAcct    struct {
+			IBAN string `xml:"Id>IBAN"`
+		}
+		CdtTrfTxInf []struct {
+			EndToEndId string `xml:"PmtId>EndToEndId"`
+			InstdAmt   struct {
+				Ccy   string `xml:"Ccy,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"Amt>InstdAmt"`
+			BIC      string `xml:"CdtrAgt>FinInstnId>BIC"`
+			MmbId    string `xml:"CdtrAgt>FinInstnId>ClrSysMmbId>MmbId"`
+			IBAN     string `xml:"CdtrAcct>Id>IBAN"`
+			OthrId   string `xml:"CdtrAcct>Id>Othr>Id"`
+			Ustrd    string `xml:"RmtInf>Ustrd"`
+			Creditor string `xml:"Cdtr>Nm"`
+		}
+	} `xml:"CstmrCdtTrfInitn>PmtInf"`
+}
+
+func TestWritePain001(t *testing.T) {
+	var buf bytes.Buffer
+	err := bankfile.Write(&buf, bankfile.FormatPain001, testBatch())
+	assert.NoError(t, err)
+	assert.Contains(t, buf.String(), `xmlns="urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"`)
+
+	var doc pain001Document
+	assert.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+
+	assert.Equal(t, "PAYROLL0001", doc.GrpHdr.MsgId)
+	assert.Equal(t, "2025-06-28T09:30:00", doc.GrpHdr.CreDtTm)
+	assert.Equal(t, "2", doc.GrpHdr.NbOfTxs)
+	assert.Equal(t, "3750.75", doc.GrpHdr.CtrlSum)
+	assert.Equal(t, "2025-06-30", doc.PmtInf.ReqdExctnDt)
+	assert.Equal(t, "DE89370400440532013000", doc.PmtInf.DbtrAcct.IBAN)
+
+	transactions := doc.PmtInf.CdtTrfTxInf
+	assert.Len(t, transactions, 2)
+
+	assert.Equal(t, "SLIP0001", transactions[0].EndToEndId)
+	assert.Equal(t, "EUR", transactions[0].InstdAmt.Ccy)
+	assert.Equal(t, "1500.50", transactions[0].InstdAmt.Value)
+	assert.Equal(t, "ABNANL2A", transactions[0].BIC)
+	assert.Equal(t, "NL91ABNA0417164300", transactions[0].IBAN)
+	assert.Equal(t, "Jane Doe", transactions[0].Creditor)
+	assert.Equal(t, "Salary June 2025", transactions[0].Ustrd)
+
+	// Accounts without an IBAN or BIC fall back to the local identifiers
+	assert.Equal(t, "", transactions[1].BIC)
+	assert.Equal(t, "014", transactions[1].MmbId)
+	assert.Equal(t, "", transactions[1].IBAN)
+	assert.Equal(t, "1234567890", transactions[1].OthrId)
+}
+
+func TestWritePain001Empty(t *testing.T) {
+	batch := testBatch()
+	batch.Transfers = nil
+
+	err := bankfile.Write(&bytes.Buffer{}, bankfile.FormatPain001, batch)
+	assert.Error(t, err)
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	err := bankfile.Write(&bytes.Buffer{}, bankfile.Format("mt940"), testBatch())
+	assert.Error(t, err)
+}
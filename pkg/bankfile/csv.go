@@ -0,0 +1,51 @@
+package bankfile
+
+import (
+	"encoding/csv"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+var csvHeader = []string{
+	"end_to_end_id",
+	"creditor_name",
+	"creditor_account",
+	"creditor_bank_code",
+	"creditor_bic",
+	"amount",
+	"currency",
+	"execution_date",
+	"remittance_information",
+}
+
+func writeCSV(w io.Writer, batch Batch) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(csvHeader); err != nil {
+		return errors.Wrap(err, "bankfile.writeCSV().Write()")
+	}
+
+	for _, transfer := range batch.Transfers {
+		err := cw.Write([]string{
+			transfer.EndToEndID,
+			transfer.Creditor.Name,
+			transfer.Creditor.AccountNumber,
+			transfer.Creditor.BankCode,
+			transfer.Creditor.BIC,
+			transfer.Amount.String(),
+			batch.Currency,
+			batch.ExecutionDate.Format(dateFormat),
+			transfer.RemittanceInformation,
+		})
+		if err != nil {
+			return errors.Wrap(err, "bankfile.writeCSV().Write()")
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return errors.Wrap(err, "bankfile.writeCSV().Flush()")
+	}
+	return nil
+}
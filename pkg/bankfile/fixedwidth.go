@@ -0,0 +1,107 @@
+package bankfile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	dateFormat        = "2006-01-02"
+	fixedDateFormat   = "20060102"
+	fixedRecordLength = 140
+	fixedLineEnding   = "\r\n"
+)
+
+// The fixed-width layout has a header, one detail record per transfer and a
+// trailer, each exactly fixedRecordLength characters long. Text fields are
+// upper case ASCII padded with spaces on the right, numbers are padded with
+// zeros on the left and amounts are in cents.
+//
+//	Header   H | created YYYYMMDD (8) | execution YYYYMMDD (8) | debtor account (34) | debtor bank code (11) | debtor name (35) | currency (3)
+//	Detail   D | sequence (6) | bank code (11) | account (34) | name (35) | amount (15) | end-to-end ID (35)
+//	Trailer  T | record count (6) | total amount (18)
+func writeFixedWidth(w io.Writer, batch Batch) error {
+	bw := bufio.NewWriter(w)
+
+	records := make([]string, 0, len(batch.Transfers)+2)
+	records = append(records, "H"+
+		batch.CreatedAt.Format(fixedDateFormat)+
+		batch.ExecutionDate.Format(fixedDateFormat)+
+		fixedText(batch.Debtor.AccountNumber, 34)+
+		fixedText(batch.Debtor.BankCode, 11)+
+		fixedText(batch.Debtor.Name, 35)+
+		fixedText(batch.Currency, 3),
+	)
+
+	for i, transfer := range batch.Transfers {
+		amount, err := fixedNumber(transfer.Amount.Cents(), 15)
+		if err != nil {
+			return errors.Wrapf(err, "bankfile.writeFixedWidth() transfer %s", transfer.EndToEndID)
+		}
+		sequence, err := fixedNumber(int64(i+1), 6)
+		if err != nil {
+			return errors.Wrap(err, "bankfile.writeFixedWidth()")
+		}
+		records = append(records, "D"+
+			sequence+
+			fixedText(transfer.Creditor.BankCode, 11)+
+			fixedText(transfer.Creditor.AccountNumber, 34)+
+			fixedText(transfer.Creditor.Name, 35)+
+			amount+
+			fixedText(transfer.EndToEndID, 35),
+		)
+	}
+
+	count, err := fixedNumber(int64(len(batch.Transfers)), 6)
+	if err != nil {
+		return errors.Wrap(err, "bankfile.writeFixedWidth()")
+	}
+	total, err := fixedNumber(batch.Total().Cents(), 18)
+	if err != nil {
+		return errors.Wrap(err, "bankfile.writeFixedWidth()")
+	}
+	records = append(records, "T"+count+total)
+
+	for _, record := range records {
+		if _, err := bw.WriteString(record + strings.Repeat(" ", fixedRecordLength-len(record)) + fixedLineEnding); err != nil {
+			return errors.Wrap(err, "bankfile.writeFixedWidth().WriteString()")
+		}
+	}
+
+	if err := bw.Flush(); err != nil {
+		return errors.Wrap(err, "bankfile.writeFixedWidth().Flush()")
+	}
+	return nil
+}
+
+// fixedText converts a value to the character set of the layout, truncates it
+// and pads it to width.
+func fixedText(value string, width int) string {
+	converted := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == ' ', r == '.', r == '-', r == '/':
+			return r
+		}
+		return ' '
+	}, strings.ToUpper(value))
+
+	if len(converted) > width {
+		return converted[:width]
+	}
+	return converted + strings.Repeat(" ", width-len(converted))
+}
+
+func fixedNumber(value int64, width int) (string, error) {
+	if value < 0 {
+		return "", errors.Errorf("negative amount %d", value)
+	}
+	formatted := fmt.Sprintf("%0*d", width, value)
+	if len(formatted) > width {
+		return "", errors.Errorf("%d does not fit in %d digits", value, width)
+	}
+	return formatted, nil
+}
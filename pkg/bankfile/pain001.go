@@ -0,0 +1,189 @@
+package bankfile
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pain001Namespace      = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+	pain001DateTimeFormat = "2006-01-02T15:04:05"
+
+	// Maximum lengths of the ISO 20022 text types used below
+	max35Text  = 35
+	max70Text  = 70
+	max140Text = 140
+)
+
+type pain001Document struct {
+	XMLName          xml.Name `xml:"Document"`
+	Xmlns            string   `xml:"xmlns,attr"`
+	CstmrCdtTrfInitn struct {
+		GrpHdr struct {
+			MsgId    string `xml:"MsgId"`
+			CreDtTm  string `xml:"CreDtTm"`
+			NbOfTxs  string `xml:"NbOfTxs"`
+			CtrlSum  string `xml:"CtrlSum"`
+			InitgPty struct {
+				Nm string `xml:"Nm"`
+			} `xml:"InitgPty"`
+		} `xml:"GrpHdr"`
+		PmtInf pain001PaymentInformation `xml:"PmtInf"`
+	} `xml:"CstmrCdtTrfInitn"`
+}
+
+type pain001PaymentInformation struct {
+	PmtInfId    string               `xml:"PmtInfId"`
+	PmtMtd      string               `xml:"PmtMtd"`
+	BtchBookg   bool                 `xml:"BtchBookg"`
+	NbOfTxs     string               `xml:"NbOfTxs"`
+	CtrlSum     string               `xml:"CtrlSum"`
+	ReqdExctnDt string               `xml:"ReqdExctnDt"`
+	Dbtr        pain001Party         `xml:"Dbtr"`
+	DbtrAcct    pain001Account       `xml:"DbtrAcct"`
+	DbtrAgt     pain001Agent         `xml:"DbtrAgt"`
+	ChrgBr      string               `xml:"ChrgBr"`
+	CdtTrfTxInf []pain001Transaction `xml:"CdtTrfTxInf"`
+}
+
+type pain001Transaction struct {
+	PmtId struct {
+		EndToEndId string `xml:"EndToEndId"`
+	} `xml:"PmtId"`
+	Amt struct {
+		InstdAmt struct {
+			Ccy   string `xml:"Ccy,attr"`
+			Value string `xml:",chardata"`
+		} `xml:"InstdAmt"`
+	} `xml:"Amt"`
+	CdtrAgt  pain001Agent   `xml:"CdtrAgt"`
+	Cdtr     pain001Party   `xml:"Cdtr"`
+	CdtrAcct pain001Account `xml:"CdtrAcct"`
+	RmtInf   *struct {
+		Ustrd string `xml:"Ustrd"`
+	} `xml:"RmtInf,omitempty"`
+}
+
+type pain001Party struct {
+	Nm string `xml:"Nm"`
+}
+
+type pain001Account struct {
+	Id struct {
+		IBAN string `xml:"IBAN,omitempty"`
+		Othr *struct {
+			Id string `xml:"Id"`
+		} `xml:"Othr,omitempty"`
+	} `xml:"Id"`
+}
+
+type pain001Agent struct {
+	FinInstnId struct {
+		BIC         string `xml:"BIC,omitempty"`
+		ClrSysMmbId *struct {
+			MmbId string `xml:"MmbId"`
+		} `xml:"ClrSysMmbId,omitempty"`
+	} `xml:"FinInstnId"`
+}
+
+func writePain001(w io.Writer, batch Batch) error {
+	if len(batch.Transfers) == 0 {
+		// The schema requires at least one transaction per payment
+		return errors.New("bankfile.writePain001(): a pain.001 batch needs at least one transfer")
+	}
+
+	numberOfTransactions := strconv.Itoa(len(batch.Transfers))
+	controlSum := batch.Total().String()
+
+	var doc pain001Document
+	doc.Xmlns = pain001Namespace
+
+	header := &doc.CstmrCdtTrfInitn.GrpHdr
+	header.MsgId = truncate(batch.MessageID, max35Text)
+	header.CreDtTm = batch.CreatedAt.Format(pain001DateTimeFormat)
+	header.NbOfTxs = numberOfTransactions
+	header.CtrlSum = controlSum
+	header.InitgPty.Nm = truncate(batch.Debtor.Name, max70Text)
+
+	payment := &doc.CstmrCdtTrfInitn.PmtInf
+	payment.PmtInfId = truncate(batch.MessageID, max35Text)
+	payment.PmtMtd = "TRF"
+	payment.BtchBookg = true
+	payment.NbOfTxs = numberOfTransactions
+	payment.CtrlSum = controlSum
+	payment.ReqdExctnDt = batch.ExecutionDate.Format(dateFormat)
+	payment.Dbtr.Nm = truncate(batch.Debtor.Name, max70Text)
+	payment.DbtrAcct = newPain001Account(batch.Debtor.AccountNumber)
+	payment.DbtrAgt = newPain001Agent(batch.Debtor)
+	// Each party bears the charges of its own bank
+	payment.ChrgBr = "SLEV"
+
+	for _, transfer := range batch.Transfers {
+		var transaction pain001Transaction
+		transaction.PmtId.EndToEndId = truncate(transfer.EndToEndID, max35Text)
+		transaction.Amt.InstdAmt.Ccy = batch.Currency
+		transaction.Amt.InstdAmt.Value = transfer.Amount.String()
+		transaction.CdtrAgt = newPain001Agent(transfer.Creditor)
+		transaction.Cdtr.Nm = truncate(transfer.Creditor.Name, max70Text)
+		transaction.CdtrAcct = newPain001Account(transfer.Creditor.AccountNumber)
+		if transfer.RemittanceInformation != "" {
+			transaction.RmtInf = &struct {
+				Ustrd string `xml:"Ustrd"`
+			}{Ustrd: truncate(transfer.RemittanceInformation, max140Text)}
+		}
+		payment.CdtTrfTxInf = append(payment.CdtTrfTxInf, transaction)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return errors.Wrap(err, "bankfile.writePain001().WriteString()")
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return errors.Wrap(err, "bankfile.writePain001().Encode()")
+	}
+	if _, err := io.WriteString(w, "\n"); err != nil {
+		return errors.Wrap(err, "bankfile.writePain001().WriteString()")
+	}
+	return nil
+}
+
+func newPain001Account(accountNumber string) pain001Account {
+	var account pain001Account
+	if IsIBAN(accountNumber) {
+		account.Id.IBAN = accountNumber
+	} else {
+		account.Id.Othr = &struct {
+			Id string `xml:"Id"`
+		}{Id: truncate(accountNumber, max35Text)}
+	}
+	return account
+}
+
+// newPain001Agent identifies the bank by BIC, or by its clearing code where
+// the account has none.
+func newPain001Agent(account Account) pain001Agent {
+	var agent pain001Agent
+	if account.BIC != "" {
+		agent.FinInstnId.BIC = account.BIC
+	} else {
+		agent.FinInstnId.ClrSysMmbId = &struct {
+			MmbId string `xml:"MmbId"`
+		}{MmbId: truncate(account.BankCode, max35Text)}
+	}
+	return agent
+}
+
+// truncate shortens text to at most n characters, counted in runes so that
+// multi-byte characters are never cut in half.
+func truncate(text string, n int) string {
+	runes := []rune(text)
+	if len(runes) <= n {
+		return text
+	}
+	return string(runes[:n])
+}
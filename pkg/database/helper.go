@@ -40,6 +40,40 @@ func IsUniqueViolation(err error, constraintName string) bool {
 	return false
 }
 
+func IsExclusionViolation(err error, constraintName string) bool {
+	if err == nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == "23P01" {
+			if constraintName == "" || pgErr.ConstraintName == constraintName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func IsForeignKeyViolation(err error, constraintName string) bool {
+	if err == nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		if pgErr.Code == "23503" {
+			if constraintName == "" || pgErr.ConstraintName == constraintName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func ExecuteSQLFile(db *sqlx.DB, filePath string) error {
 	content, err := os.ReadFile(filePath)
 	if err != nil {
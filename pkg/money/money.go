@@ -0,0 +1,397 @@
+// Package money provides an exact decimal amount for salaries, payslips and
+// reimbursements.
+//
+// Amounts have a fixed scale of two decimal places, the scale of the
+// NUMERIC(12, 2) columns they are stored in, and are kept as a count of
+// cents. Addition and subtraction are therefore always exact. Multiplying by
+// a rate or dividing can produce fractions of a cent, those operations are
+// calculated exactly with big.Rat and take an explicit RoundingMode for the
+// final cent.
+package money
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Scale is the number of decimal places of an amount.
+const Scale = 2
+
+const centsPerUnit = 100
+
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest cent, ties away from zero.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest cent, ties to the even cent.
+	RoundHalfEven
+	// RoundDown truncates towards zero.
+	RoundDown
+	// RoundUp rounds away from zero.
+	RoundUp
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfUp:
+		return "half_up"
+	case RoundHalfEven:
+		return "half_even"
+	case RoundDown:
+		return "down"
+	case RoundUp:
+		return "up"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(m)) + ")"
+}
+
+// Money is an exact amount with two decimal places. The zero value is zero.
+type Money struct {
+	cents int64
+}
+
+var Zero = Money{}
+
+// New returns an amount of whole units.
+func New(units int64) Money {
+	return Money{cents: units * centsPerUnit}
+}
+
+func FromCents(cents int64) Money {
+	return Money{cents: cents}
+}
+
+// FromRat rounds an exact rational amount to the cent.
+func FromRat(r *big.Rat, mode RoundingMode) Money {
+	cents := new(big.Rat).Mul(r, big.NewRat(centsPerUnit, 1))
+	return Money{cents: round(cents, mode)}
+}
+
+// Parse reads a decimal amount such as "1500", "-12.5" or "0.05". More than
+// two decimal places is an error rather than a silent rounding.
+func Parse(s string) (Money, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return Zero, fmt.Errorf("money: cannot parse empty amount")
+	}
+
+	negative := false
+	switch str[0] {
+	case '-':
+		negative = true
+		str = str[1:]
+	case '+':
+		str = str[1:]
+	}
+
+	whole, fraction, hasFraction := strings.Cut(str, ".")
+	if whole == "" && fraction == "" || hasFraction && fraction == "" {
+		return Zero, fmt.Errorf("money: cannot parse %q", s)
+	}
+	if len(fraction) > Scale {
+		return Zero, fmt.Errorf("money: %q has more than %d decimal places", s, Scale)
+	}
+	for _, digits := range []string{whole, fraction} {
+		for _, c := range digits {
+			if c < '0' || c > '9' {
+				return Zero, fmt.Errorf("money: cannot parse %q", s)
+			}
+		}
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return Zero, fmt.Errorf("money: cannot parse %q: %w", s, err)
+		}
+	}
+	fraction += strings.Repeat("0", Scale-len(fraction))
+	cents, _ := strconv.ParseInt(fraction, 10, 64)
+
+	total := units*centsPerUnit + cents
+	if total/centsPerUnit != units {
+		return Zero, fmt.Errorf("money: %q is out of range", s)
+	}
+	if negative {
+		total = -total
+	}
+
+	return Money{cents: total}, nil
+}
+
+func MustParse(s string) Money {
+	m, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Rate converts a float rate or multiplier into an exact fraction using its
+// shortest decimal representation, so a rate read from a NUMERIC column such
+// as 0.1 is exactly one tenth instead of its binary approximation.
+func Rate(f float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	return r
+}
+
+// Ratio returns the exact fraction num/den.
+func Ratio(num, den int64) *big.Rat {
+	return big.NewRat(num, den)
+}
+
+func (m Money) Cents() int64 {
+	return m.cents
+}
+
+// Rat returns the exact amount as a fraction of units.
+func (m Money) Rat() *big.Rat {
+	return big.NewRat(m.cents, centsPerUnit)
+}
+
+// Float64 is only meant for display purposes such as derived ratios, never
+// feed it back into a calculation.
+func (m Money) Float64() float64 {
+	f, _ := m.Rat().Float64()
+	return f
+}
+
+func (m Money) Add(other Money) Money {
+	return Money{cents: m.cents + other.cents}
+}
+
+func (m Money) Sub(other Money) Money {
+	return Money{cents: m.cents - other.cents}
+}
+
+func (m Money) Neg() Money {
+	return Money{cents: -m.cents}
+}
+
+// MulInt multiplies by a whole number, which is always exact.
+func (m Money) MulInt(n int64) Money {
+	return Money{cents: m.cents * n}
+}
+
+// Mul multiplies by an exact factor and rounds the result to the cent.
+func (m Money) Mul(factor *big.Rat, mode RoundingMode) Money {
+	return FromRat(new(big.Rat).Mul(m.Rat(), factor), mode)
+}
+
+// Div divides by n and rounds the result to the cent. Dividing by zero
+// returns zero.
+func (m Money) Div(n int64, mode RoundingMode) Money {
+	if n == 0 {
+		return Zero
+	}
+	return m.Mul(big.NewRat(1, n), mode)
+}
+
+// Cmp returns -1, 0 or +1 when m is less than, equal to or greater than other.
+func (m Money) Cmp(other Money) int {
+	switch {
+	case m.cents < other.cents:
+		return -1
+	case m.cents > other.cents:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.cents == other.cents
+}
+
+func (m Money) IsZero() bool {
+	return m.cents == 0
+}
+
+func (m Money) IsPositive() bool {
+	return m.cents > 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.cents < 0
+}
+
+func Min(a, b Money) Money {
+	if a.cents < b.cents {
+		return a
+	}
+	return b
+}
+
+func Max(a, b Money) Money {
+	if a.cents > b.cents {
+		return a
+	}
+	return b
+}
+
+func Sum(amounts ...Money) Money {
+	var total Money
+	for _, amount := range amounts {
+		total = total.Add(amount)
+	}
+	return total
+}
+
+// String formats the amount with exactly two decimal places, e.g. "-12.50".
+func (m Money) String() string {
+	cents := m.cents
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+	}
+	abs := new(big.Int).Abs(big.NewInt(cents))
+	units, fraction := new(big.Int).QuoRem(abs, big.NewInt(centsPerUnit), new(big.Int))
+	return fmt.Sprintf("%s%s.%02d", sign, units.String(), fraction.Int64())
+}
+
+// MarshalJSON writes the amount as a JSON number with two decimal places.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON accepts a JSON number or a string holding a number.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	str := string(data)
+	if str == "null" {
+		return fmt.Errorf("money: cannot unmarshal null")
+	}
+	if unquoted, err := strconv.Unquote(str); err == nil {
+		str = unquoted
+	}
+
+	// JSON numbers may use an exponent, resolve it exactly before parsing
+	if strings.ContainsAny(str, "eE") {
+		r, ok := new(big.Rat).SetString(str)
+		if !ok {
+			return fmt.Errorf("money: cannot unmarshal %s", data)
+		}
+		return m.setExact(r)
+	}
+
+	parsed, err := Parse(str)
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
+// Value implements driver.Valuer, the amount is sent as its decimal text.
+func (m Money) Value() (driver.Value, error) {
+	return m.String(), nil
+}
+
+// Scan implements sql.Scanner for drivers that hand out numerics as text or
+// numbers.
+func (m *Money) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		return fmt.Errorf("money: cannot scan NULL")
+	case string:
+		return m.scanString(v)
+	case []byte:
+		return m.scanString(string(v))
+	case int64:
+		*m = New(v)
+		return nil
+	case float64:
+		*m = FromRat(Rate(v), RoundHalfUp)
+		return nil
+	}
+	return fmt.Errorf("money: cannot scan %T", value)
+}
+
+func (m *Money) scanString(s string) error {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return fmt.Errorf("money: cannot scan %q", s)
+	}
+	return m.setExact(r)
+}
+
+// ScanNumeric implements pgtype.NumericScanner so pgx decodes NUMERIC
+// columns without going through text or floats.
+func (m *Money) ScanNumeric(n pgtype.Numeric) error {
+	if !n.Valid {
+		return fmt.Errorf("money: cannot scan NULL")
+	}
+	if n.NaN || n.InfinityModifier != pgtype.Finite {
+		return fmt.Errorf("money: cannot scan a non-finite numeric")
+	}
+
+	r := new(big.Rat).SetInt(n.Int)
+	exp := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(n.Exp))), nil)
+	if n.Exp < 0 {
+		r.Quo(r, new(big.Rat).SetInt(exp))
+	} else {
+		r.Mul(r, new(big.Rat).SetInt(exp))
+	}
+	return m.setExact(r)
+}
+
+// NumericValue implements pgtype.NumericValuer.
+func (m Money) NumericValue() (pgtype.Numeric, error) {
+	return pgtype.Numeric{Int: big.NewInt(m.cents), Exp: -Scale, Valid: true}, nil
+}
+
+// setExact refuses values with fractions of a cent, a stored amount is
+// expected to already have been rounded when it was calculated.
+func (m *Money) setExact(r *big.Rat) error {
+	cents := new(big.Rat).Mul(r, big.NewRat(centsPerUnit, 1))
+	if !cents.IsInt() {
+		return fmt.Errorf("money: %s has more than %d decimal places", r.FloatString(10), Scale)
+	}
+	if !cents.Num().IsInt64() {
+		return fmt.Errorf("money: %s is out of range", r.FloatString(Scale))
+	}
+	*m = Money{cents: cents.Num().Int64()}
+	return nil
+}
+
+func round(r *big.Rat, mode RoundingMode) int64 {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 {
+		return quo.Int64()
+	}
+
+	// sign of the discarded fraction, the quotient is truncated towards zero
+	sign := int64(r.Sign())
+	twiceRem := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2))
+	half := twiceRem.Cmp(r.Denom())
+
+	awayFromZero := false
+	switch mode {
+	case RoundHalfUp:
+		awayFromZero = half >= 0
+	case RoundHalfEven:
+		awayFromZero = half > 0 || half == 0 && quo.Bit(0) == 1
+	case RoundDown:
+		awayFromZero = false
+	case RoundUp:
+		awayFromZero = true
+	}
+
+	if awayFromZero {
+		return quo.Int64() + sign
+	}
+	return quo.Int64()
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,195 @@
+package money_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/goccy/go-json"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  money.Money
+		expectErr bool
+	}{
+		{name: "whole units", input: "1500", expected: money.FromCents(150000)},
+		{name: "one decimal", input: "12.5", expected: money.FromCents(1250)},
+		{name: "two decimals", input: "0.05", expected: money.FromCents(5)},
+		{name: "negative", input: "-3.10", expected: money.FromCents(-310)},
+		{name: "leading dot", input: ".75", expected: money.FromCents(75)},
+		{name: "error - too many decimals", input: "1.005", expectErr: true},
+		{name: "error - not a number", input: "abc", expectErr: true},
+		{name: "error - trailing dot", input: "1.", expectErr: true},
+		{name: "error - empty", input: "", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := money.Parse(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "1500.00", money.New(1500).String())
+	assert.Equal(t, "0.05", money.FromCents(5).String())
+	assert.Equal(t, "-0.50", money.FromCents(-50).String())
+	assert.Equal(t, "-12.34", money.FromCents(-1234).String())
+}
+
+func TestFromRat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *big.Rat
+		mode     money.RoundingMode
+		expected money.Money
+	}{
+		{name: "half up - tie", input: big.NewRat(1005, 1000), mode: money.RoundHalfUp, expected: money.FromCents(101)},
+		{name: "half up - negative tie", input: big.NewRat(-1005, 1000), mode: money.RoundHalfUp, expected: money.FromCents(-101)},
+		{name: "half up - below half", input: big.NewRat(1004, 1000), mode: money.RoundHalfUp, expected: money.FromCents(100)},
+		{name: "half even - tie to even", input: big.NewRat(1005, 1000), mode: money.RoundHalfEven, expected: money.FromCents(100)},
+		{name: "half even - tie to even from odd", input: big.NewRat(1015, 1000), mode: money.RoundHalfEven, expected: money.FromCents(102)},
+		{name: "half even - negative tie", input: big.NewRat(-1015, 1000), mode: money.RoundHalfEven, expected: money.FromCents(-102)},
+		{name: "half even - above half", input: big.NewRat(10051, 10000), mode: money.RoundHalfEven, expected: money.FromCents(101)},
+		{name: "down", input: big.NewRat(1999, 1000), mode: money.RoundDown, expected: money.FromCents(199)},
+		{name: "down - negative", input: big.NewRat(-1999, 1000), mode: money.RoundDown, expected: money.FromCents(-199)},
+		{name: "up", input: big.NewRat(1001, 1000), mode: money.RoundUp, expected: money.FromCents(101)},
+		{name: "up - negative", input: big.NewRat(-1001, 1000), mode: money.RoundUp, expected: money.FromCents(-101)},
+		{name: "exact is never rounded", input: big.NewRat(1, 4), mode: money.RoundUp, expected: money.FromCents(25)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, money.FromRat(tt.input, tt.mode))
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	salary := money.New(1000)
+
+	// 1000 / 21 = 47.619...
+	assert.Equal(t, money.MustParse("47.62"), salary.Div(21, money.RoundHalfUp))
+	assert.Equal(t, money.MustParse("47.61"), salary.Div(21, money.RoundDown))
+	assert.Equal(t, money.Zero, salary.Div(0, money.RoundHalfUp))
+
+	// 0.1 is exactly one tenth, not its binary approximation
+	assert.Equal(t, money.MustParse("0.30"), money.MustParse("3").Mul(money.Rate(0.1), money.RoundDown))
+
+	assert.Equal(t, money.MustParse("1000.30"), salary.Add(money.MustParse("0.30")))
+	assert.Equal(t, money.MustParse("-0.30"), money.Zero.Sub(money.MustParse("0.30")))
+	assert.Equal(t, money.MustParse("3000"), salary.MulInt(3))
+	assert.Equal(t, money.MustParse("0.30"), money.Sum(money.MustParse("0.10"), money.MustParse("0.20")))
+	assert.Equal(t, money.MustParse("0.10"), money.Min(money.MustParse("0.10"), money.MustParse("0.20")))
+	assert.Equal(t, money.MustParse("0.20"), money.Max(money.MustParse("0.10"), money.MustParse("0.20")))
+	assert.Equal(t, -1, money.MustParse("0.10").Cmp(money.MustParse("0.20")))
+	assert.True(t, money.MustParse("-0.01").IsNegative())
+}
+
+func TestJSON(t *testing.T) {
+	type payload struct {
+		Amount money.Money `json:"amount"`
+	}
+
+	encoded, err := json.Marshal(payload{Amount: money.MustParse("150.5")})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":150.50}`, string(encoded))
+
+	tests := []struct {
+		name      string
+		input     string
+		expected  money.Money
+		expectErr bool
+	}{
+		{name: "number", input: `{"amount":150.5}`, expected: money.MustParse("150.50")},
+		{name: "string", input: `{"amount":"150.50"}`, expected: money.MustParse("150.50")},
+		{name: "exponent", input: `{"amount":1.5e2}`, expected: money.MustParse("150")},
+		{name: "error - fraction of a cent", input: `{"amount":150.505}`, expectErr: true},
+		{name: "error - null", input: `{"amount":null}`, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var decoded payload
+			err := json.Unmarshal([]byte(tt.input), &decoded)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, decoded.Amount)
+		})
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     any
+		expected  money.Money
+		expectErr bool
+	}{
+		{name: "numeric text", input: "1234.50", expected: money.MustParse("1234.50")},
+		{name: "bytes", input: []byte("0.01"), expected: money.MustParse("0.01")},
+		{name: "integer", input: int64(12), expected: money.New(12)},
+		{name: "error - fraction of a cent", input: "0.001", expectErr: true},
+		{name: "error - null", input: nil, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m money.Money
+			err := m.Scan(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+}
+
+func TestNumeric(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     pgtype.Numeric
+		expected  money.Money
+		expectErr bool
+	}{
+		{name: "scale two", input: pgtype.Numeric{Int: big.NewInt(123450), Exp: -2, Valid: true}, expected: money.MustParse("1234.50")},
+		{name: "positive exponent", input: pgtype.Numeric{Int: big.NewInt(15), Exp: 2, Valid: true}, expected: money.New(1500)},
+		{name: "trailing zeros beyond scale", input: pgtype.Numeric{Int: big.NewInt(12000), Exp: -4, Valid: true}, expected: money.MustParse("1.20")},
+		{name: "error - fraction of a cent", input: pgtype.Numeric{Int: big.NewInt(1), Exp: -3, Valid: true}, expectErr: true},
+		{name: "error - NaN", input: pgtype.Numeric{NaN: true, Valid: true}, expectErr: true},
+		{name: "error - null", input: pgtype.Numeric{}, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m money.Money
+			err := m.ScanNumeric(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, m)
+		})
+	}
+
+	numeric, err := money.MustParse("-12.34").NumericValue()
+	assert.NoError(t, err)
+	assert.Equal(t, pgtype.Numeric{Int: big.NewInt(-1234), Exp: -2, Valid: true}, numeric)
+}
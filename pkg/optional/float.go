@@ -1,6 +1,9 @@
 package optional
 
-import "database/sql"
+import (
+	"database/sql"
+	"database/sql/driver"
+)
 
 type Float64 struct {
 	Option[float64]
@@ -28,12 +31,12 @@ func NewFloat64FromRef(value *float64) Float64 {
 	return f
 }
 
-func (f Float64) Value() (float64, bool) {
+func (f Float64) Value() (driver.Value, error) {
 	v, ok := f.Get()
 	if !f.IsValueSet() || !ok {
-		return 0, false
+		return nil, nil
 	}
-	return v, true
+	return v, nil
 }
 
 func (f *Float64) Scan(value any) error {
@@ -2,6 +2,7 @@ package optional
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strconv"
 )
@@ -32,12 +33,12 @@ func NewInt64FromRef(value *int64) Int64 {
 	return i
 }
 
-func (i Int64) Value() (int64, bool) {
+func (i Int64) Value() (driver.Value, error) {
 	v, ok := i.Get()
 	if !i.IsValueSet() || !ok {
-		return 0, false
+		return nil, nil
 	}
-	return v, true
+	return v, nil
 }
 
 func (i *Int64) Scan(value any) error {
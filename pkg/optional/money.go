@@ -0,0 +1,58 @@
+package optional
+
+import (
+	"database/sql/driver"
+
+	"github.com/vnnyx/employee-management/pkg/money"
+)
+
+type Money struct {
+	Option[money.Money]
+}
+
+func NewMoney(values ...money.Money) Money {
+	var m Money
+	m.SetEmpty()
+
+	if len(values) > 0 {
+		m.Set(values[0])
+	}
+
+	return m
+}
+
+func NewMoneyFromRef(value *money.Money) Money {
+	var m Money
+	m.SetEmpty()
+
+	if value != nil {
+		m.Set(*value)
+	}
+
+	return m
+}
+
+func (m Money) Value() (driver.Value, error) {
+	v, ok := m.Get()
+	if !m.IsValueSet() || !ok {
+		return nil, nil
+	}
+	return v.Value()
+}
+
+func (m *Money) Scan(value any) error {
+	if value == nil {
+		m.SetEmpty()
+		return nil
+	}
+
+	var amount money.Money
+	err := amount.Scan(value)
+	if err != nil {
+		return err
+	}
+
+	m.Set(amount)
+
+	return nil
+}
@@ -0,0 +1,258 @@
+// Package pdf writes simple single-column PDF documents in process.
+//
+// It supports what generated documents such as payslips need: A4 pages, text
+// in the standard Helvetica fonts, lines and filled rectangles. The standard
+// fonts are built into every PDF reader, so nothing has to be embedded and the
+// output stays small. Text is encoded as WinAnsi, characters outside of it are
+// replaced with a question mark.
+//
+// Coordinates are in points with the origin at the top left corner of the
+// page, y grows downwards.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Font int
+
+const (
+	FontRegular Font = iota
+	FontBold
+)
+
+func (f Font) resourceName() string {
+	if f == FontBold {
+		return "F2"
+	}
+	return "F1"
+}
+
+// Color is an RGB color, each component from 0 to 255.
+type Color struct {
+	R, G, B uint8
+}
+
+var (
+	Black = Color{0, 0, 0}
+	White = Color{255, 255, 255}
+)
+
+func (c Color) operands() string {
+	return formatFloat(float64(c.R)/255) + " " + formatFloat(float64(c.G)/255) + " " + formatFloat(float64(c.B)/255)
+}
+
+// Document is a PDF document under construction.
+type Document struct {
+	title string
+	pages []*Page
+}
+
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+// AddPage appends an empty A4 page and returns it.
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Page is a single page, drawing operations are appended to its content
+// stream in order.
+type Page struct {
+	content bytes.Buffer
+}
+
+// Text draws text with its baseline at y.
+func (p *Page) Text(x, y float64, font Font, size float64, color Color, text string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s rg %s %s Td (%s) Tj ET\n",
+		font.resourceName(),
+		formatFloat(size),
+		color.operands(),
+		formatFloat(x),
+		formatFloat(PageHeight-y),
+		escape(encode(text)),
+	)
+}
+
+// TextRight draws text that ends at x.
+func (p *Page) TextRight(x, y float64, font Font, size float64, color Color, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, color, text)
+}
+
+// Line draws a straight line.
+func (p *Page) Line(x1, y1, x2, y2, width float64, color Color) {
+	fmt.Fprintf(&p.content, "%s w %s RG %s %s m %s %s l S\n",
+		formatFloat(width),
+		color.operands(),
+		formatFloat(x1),
+		formatFloat(PageHeight-y1),
+		formatFloat(x2),
+		formatFloat(PageHeight-y2),
+	)
+}
+
+// Rect fills a rectangle whose top left corner is at x, y.
+func (p *Page) Rect(x, y, width, height float64, color Color) {
+	fmt.Fprintf(&p.content, "%s rg %s %s %s %s re f\n",
+		color.operands(),
+		formatFloat(x),
+		formatFloat(PageHeight-y-height),
+		formatFloat(width),
+		formatFloat(height),
+	)
+}
+
+// TextWidth returns the width of text in points.
+func TextWidth(font Font, size float64, text string) float64 {
+	widths := helveticaWidths
+	if font == FontBold {
+		widths = helveticaBoldWidths
+	}
+
+	var units int
+	for _, b := range encode(text) {
+		if b >= firstWidthChar && int(b-firstWidthChar) < len(widths) {
+			units += widths[b-firstWidthChar]
+		} else {
+			units += defaultWidth
+		}
+	}
+	return float64(units) * size / 1000
+}
+
+// WriteTo writes the complete document.
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	pages := d.pages
+	if len(pages) == 0 {
+		pages = []*Page{{}}
+	}
+
+	// Object numbers: 1 catalog, 2 page tree, 3 and 4 fonts, 5 info, then a
+	// page and its content stream for every page.
+	const firstPageObject = 6
+	objects := make([][]byte, 0, firstPageObject-1+2*len(pages))
+
+	kids := make([]string, 0, len(pages))
+	for i := range pages {
+		kids = append(kids, strconv.Itoa(firstPageObject+2*i)+" 0 R")
+	}
+
+	objects = append(objects,
+		[]byte("<< /Type /Catalog /Pages 2 0 R >>"),
+		[]byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))),
+		[]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"),
+		[]byte("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"),
+		[]byte(fmt.Sprintf("<< /Title (%s) /Producer (employee-management) >>", escape(encode(d.title)))),
+	)
+
+	for i, page := range pages {
+		contentObject := firstPageObject + 2*i + 1
+		objects = append(objects, []byte(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			formatFloat(PageWidth),
+			formatFloat(PageHeight),
+			contentObject,
+		)))
+
+		stream, err := compress(page.content.Bytes())
+		if err != nil {
+			return 0, err
+		}
+		var object bytes.Buffer
+		fmt.Fprintf(&object, "<< /Length %d /Filter /FlateDecode >>\nstream\n", len(stream))
+		object.Write(stream)
+		object.WriteString("\nendstream")
+		objects = append(objects, object.Bytes())
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int, 0, len(objects))
+	for i, object := range objects {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n", i+1)
+		out.Write(object)
+		out.WriteString("\nendobj\n")
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	n, err := w.Write(out.Bytes())
+	return int64(n), err
+}
+
+func compress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// encode converts text to WinAnsi. Latin-1 maps one to one, the few WinAnsi
+// characters in 0x80-0x9F that documents commonly use are mapped explicitly.
+func encode(text string) []byte {
+	out := make([]byte, 0, len(text))
+	for _, r := range text {
+		switch {
+		case r == '\t':
+			out = append(out, ' ')
+		case r >= 0x20 && r < 0x7f, r >= 0xa0 && r <= 0xff:
+			out = append(out, byte(r))
+		case r == '€':
+			out = append(out, 0x80)
+		case r == '–':
+			out = append(out, 0x96)
+		case r == '—':
+			out = append(out, 0x97)
+		case r == '•':
+			out = append(out, 0x95)
+		default:
+			out = append(out, '?')
+		}
+	}
+	return out
+}
+
+func escape(text []byte) string {
+	var sb strings.Builder
+	for _, b := range text {
+		switch b {
+		case '(', ')', '\\':
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(b)
+	}
+	return sb.String()
+}
+
+// formatFloat writes a number with at most three decimals, which is far
+// below what a reader can render.
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(math.Round(f*1000)/1000, 'f', -1, 64)
+}
@@ -0,0 +1,87 @@
+package pdf_test
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/pkg/pdf"
+)
+
+func TestWriteTo(t *testing.T) {
+	doc := pdf.New("Payslip")
+	first := doc.AddPage()
+	first.Rect(0, 0, pdf.PageWidth, 90, pdf.Color{R: 31, G: 58, B: 96})
+	first.Text(48, 50, pdf.FontBold, 20, pdf.White, "Acme (Indonesia)")
+	first.Line(48, 100, 547, 100, 0.5, pdf.Black)
+	second := doc.AddPage()
+	second.TextRight(547, 48, pdf.FontRegular, 10, pdf.Black, "café – 1,000.00")
+
+	var buf bytes.Buffer
+	_, err := doc.WriteTo(&buf)
+	assert.NoError(t, err)
+	out := buf.Bytes()
+
+	assert.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	assert.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	assert.Contains(t, string(out), "/Count 2")
+
+	// Every cross-reference entry points at the start of its object
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	assert.NotNil(t, startxref)
+	xref, err := strconv.Atoi(string(startxref[1]))
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(out[xref:], []byte("xref\n")))
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(out[xref:], -1)
+	assert.Len(t, entries, 9)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")), "object %d", i+1)
+	}
+
+	streams := regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllSubmatch(out, -1)
+	assert.Len(t, streams, 2)
+
+	firstContent := inflate(t, streams[0][1])
+	assert.Contains(t, firstContent, "BT /F2 20 Tf 1 1 1 rg 48 791.89 Td (Acme \\(Indonesia\\)) Tj ET")
+	assert.Contains(t, firstContent, "0.122 0.227 0.376 rg 0 751.89 595.28 90 re f")
+	assert.Contains(t, firstContent, "0.5 w 0 0 0 RG 48 741.89 m 547 741.89 l S")
+
+	secondContent := inflate(t, streams[1][1])
+	assert.Contains(t, secondContent, "(caf\xe9 \x96 1,000.00) Tj")
+}
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		font     pdf.Font
+		size     float64
+		text     string
+		expected float64
+	}{
+		{name: "digits", font: pdf.FontRegular, size: 10, text: "1,000.00", expected: 38.92},
+		{name: "bold is wider", font: pdf.FontBold, size: 10, text: "Gross pay", expected: 48.91},
+		{name: "empty", font: pdf.FontRegular, size: 10, text: "", expected: 0},
+		{name: "outside ascii uses the default width", font: pdf.FontRegular, size: 10, text: "é", expected: 5.56},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, pdf.TextWidth(tt.font, tt.size, tt.text), 0.001)
+		})
+	}
+}
+
+func inflate(t *testing.T, data []byte) string {
+	t.Helper()
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	out, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return string(out)
+}
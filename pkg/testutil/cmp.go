@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/vnnyx/employee-management/pkg/money"
 	"github.com/vnnyx/employee-management/pkg/optional"
 )
 
@@ -17,6 +18,8 @@ func EqualVerbose(x interface{}, y interface{}, opts ...cmp.Option) bool {
 		comparerForOptional[bool](),
 		comparerForOptional[string](),
 		comparerForOptional[time.Duration](),
+		comparerForOptional[time.Time](),
+		comparerForOptional[money.Money](),
 
 		comparerForWrapper[int64, optional.Int64](),
 		comparerForWrapper[int32, optional.Int32](),
@@ -25,6 +28,8 @@ func EqualVerbose(x interface{}, y interface{}, opts ...cmp.Option) bool {
 		comparerForWrapper[bool, optional.Bool](),
 		comparerForWrapper[string, optional.String](),
 		comparerForWrapper[time.Duration, optional.Duration](),
+		comparerForWrapper[time.Time, optional.Time](),
+		comparerForWrapper[money.Money, optional.Money](),
 	)
 
 	if diff := cmp.Diff(x, y, opts...); diff != "" {
@@ -43,7 +48,7 @@ func comparerForOptional[T comparable]() cmp.Option {
 		if !aOk {
 			return true
 		}
-		return aVal == bVal
+		return equalValue(aVal, bVal)
 	})
 }
 
@@ -57,6 +62,15 @@ func comparerForWrapper[T comparable, W interface {Get() (T, bool)}]() cmp.Optio
 		if !aok {
 			return true
 		}
-		return av == bv
+		return equalValue(av, bv)
 	})
 }
+
+// equalValue compares times by the instant they are, times loaded in
+// different *time.Location values are otherwise never equal.
+func equalValue[T comparable](a, b T) bool {
+	if aTime, ok := any(a).(time.Time); ok {
+		return aTime.Equal(any(b).(time.Time))
+	}
+	return a == b
+}
@@ -147,6 +147,20 @@ func TestEqualVerbose(t *testing.T) {
 			expected: false,
 		},
 
+		// Time
+		{
+			name:     "Equal optional.Time",
+			x:        optional.NewTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+			y:        optional.NewTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+			expected: true,
+		},
+		{
+			name:     "Empty vs non-empty optional.Time",
+			x:        optional.NewTime(),
+			y:        optional.NewTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)),
+			expected: false,
+		},
+
 		{
 			name:     "Equal Option[int32]",
 			x:        optional.Option[int32]{}.SetAndReturn(123),
@@ -0,0 +1,36 @@
+// Package timezone resolves the IANA timezones employees work in and the
+// local dates their attendance and overtime are recorded on.
+//
+// The timezone database is embedded, containers without zoneinfo installed
+// still load every timezone.
+package timezone
+
+import (
+	"errors"
+	"time"
+	_ "time/tzdata"
+)
+
+// Default is the timezone of users who were not given one.
+const Default = "UTC"
+
+var errLocalTimezone = errors.New("the timezone of the server cannot be used, name an IANA timezone")
+
+// Load resolves an IANA name such as Asia/Jakarta, an empty name is Default.
+func Load(name string) (*time.Location, error) {
+	if name == "" {
+		name = Default
+	}
+	if name == "Local" {
+		return nil, errLocalTimezone
+	}
+	return time.LoadLocation(name)
+}
+
+// Date is the calendar date t falls on in its own location. It is returned as
+// midnight UTC, so the same DATE is stored and compared whatever timezone the
+// server runs in.
+func Date(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
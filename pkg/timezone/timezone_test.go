@@ -0,0 +1,87 @@
+package timezone_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vnnyx/employee-management/pkg/timezone"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{name: "IANA name", input: "Asia/Jakarta", expected: "Asia/Jakarta"},
+		{name: "empty is the default", input: "", expected: "UTC"},
+		{name: "error - server timezone", input: "Local", expectErr: true},
+		{name: "error - unknown", input: "Mars/Olympus_Mons", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := timezone.Load(tt.input)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, loc.String())
+		})
+	}
+}
+
+func TestDate(t *testing.T) {
+	newYork, err := timezone.Load("America/New_York")
+	assert.NoError(t, err)
+	jakarta, err := timezone.Load("Asia/Jakarta")
+	assert.NoError(t, err)
+	sydney, err := timezone.Load("Australia/Sydney")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		input    time.Time
+		expected time.Time
+	}{
+		{
+			name:     "ahead of UTC, already the next day",
+			input:    time.Date(2025, 6, 4, 23, 30, 0, 0, time.UTC).In(jakarta),
+			expected: time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "behind UTC, still the previous day",
+			input:    time.Date(2025, 6, 5, 2, 0, 0, 0, time.UTC).In(newYork),
+			expected: time.Date(2025, 6, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "last minute before clocks spring forward",
+			input:    time.Date(2025, 3, 9, 1, 59, 0, 0, newYork),
+			expected: time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "after clocks spring forward, the day has 23 hours",
+			input:    time.Date(2025, 3, 9, 23, 59, 0, 0, newYork),
+			expected: time.Date(2025, 3, 9, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "repeated hour when clocks fall back",
+			input:    time.Date(2025, 11, 2, 5, 30, 0, 0, time.UTC).In(newYork), // second 01:30
+			expected: time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "midnight after a southern hemisphere fall back",
+			input:    time.Date(2025, 4, 6, 0, 0, 0, 0, sydney),
+			expected: time.Date(2025, 4, 6, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, timezone.Date(tt.input))
+		})
+	}
+}